@@ -143,10 +143,13 @@ type HealthCheck_Conf_Active struct {
 	// unhealthy.
 	UnhealthyThreshold uint32 `protobuf:"varint,3,opt,name=unhealthy_threshold,json=unhealthyThreshold,proto3" json:"unhealthy_threshold,omitempty"`
 	// Number of consecutive healthy checks before considering a host healthy.
-	HealthyThreshold     uint32   `protobuf:"varint,4,opt,name=healthy_threshold,json=healthyThreshold,proto3" json:"healthy_threshold,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	HealthyThreshold uint32 `protobuf:"varint,4,opt,name=healthy_threshold,json=healthyThreshold,proto3" json:"healthy_threshold,omitempty"`
+	// Configuration for HTTP health checks. If not set, TCP health checks are
+	// performed.
+	Http                 *HealthCheck_Conf_Active_Http `protobuf:"bytes,5,opt,name=http,proto3" json:"http,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
 func (m *HealthCheck_Conf_Active) Reset()         { *m = HealthCheck_Conf_Active{} }
@@ -202,6 +205,54 @@ func (m *HealthCheck_Conf_Active) GetHealthyThreshold() uint32 {
 	return 0
 }
 
+func (m *HealthCheck_Conf_Active) GetHttp() *HealthCheck_Conf_Active_Http {
+	if m != nil {
+		return m.Http
+	}
+	return nil
+}
+
+// Http defines configuration for HTTP health checks.
+type HealthCheck_Conf_Active_Http struct {
+	// Path of HTTP requests sent to health checked hosts.
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HealthCheck_Conf_Active_Http) Reset()         { *m = HealthCheck_Conf_Active_Http{} }
+func (m *HealthCheck_Conf_Active_Http) String() string { return proto.CompactTextString(m) }
+func (*HealthCheck_Conf_Active_Http) ProtoMessage()    {}
+func (*HealthCheck_Conf_Active_Http) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4f9382814224e98, []int{0, 0, 0, 0}
+}
+
+func (m *HealthCheck_Conf_Active_Http) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HealthCheck_Conf_Active_Http.Unmarshal(m, b)
+}
+func (m *HealthCheck_Conf_Active_Http) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HealthCheck_Conf_Active_Http.Marshal(b, m, deterministic)
+}
+func (m *HealthCheck_Conf_Active_Http) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthCheck_Conf_Active_Http.Merge(m, src)
+}
+func (m *HealthCheck_Conf_Active_Http) XXX_Size() int {
+	return xxx_messageInfo_HealthCheck_Conf_Active_Http.Size(m)
+}
+func (m *HealthCheck_Conf_Active_Http) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthCheck_Conf_Active_Http.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthCheck_Conf_Active_Http proto.InternalMessageInfo
+
+func (m *HealthCheck_Conf_Active_Http) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
 // Passive defines configuration for passive health checking.
 type HealthCheck_Conf_Passive struct {
 	// Number of consecutive failed requests before considering a host
@@ -258,39 +309,42 @@ func init() {
 	proto.RegisterType((*HealthCheck)(nil), "kuma.mesh.v1alpha1.HealthCheck")
 	proto.RegisterType((*HealthCheck_Conf)(nil), "kuma.mesh.v1alpha1.HealthCheck.Conf")
 	proto.RegisterType((*HealthCheck_Conf_Active)(nil), "kuma.mesh.v1alpha1.HealthCheck.Conf.Active")
+	proto.RegisterType((*HealthCheck_Conf_Active_Http)(nil), "kuma.mesh.v1alpha1.HealthCheck.Conf.Active.Http")
 	proto.RegisterType((*HealthCheck_Conf_Passive)(nil), "kuma.mesh.v1alpha1.HealthCheck.Conf.Passive")
 }
 
 func init() { proto.RegisterFile("mesh/v1alpha1/health_check.proto", fileDescriptor_a4f9382814224e98) }
 
 var fileDescriptor_a4f9382814224e98 = []byte{
-	// 441 bytes of a gzipped FileDescriptorProto
+	// 478 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xe7, 0x2e, 0x34, 0xd1, 0xeb, 0x0a, 0xc3, 0x1c, 0x28, 0xd1, 0x84, 0x22, 0xc4, 0x61,
-	0x1a, 0xc8, 0xd1, 0x06, 0x87, 0xdd, 0x60, 0x19, 0x07, 0xe0, 0x54, 0x65, 0x9c, 0xb8, 0x54, 0x5e,
-	0xe2, 0x2e, 0xd1, 0xdc, 0x38, 0xb2, 0x9d, 0xa0, 0x7d, 0x0d, 0x24, 0x6e, 0x7c, 0x02, 0x3e, 0x07,
-	0x9f, 0xaa, 0xe2, 0x80, 0x62, 0x3b, 0xd5, 0xca, 0x98, 0xd6, 0xdd, 0x62, 0xbd, 0xf7, 0xfb, 0xbd,
-	0xbf, 0xfd, 0x02, 0xd1, 0x82, 0xa9, 0x22, 0x6e, 0x0f, 0x29, 0xaf, 0x0b, 0x7a, 0x18, 0x17, 0x8c,
-	0x72, 0x5d, 0xcc, 0xb2, 0x82, 0x65, 0x97, 0xa4, 0x96, 0x42, 0x0b, 0x8c, 0x2f, 0x9b, 0x05, 0x25,
-	0x5d, 0x1b, 0xe9, 0xdb, 0xc2, 0xbd, 0x75, 0x4a, 0x31, 0xce, 0x32, 0x2d, 0xa4, 0x25, 0xc2, 0xe7,
-	0x17, 0x42, 0x5c, 0x70, 0x16, 0x9b, 0xd3, 0x79, 0x33, 0x8f, 0xf3, 0x46, 0x52, 0x5d, 0x8a, 0xea,
-	0xb6, 0xfa, 0x37, 0x49, 0xeb, 0x9a, 0x49, 0xe5, 0xea, 0x4f, 0x5b, 0xca, 0xcb, 0x9c, 0x6a, 0x16,
-	0xf7, 0x1f, 0xb6, 0xf0, 0xe2, 0xe7, 0x10, 0x46, 0x1f, 0x4d, 0xc2, 0xd3, 0x2e, 0x20, 0x7e, 0x0f,
-	0xbe, 0x12, 0x8d, 0xcc, 0x98, 0x9a, 0xa0, 0x68, 0x7b, 0x7f, 0x74, 0xb4, 0x47, 0x6e, 0x86, 0x25,
-	0x67, 0x2e, 0x5d, 0x12, 0x2c, 0x93, 0x07, 0xdf, 0xd1, 0x20, 0x40, 0x69, 0x8f, 0xe1, 0xcf, 0xb0,
-	0x93, 0x33, 0xa5, 0xcb, 0xca, 0xe4, 0x53, 0x93, 0xc1, 0xbd, 0x34, 0x6b, 0x2c, 0x3e, 0x06, 0x2f,
-	0x13, 0xd5, 0x7c, 0xb2, 0x1d, 0xa1, 0xfd, 0xd1, 0xd1, 0xcb, 0xff, 0x39, 0xae, 0x85, 0x27, 0xa7,
-	0xa2, 0x9a, 0xa7, 0x86, 0x08, 0x7f, 0x7b, 0xe0, 0x75, 0x47, 0x3c, 0x85, 0x31, 0xcd, 0x74, 0xd9,
-	0x32, 0xbb, 0x81, 0xee, 0x5a, 0x9d, 0xeb, 0xd5, 0x26, 0x2e, 0x72, 0x62, 0xc8, 0x74, 0xc7, 0x1a,
-	0x4c, 0x41, 0xe1, 0x33, 0x78, 0x58, 0x53, 0xa5, 0xae, 0x29, 0x07, 0x46, 0xf9, 0x7a, 0x23, 0xe5,
-	0xd4, 0xa2, 0xe9, 0xd8, 0x39, 0xac, 0x34, 0xfc, 0x83, 0x60, 0x68, 0xa7, 0xe1, 0x13, 0x08, 0xca,
-	0x4a, 0x33, 0xd9, 0x52, 0xee, 0xc2, 0x3e, 0x23, 0x76, 0xbd, 0xa4, 0x5f, 0x2f, 0xf9, 0xe0, 0xd6,
-	0x9f, 0xc0, 0x32, 0xf1, 0x7f, 0x21, 0x2f, 0x40, 0x07, 0x5b, 0xe9, 0x0a, 0xc3, 0xef, 0xc0, 0xd7,
-	0xe5, 0x82, 0x89, 0x46, 0xbb, 0x6c, 0x1b, 0x1a, 0x7a, 0x0a, 0x1f, 0xc3, 0x93, 0xa6, 0xb2, 0x7f,
-	0xee, 0xd5, 0x4c, 0x17, 0x92, 0xa9, 0x42, 0xf0, 0xdc, 0xec, 0x61, 0x9c, 0xf8, 0xcb, 0xc4, 0x3b,
-	0x18, 0x44, 0x5b, 0x29, 0x5e, 0xf5, 0x7c, 0xe9, 0x5b, 0xf0, 0x5b, 0x78, 0x7c, 0x93, 0xf3, 0xd6,
-	0xb9, 0xdd, 0x7f, 0xa9, 0xf0, 0x07, 0x02, 0xdf, 0xbd, 0xcc, 0x6d, 0xb3, 0xd1, 0xdd, 0xb3, 0xa7,
-	0xb0, 0x5b, 0xb3, 0x8a, 0x72, 0x7d, 0x35, 0x5b, 0xbd, 0xe0, 0xbd, 0xee, 0xff, 0xc8, 0xe1, 0x9f,
-	0x1c, 0x9d, 0xc0, 0xd7, 0xa0, 0x5f, 0xe5, 0xf9, 0xd0, 0xb0, 0x6f, 0xfe, 0x06, 0x00, 0x00, 0xff,
-	0xff, 0x55, 0xd7, 0x1e, 0xcf, 0xe0, 0x03, 0x00, 0x00,
+	0x14, 0xc7, 0x97, 0x2c, 0x5d, 0xc2, 0xeb, 0x0a, 0xc5, 0x1c, 0x08, 0x61, 0x42, 0x15, 0x70, 0x98,
+	0x06, 0x72, 0xd8, 0xe0, 0xb0, 0x1b, 0x2c, 0xdb, 0x61, 0x70, 0xaa, 0x32, 0x4e, 0x5c, 0x2a, 0x2f,
+	0x75, 0xe7, 0x68, 0x69, 0x6c, 0xd9, 0x4e, 0xd1, 0x3e, 0x01, 0x77, 0x24, 0xbe, 0x04, 0x17, 0x3e,
+	0x16, 0xdf, 0xa1, 0x27, 0x14, 0x3b, 0xa9, 0x56, 0x95, 0x69, 0xed, 0x2d, 0x2f, 0xef, 0xfd, 0xfe,
+	0xfe, 0x3f, 0xff, 0x0d, 0x83, 0x29, 0x55, 0x2c, 0x9e, 0x1d, 0x92, 0x42, 0x30, 0x72, 0x18, 0x33,
+	0x4a, 0x0a, 0xcd, 0x46, 0x19, 0xa3, 0xd9, 0x35, 0x16, 0x92, 0x6b, 0x8e, 0xd0, 0x75, 0x35, 0x25,
+	0xb8, 0x1e, 0xc3, 0xed, 0x58, 0xb4, 0xb7, 0x4c, 0x29, 0x5a, 0xd0, 0x4c, 0x73, 0x69, 0x89, 0xe8,
+	0xc5, 0x15, 0xe7, 0x57, 0x05, 0x8d, 0x4d, 0x75, 0x59, 0x4d, 0xe2, 0x71, 0x25, 0x89, 0xce, 0x79,
+	0x79, 0x57, 0xff, 0xbb, 0x24, 0x42, 0x50, 0xa9, 0x9a, 0xfe, 0xd3, 0x19, 0x29, 0xf2, 0x31, 0xd1,
+	0x34, 0x6e, 0x3f, 0x6c, 0xe3, 0xe5, 0x0f, 0x1f, 0xba, 0xe7, 0xc6, 0xe1, 0x69, 0x6d, 0x10, 0x7d,
+	0x02, 0x5f, 0xf1, 0x4a, 0x66, 0x54, 0x85, 0xce, 0x60, 0x7b, 0xbf, 0x7b, 0xb4, 0x87, 0x57, 0xcd,
+	0xe2, 0x8b, 0xc6, 0x5d, 0x12, 0xcc, 0x93, 0xce, 0x4f, 0xc7, 0x0d, 0x9c, 0xb4, 0xc5, 0xd0, 0x17,
+	0xd8, 0x1d, 0x53, 0xa5, 0xf3, 0xd2, 0xf8, 0x53, 0xa1, 0xbb, 0x91, 0xcc, 0x12, 0x8b, 0x8e, 0xc1,
+	0xcb, 0x78, 0x39, 0x09, 0xb7, 0x07, 0xce, 0x7e, 0xf7, 0xe8, 0xf5, 0xff, 0x34, 0x6e, 0x99, 0xc7,
+	0xa7, 0xbc, 0x9c, 0xa4, 0x86, 0x88, 0xfe, 0x74, 0xc0, 0xab, 0x4b, 0x34, 0x84, 0x1e, 0xc9, 0x74,
+	0x3e, 0xa3, 0x36, 0x81, 0x7a, 0xad, 0x5a, 0xeb, 0xcd, 0x3a, 0x5a, 0xf8, 0xc4, 0x90, 0xe9, 0xae,
+	0x55, 0x30, 0x0d, 0x85, 0x2e, 0xe0, 0xa1, 0x20, 0x4a, 0xdd, 0x92, 0x74, 0x8d, 0xe4, 0xdb, 0xb5,
+	0x24, 0x87, 0x16, 0x4d, 0x7b, 0x8d, 0x86, 0x15, 0x8d, 0xfe, 0xba, 0xb0, 0x63, 0x4f, 0x43, 0x27,
+	0x10, 0xe4, 0xa5, 0xa6, 0x72, 0x46, 0x8a, 0xc6, 0xec, 0x33, 0x6c, 0xe3, 0xc5, 0x6d, 0xbc, 0xf8,
+	0xac, 0x89, 0x3f, 0x81, 0x79, 0xe2, 0xff, 0x76, 0xbc, 0xc0, 0x39, 0xd8, 0x4a, 0x17, 0x18, 0xfa,
+	0x08, 0xbe, 0xce, 0xa7, 0x94, 0x57, 0x3a, 0x74, 0x37, 0x51, 0x68, 0x29, 0x74, 0x0c, 0x4f, 0xaa,
+	0xd2, 0xbe, 0xdc, 0x9b, 0x91, 0x66, 0x92, 0x2a, 0xc6, 0x8b, 0xb1, 0xc9, 0xa1, 0x97, 0xf8, 0xf3,
+	0xc4, 0x3b, 0x70, 0x07, 0x5b, 0x29, 0x5a, 0xcc, 0x7c, 0x6d, 0x47, 0xd0, 0x07, 0x78, 0xbc, 0xca,
+	0x79, 0xcb, 0x5c, 0x7f, 0x85, 0x3a, 0x03, 0x8f, 0x69, 0x2d, 0xc2, 0x8e, 0x71, 0xfb, 0x6e, 0x83,
+	0x70, 0xf0, 0xb9, 0xd6, 0x22, 0x35, 0x74, 0xf4, 0x0a, 0xbc, 0xba, 0x42, 0xcf, 0xc1, 0x13, 0x44,
+	0x33, 0x73, 0x7b, 0x0f, 0xcc, 0xb1, 0xd2, 0xed, 0x3b, 0xa9, 0xf9, 0x19, 0xfd, 0x72, 0xc0, 0x6f,
+	0x42, 0xb8, 0x6b, 0x4d, 0xe7, 0xfe, 0x35, 0x87, 0xd0, 0x17, 0xb4, 0x24, 0x85, 0xbe, 0x19, 0x2d,
+	0xc2, 0xda, 0xe8, 0xaa, 0x1f, 0x35, 0xf8, 0xe7, 0x86, 0x4e, 0xe0, 0x5b, 0xd0, 0xee, 0x7a, 0xb9,
+	0x63, 0xd8, 0xf7, 0xff, 0x06, 0x00, 0xb6, 0xe8, 0x16, 0xda, 0x4b, 0x04, 0x00, 0x00,
 }
@@ -318,6 +318,16 @@ func (m *HealthCheck_Conf_Active) Validate() error {
 		}
 	}
 
+	if v, ok := interface{}(m.GetHttp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HealthCheck_Conf_ActiveValidationError{
+				field:  "Http",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -377,6 +387,81 @@ var _ interface {
 	ErrorName() string
 } = HealthCheck_Conf_ActiveValidationError{}
 
+// Validate checks the field values on HealthCheck_Conf_Active_Http with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *HealthCheck_Conf_Active_Http) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetPath()) < 1 {
+		return HealthCheck_Conf_Active_HttpValidationError{
+			field:  "Path",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// HealthCheck_Conf_Active_HttpValidationError is the validation error
+// returned by HealthCheck_Conf_Active_Http.Validate if the designated
+// constraints aren't met.
+type HealthCheck_Conf_Active_HttpValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HealthCheck_Conf_Active_HttpValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HealthCheck_Conf_Active_HttpValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HealthCheck_Conf_Active_HttpValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HealthCheck_Conf_Active_HttpValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HealthCheck_Conf_Active_HttpValidationError) ErrorName() string {
+	return "HealthCheck_Conf_Active_HttpValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HealthCheck_Conf_Active_HttpValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHealthCheck_Conf_Active_Http.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HealthCheck_Conf_Active_HttpValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HealthCheck_Conf_Active_HttpValidationError{}
+
 // Validate checks the field values on HealthCheck_Conf_Passive with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -6,6 +6,7 @@ package v1alpha1
 import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	_struct "github.com/golang/protobuf/ptypes/struct"
 	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	math "math"
@@ -39,10 +40,13 @@ type Mesh struct {
 	// Additionally, it is also possible to further customize this configuration
 	// for each dataplane individually using Dataplane resource.
 	// +optional
-	Metrics              *Metrics `protobuf:"bytes,4,opt,name=metrics,proto3" json:"metrics,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Metrics *Metrics `protobuf:"bytes,4,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	// Networking settings.
+	// +optional
+	Networking           *Mesh_Networking `protobuf:"bytes,5,opt,name=networking,proto3" json:"networking,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *Mesh) Reset()         { *m = Mesh{} }
@@ -98,15 +102,33 @@ func (m *Mesh) GetMetrics() *Metrics {
 	return nil
 }
 
+func (m *Mesh) GetNetworking() *Mesh_Networking {
+	if m != nil {
+		return m.Networking
+	}
+	return nil
+}
+
 // mTLS settings of a Mesh.
 type Mesh_Mtls struct {
 	// Name of the enabled backend
 	EnabledBackend string `protobuf:"bytes,1,opt,name=enabledBackend,proto3" json:"enabledBackend,omitempty"`
 	// List of available Certificate Authority backends
-	Backends             []*CertificateAuthorityBackend `protobuf:"bytes,2,rep,name=backends,proto3" json:"backends,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
-	XXX_unrecognized     []byte                         `json:"-"`
-	XXX_sizecache        int32                          `json:"-"`
+	Backends []*CertificateAuthorityBackend `protobuf:"bytes,2,rep,name=backends,proto3" json:"backends,omitempty"`
+	// Rotation settings.
+	// +optional
+	Rotation *Mesh_Mtls_Rotation `protobuf:"bytes,3,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	// TLS settings.
+	// +optional
+	Tls *Mesh_Mtls_Tls `protobuf:"bytes,4,opt,name=tls,proto3" json:"tls,omitempty"`
+	// Dataplanes whose inbound interfaces match any of the selectors are
+	// exempt from mTLS, e.g. legacy services during a migration to mTLS.
+	// Their inbound listeners accept plain text connections besides mTLS ones.
+	// +optional
+	Exclusions           []*Selector `protobuf:"bytes,5,rep,name=exclusions,proto3" json:"exclusions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *Mesh_Mtls) Reset()         { *m = Mesh_Mtls{} }
@@ -148,12 +170,220 @@ func (m *Mesh_Mtls) GetBackends() []*CertificateAuthorityBackend {
 	return nil
 }
 
+func (m *Mesh_Mtls) GetRotation() *Mesh_Mtls_Rotation {
+	if m != nil {
+		return m.Rotation
+	}
+	return nil
+}
+
+func (m *Mesh_Mtls) GetTls() *Mesh_Mtls_Tls {
+	if m != nil {
+		return m.Tls
+	}
+	return nil
+}
+
+func (m *Mesh_Mtls) GetExclusions() []*Selector {
+	if m != nil {
+		return m.Exclusions
+	}
+	return nil
+}
+
+// Rotation settings of certificates issued to dataplanes.
+type Mesh_Mtls_Rotation struct {
+	// Lifetime of a dataplane certificate. Default: 720h
+	Expiration *duration.Duration `protobuf:"bytes,1,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// How long before expiration a dataplane certificate is rotated.
+	// Must be shorter than expiration. Default: 24h
+	RotationWindow       *duration.Duration `protobuf:"bytes,2,opt,name=rotationWindow,proto3" json:"rotationWindow,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *Mesh_Mtls_Rotation) Reset()         { *m = Mesh_Mtls_Rotation{} }
+func (m *Mesh_Mtls_Rotation) String() string { return proto.CompactTextString(m) }
+func (*Mesh_Mtls_Rotation) ProtoMessage()    {}
+func (*Mesh_Mtls_Rotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{0, 0, 0}
+}
+
+func (m *Mesh_Mtls_Rotation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Mesh_Mtls_Rotation.Unmarshal(m, b)
+}
+func (m *Mesh_Mtls_Rotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Mesh_Mtls_Rotation.Marshal(b, m, deterministic)
+}
+func (m *Mesh_Mtls_Rotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Mesh_Mtls_Rotation.Merge(m, src)
+}
+func (m *Mesh_Mtls_Rotation) XXX_Size() int {
+	return xxx_messageInfo_Mesh_Mtls_Rotation.Size(m)
+}
+func (m *Mesh_Mtls_Rotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Mesh_Mtls_Rotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Mesh_Mtls_Rotation proto.InternalMessageInfo
+
+func (m *Mesh_Mtls_Rotation) GetExpiration() *duration.Duration {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *Mesh_Mtls_Rotation) GetRotationWindow() *duration.Duration {
+	if m != nil {
+		return m.RotationWindow
+	}
+	return nil
+}
+
+// TLS settings of connections between dataplanes.
+type Mesh_Mtls_Tls struct {
+	// Minimum TLS version. One of TLSv1_2, TLSv1_3. Default: TLSv1_2
+	MinVersion string `protobuf:"bytes,1,opt,name=minVersion,proto3" json:"minVersion,omitempty"`
+	// Cipher suites allowed for TLS 1.2, in order of preference.
+	// Default: ECDHE-ECDSA-AES128-GCM-SHA256, ECDHE-RSA-AES128-GCM-SHA256,
+	// ECDHE-ECDSA-AES256-GCM-SHA384, ECDHE-RSA-AES256-GCM-SHA384,
+	// ECDHE-ECDSA-CHACHA20-POLY1305, ECDHE-RSA-CHACHA20-POLY1305
+	CipherSuites         []string `protobuf:"bytes,2,rep,name=cipherSuites,proto3" json:"cipherSuites,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Mesh_Mtls_Tls) Reset()         { *m = Mesh_Mtls_Tls{} }
+func (m *Mesh_Mtls_Tls) String() string { return proto.CompactTextString(m) }
+func (*Mesh_Mtls_Tls) ProtoMessage()    {}
+func (*Mesh_Mtls_Tls) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{0, 0, 1}
+}
+
+func (m *Mesh_Mtls_Tls) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Mesh_Mtls_Tls.Unmarshal(m, b)
+}
+func (m *Mesh_Mtls_Tls) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Mesh_Mtls_Tls.Marshal(b, m, deterministic)
+}
+func (m *Mesh_Mtls_Tls) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Mesh_Mtls_Tls.Merge(m, src)
+}
+func (m *Mesh_Mtls_Tls) XXX_Size() int {
+	return xxx_messageInfo_Mesh_Mtls_Tls.Size(m)
+}
+func (m *Mesh_Mtls_Tls) XXX_DiscardUnknown() {
+	xxx_messageInfo_Mesh_Mtls_Tls.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Mesh_Mtls_Tls proto.InternalMessageInfo
+
+func (m *Mesh_Mtls_Tls) GetMinVersion() string {
+	if m != nil {
+		return m.MinVersion
+	}
+	return ""
+}
+
+func (m *Mesh_Mtls_Tls) GetCipherSuites() []string {
+	if m != nil {
+		return m.CipherSuites
+	}
+	return nil
+}
+
+// Networking settings of a Mesh.
+type Mesh_Networking struct {
+	// Outbound settings.
+	Outbound             *Mesh_Networking_Outbound `protobuf:"bytes,1,opt,name=outbound,proto3" json:"outbound,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *Mesh_Networking) Reset()         { *m = Mesh_Networking{} }
+func (m *Mesh_Networking) String() string { return proto.CompactTextString(m) }
+func (*Mesh_Networking) ProtoMessage()    {}
+func (*Mesh_Networking) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{0, 1}
+}
+
+func (m *Mesh_Networking) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Mesh_Networking.Unmarshal(m, b)
+}
+func (m *Mesh_Networking) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Mesh_Networking.Marshal(b, m, deterministic)
+}
+func (m *Mesh_Networking) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Mesh_Networking.Merge(m, src)
+}
+func (m *Mesh_Networking) XXX_Size() int {
+	return xxx_messageInfo_Mesh_Networking.Size(m)
+}
+func (m *Mesh_Networking) XXX_DiscardUnknown() {
+	xxx_messageInfo_Mesh_Networking.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Mesh_Networking proto.InternalMessageInfo
+
+func (m *Mesh_Networking) GetOutbound() *Mesh_Networking_Outbound {
+	if m != nil {
+		return m.Outbound
+	}
+	return nil
+}
+
+// Outbound settings of a Mesh.
+type Mesh_Networking_Outbound struct {
+	// Control whether traffic to destinations unknown to the Mesh is passed
+	// through or blocked. Default: true
+	Passthrough          *wrappers.BoolValue `protobuf:"bytes,1,opt,name=passthrough,proto3" json:"passthrough,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *Mesh_Networking_Outbound) Reset()         { *m = Mesh_Networking_Outbound{} }
+func (m *Mesh_Networking_Outbound) String() string { return proto.CompactTextString(m) }
+func (*Mesh_Networking_Outbound) ProtoMessage()    {}
+func (*Mesh_Networking_Outbound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{0, 1, 0}
+}
+
+func (m *Mesh_Networking_Outbound) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Mesh_Networking_Outbound.Unmarshal(m, b)
+}
+func (m *Mesh_Networking_Outbound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Mesh_Networking_Outbound.Marshal(b, m, deterministic)
+}
+func (m *Mesh_Networking_Outbound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Mesh_Networking_Outbound.Merge(m, src)
+}
+func (m *Mesh_Networking_Outbound) XXX_Size() int {
+	return xxx_messageInfo_Mesh_Networking_Outbound.Size(m)
+}
+func (m *Mesh_Networking_Outbound) XXX_DiscardUnknown() {
+	xxx_messageInfo_Mesh_Networking_Outbound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Mesh_Networking_Outbound proto.InternalMessageInfo
+
+func (m *Mesh_Networking_Outbound) GetPassthrough() *wrappers.BoolValue {
+	if m != nil {
+		return m.Passthrough
+	}
+	return nil
+}
+
 // CertificateAuthorityBackend defines Certificate Authority backend
 type CertificateAuthorityBackend struct {
 	// Name of the backend
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// Type of the backend. Has to be one of the loaded plugins (Kuma ships with
-	// builtin and provided)
+	// builtin, provided and vault)
 	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
 	// Configuration of the backend
 	Config               *_struct.Struct `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
@@ -269,6 +499,7 @@ type TracingBackend struct {
 	Sampling *wrappers.DoubleValue `protobuf:"bytes,2,opt,name=sampling,proto3" json:"sampling,omitempty"`
 	// Types that are valid to be assigned to Type:
 	//	*TracingBackend_Zipkin_
+	//	*TracingBackend_Datadog_
 	Type                 isTracingBackend_Type `protobuf_oneof:"type"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
@@ -322,8 +553,14 @@ type TracingBackend_Zipkin_ struct {
 	Zipkin *TracingBackend_Zipkin `protobuf:"bytes,3,opt,name=zipkin,proto3,oneof"`
 }
 
+type TracingBackend_Datadog_ struct {
+	Datadog *TracingBackend_Datadog `protobuf:"bytes,4,opt,name=datadog,proto3,oneof"`
+}
+
 func (*TracingBackend_Zipkin_) isTracingBackend_Type() {}
 
+func (*TracingBackend_Datadog_) isTracingBackend_Type() {}
+
 func (m *TracingBackend) GetType() isTracingBackend_Type {
 	if m != nil {
 		return m.Type
@@ -338,10 +575,18 @@ func (m *TracingBackend) GetZipkin() *TracingBackend_Zipkin {
 	return nil
 }
 
+func (m *TracingBackend) GetDatadog() *TracingBackend_Datadog {
+	if x, ok := m.GetType().(*TracingBackend_Datadog_); ok {
+		return x.Datadog
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*TracingBackend) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*TracingBackend_Zipkin_)(nil),
+		(*TracingBackend_Datadog_)(nil),
 	}
 }
 
@@ -406,6 +651,56 @@ func (m *TracingBackend_Zipkin) GetApiVersion() string {
 	return ""
 }
 
+// Datadog defines configuration of Datadog tracer.
+type TracingBackend_Datadog struct {
+	// Address of Datadog collector.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Port of Datadog collector.
+	Port                 uint32   `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TracingBackend_Datadog) Reset()         { *m = TracingBackend_Datadog{} }
+func (m *TracingBackend_Datadog) String() string { return proto.CompactTextString(m) }
+func (*TracingBackend_Datadog) ProtoMessage()    {}
+func (*TracingBackend_Datadog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{3, 1}
+}
+
+func (m *TracingBackend_Datadog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TracingBackend_Datadog.Unmarshal(m, b)
+}
+func (m *TracingBackend_Datadog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TracingBackend_Datadog.Marshal(b, m, deterministic)
+}
+func (m *TracingBackend_Datadog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TracingBackend_Datadog.Merge(m, src)
+}
+func (m *TracingBackend_Datadog) XXX_Size() int {
+	return xxx_messageInfo_TracingBackend_Datadog.Size(m)
+}
+func (m *TracingBackend_Datadog) XXX_DiscardUnknown() {
+	xxx_messageInfo_TracingBackend_Datadog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TracingBackend_Datadog proto.InternalMessageInfo
+
+func (m *TracingBackend_Datadog) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TracingBackend_Datadog) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
 type Logging struct {
 	// Name of the default backend
 	DefaultBackend string `protobuf:"bytes,1,opt,name=defaultBackend,proto3" json:"defaultBackend,omitempty"`
@@ -639,10 +934,15 @@ func (m *LoggingBackend_Tcp) GetAddress() string {
 func init() {
 	proto.RegisterType((*Mesh)(nil), "kuma.mesh.v1alpha1.Mesh")
 	proto.RegisterType((*Mesh_Mtls)(nil), "kuma.mesh.v1alpha1.Mesh.Mtls")
+	proto.RegisterType((*Mesh_Mtls_Rotation)(nil), "kuma.mesh.v1alpha1.Mesh.Mtls.Rotation")
+	proto.RegisterType((*Mesh_Mtls_Tls)(nil), "kuma.mesh.v1alpha1.Mesh.Mtls.Tls")
+	proto.RegisterType((*Mesh_Networking)(nil), "kuma.mesh.v1alpha1.Mesh.Networking")
+	proto.RegisterType((*Mesh_Networking_Outbound)(nil), "kuma.mesh.v1alpha1.Mesh.Networking.Outbound")
 	proto.RegisterType((*CertificateAuthorityBackend)(nil), "kuma.mesh.v1alpha1.CertificateAuthorityBackend")
 	proto.RegisterType((*Tracing)(nil), "kuma.mesh.v1alpha1.Tracing")
 	proto.RegisterType((*TracingBackend)(nil), "kuma.mesh.v1alpha1.TracingBackend")
 	proto.RegisterType((*TracingBackend_Zipkin)(nil), "kuma.mesh.v1alpha1.TracingBackend.Zipkin")
+	proto.RegisterType((*TracingBackend_Datadog)(nil), "kuma.mesh.v1alpha1.TracingBackend.Datadog")
 	proto.RegisterType((*Logging)(nil), "kuma.mesh.v1alpha1.Logging")
 	proto.RegisterType((*LoggingBackend)(nil), "kuma.mesh.v1alpha1.LoggingBackend")
 	proto.RegisterType((*LoggingBackend_File)(nil), "kuma.mesh.v1alpha1.LoggingBackend.File")
@@ -652,41 +952,58 @@ func init() {
 func init() { proto.RegisterFile("mesh/v1alpha1/mesh.proto", fileDescriptor_ae9b3cd8c92bbf6a) }
 
 var fileDescriptor_ae9b3cd8c92bbf6a = []byte{
-	// 565 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x6d, 0x13, 0xcb, 0x4d, 0xa6, 0x22, 0x42, 0x7b, 0x00, 0xcb, 0x29, 0xa5, 0x8a, 0x50, 0x29,
-	0x17, 0x5b, 0x09, 0x42, 0xaa, 0x90, 0x40, 0x22, 0x45, 0x28, 0x08, 0x72, 0x59, 0xa2, 0x1e, 0x7a,
-	0x62, 0x6d, 0xaf, 0x93, 0x55, 0xd6, 0xf6, 0xb2, 0x5e, 0x17, 0x15, 0x7e, 0x03, 0xbf, 0x90, 0xbf,
-	0xc2, 0x01, 0xed, 0x7a, 0x5d, 0xc8, 0x47, 0x5b, 0x0e, 0xdc, 0x66, 0x67, 0xde, 0x1b, 0xbf, 0x99,
-	0x37, 0x06, 0x2f, 0xa3, 0xe5, 0x22, 0xbc, 0x1c, 0x12, 0x2e, 0x16, 0x64, 0x18, 0xea, 0x57, 0x20,
-	0x64, 0xa1, 0x0a, 0x84, 0x96, 0x55, 0x46, 0x02, 0x93, 0x68, 0xca, 0x7e, 0x7f, 0x1d, 0xad, 0x24,
-	0x8b, 0xcb, 0x9a, 0xe0, 0x1f, 0xce, 0x8b, 0x62, 0xce, 0x69, 0x68, 0x5e, 0x51, 0x95, 0x86, 0x5f,
-	0x25, 0x11, 0x82, 0xca, 0xa6, 0x7e, 0xb0, 0x5e, 0x2f, 0x95, 0xac, 0x62, 0x55, 0x57, 0x07, 0x3f,
-	0x5b, 0xe0, 0x4c, 0x69, 0xb9, 0x40, 0x43, 0x70, 0x32, 0xc5, 0x4b, 0x6f, 0xf7, 0x68, 0xf7, 0x64,
-	0x7f, 0xf4, 0x28, 0xd8, 0x94, 0x11, 0x68, 0x5c, 0x30, 0x55, 0xbc, 0xc4, 0x06, 0x8a, 0x5e, 0xc0,
-	0x9e, 0x92, 0x24, 0x66, 0xf9, 0xdc, 0x6b, 0x19, 0x56, 0x7f, 0x1b, 0x6b, 0x56, 0x43, 0x70, 0x83,
-	0xd5, 0x34, 0x5e, 0xcc, 0xe7, 0x9a, 0xd6, 0xbe, 0x99, 0xf6, 0xb1, 0x86, 0xe0, 0x06, 0xab, 0x69,
-	0x76, 0x70, 0xcf, 0xb9, 0x99, 0x36, 0xad, 0x21, 0xb8, 0xc1, 0xfa, 0xdf, 0xc1, 0xd1, 0x92, 0xd1,
-	0x31, 0xf4, 0x68, 0x4e, 0x22, 0x4e, 0x93, 0x31, 0x89, 0x97, 0x34, 0x4f, 0xcc, 0xa4, 0x5d, 0xbc,
-	0x96, 0x45, 0x1f, 0xa0, 0x13, 0xd5, 0x61, 0xe9, 0xb5, 0x8e, 0xda, 0x27, 0xfb, 0xa3, 0x70, 0xdb,
-	0x77, 0xce, 0xa8, 0x54, 0x2c, 0x65, 0x31, 0x51, 0xf4, 0x4d, 0xa5, 0x16, 0x85, 0x64, 0xea, 0xca,
-	0xb6, 0xc0, 0xd7, 0x0d, 0x06, 0x97, 0xd0, 0xbf, 0x05, 0x88, 0x10, 0x38, 0x39, 0xc9, 0xa8, 0x55,
-	0x62, 0x62, 0x9d, 0x53, 0x57, 0x82, 0x9a, 0x8d, 0x76, 0xb1, 0x89, 0x51, 0x08, 0x6e, 0x5c, 0xe4,
-	0x29, 0x6b, 0x16, 0xf6, 0x30, 0xa8, 0x3d, 0x0d, 0x1a, 0x4f, 0x83, 0x4f, 0xc6, 0x53, 0x6c, 0x61,
-	0x83, 0x2f, 0xb0, 0x67, 0xd7, 0xae, 0xe7, 0x4e, 0x68, 0x4a, 0x2a, 0xae, 0xd6, 0xe6, 0x5e, 0xcd,
-	0xa2, 0xd7, 0x1b, 0x73, 0x0f, 0x6e, 0x71, 0x73, 0x73, 0xd4, 0x1f, 0x2d, 0xe8, 0xad, 0x16, 0xb7,
-	0x8e, 0x77, 0x0a, 0x9d, 0x92, 0x64, 0x82, 0xff, 0x39, 0x9a, 0x83, 0x8d, 0x61, 0xde, 0x16, 0x55,
-	0xc4, 0xe9, 0x39, 0xe1, 0x15, 0xc5, 0xd7, 0x68, 0x74, 0x06, 0xee, 0x37, 0x26, 0x96, 0x2c, 0xb7,
-	0x4b, 0x78, 0x76, 0xb7, 0xbc, 0xe0, 0xc2, 0x10, 0x26, 0x3b, 0xd8, 0x52, 0xfd, 0xcf, 0xe0, 0xd6,
-	0x39, 0x74, 0x1f, 0xda, 0x95, 0xe4, 0x56, 0x9b, 0x0e, 0xd1, 0x13, 0xb8, 0xa7, 0x4f, 0x94, 0xbe,
-	0x4f, 0x86, 0xa3, 0xd3, 0x88, 0x29, 0xa3, 0xaf, 0x83, 0x57, 0x93, 0xe8, 0x10, 0x80, 0x08, 0x76,
-	0x4e, 0x65, 0xc9, 0x8a, 0x5a, 0x4a, 0x17, 0xff, 0x95, 0x19, 0xbb, 0xb5, 0x7f, 0xda, 0x02, 0x7b,
-	0xc2, 0xff, 0xdb, 0x02, 0xdb, 0x76, 0xd3, 0x82, 0x5f, 0xbb, 0xd0, 0x5b, 0x2d, 0x6e, 0xb5, 0xe0,
-	0x01, 0xb8, 0x69, 0x21, 0x33, 0xa2, 0xec, 0x8d, 0xd9, 0x17, 0x7a, 0x05, 0x4e, 0xca, 0x38, 0xb5,
-	0xeb, 0x7d, 0x7a, 0xf7, 0xa7, 0x83, 0x77, 0x8c, 0xd3, 0xc9, 0x0e, 0x36, 0x34, 0xf4, 0x12, 0xda,
-	0x2a, 0x16, 0xf6, 0xdf, 0x3c, 0xfe, 0x07, 0xf6, 0x2c, 0x16, 0x93, 0x1d, 0xac, 0x49, 0xbe, 0x0f,
-	0x8e, 0xee, 0xa5, 0xe5, 0x0a, 0xa2, 0x16, 0x8d, 0x5c, 0x1d, 0xfb, 0x8f, 0xa1, 0x3d, 0x8b, 0x05,
-	0xf2, 0x60, 0x8f, 0x24, 0x89, 0xa4, 0x65, 0x69, 0xab, 0xcd, 0xb3, 0xd9, 0xf8, 0x18, 0x2e, 0x3a,
-	0xcd, 0xa7, 0x22, 0xd7, 0x1c, 0xd3, 0xf3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x7b, 0xcd,
-	0x28, 0x68, 0x05, 0x00, 0x00,
+	// 833 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x5f, 0x6f, 0x1c, 0x35,
+	0x10, 0xcf, 0x65, 0xb7, 0x77, 0x9b, 0x09, 0x8d, 0x90, 0x1f, 0x60, 0xd9, 0x84, 0x52, 0x0e, 0x54,
+	0x0a, 0x42, 0x7b, 0x4a, 0x2a, 0x44, 0x41, 0x05, 0xa9, 0x97, 0xaa, 0xba, 0x0a, 0x02, 0x92, 0x73,
+	0x2a, 0x52, 0x9f, 0xf0, 0xed, 0xfa, 0x6e, 0xad, 0xf3, 0xad, 0x17, 0xdb, 0xdb, 0x50, 0x3e, 0x41,
+	0x9f, 0xf8, 0x0c, 0x7c, 0x25, 0xbe, 0x0f, 0x0f, 0xc8, 0x5e, 0xfb, 0x72, 0xff, 0x93, 0x87, 0xbe,
+	0xd9, 0x33, 0xbf, 0xdf, 0x8c, 0x67, 0xfc, 0x9b, 0x81, 0x78, 0x46, 0x55, 0xd1, 0x7b, 0x7d, 0x4a,
+	0x78, 0x55, 0x90, 0xd3, 0x9e, 0xb9, 0xa5, 0x95, 0x14, 0x5a, 0x20, 0x34, 0xad, 0x67, 0x24, 0xb5,
+	0x06, 0xef, 0x4e, 0x8e, 0x57, 0xd1, 0x5a, 0xb2, 0x4c, 0x35, 0x84, 0xe4, 0x64, 0xd9, 0xa9, 0x28,
+	0xa7, 0x99, 0x16, 0xd2, 0x79, 0xef, 0x4d, 0x84, 0x98, 0x70, 0xda, 0xb3, 0xb7, 0x51, 0x3d, 0xee,
+	0x5d, 0x49, 0x52, 0x55, 0x54, 0xce, 0xd9, 0xab, 0x7e, 0xa5, 0x65, 0x9d, 0xe9, 0x6d, 0xec, 0xbc,
+	0x96, 0x44, 0x33, 0x51, 0x36, 0xfe, 0xee, 0xbf, 0x1d, 0x08, 0x2f, 0xa8, 0x2a, 0xd0, 0x29, 0x84,
+	0x33, 0xcd, 0x55, 0xdc, 0xba, 0xdf, 0x7a, 0x78, 0x78, 0xf6, 0x71, 0xba, 0x5e, 0x44, 0x6a, 0x70,
+	0xe9, 0x85, 0xe6, 0x0a, 0x5b, 0x28, 0xfa, 0x06, 0x3a, 0x5a, 0x92, 0x8c, 0x95, 0x93, 0x78, 0xdf,
+	0xb2, 0x8e, 0x37, 0xb1, 0x86, 0x0d, 0x04, 0x7b, 0xac, 0xa1, 0x71, 0x31, 0x99, 0x18, 0x5a, 0xb0,
+	0x9d, 0xf6, 0x73, 0x03, 0xc1, 0x1e, 0x6b, 0x68, 0xae, 0x6d, 0x71, 0xb8, 0x9d, 0x76, 0xd1, 0x40,
+	0xb0, 0xc7, 0xa2, 0x73, 0x80, 0x92, 0xea, 0x2b, 0x21, 0xa7, 0x26, 0xe1, 0x1d, 0xcb, 0xfc, 0x6c,
+	0x6b, 0x75, 0xbf, 0xcc, 0xa1, 0x78, 0x81, 0x96, 0xbc, 0x0d, 0x21, 0x34, 0x85, 0xa3, 0x07, 0x70,
+	0x44, 0x4b, 0x32, 0xe2, 0x34, 0xef, 0x93, 0x6c, 0x4a, 0xcb, 0xdc, 0xf6, 0xeb, 0x00, 0xaf, 0x58,
+	0xd1, 0x4f, 0x10, 0x8d, 0x9a, 0xa3, 0x8a, 0xf7, 0xef, 0x07, 0x0f, 0x0f, 0xcf, 0x7a, 0x9b, 0x72,
+	0x9e, 0x53, 0xa9, 0xd9, 0x98, 0x65, 0x44, 0xd3, 0xa7, 0xb5, 0x2e, 0x84, 0x64, 0xfa, 0x8d, 0x0b,
+	0x81, 0xe7, 0x01, 0x50, 0x1f, 0x22, 0x29, 0xb4, 0xfd, 0x35, 0xd7, 0xb1, 0x07, 0x3b, 0xbf, 0x27,
+	0xc5, 0x0e, 0x8d, 0xe7, 0x3c, 0xf4, 0x08, 0x02, 0xcd, 0x7d, 0xe7, 0x3e, 0xdd, 0x4d, 0x1f, 0x72,
+	0x85, 0x0d, 0x1a, 0x3d, 0x01, 0xa0, 0x7f, 0x66, 0xbc, 0x56, 0x4c, 0x94, 0x2a, 0xbe, 0x63, 0xeb,
+	0x38, 0xd9, 0xc4, 0xbd, 0x74, 0x92, 0xc5, 0x0b, 0xf8, 0xe4, 0x6d, 0x0b, 0x22, 0xff, 0x12, 0xf4,
+	0x9d, 0x09, 0x55, 0xb1, 0x46, 0x7b, 0x4e, 0x64, 0x1f, 0xa5, 0x8d, 0x38, 0x53, 0x2f, 0xce, 0xf4,
+	0x99, 0x13, 0x27, 0x5e, 0x00, 0xa3, 0xa7, 0x70, 0xe4, 0xcb, 0xf8, 0x8d, 0x95, 0xb9, 0xb8, 0x8a,
+	0xf7, 0x6f, 0xa2, 0xaf, 0x10, 0x92, 0x17, 0x10, 0x0c, 0xb9, 0x42, 0xf7, 0x00, 0x66, 0xac, 0x7c,
+	0x49, 0xa5, 0xf2, 0x8f, 0x38, 0xc0, 0x0b, 0x16, 0xd4, 0x85, 0xf7, 0x32, 0x56, 0x15, 0x54, 0x5e,
+	0xd6, 0x4c, 0xd3, 0xe6, 0xe7, 0x0e, 0xf0, 0x92, 0x2d, 0xf9, 0xa7, 0x05, 0x70, 0xad, 0x12, 0x34,
+	0x80, 0x48, 0xd4, 0x7a, 0x24, 0x6a, 0x27, 0x85, 0xc3, 0xb3, 0xaf, 0x6f, 0x21, 0xae, 0xf4, 0x57,
+	0xc7, 0xc1, 0x73, 0x76, 0x32, 0x80, 0xc8, 0x5b, 0xd1, 0x13, 0x38, 0xac, 0x88, 0x52, 0xba, 0x90,
+	0xa2, 0x9e, 0x14, 0x2e, 0x70, 0xb2, 0x56, 0x6f, 0x5f, 0x08, 0xfe, 0x92, 0xf0, 0x9a, 0xe2, 0x45,
+	0x78, 0xf7, 0x35, 0x1c, 0xef, 0x10, 0x16, 0x42, 0x10, 0x96, 0x64, 0x46, 0x5d, 0xfd, 0xf6, 0x6c,
+	0x6c, 0xfa, 0x4d, 0x45, 0x6d, 0x67, 0x0f, 0xb0, 0x3d, 0xa3, 0x1e, 0xb4, 0x33, 0x51, 0x8e, 0x99,
+	0x1f, 0xd3, 0x0f, 0xd7, 0xf2, 0x5f, 0xda, 0x4d, 0x83, 0x1d, 0xac, 0xfb, 0x07, 0x74, 0xdc, 0xb0,
+	0x9b, 0x39, 0xc9, 0xe9, 0x98, 0xd4, 0x5c, 0xaf, 0xcc, 0xc9, 0xb2, 0x15, 0xfd, 0xb8, 0x36, 0x27,
+	0xdd, 0x1d, 0x3b, 0x64, 0x6d, 0x34, 0xba, 0x7f, 0x07, 0x70, 0xb4, 0xec, 0xdc, 0x58, 0xde, 0x63,
+	0x88, 0x14, 0x99, 0x55, 0xfc, 0x7a, 0x55, 0x9d, 0xac, 0x8b, 0x47, 0xd4, 0x23, 0x4e, 0x9b, 0x76,
+	0xce, 0xd1, 0xe8, 0x1c, 0xda, 0x7f, 0xb1, 0x6a, 0xca, 0xfc, 0xe4, 0x7d, 0x79, 0xf3, 0xf3, 0xd2,
+	0x57, 0x96, 0x30, 0xd8, 0xc3, 0x8e, 0x8a, 0x9e, 0x43, 0x27, 0x27, 0x9a, 0xe4, 0x62, 0xe2, 0x06,
+	0xf0, 0xab, 0x5b, 0x44, 0x79, 0xd6, 0x30, 0x06, 0x7b, 0xd8, 0x93, 0x93, 0xdf, 0xa1, 0xdd, 0xc4,
+	0x46, 0xef, 0x43, 0x50, 0x4b, 0xee, 0x6a, 0x34, 0x47, 0xf4, 0x39, 0xdc, 0x35, 0x0b, 0x96, 0xbe,
+	0xc8, 0x4f, 0xcf, 0x1e, 0x8f, 0x98, 0xb6, 0x75, 0x46, 0x78, 0xd9, 0x68, 0x26, 0x80, 0x54, 0xcc,
+	0x4f, 0x40, 0xd0, 0x4c, 0xc0, 0xb5, 0x25, 0xf9, 0x16, 0x3a, 0x2e, 0x2f, 0x8a, 0xa1, 0x43, 0xf2,
+	0x5c, 0x52, 0xa5, 0x5c, 0x1a, 0x7f, 0x35, 0x1d, 0xae, 0x84, 0x6c, 0x32, 0xdc, 0xc5, 0xf6, 0xdc,
+	0x6f, 0x37, 0x02, 0x32, 0x1a, 0x70, 0x9b, 0xfb, 0x5d, 0x6b, 0xc0, 0x85, 0x5d, 0xd7, 0xc0, 0x7f,
+	0x2d, 0x38, 0x5a, 0x76, 0x6e, 0xd4, 0xc0, 0x07, 0xd0, 0x1e, 0x0b, 0x39, 0x23, 0xda, 0x89, 0xdc,
+	0xdd, 0xd0, 0x0f, 0x10, 0x8e, 0x19, 0xa7, 0xee, 0x7f, 0xbf, 0xb8, 0x39, 0x75, 0xfa, 0x9c, 0x71,
+	0x3a, 0xd8, 0xc3, 0x96, 0x86, 0xbe, 0x87, 0x40, 0x67, 0x55, 0x1c, 0x6e, 0xdf, 0xcb, 0x2b, 0xec,
+	0x61, 0x56, 0x0d, 0xf6, 0xb0, 0x21, 0x25, 0x09, 0x84, 0x26, 0x96, 0x6d, 0x28, 0xd1, 0x85, 0x7f,
+	0xae, 0x39, 0x27, 0x9f, 0x40, 0x30, 0xcc, 0xaa, 0xed, 0xbf, 0xe0, 0x3b, 0xde, 0x87, 0x57, 0x91,
+	0x4f, 0x35, 0x6a, 0x5b, 0x35, 0x3f, 0xfa, 0x7f, 0x00, 0x4f, 0xb6, 0x6e, 0xd2, 0x9d, 0x08, 0x00,
+	0x00,
 }
@@ -6,6 +6,7 @@ package v1alpha1
 import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	math "math"
 )
 
@@ -25,7 +26,10 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 type Metrics struct {
 	// Prometheus-specific configuration for metrics that should be collected and
 	// exposed by dataplanes.
-	Prometheus           *Metrics_Prometheus `protobuf:"bytes,1,opt,name=prometheus,proto3" json:"prometheus,omitempty"`
+	Prometheus *Metrics_Prometheus `protobuf:"bytes,1,opt,name=prometheus,proto3" json:"prometheus,omitempty"`
+	// Whether dataplanes should collect and expose metrics.
+	// If not set, metrics are enabled as long as prometheus is defined.
+	Enabled              *wrappers.BoolValue `protobuf:"bytes,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -63,6 +67,13 @@ func (m *Metrics) GetPrometheus() *Metrics_Prometheus {
 	return nil
 }
 
+func (m *Metrics) GetEnabled() *wrappers.BoolValue {
+	if m != nil {
+		return m.Enabled
+	}
+	return nil
+}
+
 // Prometheus defines Prometheus-specific configuration for metrics that
 // should be collected and exposed by dataplanes.
 type Metrics_Prometheus struct {
@@ -124,15 +135,18 @@ func init() {
 func init() { proto.RegisterFile("mesh/v1alpha1/metrics.proto", fileDescriptor_7dd8c7f420ce268c) }
 
 var fileDescriptor_7dd8c7f420ce268c = []byte{
-	// 153 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0x4d, 0x2d, 0xce,
-	0xd0, 0x2f, 0x33, 0x4c, 0xcc, 0x29, 0xc8, 0x48, 0x34, 0xd4, 0xcf, 0x4d, 0x2d, 0x29, 0xca, 0x4c,
-	0x2e, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xca, 0x2e, 0xcd, 0x4d, 0xd4, 0x03, 0xa9,
-	0xd0, 0x83, 0xa9, 0x50, 0x6a, 0x67, 0xe4, 0x62, 0xf7, 0x85, 0xa8, 0x12, 0x72, 0xe3, 0xe2, 0x2a,
-	0x28, 0xca, 0xcf, 0x4d, 0x2d, 0xc9, 0x48, 0x2d, 0x2d, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36,
-	0x52, 0xd3, 0xc3, 0xd4, 0xa4, 0x07, 0xd5, 0xa0, 0x17, 0x00, 0x57, 0x1d, 0x84, 0xa4, 0x53, 0xca,
-	0x84, 0x8b, 0x0b, 0x21, 0x23, 0x24, 0xc4, 0xc5, 0x52, 0x90, 0x5f, 0x54, 0x02, 0x36, 0x8f, 0x37,
-	0x08, 0xcc, 0x06, 0x8b, 0x25, 0x96, 0x64, 0x48, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x06, 0x81, 0xd9,
-	0x4e, 0x5c, 0x51, 0x1c, 0x30, 0x0b, 0x92, 0xd8, 0xc0, 0x0e, 0x36, 0x06, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0xbf, 0xe9, 0xed, 0xe9, 0xcf, 0x00, 0x00, 0x00,
+	// 204 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x8e, 0x31, 0x4b, 0xc6, 0x30,
+	0x10, 0x86, 0xa9, 0x88, 0x9f, 0x9e, 0xb8, 0x64, 0xfa, 0xa8, 0x20, 0xe2, 0x20, 0x4e, 0x17, 0xaa,
+	0xfd, 0x05, 0x1d, 0xdc, 0x04, 0xc9, 0xe0, 0xe0, 0x96, 0xea, 0xd9, 0x88, 0x89, 0x17, 0xd2, 0x44,
+	0x7f, 0x9d, 0xff, 0x4d, 0xbc, 0x36, 0x2a, 0x7c, 0xdb, 0x71, 0x3c, 0xef, 0xc3, 0x03, 0xa7, 0x81,
+	0x66, 0xa7, 0x3f, 0x3a, 0xeb, 0xa3, 0xb3, 0x9d, 0x0e, 0x94, 0xd3, 0xeb, 0xd3, 0x8c, 0x31, 0x71,
+	0x66, 0xa5, 0xde, 0x4a, 0xb0, 0xf8, 0x43, 0x60, 0x25, 0xda, 0xb3, 0x89, 0x79, 0xf2, 0xa4, 0x85,
+	0x18, 0xcb, 0x8b, 0xfe, 0x4c, 0x36, 0x46, 0x4a, 0xeb, 0xe6, 0xe2, 0xab, 0x81, 0xcd, 0xdd, 0x62,
+	0x51, 0xb7, 0x00, 0x31, 0x71, 0xa0, 0xec, 0xa8, 0xcc, 0xdb, 0xe6, 0xbc, 0xb9, 0x3a, 0xbe, 0xbe,
+	0xc4, 0x5d, 0x29, 0xae, 0x03, 0xbc, 0xff, 0xa5, 0xcd, 0xbf, 0xa5, 0xea, 0x61, 0x43, 0xef, 0x76,
+	0xf4, 0xf4, 0xbc, 0xdd, 0x13, 0x49, 0x8b, 0x4b, 0x05, 0xd6, 0x0a, 0x1c, 0x98, 0xfd, 0x83, 0xf5,
+	0x85, 0x4c, 0x45, 0xdb, 0x1e, 0xe0, 0xcf, 0xa7, 0x14, 0xec, 0x47, 0x4e, 0x59, 0x2a, 0x4e, 0x8c,
+	0xdc, 0xf2, 0xb3, 0xd9, 0x89, 0xf4, 0xc8, 0xc8, 0x3d, 0xc0, 0xe3, 0x61, 0xcd, 0x1a, 0x0f, 0x44,
+	0x7f, 0xf3, 0x3d, 0x00, 0x3d, 0xc3, 0xdc, 0xc4, 0x25, 0x01, 0x00, 0x00,
 }
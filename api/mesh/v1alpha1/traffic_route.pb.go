@@ -33,10 +33,15 @@ type TrafficRoute struct {
 	// of a mesh.
 	Destinations []*Selector `protobuf:"bytes,2,rep,name=destinations,proto3" json:"destinations,omitempty"`
 	// List of destinations with weights assigned to them.
-	Conf                 []*TrafficRoute_WeightedDestination `protobuf:"bytes,3,rep,name=conf,proto3" json:"conf,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
-	XXX_unrecognized     []byte                              `json:"-"`
-	XXX_sizecache        int32                               `json:"-"`
+	Conf []*TrafficRoute_WeightedDestination `protobuf:"bytes,3,rep,name=conf,proto3" json:"conf,omitempty"`
+	// List of routing rules for HTTP requests, which are evaluated in order.
+	// Requests that match none of them are routed according to `conf`.
+	//
+	// Notice that the rules apply only to destinations that speak HTTP.
+	Http                 []*TrafficRoute_Http `protobuf:"bytes,4,rep,name=http,proto3" json:"http,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *TrafficRoute) Reset()         { *m = TrafficRoute{} }
@@ -85,6 +90,13 @@ func (m *TrafficRoute) GetConf() []*TrafficRoute_WeightedDestination {
 	return nil
 }
 
+func (m *TrafficRoute) GetHttp() []*TrafficRoute_Http {
+	if m != nil {
+		return m.Http
+	}
+	return nil
+}
+
 // WeightedDestination defines a destination with a weight assigned to it.
 type TrafficRoute_WeightedDestination struct {
 	// Weight assigned to that destination.
@@ -139,35 +151,262 @@ func (m *TrafficRoute_WeightedDestination) GetDestination() map[string]string {
 	return nil
 }
 
+// Http defines a routing rule for HTTP requests that match given
+// HTTP-level attributes.
+type TrafficRoute_Http struct {
+	// Attributes of a request that the rule applies to.
+	Match *TrafficRoute_Http_Match `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	// List of destinations with weights assigned to them.
+	Split                []*TrafficRoute_WeightedDestination `protobuf:"bytes,2,rep,name=split,proto3" json:"split,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *TrafficRoute_Http) Reset()         { *m = TrafficRoute_Http{} }
+func (m *TrafficRoute_Http) String() string { return proto.CompactTextString(m) }
+func (*TrafficRoute_Http) ProtoMessage()    {}
+func (*TrafficRoute_Http) Descriptor() ([]byte, []int) {
+	return fileDescriptor_059271a05615c95f, []int{0, 1}
+}
+
+func (m *TrafficRoute_Http) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficRoute_Http.Unmarshal(m, b)
+}
+func (m *TrafficRoute_Http) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficRoute_Http.Marshal(b, m, deterministic)
+}
+func (m *TrafficRoute_Http) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficRoute_Http.Merge(m, src)
+}
+func (m *TrafficRoute_Http) XXX_Size() int {
+	return xxx_messageInfo_TrafficRoute_Http.Size(m)
+}
+func (m *TrafficRoute_Http) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficRoute_Http.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficRoute_Http proto.InternalMessageInfo
+
+func (m *TrafficRoute_Http) GetMatch() *TrafficRoute_Http_Match {
+	if m != nil {
+		return m.Match
+	}
+	return nil
+}
+
+func (m *TrafficRoute_Http) GetSplit() []*TrafficRoute_WeightedDestination {
+	if m != nil {
+		return m.Split
+	}
+	return nil
+}
+
+// Match defines HTTP-level attributes of a request.
+// All of the defined attributes have to match.
+type TrafficRoute_Http_Match struct {
+	// Path of a request.
+	Path *TrafficRoute_Http_Match_StringMatcher `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Method of a request, e.g. GET.
+	Method *TrafficRoute_Http_Match_StringMatcher `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// Headers of a request.
+	Headers              map[string]*TrafficRoute_Http_Match_StringMatcher `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                                          `json:"-"`
+	XXX_unrecognized     []byte                                            `json:"-"`
+	XXX_sizecache        int32                                             `json:"-"`
+}
+
+func (m *TrafficRoute_Http_Match) Reset()         { *m = TrafficRoute_Http_Match{} }
+func (m *TrafficRoute_Http_Match) String() string { return proto.CompactTextString(m) }
+func (*TrafficRoute_Http_Match) ProtoMessage()    {}
+func (*TrafficRoute_Http_Match) Descriptor() ([]byte, []int) {
+	return fileDescriptor_059271a05615c95f, []int{0, 1, 0}
+}
+
+func (m *TrafficRoute_Http_Match) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficRoute_Http_Match.Unmarshal(m, b)
+}
+func (m *TrafficRoute_Http_Match) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficRoute_Http_Match.Marshal(b, m, deterministic)
+}
+func (m *TrafficRoute_Http_Match) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficRoute_Http_Match.Merge(m, src)
+}
+func (m *TrafficRoute_Http_Match) XXX_Size() int {
+	return xxx_messageInfo_TrafficRoute_Http_Match.Size(m)
+}
+func (m *TrafficRoute_Http_Match) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficRoute_Http_Match.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficRoute_Http_Match proto.InternalMessageInfo
+
+func (m *TrafficRoute_Http_Match) GetPath() *TrafficRoute_Http_Match_StringMatcher {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *TrafficRoute_Http_Match) GetMethod() *TrafficRoute_Http_Match_StringMatcher {
+	if m != nil {
+		return m.Method
+	}
+	return nil
+}
+
+func (m *TrafficRoute_Http_Match) GetHeaders() map[string]*TrafficRoute_Http_Match_StringMatcher {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+// StringMatcher matches a string value of a request attribute.
+type TrafficRoute_Http_Match_StringMatcher struct {
+	// Types that are valid to be assigned to MatcherType:
+	//	*TrafficRoute_Http_Match_StringMatcher_Prefix
+	//	*TrafficRoute_Http_Match_StringMatcher_Exact
+	//	*TrafficRoute_Http_Match_StringMatcher_Regex
+	MatcherType          isTrafficRoute_Http_Match_StringMatcher_MatcherType `protobuf_oneof:"matcherType"`
+	XXX_NoUnkeyedLiteral struct{}                                            `json:"-"`
+	XXX_unrecognized     []byte                                              `json:"-"`
+	XXX_sizecache        int32                                               `json:"-"`
+}
+
+func (m *TrafficRoute_Http_Match_StringMatcher) Reset()         { *m = TrafficRoute_Http_Match_StringMatcher{} }
+func (m *TrafficRoute_Http_Match_StringMatcher) String() string { return proto.CompactTextString(m) }
+func (*TrafficRoute_Http_Match_StringMatcher) ProtoMessage()    {}
+func (*TrafficRoute_Http_Match_StringMatcher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_059271a05615c95f, []int{0, 1, 0, 0}
+}
+
+func (m *TrafficRoute_Http_Match_StringMatcher) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficRoute_Http_Match_StringMatcher.Unmarshal(m, b)
+}
+func (m *TrafficRoute_Http_Match_StringMatcher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficRoute_Http_Match_StringMatcher.Marshal(b, m, deterministic)
+}
+func (m *TrafficRoute_Http_Match_StringMatcher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficRoute_Http_Match_StringMatcher.Merge(m, src)
+}
+func (m *TrafficRoute_Http_Match_StringMatcher) XXX_Size() int {
+	return xxx_messageInfo_TrafficRoute_Http_Match_StringMatcher.Size(m)
+}
+func (m *TrafficRoute_Http_Match_StringMatcher) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficRoute_Http_Match_StringMatcher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficRoute_Http_Match_StringMatcher proto.InternalMessageInfo
+
+type isTrafficRoute_Http_Match_StringMatcher_MatcherType interface {
+	isTrafficRoute_Http_Match_StringMatcher_MatcherType()
+}
+
+type TrafficRoute_Http_Match_StringMatcher_Prefix struct {
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3,oneof"`
+}
+
+type TrafficRoute_Http_Match_StringMatcher_Exact struct {
+	Exact string `protobuf:"bytes,2,opt,name=exact,proto3,oneof"`
+}
+
+type TrafficRoute_Http_Match_StringMatcher_Regex struct {
+	Regex string `protobuf:"bytes,3,opt,name=regex,proto3,oneof"`
+}
+
+func (*TrafficRoute_Http_Match_StringMatcher_Prefix) isTrafficRoute_Http_Match_StringMatcher_MatcherType() {
+}
+
+func (*TrafficRoute_Http_Match_StringMatcher_Exact) isTrafficRoute_Http_Match_StringMatcher_MatcherType() {
+}
+
+func (*TrafficRoute_Http_Match_StringMatcher_Regex) isTrafficRoute_Http_Match_StringMatcher_MatcherType() {
+}
+
+func (m *TrafficRoute_Http_Match_StringMatcher) GetMatcherType() isTrafficRoute_Http_Match_StringMatcher_MatcherType {
+	if m != nil {
+		return m.MatcherType
+	}
+	return nil
+}
+
+func (m *TrafficRoute_Http_Match_StringMatcher) GetPrefix() string {
+	if x, ok := m.GetMatcherType().(*TrafficRoute_Http_Match_StringMatcher_Prefix); ok {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (m *TrafficRoute_Http_Match_StringMatcher) GetExact() string {
+	if x, ok := m.GetMatcherType().(*TrafficRoute_Http_Match_StringMatcher_Exact); ok {
+		return x.Exact
+	}
+	return ""
+}
+
+func (m *TrafficRoute_Http_Match_StringMatcher) GetRegex() string {
+	if x, ok := m.GetMatcherType().(*TrafficRoute_Http_Match_StringMatcher_Regex); ok {
+		return x.Regex
+	}
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*TrafficRoute_Http_Match_StringMatcher) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*TrafficRoute_Http_Match_StringMatcher_Prefix)(nil),
+		(*TrafficRoute_Http_Match_StringMatcher_Exact)(nil),
+		(*TrafficRoute_Http_Match_StringMatcher_Regex)(nil),
+	}
+}
+
 func init() {
 	proto.RegisterType((*TrafficRoute)(nil), "kuma.mesh.v1alpha1.TrafficRoute")
 	proto.RegisterType((*TrafficRoute_WeightedDestination)(nil), "kuma.mesh.v1alpha1.TrafficRoute.WeightedDestination")
 	proto.RegisterMapType((map[string]string)(nil), "kuma.mesh.v1alpha1.TrafficRoute.WeightedDestination.DestinationEntry")
+	proto.RegisterType((*TrafficRoute_Http)(nil), "kuma.mesh.v1alpha1.TrafficRoute.Http")
+	proto.RegisterType((*TrafficRoute_Http_Match)(nil), "kuma.mesh.v1alpha1.TrafficRoute.Http.Match")
+	proto.RegisterMapType((map[string]*TrafficRoute_Http_Match_StringMatcher)(nil), "kuma.mesh.v1alpha1.TrafficRoute.Http.Match.HeadersEntry")
+	proto.RegisterType((*TrafficRoute_Http_Match_StringMatcher)(nil), "kuma.mesh.v1alpha1.TrafficRoute.Http.Match.StringMatcher")
 }
 
 func init() { proto.RegisterFile("mesh/v1alpha1/traffic_route.proto", fileDescriptor_059271a05615c95f) }
 
 var fileDescriptor_059271a05615c95f = []byte{
-	// 332 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcc, 0x4d, 0x2d, 0xce,
-	0xd0, 0x2f, 0x33, 0x4c, 0xcc, 0x29, 0xc8, 0x48, 0x34, 0xd4, 0x2f, 0x29, 0x4a, 0x4c, 0x4b, 0xcb,
-	0x4c, 0x8e, 0x2f, 0xca, 0x2f, 0x2d, 0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xca,
-	0x2e, 0xcd, 0x4d, 0xd4, 0x03, 0xa9, 0xd3, 0x83, 0xa9, 0x93, 0x92, 0x41, 0xd5, 0x56, 0x9c, 0x9a,
-	0x93, 0x9a, 0x5c, 0x92, 0x5f, 0x04, 0xd1, 0x21, 0x25, 0x5e, 0x96, 0x98, 0x93, 0x99, 0x92, 0x58,
-	0x92, 0xaa, 0x0f, 0x63, 0x40, 0x24, 0x94, 0x5e, 0x30, 0x73, 0xf1, 0x84, 0x40, 0xac, 0x08, 0x02,
-	0xd9, 0x20, 0xe4, 0xc0, 0xc5, 0x5e, 0x9c, 0x5f, 0x5a, 0x94, 0x9c, 0x5a, 0x2c, 0xc1, 0xa8, 0xc0,
-	0xac, 0xc1, 0x6d, 0x24, 0xa3, 0x87, 0x69, 0x9b, 0x5e, 0x30, 0xd4, 0x78, 0x27, 0x8e, 0x5f, 0x4e,
-	0xac, 0x93, 0x18, 0x99, 0x38, 0x18, 0x83, 0x60, 0xda, 0x84, 0xbc, 0xb8, 0x78, 0x52, 0x52, 0x8b,
-	0x4b, 0x32, 0xf3, 0x12, 0x4b, 0x32, 0xf3, 0xf3, 0x8a, 0x25, 0x98, 0x48, 0x32, 0x06, 0x45, 0xaf,
-	0x50, 0x10, 0x17, 0x4b, 0x72, 0x7e, 0x5e, 0x9a, 0x04, 0x33, 0xd8, 0x0c, 0x13, 0x6c, 0x66, 0x20,
-	0xbb, 0x5e, 0x2f, 0x3c, 0x35, 0x33, 0x3d, 0xa3, 0x24, 0x35, 0xc5, 0x05, 0x61, 0x08, 0x92, 0xd9,
-	0x60, 0xb3, 0xa4, 0xbe, 0x32, 0x72, 0x09, 0x63, 0x51, 0x27, 0x24, 0xcf, 0xc5, 0x56, 0x0e, 0x16,
-	0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x75, 0x62, 0xff, 0xe5, 0xc4, 0xa2, 0xc5, 0xa4, 0xc1, 0x10,
-	0x04, 0x15, 0x16, 0xaa, 0xe5, 0xe2, 0x46, 0x72, 0x1c, 0xd4, 0x5f, 0xae, 0xe4, 0xb8, 0x49, 0x0f,
-	0x89, 0xed, 0x9a, 0x57, 0x52, 0x54, 0xe9, 0x24, 0xf2, 0xcb, 0x49, 0x70, 0x16, 0x23, 0x1f, 0x07,
-	0xa3, 0x12, 0x4b, 0x11, 0x93, 0x00, 0xa3, 0x16, 0x98, 0x0c, 0x42, 0xb6, 0x4f, 0xca, 0x8e, 0x4b,
-	0x00, 0x5d, 0x9b, 0x90, 0x00, 0x17, 0x73, 0x76, 0x6a, 0x25, 0xd8, 0xc1, 0x9c, 0x41, 0x20, 0xa6,
-	0x90, 0x08, 0x17, 0x6b, 0x59, 0x62, 0x4e, 0x69, 0xaa, 0x04, 0x13, 0x58, 0x0c, 0xc2, 0xb1, 0x62,
-	0xb2, 0x60, 0x74, 0xe2, 0x8a, 0xe2, 0x80, 0x39, 0x30, 0x89, 0x0d, 0x1c, 0xfb, 0xc6, 0x80, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x7b, 0xf5, 0xf0, 0xbd, 0x6d, 0x02, 0x00, 0x00,
+	// 531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x51, 0x6b, 0xd3, 0x50,
+	0x14, 0xc7, 0x7b, 0xd3, 0xa4, 0xed, 0x4e, 0x5b, 0xa9, 0xd7, 0xa1, 0x21, 0x0c, 0x9c, 0x03, 0xa1,
+	0x4c, 0x48, 0xd9, 0xf4, 0x61, 0xf3, 0x41, 0x24, 0x38, 0x28, 0xca, 0x10, 0xef, 0x0a, 0x82, 0x2f,
+	0x72, 0x4d, 0x4f, 0x9b, 0xb0, 0xb6, 0x09, 0x37, 0x37, 0xb5, 0x7d, 0xf0, 0x0b, 0xec, 0xd1, 0x47,
+	0x3f, 0x85, 0x9f, 0xc8, 0x6f, 0xe1, 0x4b, 0x9f, 0x24, 0xf7, 0x26, 0x2c, 0xd5, 0x82, 0xd6, 0xed,
+	0xa5, 0x9c, 0x73, 0x9a, 0xff, 0xef, 0x7f, 0x72, 0xce, 0x21, 0xf0, 0x68, 0x8a, 0x49, 0xd0, 0x9b,
+	0x1f, 0xf1, 0x49, 0x1c, 0xf0, 0xa3, 0x9e, 0x14, 0x7c, 0x34, 0x0a, 0xfd, 0x8f, 0x22, 0x4a, 0x25,
+	0xba, 0xb1, 0x88, 0x64, 0x44, 0xe9, 0x65, 0x3a, 0xe5, 0x6e, 0xf6, 0x9c, 0x5b, 0x3c, 0xe7, 0xec,
+	0xad, 0xcb, 0x12, 0x9c, 0xa0, 0x2f, 0x23, 0xa1, 0x15, 0xce, 0x83, 0x39, 0x9f, 0x84, 0x43, 0x2e,
+	0xb1, 0x57, 0x04, 0xfa, 0x8f, 0x83, 0xef, 0x3b, 0xd0, 0x1a, 0x68, 0x0b, 0x96, 0x39, 0xd0, 0x97,
+	0x50, 0x4f, 0xa2, 0x54, 0xf8, 0x98, 0xd8, 0x64, 0xbf, 0xda, 0x6d, 0x1e, 0xef, 0xb9, 0x7f, 0xba,
+	0xb9, 0x17, 0x39, 0xde, 0x6b, 0xac, 0x3c, 0xeb, 0x2b, 0x31, 0x1a, 0x84, 0x15, 0x32, 0xfa, 0x1a,
+	0x5a, 0x43, 0x4c, 0x64, 0x38, 0xe3, 0x32, 0x8c, 0x66, 0x89, 0x6d, 0x6c, 0x85, 0x59, 0xd3, 0x52,
+	0x06, 0xa6, 0x1f, 0xcd, 0x46, 0x76, 0x55, 0x31, 0x9e, 0x6d, 0x62, 0x94, 0xbb, 0x77, 0xdf, 0x63,
+	0x38, 0x0e, 0x24, 0x0e, 0x5f, 0x5d, 0x43, 0x4a, 0x6c, 0xc5, 0xa2, 0xa7, 0x60, 0x06, 0x52, 0xc6,
+	0xb6, 0xa9, 0x98, 0x8f, 0xff, 0xca, 0xec, 0x4b, 0x19, 0x33, 0x25, 0x71, 0x7e, 0x12, 0xb8, 0xb7,
+	0xc1, 0x82, 0x3e, 0x84, 0xda, 0x67, 0x55, 0xb6, 0xc9, 0x3e, 0xe9, 0xb6, 0xbd, 0xfa, 0xca, 0x33,
+	0x0f, 0x8d, 0x6e, 0x85, 0xe5, 0x65, 0xfa, 0x05, 0x9a, 0xa5, 0xf7, 0xca, 0x47, 0x72, 0xf6, 0x3f,
+	0xaf, 0xe3, 0x96, 0xe2, 0xb3, 0x99, 0x14, 0x4b, 0x6f, 0x77, 0xe5, 0xdd, 0xfd, 0x46, 0xee, 0x34,
+	0xc8, 0x81, 0x29, 0x8c, 0x0e, 0x39, 0x54, 0xbf, 0xac, 0xec, 0xe7, 0xbc, 0x80, 0xce, 0xef, 0x32,
+	0xda, 0x81, 0xea, 0x25, 0x2e, 0x55, 0xc3, 0x3b, 0x2c, 0x0b, 0xe9, 0x2e, 0x58, 0x73, 0x3e, 0x49,
+	0xd1, 0x36, 0x54, 0x4d, 0x27, 0xcf, 0x8d, 0x13, 0xe2, 0x5c, 0x59, 0x60, 0x66, 0x63, 0xa0, 0x6f,
+	0xc0, 0x9a, 0x72, 0xe9, 0x07, 0x4a, 0xd6, 0x3c, 0x7e, 0xf2, 0x4f, 0xc3, 0x73, 0xcf, 0x33, 0x89,
+	0xda, 0xc3, 0x15, 0xc9, 0x7a, 0xd3, 0x0c, 0x3a, 0x00, 0x2b, 0x89, 0x27, 0xa1, 0xb4, 0x8d, 0x5b,
+	0xd9, 0xae, 0x86, 0x39, 0x3f, 0xaa, 0x60, 0x29, 0x43, 0x7a, 0x0e, 0x66, 0xcc, 0x65, 0xd1, 0xeb,
+	0xe9, 0x16, 0xbd, 0xba, 0x17, 0x52, 0x84, 0xb3, 0xb1, 0x8a, 0x51, 0x30, 0x85, 0xa1, 0xef, 0xa0,
+	0x36, 0x45, 0x19, 0x44, 0x43, 0xdb, 0xb8, 0x29, 0x30, 0x07, 0x51, 0x06, 0xf5, 0x00, 0xf9, 0x10,
+	0x45, 0x92, 0x5f, 0xf8, 0xc9, 0x36, 0xcc, 0xbe, 0x96, 0xaa, 0x75, 0xb2, 0x02, 0xe4, 0x04, 0xd0,
+	0x5e, 0x33, 0xa3, 0x36, 0xd4, 0x62, 0x81, 0xa3, 0x70, 0xa1, 0x77, 0xdd, 0xaf, 0xb0, 0x3c, 0xa7,
+	0xf7, 0xc1, 0xc2, 0x05, 0xf7, 0xa5, 0x5e, 0x78, 0xbf, 0xc2, 0x74, 0x9a, 0xd5, 0x05, 0x8e, 0x71,
+	0x61, 0x57, 0x8b, 0xba, 0x4a, 0xbd, 0x36, 0x34, 0xa7, 0x1a, 0x3a, 0x58, 0xc6, 0xe8, 0xa4, 0xd0,
+	0x2a, 0xb7, 0xb0, 0xe1, 0xa2, 0xde, 0x96, 0x2f, 0xea, 0x46, 0x13, 0xbb, 0x3e, 0x46, 0x0f, 0x3e,
+	0x34, 0x0a, 0xf1, 0xa7, 0x9a, 0xfa, 0x8a, 0x3d, 0xfd, 0x35, 0x00, 0x83, 0x63, 0x23, 0x5f, 0x35,
+	0x05, 0x00, 0x00,
 }
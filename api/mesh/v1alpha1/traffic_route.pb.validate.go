@@ -110,6 +110,21 @@ func (m *TrafficRoute) Validate() error {
 
 	}
 
+	for idx, item := range m.GetHttp() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TrafficRouteValidationError{
+					field:  fmt.Sprintf("Http[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -267,3 +282,294 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = TrafficRoute_WeightedDestinationValidationError{}
+
+// Validate checks the field values on TrafficRoute_Http with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *TrafficRoute_Http) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetMatch() == nil {
+		return TrafficRoute_HttpValidationError{
+			field:  "Match",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetMatch()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TrafficRoute_HttpValidationError{
+				field:  "Match",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetSplit()) < 1 {
+		return TrafficRoute_HttpValidationError{
+			field:  "Split",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetSplit() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TrafficRoute_HttpValidationError{
+					field:  fmt.Sprintf("Split[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// TrafficRoute_HttpValidationError is the validation error returned by
+// TrafficRoute_Http.Validate if the designated constraints aren't met.
+type TrafficRoute_HttpValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrafficRoute_HttpValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrafficRoute_HttpValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrafficRoute_HttpValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrafficRoute_HttpValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrafficRoute_HttpValidationError) ErrorName() string {
+	return "TrafficRoute_HttpValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TrafficRoute_HttpValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrafficRoute_Http.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrafficRoute_HttpValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrafficRoute_HttpValidationError{}
+
+// Validate checks the field values on TrafficRoute_Http_Match with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *TrafficRoute_Http_Match) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetPath()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TrafficRoute_Http_MatchValidationError{
+				field:  "Path",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetMethod()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TrafficRoute_Http_MatchValidationError{
+				field:  "Method",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for key, val := range m.GetHeaders() {
+		_ = val
+
+		// no validation rules for Headers[key]
+
+		if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TrafficRoute_Http_MatchValidationError{
+					field:  fmt.Sprintf("Headers[%v]", key),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// TrafficRoute_Http_MatchValidationError is the validation error returned
+// by TrafficRoute_Http_Match.Validate if the designated constraints aren't met.
+type TrafficRoute_Http_MatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrafficRoute_Http_MatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrafficRoute_Http_MatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrafficRoute_Http_MatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrafficRoute_Http_MatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrafficRoute_Http_MatchValidationError) ErrorName() string {
+	return "TrafficRoute_Http_MatchValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TrafficRoute_Http_MatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrafficRoute_Http_Match.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrafficRoute_Http_MatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrafficRoute_Http_MatchValidationError{}
+
+// Validate checks the field values on TrafficRoute_Http_Match_StringMatcher with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *TrafficRoute_Http_Match_StringMatcher) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	switch m.MatcherType.(type) {
+
+	case *TrafficRoute_Http_Match_StringMatcher_Prefix:
+		// no validation rules for Prefix
+
+	case *TrafficRoute_Http_Match_StringMatcher_Exact:
+		// no validation rules for Exact
+
+	case *TrafficRoute_Http_Match_StringMatcher_Regex:
+		// no validation rules for Regex
+
+	}
+
+	return nil
+}
+
+// TrafficRoute_Http_Match_StringMatcherValidationError is the validation error
+// returned by TrafficRoute_Http_Match_StringMatcher.Validate if the designated
+// constraints aren't met.
+type TrafficRoute_Http_Match_StringMatcherValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrafficRoute_Http_Match_StringMatcherValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrafficRoute_Http_Match_StringMatcherValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrafficRoute_Http_Match_StringMatcherValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrafficRoute_Http_Match_StringMatcherValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrafficRoute_Http_Match_StringMatcherValidationError) ErrorName() string {
+	return "TrafficRoute_Http_Match_StringMatcherValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TrafficRoute_Http_Match_StringMatcherValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrafficRoute_Http_Match_StringMatcher.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrafficRoute_Http_Match_StringMatcherValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrafficRoute_Http_Match_StringMatcherValidationError{}
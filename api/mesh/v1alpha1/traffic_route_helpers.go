@@ -0,0 +1,15 @@
+package v1alpha1
+
+import (
+	"sort"
+)
+
+// HeaderNames returns sorted names of headers to match, so that they are always processed in the same order.
+func (m *TrafficRoute_Http_Match) HeaderNames() []string {
+	names := make([]string, 0, len(m.GetHeaders()))
+	for name := range m.GetHeaders() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,38 @@
+package v1alpha1_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/api/mesh/v1alpha1"
+)
+
+var _ = Describe("TrafficRouteHelper", func() {
+
+	Describe("HeaderNames", func() {
+		It("should return sorted names of headers", func() {
+			// given
+			match := &TrafficRoute_Http_Match{
+				Headers: map[string]*TrafficRoute_Http_Match_StringMatcher{
+					"x-region":     {MatcherType: &TrafficRoute_Http_Match_StringMatcher_Exact{Exact: "us"}},
+					"content-type": {MatcherType: &TrafficRoute_Http_Match_StringMatcher_Prefix{Prefix: "application/"}},
+					"x-canary":     {MatcherType: &TrafficRoute_Http_Match_StringMatcher_Regex{Regex: "^true$"}},
+				},
+			}
+
+			// when
+			names := match.HeaderNames()
+
+			// then
+			Expect(names).To(Equal([]string{"content-type", "x-canary", "x-region"}))
+		})
+
+		It("should return no names for a nil match", func() {
+			// given
+			var match *TrafficRoute_Http_Match
+
+			// expect
+			Expect(match.HeaderNames()).To(BeEmpty())
+		})
+	})
+})
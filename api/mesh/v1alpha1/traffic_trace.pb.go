@@ -6,6 +6,7 @@ package v1alpha1
 import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	math "math"
 )
 
@@ -73,10 +74,13 @@ func (m *TrafficTrace) GetConf() *TrafficTrace_Conf {
 // Configuration defines settings of the tracing.
 type TrafficTrace_Conf struct {
 	// Backend defined in the Mesh entity.
-	Backend              string   `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Backend string `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`
+	// Percentage of traces that will be sent to the backend (range 0.0 - 100.0).
+	// Empty value defaults to the sampling of the backend.
+	Sampling             *wrappers.DoubleValue `protobuf:"bytes,2,opt,name=sampling,proto3" json:"sampling,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *TrafficTrace_Conf) Reset()         { *m = TrafficTrace_Conf{} }
@@ -111,6 +115,13 @@ func (m *TrafficTrace_Conf) GetBackend() string {
 	return ""
 }
 
+func (m *TrafficTrace_Conf) GetSampling() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Sampling
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TrafficTrace)(nil), "kuma.mesh.v1alpha1.TrafficTrace")
 	proto.RegisterType((*TrafficTrace_Conf)(nil), "kuma.mesh.v1alpha1.TrafficTrace.Conf")
@@ -119,17 +130,21 @@ func init() {
 func init() { proto.RegisterFile("mesh/v1alpha1/traffic_trace.proto", fileDescriptor_bc2b4b31d8d46cbb) }
 
 var fileDescriptor_bc2b4b31d8d46cbb = []byte{
-	// 187 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcc, 0x4d, 0x2d, 0xce,
-	0xd0, 0x2f, 0x33, 0x4c, 0xcc, 0x29, 0xc8, 0x48, 0x34, 0xd4, 0x2f, 0x29, 0x4a, 0x4c, 0x4b, 0xcb,
-	0x4c, 0x8e, 0x2f, 0x29, 0x4a, 0x4c, 0x4e, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xca,
-	0x2e, 0xcd, 0x4d, 0xd4, 0x03, 0xa9, 0xd3, 0x83, 0xa9, 0x93, 0x92, 0x41, 0xd5, 0x56, 0x9c, 0x9a,
-	0x93, 0x9a, 0x5c, 0x92, 0x5f, 0x04, 0xd1, 0xa1, 0xb4, 0x9c, 0x91, 0x8b, 0x27, 0x04, 0x62, 0x52,
-	0x08, 0xc8, 0x20, 0x21, 0x2b, 0x2e, 0x4e, 0x98, 0x92, 0x62, 0x09, 0x46, 0x05, 0x66, 0x0d, 0x6e,
-	0x23, 0x19, 0x3d, 0x4c, 0x63, 0xf5, 0x82, 0xa1, 0x8a, 0x82, 0x10, 0xca, 0x85, 0x2c, 0xb9, 0x58,
-	0x92, 0xf3, 0xf3, 0xd2, 0x24, 0x98, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x54, 0xb1, 0x69, 0x43, 0xb6,
-	0x4b, 0xcf, 0x39, 0x3f, 0x2f, 0x2d, 0x08, 0xac, 0x45, 0x4a, 0x81, 0x8b, 0x05, 0xc4, 0x13, 0x92,
-	0xe0, 0x62, 0x4f, 0x4a, 0x4c, 0xce, 0x4e, 0xcd, 0x4b, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c,
-	0x82, 0x71, 0x9d, 0xb8, 0xa2, 0x38, 0x60, 0xa6, 0x24, 0xb1, 0x81, 0x1d, 0x6f, 0x0c, 0x08, 0x00,
-	0x00, 0xff, 0xff, 0x2b, 0xc3, 0x68, 0xa5, 0x13, 0x01, 0x00, 0x00,
+	// 241 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x8f, 0xbd, 0x4e, 0xc3, 0x30,
+	0x14, 0x46, 0x65, 0x5a, 0x41, 0xeb, 0x32, 0x79, 0xb2, 0xaa, 0x08, 0x05, 0x24, 0xa4, 0x4c, 0x37,
+	0x6a, 0x59, 0x80, 0x11, 0x78, 0x82, 0x50, 0x31, 0x74, 0x41, 0x37, 0xe6, 0x3a, 0xad, 0xea, 0xc4,
+	0x96, 0x9d, 0xc0, 0xf3, 0xf2, 0x26, 0x28, 0x09, 0xe6, 0x47, 0x30, 0x7e, 0xf2, 0x39, 0x3e, 0xba,
+	0xfc, 0xbc, 0xa6, 0xb0, 0xcb, 0x5f, 0x57, 0x68, 0xdc, 0x0e, 0x57, 0x79, 0xeb, 0x51, 0xeb, 0xbd,
+	0x7a, 0x6e, 0x3d, 0x2a, 0x02, 0xe7, 0x6d, 0x6b, 0x85, 0x38, 0x74, 0x35, 0x42, 0xcf, 0x41, 0xe4,
+	0x96, 0xc9, 0x6f, 0x2d, 0x90, 0x21, 0xd5, 0x5a, 0x3f, 0x1a, 0xcb, 0xb3, 0xca, 0xda, 0xca, 0x50,
+	0x3e, 0xac, 0xb2, 0xd3, 0xf9, 0x9b, 0x47, 0xe7, 0xc8, 0x87, 0xf1, 0xfd, 0xe2, 0x9d, 0xf1, 0xd3,
+	0xcd, 0x58, 0xda, 0xf4, 0x21, 0x71, 0xcb, 0xe7, 0xf1, 0x8b, 0x20, 0x59, 0x3a, 0xc9, 0x16, 0xeb,
+	0x04, 0xfe, 0x66, 0xe1, 0xf1, 0x13, 0x2a, 0xbe, 0x71, 0x71, 0xc3, 0xa7, 0xca, 0x36, 0x5a, 0x4e,
+	0x52, 0x96, 0x2d, 0xd6, 0x97, 0xff, 0x69, 0x3f, 0x5b, 0x70, 0x6f, 0x1b, 0x5d, 0x0c, 0xca, 0x72,
+	0xcb, 0xa7, 0xfd, 0x12, 0x92, 0x9f, 0x94, 0xa8, 0x0e, 0xd4, 0xbc, 0x48, 0x96, 0xb2, 0x6c, 0x5e,
+	0xc4, 0x29, 0xae, 0xf9, 0x2c, 0x60, 0xed, 0xcc, 0xbe, 0xa9, 0xe4, 0xd1, 0x10, 0x48, 0x60, 0x3c,
+	0x0e, 0xe2, 0x71, 0xf0, 0x60, 0xbb, 0xd2, 0xd0, 0x13, 0x9a, 0x8e, 0x8a, 0x2f, 0xfa, 0x8e, 0x6f,
+	0x67, 0xb1, 0x5f, 0x1e, 0x0f, 0xec, 0xd5, 0xc7, 0x00, 0x85, 0x5e, 0xf8, 0x6d, 0x6d, 0x01, 0x00,
+	0x00,
 }
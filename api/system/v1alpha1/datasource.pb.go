@@ -27,6 +27,7 @@ type DataSource struct {
 	//	*DataSource_Secret
 	//	*DataSource_File
 	//	*DataSource_Inline
+	//	*DataSource_Projected
 	Type                 isDataSource_Type `protobuf_oneof:"type"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
@@ -74,12 +75,18 @@ type DataSource_Inline struct {
 	Inline *wrappers.BytesValue `protobuf:"bytes,3,opt,name=inline,proto3,oneof"`
 }
 
+type DataSource_Projected struct {
+	Projected string `protobuf:"bytes,4,opt,name=projected,proto3,oneof"`
+}
+
 func (*DataSource_Secret) isDataSource_Type() {}
 
 func (*DataSource_File) isDataSource_Type() {}
 
 func (*DataSource_Inline) isDataSource_Type() {}
 
+func (*DataSource_Projected) isDataSource_Type() {}
+
 func (m *DataSource) GetType() isDataSource_Type {
 	if m != nil {
 		return m.Type
@@ -108,12 +115,20 @@ func (m *DataSource) GetInline() *wrappers.BytesValue {
 	return nil
 }
 
+func (m *DataSource) GetProjected() string {
+	if x, ok := m.GetType().(*DataSource_Projected); ok {
+		return x.Projected
+	}
+	return ""
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*DataSource) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*DataSource_Secret)(nil),
 		(*DataSource_File)(nil),
 		(*DataSource_Inline)(nil),
+		(*DataSource_Projected)(nil),
 	}
 }
 
@@ -124,18 +139,19 @@ func init() {
 func init() { proto.RegisterFile("system/v1alpha1/datasource.proto", fileDescriptor_4cdac177af1029de) }
 
 var fileDescriptor_4cdac177af1029de = []byte{
-	// 196 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x34, 0x8e, 0x3f, 0x4b, 0xc7, 0x30,
-	0x10, 0x86, 0xad, 0x96, 0xa0, 0xe7, 0x16, 0x3a, 0x04, 0x05, 0x29, 0x4e, 0x9d, 0x12, 0xaa, 0xf8,
-	0x05, 0x8a, 0x83, 0xb3, 0x82, 0x83, 0xdb, 0xb5, 0x5e, 0x6b, 0x31, 0x6d, 0x42, 0xfe, 0x28, 0xc5,
-	0x2f, 0x2f, 0xbf, 0xa4, 0x1d, 0xdf, 0xe3, 0x79, 0xdf, 0xe7, 0xa0, 0xf6, 0x9b, 0x0f, 0xb4, 0xa8,
-	0x9f, 0x16, 0xb5, 0xfd, 0xc2, 0x56, 0x7d, 0x62, 0x40, 0x6f, 0xa2, 0x1b, 0x48, 0x5a, 0x67, 0x82,
-	0xe1, 0xd5, 0x77, 0x5c, 0x50, 0x66, 0x4c, 0x1e, 0xd8, 0xcd, 0xdd, 0x64, 0xcc, 0xa4, 0x49, 0x25,
-	0xa6, 0x8f, 0xa3, 0xfa, 0x75, 0x68, 0x2d, 0x39, 0x9f, 0x5b, 0xf7, 0x7f, 0x00, 0xcf, 0x18, 0xf0,
-	0x2d, 0x2d, 0x71, 0x01, 0xcc, 0xd3, 0xe0, 0x28, 0x88, 0xa2, 0x2e, 0x9a, 0xab, 0x97, 0xb3, 0xd7,
-	0x3d, 0xf3, 0x0a, 0xca, 0x71, 0xd6, 0x24, 0xce, 0xf7, 0x7b, 0x4a, 0xfc, 0x09, 0xd8, 0xbc, 0xea,
-	0x79, 0x25, 0x71, 0x51, 0x17, 0xcd, 0xf5, 0xc3, 0xad, 0xcc, 0x3a, 0x79, 0xe8, 0x64, 0xb7, 0x05,
-	0xf2, 0xef, 0xa8, 0x23, 0x9d, 0xc6, 0x32, 0xdc, 0x31, 0x28, 0xc3, 0x66, 0xa9, 0x83, 0x8f, 0xcb,
-	0xe3, 0xd1, 0x9e, 0xa5, 0xca, 0xe3, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc6, 0xa1, 0x21, 0x28,
-	0xe9, 0x00, 0x00, 0x00,
+	// 211 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x8f, 0xc1, 0x4a, 0x03, 0x31,
+	0x10, 0x86, 0x8d, 0x2e, 0xc1, 0x8e, 0xb7, 0xd0, 0x43, 0x50, 0x28, 0x8b, 0xa7, 0x9e, 0x12, 0xaa,
+	0xf8, 0x02, 0x8b, 0x07, 0xcf, 0x0a, 0x1e, 0xbc, 0x4d, 0xb7, 0xd3, 0xba, 0x9a, 0x36, 0x21, 0x99,
+	0x28, 0xfb, 0x2c, 0xbe, 0xac, 0x98, 0xec, 0xe2, 0xf1, 0xff, 0xf9, 0xe6, 0xfb, 0x19, 0x68, 0xd3,
+	0x98, 0x98, 0x8e, 0xf6, 0x6b, 0x83, 0x2e, 0xbc, 0xe3, 0xc6, 0xee, 0x90, 0x31, 0xf9, 0x1c, 0x7b,
+	0x32, 0x21, 0x7a, 0xf6, 0x6a, 0xf9, 0x99, 0x8f, 0x68, 0x2a, 0x66, 0x66, 0xec, 0x7a, 0x75, 0xf0,
+	0xfe, 0xe0, 0xc8, 0x16, 0x66, 0x9b, 0xf7, 0xf6, 0x3b, 0x62, 0x08, 0x14, 0x53, 0xbd, 0xba, 0xfd,
+	0x11, 0x00, 0x8f, 0xc8, 0xf8, 0x52, 0x54, 0x4a, 0x83, 0x4c, 0xd4, 0x47, 0x62, 0x2d, 0x5a, 0xb1,
+	0x5e, 0x3c, 0x9d, 0x3d, 0x4f, 0x59, 0x2d, 0xa1, 0xd9, 0x0f, 0x8e, 0xf4, 0xf9, 0xd4, 0x97, 0xa4,
+	0x1e, 0x40, 0x0e, 0x27, 0x37, 0x9c, 0x48, 0x5f, 0xb4, 0x62, 0x7d, 0x75, 0x77, 0x63, 0xea, 0x9e,
+	0x99, 0xf7, 0x4c, 0x37, 0x32, 0xa5, 0x57, 0x74, 0x99, 0xfe, 0x64, 0x15, 0x56, 0x2b, 0x58, 0x84,
+	0xe8, 0x3f, 0xa8, 0x67, 0xda, 0xe9, 0x66, 0x32, 0xfe, 0x57, 0x9d, 0x84, 0x86, 0xc7, 0x40, 0x1d,
+	0xbc, 0x5d, 0xce, 0x9f, 0x6c, 0x65, 0x51, 0xde, 0xff, 0x0e, 0x00, 0xf1, 0x79, 0x29, 0xb7, 0x0a,
+	0x01, 0x00, 0x00,
 }
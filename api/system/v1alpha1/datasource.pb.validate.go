@@ -63,6 +63,9 @@ func (m *DataSource) Validate() error {
 			}
 		}
 
+	case *DataSource_Projected:
+		// no validation rules for Projected
+
 	}
 
 	return nil
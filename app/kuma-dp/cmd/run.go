@@ -2,9 +2,11 @@ package cmd
 
 import (
 	"crypto/tls"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	kuma_version "github.com/Kong/kuma/pkg/version"
@@ -17,6 +19,7 @@ import (
 	kumadp_config "github.com/Kong/kuma/app/kuma-dp/pkg/config"
 	"github.com/Kong/kuma/app/kuma-dp/pkg/dataplane/accesslogs"
 	"github.com/Kong/kuma/app/kuma-dp/pkg/dataplane/envoy"
+	"github.com/Kong/kuma/app/kuma-dp/pkg/dataplane/probes"
 	"github.com/Kong/kuma/pkg/config"
 	kuma_dp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
 	config_types "github.com/Kong/kuma/pkg/config/types"
@@ -113,6 +116,11 @@ func newRunCmd() *cobra.Command {
 			if err := componentMgr.Add(server, dataplane); err != nil {
 				return err
 			}
+			if cfg.Dataplane.VirtualProbesPort != 0 {
+				if err := componentMgr.Add(probes.NewVirtualProbesServer(cfg.Dataplane.VirtualProbesPort, cfg.Dataplane.VirtualProbesPaths)); err != nil {
+					return err
+				}
+			}
 
 			runLog.Info("starting Kuma DP", "version", kuma_version.Build.Version)
 			if err := componentMgr.Start(core.SetupSignalHandler()); err != nil {
@@ -131,5 +139,7 @@ func newRunCmd() *cobra.Command {
 	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.BinaryPath, "binary-path", cfg.DataplaneRuntime.BinaryPath, "Binary path of Envoy executable")
 	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.ConfigDir, "config-dir", cfg.DataplaneRuntime.ConfigDir, "Directory in which Envoy config will be generated")
 	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.TokenPath, "dataplane-token-file", cfg.DataplaneRuntime.TokenPath, "Path to a file with dataplane token (use 'kumactl generate dataplane-token' to get one)")
+	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.EnvoyLogLevel, "envoy-log-level", cfg.DataplaneRuntime.EnvoyLogLevel, fmt.Sprintf("Envoy log level: one of %s", strings.Join(kuma_dp.EnvoyLogLevels, "|")))
+	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.EnvoyLogFormat, "envoy-log-format", cfg.DataplaneRuntime.EnvoyLogFormat, fmt.Sprintf("Envoy log format: one of %s", strings.Join(kuma_dp.EnvoyLogFormats, "|")))
 	return cmd
 }
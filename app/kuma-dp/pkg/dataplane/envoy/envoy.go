@@ -91,6 +91,10 @@ func lookupEnvoyPath(configuredPath string) (string, error) {
 	return path, nil
 }
 
+// jsonLogFormat makes Envoy log every message as a JSON object.
+// Messages are escaped by Envoy, so that a multi-line message does not break a log line.
+const jsonLogFormat = `{"time":"%Y-%m-%dT%T.%e","level":"%l","logger":"%n","message":"%v"}`
+
 func (e *Envoy) Start(stop <-chan struct{}) error {
 	bootstrapConfig, err := e.opts.Generator(e.opts.Catalog.Apis.Bootstrap.Url, e.opts.Config)
 	if err != nil {
@@ -124,6 +128,15 @@ func (e *Envoy) Start(stop <-chan struct{}) error {
 		// so, let's turn it off to simplify getting started experience.
 		"--disable-hot-restart",
 	}
+	if e.opts.Config.Dataplane.Concurrency > 0 {
+		args = append(args, "--concurrency", fmt.Sprintf("%d", e.opts.Config.Dataplane.Concurrency))
+	}
+	if level := e.opts.Config.DataplaneRuntime.EnvoyLogLevel; level != "" {
+		args = append(args, "--log-level", level)
+	}
+	if e.opts.Config.DataplaneRuntime.EnvoyLogFormat == kuma_dp.EnvoyLogFormatJSON {
+		args = append(args, "--log-format", jsonLogFormat, "--log-format-escaped")
+	}
 	command := exec.CommandContext(ctx, resolvedPath, args...)
 	command.Stdout = e.opts.Stdout
 	command.Stderr = e.opts.Stderr
@@ -65,11 +65,14 @@ var _ = Describe("Envoy", func() {
 			// given
 			cfg := kuma_dp.Config{
 				Dataplane: kuma_dp.Dataplane{
-					DrainTime: 15 * time.Second,
+					DrainTime:   15 * time.Second,
+					Concurrency: 4,
 				},
 				DataplaneRuntime: kuma_dp.DataplaneRuntime{
-					BinaryPath: filepath.Join("testdata", "envoy-mock.exit-0.sh"),
-					ConfigDir:  configDir,
+					BinaryPath:     filepath.Join("testdata", "envoy-mock.exit-0.sh"),
+					ConfigDir:      configDir,
+					EnvoyLogLevel:  "debug",
+					EnvoyLogFormat: "json",
 				},
 			}
 			sampleConfig := func(string, kuma_dp.Config) (proto.Message, error) {
@@ -119,7 +122,7 @@ var _ = Describe("Envoy", func() {
 			// then
 			Expect(err).ToNot(HaveOccurred())
 			// and
-			Expect(strings.TrimSpace(buf.String())).To(Equal(fmt.Sprintf("-c %s --drain-time-s 15 --disable-hot-restart", expectedConfigFile)))
+			Expect(strings.TrimSpace(buf.String())).To(Equal(fmt.Sprintf("-c %s --drain-time-s 15 --disable-hot-restart --concurrency 4 --log-level debug --log-format %s --log-format-escaped", expectedConfigFile, `{"time":"%Y-%m-%dT%T.%e","level":"%l","logger":"%n","message":"%v"}`)))
 
 			By("verifying the contents Envoy config file")
 			// when
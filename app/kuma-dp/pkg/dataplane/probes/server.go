@@ -0,0 +1,113 @@
+package probes
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Kong/kuma/pkg/core"
+	"github.com/Kong/kuma/pkg/core/runtime/component"
+)
+
+var logger = core.Log.WithName("virtual-probes")
+
+var _ component.Component = &virtualProbesServer{}
+
+// virtualProbesServer serves HTTP probes of applications that Kuma Injector has rewritten to target the sidecar,
+// i.e. probes whose path is the application port followed by the original path, e.g. `/8080/healthz`.
+// Kubelet cannot probe applications directly once inbound traffic is redirected to Envoy,
+// so probes are forwarded to applications over localhost, which is not redirected.
+//
+// Since the port of the server is not redirected either, only paths of probes rewritten by Kuma Injector are forwarded,
+// otherwise any port of the Pod, e.g. Envoy admin, would be reachable without mTLS.
+type virtualProbesServer struct {
+	address string
+	paths   map[string]bool
+	client  *http.Client
+}
+
+func NewVirtualProbesServer(port uint32, paths []string) *virtualProbesServer {
+	allowed := map[string]bool{}
+	for _, path := range paths {
+		allowed[path] = true
+	}
+	return &virtualProbesServer{
+		address: fmt.Sprintf(":%d", port),
+		paths:   allowed,
+		client: &http.Client{
+			// kubelet treats redirects as a success, so they are passed through rather than followed
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+func (s *virtualProbesServer) ServeHTTP(writer http.ResponseWriter, req *http.Request) {
+	if !s.paths[req.URL.Path] {
+		http.NotFound(writer, req)
+		return
+	}
+	parts := strings.SplitN(strings.TrimPrefix(req.URL.Path, "/"), "/", 2)
+	port, err := strconv.ParseUint(parts[0], 10, 16)
+	if err != nil || port == 0 {
+		http.Error(writer, fmt.Sprintf("path %q must start with a port of the application", req.URL.Path), http.StatusBadRequest)
+		return
+	}
+	target := *req.URL
+	target.Scheme = "http"
+	target.Host = net.JoinHostPort("127.0.0.1", strconv.FormatUint(port, 10))
+	target.Path = "/"
+	if len(parts) == 2 {
+		target.Path += parts[1]
+	}
+	target.RawPath = ""
+
+	probe, err := http.NewRequest(http.MethodGet, target.String(), nil)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+	probe = probe.WithContext(req.Context())
+	// headers of a probe are defined by the application, so they are forwarded as is
+	for name, values := range req.Header {
+		probe.Header[name] = values
+	}
+	resp, err := s.client.Do(probe)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	defer resp.Body.Close()
+	// kubelet only checks the status code of a probe
+	writer.WriteHeader(resp.StatusCode)
+}
+
+func (s *virtualProbesServer) Start(stop <-chan struct{}) error {
+	lis, err := net.Listen("tcp", s.address)
+	if err != nil {
+		return err
+	}
+	server := &http.Server{
+		Handler:           s,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	logger.Info("starting Virtual Probes Server", "address", lis.Addr().String())
+	errCh := make(chan error, 1)
+	go func() {
+		if err := server.Serve(lis); err != nil && err != http.ErrServerClosed {
+			errCh <- err
+		}
+	}()
+	select {
+	case err := <-errCh:
+		return err
+	case <-stop:
+		logger.Info("stopping Virtual Probes Server")
+		return server.Shutdown(context.Background())
+	}
+}
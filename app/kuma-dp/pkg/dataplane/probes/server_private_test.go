@@ -0,0 +1,117 @@
+package probes
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Virtual Probes Server", func() {
+
+	var app *httptest.Server
+	var appPort string
+	var server *virtualProbesServer
+
+	BeforeEach(func() {
+		app = httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			switch req.URL.Path {
+			case "/healthz":
+				if req.URL.Query().Get("verbose") != "true" || req.Header.Get("X-Probe") != "liveness" {
+					writer.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				writer.WriteHeader(http.StatusOK)
+			case "/moved":
+				http.Redirect(writer, req, "/elsewhere", http.StatusFound)
+			default:
+				writer.WriteHeader(http.StatusInternalServerError)
+			}
+		}))
+		u, err := url.Parse(app.URL)
+		Expect(err).ToNot(HaveOccurred())
+		appPort = u.Port()
+		server = NewVirtualProbesServer(0, []string{
+			fmt.Sprintf("/%s/healthz", appPort),
+			fmt.Sprintf("/%s/broken", appPort),
+			fmt.Sprintf("/%s/moved", appPort),
+		})
+	})
+
+	AfterEach(func() {
+		app.Close()
+	})
+
+	type testCase struct {
+		path           func() string
+		expectedStatus int
+	}
+
+	DescribeTable("should forward probes to the application",
+		func(given testCase) {
+			// given
+			req := httptest.NewRequest(http.MethodGet, given.path(), nil)
+			req.Header.Set("X-Probe", "liveness")
+			recorder := httptest.NewRecorder()
+
+			// when
+			server.ServeHTTP(recorder, req)
+
+			// then
+			Expect(recorder.Code).To(Equal(given.expectedStatus))
+		},
+		Entry("successful probe with a query", testCase{
+			path:           func() string { return fmt.Sprintf("/%s/healthz?verbose=true", appPort) },
+			expectedStatus: http.StatusOK,
+		}),
+		Entry("failing probe", testCase{
+			path:           func() string { return fmt.Sprintf("/%s/broken", appPort) },
+			expectedStatus: http.StatusInternalServerError,
+		}),
+		Entry("redirect is passed through", testCase{
+			path:           func() string { return fmt.Sprintf("/%s/moved", appPort) },
+			expectedStatus: http.StatusFound,
+		}),
+	)
+
+	DescribeTable("should reject paths that were not rewritten by the injector",
+		func(path func() string) {
+			// given
+			req := httptest.NewRequest(http.MethodGet, path(), nil)
+			req.Header.Set("X-Probe", "liveness")
+			recorder := httptest.NewRecorder()
+
+			// when
+			server.ServeHTTP(recorder, req)
+
+			// then
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		},
+		Entry("other path of the application port", func() string {
+			return fmt.Sprintf("/%s/elsewhere", appPort)
+		}),
+		Entry("other port", func() string {
+			return "/9901/ready"
+		}),
+		Entry("path without a port", func() string {
+			return "/healthz"
+		}),
+	)
+
+	It("should fail a probe of an application that does not listen", func() {
+		// given
+		app.Close()
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s/healthz", appPort), nil)
+		recorder := httptest.NewRecorder()
+
+		// when
+		server.ServeHTTP(recorder, req)
+
+		// then
+		Expect(recorder.Code).To(Equal(http.StatusServiceUnavailable))
+	})
+})
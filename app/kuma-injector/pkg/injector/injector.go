@@ -3,7 +3,10 @@ package injector
 import (
 	"context"
 	"fmt"
+	"math"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -16,6 +19,7 @@ import (
 	kube_core "k8s.io/api/core/v1"
 	kube_api "k8s.io/apimachinery/pkg/api/resource"
 	kube_types "k8s.io/apimachinery/pkg/types"
+	kube_intstr "k8s.io/apimachinery/pkg/util/intstr"
 	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -27,6 +31,10 @@ const (
 const (
 	// serviceAccountTokenMountPath is a well-known location where Kubernetes mounts a ServiceAccount token.
 	serviceAccountTokenMountPath = "/var/run/secrets/kubernetes.io/serviceaccount"
+	// sidecarTmpVolumeName is a name of a writable volume for Envoy configuration
+	// in case the sidecar container has a read-only root filesystem.
+	sidecarTmpVolumeName = "kuma-sidecar-tmp"
+	sidecarTmpMountPath  = "/tmp"
 )
 
 func New(cfg config.Injector, client kube_client.Client) *KumaInjector {
@@ -48,23 +56,111 @@ func (i *KumaInjector) InjectKuma(pod *kube_core.Pod) error {
 	if pod.Annotations[metadata.KumaSidecarInjectionAnnotation] == metadata.KumaSidecarInjectionDisabled {
 		return nil
 	}
+	// unless a Mesh is selected explicitly, it is derived from the Namespace of a Pod
+	if pod.Annotations[metadata.KumaMeshAnnotation] == "" && i.cfg.NamespaceMeshLabel != "" {
+		meshName, err := i.namespaceMeshFor(pod)
+		if err != nil {
+			return err
+		}
+		// Pods in Namespaces that belong to no Mesh are left untouched
+		if meshName == "" {
+			return nil
+		}
+		if pod.Annotations == nil {
+			pod.Annotations = map[string]string{}
+		}
+		pod.Annotations[metadata.KumaMeshAnnotation] = meshName
+	}
+	// re-injection only refreshes labels and annotations set by Kuma
+	if i.hasSidecarContainer(pod) {
+		mesh, err := i.meshFor(pod)
+		if err != nil {
+			return errors.Wrap(err, "could not retrieve mesh for pod")
+		}
+		return i.injectMetadata(pod, mesh)
+	}
+	drainTime, err := metadata.GetDrainTime(pod, i.cfg.SidecarContainer.DrainTime)
+	if err != nil {
+		return err
+	}
+	concurrency, err := metadata.GetConcurrency(pod, i.cfg.SidecarContainer.Concurrency)
+	if err != nil {
+		return err
+	}
+	logLevel, err := metadata.GetSidecarLogLevel(pod, i.cfg.SidecarContainer.EnvoyLogLevel)
+	if err != nil {
+		return err
+	}
+	logFormat, err := metadata.GetSidecarLogFormat(pod, i.cfg.SidecarContainer.EnvoyLogFormat)
+	if err != nil {
+		return err
+	}
+	envFrom, err := metadata.GetSidecarEnvFrom(pod)
+	if err != nil {
+		return err
+	}
+	nativeSidecar, err := metadata.GetNativeSidecar(pod, i.cfg.SidecarContainer.NativeSidecar)
+	if err != nil {
+		return err
+	}
+	virtualProbes, err := metadata.GetVirtualProbes(pod, i.cfg.SidecarContainer.VirtualProbes.Enabled)
+	if err != nil {
+		return err
+	}
+	// preStop hooks of application containers
+	if i.cfg.SidecarContainer.PreStop.Enabled && i.cfg.SidecarContainer.PreStop.AppContainers {
+		for idx := range pod.Spec.Containers {
+			i.addPreStopHook(&pod.Spec.Containers[idx], drainTime)
+		}
+	}
+	// HTTP probes of application containers
+	var virtualProbePaths []string
+	if virtualProbes {
+		for idx := range pod.Spec.Containers {
+			virtualProbePaths = append(virtualProbePaths, i.rewriteProbes(&pod.Spec.Containers[idx])...)
+		}
+	}
 	// sidecar container
-	if pod.Spec.Containers == nil {
-		pod.Spec.Containers = []kube_core.Container{}
+	sidecar := i.NewSidecarContainer(pod, drainTime, concurrency, logLevel, logFormat)
+	sidecar.EnvFrom = append(sidecar.EnvFrom, envFrom...)
+	if virtualProbes {
+		sidecar.Env = append(sidecar.Env, kube_core.EnvVar{
+			Name:  "KUMA_DATAPLANE_VIRTUAL_PROBES_PORT",
+			Value: fmt.Sprintf("%d", i.cfg.SidecarContainer.VirtualProbes.Port),
+		}, kube_core.EnvVar{
+			// only probes rewritten by the injector are forwarded, otherwise any port of the Pod could be reached
+			// through the virtual probes port, which is excluded from the redirection to Envoy
+			Name:  "KUMA_DATAPLANE_VIRTUAL_PROBES_PATHS",
+			Value: strings.Join(virtualProbePaths, ","),
+		})
+	}
+	if !nativeSidecar {
+		if pod.Spec.Containers == nil {
+			pod.Spec.Containers = []kube_core.Container{}
+		}
+		pod.Spec.Containers = append(pod.Spec.Containers, sidecar)
+	}
+	if i.cfg.SidecarContainer.SecurityContext.ReadOnlyRootFilesystem {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, kube_core.Volume{
+			Name: sidecarTmpVolumeName,
+			VolumeSource: kube_core.VolumeSource{
+				EmptyDir: &kube_core.EmptyDirVolumeSource{},
+			},
+		})
+	}
+	// termination grace period
+	if i.cfg.SidecarContainer.PreStop.Enabled {
+		pod.Spec.TerminationGracePeriodSeconds = i.terminationGracePeriodFor(pod, drainTime)
 	}
-	pod.Spec.Containers = append(pod.Spec.Containers, i.NewSidecarContainer(pod))
 
 	mesh, err := i.meshFor(pod)
 	if err != nil {
 		return errors.Wrap(err, "could not retrieve mesh for pod")
 	}
 
-	// annotations
-	if pod.Annotations == nil {
-		pod.Annotations = map[string]string{}
-	}
-	for key, value := range i.NewAnnotations(pod, mesh) {
-		pod.Annotations[key] = value
+	// labels and annotations
+	if err := i.injectMetadata(pod, mesh); err != nil {
+		return err
 	}
 
 	// init container
@@ -72,12 +168,96 @@ func (i *KumaInjector) InjectKuma(pod *kube_core.Pod) error {
 		if pod.Spec.InitContainers == nil {
 			pod.Spec.InitContainers = []kube_core.Container{}
 		}
-		pod.Spec.InitContainers = append(pod.Spec.InitContainers, i.NewInitContainer(pod))
+		initContainer := i.NewInitContainer(pod)
+		if virtualProbes {
+			// kubelet has to reach the virtual probes port of the sidecar directly
+			initContainer.Args = append(initContainer.Args, "-d", fmt.Sprintf("%d", i.cfg.SidecarContainer.VirtualProbes.Port))
+		}
+		pod.Spec.InitContainers = append(pod.Spec.InitContainers, initContainer)
+	}
+	// native sidecar starts after iptables are configured and keeps running next to application containers,
+	// see the restart policy set by the webhook
+	if nativeSidecar {
+		pod.Spec.InitContainers = append(pod.Spec.InitContainers, sidecar)
 	}
 
 	return nil
 }
 
+func (i *KumaInjector) hasSidecarContainer(pod *kube_core.Pod) bool {
+	for _, container := range pod.Spec.Containers {
+		if container.Name == KumaSidecarContainerName {
+			return true
+		}
+	}
+	// native sidecar
+	for _, container := range pod.Spec.InitContainers {
+		if container.Name == KumaSidecarContainerName {
+			return true
+		}
+	}
+	return false
+}
+
+// reservedAnnotations are annotations that are only meant to be set by Kuma.
+var reservedAnnotations = []string{
+	metadata.KumaSidecarInjectedAnnotation,
+	metadata.KumaTransparentProxyingAnnotation,
+	metadata.KumaTransparentProxyingPortAnnotation,
+}
+
+// injectMetadata sets labels and annotations of Kuma, unless a user has set reserved ones to different values.
+func (i *KumaInjector) injectMetadata(pod *kube_core.Pod, mesh *mesh_core.MeshResource) error {
+	if err := i.validateReservedMetadata(pod, mesh); err != nil {
+		return err
+	}
+	i.mergeMetadata(pod, mesh)
+	return nil
+}
+
+// validateReservedMetadata makes sure that labels and annotations reserved for Kuma
+// are either not set by a user or set to the same values Kuma would set.
+func (i *KumaInjector) validateReservedMetadata(pod *kube_core.Pod, mesh *mesh_core.MeshResource) error {
+	for key, value := range i.NewLabels(pod, mesh) {
+		if actual, exists := pod.Labels[key]; exists && actual != value {
+			return errors.Errorf("label %q is reserved for Kuma and has to be either unset or set to %q, got %q", key, value, actual)
+		}
+	}
+	annotations := i.NewAnnotations(pod, mesh)
+	for _, key := range reservedAnnotations {
+		if actual, exists := pod.Annotations[key]; exists && actual != annotations[key] {
+			return errors.Errorf("annotation %q is reserved for Kuma and has to be either unset or set to %q, got %q", key, annotations[key], actual)
+		}
+	}
+	return nil
+}
+
+// mergeMetadata adds labels and annotations set by Kuma, preserving the rest of user-defined ones.
+func (i *KumaInjector) mergeMetadata(pod *kube_core.Pod, mesh *mesh_core.MeshResource) {
+	if pod.Labels == nil {
+		pod.Labels = map[string]string{}
+	}
+	for key, value := range i.NewLabels(pod, mesh) {
+		pod.Labels[key] = value
+	}
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	for key, value := range i.NewAnnotations(pod, mesh) {
+		pod.Annotations[key] = value
+	}
+}
+
+// namespaceMeshFor returns a name of a Mesh defined by a label of the Pod's Namespace
+// or an empty string if the Namespace belongs to no Mesh.
+func (i *KumaInjector) namespaceMeshFor(pod *kube_core.Pod) (string, error) {
+	namespace := &kube_core.Namespace{}
+	if err := i.client.Get(context.Background(), kube_types.NamespacedName{Name: pod.Namespace}, namespace); err != nil {
+		return "", errors.Wrapf(err, "could not retrieve namespace %q of pod", pod.Namespace)
+	}
+	return namespace.Labels[i.cfg.NamespaceMeshLabel], nil
+}
+
 func (i *KumaInjector) meshFor(pod *kube_core.Pod) (*mesh_core.MeshResource, error) {
 	meshName := metadata.GetMesh(pod) // either user-defined value or default
 	mesh := &mesh_k8s.Mesh{}
@@ -91,9 +271,9 @@ func (i *KumaInjector) meshFor(pod *kube_core.Pod) (*mesh_core.MeshResource, err
 	return meshResource, nil
 }
 
-func (i *KumaInjector) NewSidecarContainer(pod *kube_core.Pod) kube_core.Container {
+func (i *KumaInjector) NewSidecarContainer(pod *kube_core.Pod, drainTime time.Duration, concurrency uint32, logLevel, logFormat string) kube_core.Container {
 	mesh := metadata.GetMesh(pod) // either user-defined value or default
-	return kube_core.Container{
+	container := kube_core.Container{
 		Name:            KumaSidecarContainerName,
 		Image:           i.cfg.SidecarContainer.Image,
 		ImagePullPolicy: kube_core.PullIfNotPresent,
@@ -149,17 +329,14 @@ func (i *KumaInjector) NewSidecarContainer(pod *kube_core.Pod) kube_core.Contain
 			},
 			{
 				Name:  "KUMA_DATAPLANE_DRAIN_TIME",
-				Value: i.cfg.SidecarContainer.DrainTime.String(),
+				Value: drainTime.String(),
 			},
 			{
 				Name:  "KUMA_DATAPLANE_RUNTIME_TOKEN_PATH",
 				Value: "/var/run/secrets/kubernetes.io/serviceaccount/token",
 			},
 		},
-		SecurityContext: &kube_core.SecurityContext{
-			RunAsUser:  &i.cfg.SidecarContainer.UID,
-			RunAsGroup: &i.cfg.SidecarContainer.GID,
-		},
+		SecurityContext: i.NewSidecarSecurityContext(),
 		LivenessProbe: &kube_core.Probe{
 			Handler: kube_core.Handler{
 				Exec: &kube_core.ExecAction{
@@ -182,7 +359,7 @@ func (i *KumaInjector) NewSidecarContainer(pod *kube_core.Pod) kube_core.Contain
 					Command: []string{
 						"wget",
 						"-qO-",
-						fmt.Sprintf("http://127.0.0.1:%d", i.cfg.SidecarContainer.AdminPort),
+						fmt.Sprintf("http://127.0.0.1:%d%s", i.cfg.SidecarContainer.AdminPort, i.cfg.SidecarContainer.ReadinessProbe.Path),
 					},
 				},
 			},
@@ -208,6 +385,190 @@ func (i *KumaInjector) NewSidecarContainer(pod *kube_core.Pod) kube_core.Contain
 		// ServiceAccount volume mount into containers it creates.
 		VolumeMounts: i.NewVolumeMounts(pod),
 	}
+	if i.cfg.SidecarContainer.SecurityContext.ReadOnlyRootFilesystem {
+		container.VolumeMounts = append(container.VolumeMounts, kube_core.VolumeMount{
+			Name:      sidecarTmpVolumeName,
+			MountPath: sidecarTmpMountPath,
+		})
+	}
+	if concurrency > 0 {
+		container.Env = append(container.Env, kube_core.EnvVar{
+			Name:  "KUMA_DATAPLANE_CONCURRENCY",
+			Value: fmt.Sprintf("%d", concurrency),
+		})
+	}
+	for _, source := range i.cfg.SidecarContainer.EnvFrom {
+		container.EnvFrom = append(container.EnvFrom, newEnvFromSource(source))
+	}
+	for _, env := range i.cfg.SidecarContainer.Env {
+		container.Env = append(container.Env, newEnvVar(env))
+	}
+	if logLevel != "" {
+		container.Args = append(container.Args, fmt.Sprintf("--envoy-log-level=%s", logLevel))
+	}
+	if logFormat != "" {
+		container.Args = append(container.Args, fmt.Sprintf("--envoy-log-format=%s", logFormat))
+	}
+	if i.cfg.SidecarContainer.PreStop.Enabled {
+		i.addPreStopHook(&container, drainTime)
+	}
+	return container
+}
+
+func newEnvFromSource(source config.SidecarEnvFromSource) kube_core.EnvFromSource {
+	if source.Secret != "" {
+		return kube_core.EnvFromSource{
+			SecretRef: &kube_core.SecretEnvSource{
+				LocalObjectReference: kube_core.LocalObjectReference{Name: source.Secret},
+			},
+		}
+	}
+	return kube_core.EnvFromSource{
+		ConfigMapRef: &kube_core.ConfigMapEnvSource{
+			LocalObjectReference: kube_core.LocalObjectReference{Name: source.ConfigMap},
+		},
+	}
+}
+
+func newEnvVar(env config.SidecarEnvVar) kube_core.EnvVar {
+	if env.SecretKeyRef != nil {
+		return kube_core.EnvVar{
+			Name: env.Name,
+			ValueFrom: &kube_core.EnvVarSource{
+				SecretKeyRef: &kube_core.SecretKeySelector{
+					LocalObjectReference: kube_core.LocalObjectReference{Name: env.SecretKeyRef.Name},
+					Key:                  env.SecretKeyRef.Key,
+				},
+			},
+		}
+	}
+	return kube_core.EnvVar{
+		Name: env.Name,
+		ValueFrom: &kube_core.EnvVarSource{
+			ConfigMapKeyRef: &kube_core.ConfigMapKeySelector{
+				LocalObjectReference: kube_core.LocalObjectReference{Name: env.ConfigMapKeyRef.Name},
+				Key:                  env.ConfigMapKeyRef.Key,
+			},
+		},
+	}
+}
+
+func (i *KumaInjector) NewSidecarSecurityContext() *kube_core.SecurityContext {
+	cfg := i.cfg.SidecarContainer.SecurityContext
+	securityContext := &kube_core.SecurityContext{
+		RunAsUser:                &i.cfg.SidecarContainer.UID,
+		RunAsGroup:               &i.cfg.SidecarContainer.GID,
+		AllowPrivilegeEscalation: &cfg.AllowPrivilegeEscalation,
+	}
+	if cfg.RunAsNonRoot {
+		securityContext.RunAsNonRoot = &cfg.RunAsNonRoot
+	}
+	if cfg.ReadOnlyRootFilesystem {
+		securityContext.ReadOnlyRootFilesystem = &cfg.ReadOnlyRootFilesystem
+	}
+	if len(cfg.DropCapabilities) > 0 {
+		securityContext.Capabilities = &kube_core.Capabilities{
+			Drop: capabilities(cfg.DropCapabilities),
+		}
+	}
+	return securityContext
+}
+
+func capabilities(names []string) []kube_core.Capability {
+	var capabilities []kube_core.Capability
+	for _, name := range names {
+		capabilities = append(capabilities, kube_core.Capability(name))
+	}
+	return capabilities
+}
+
+// addPreStopHook delays termination of a given container until listeners are drained.
+// User-defined preStop hooks are left untouched.
+func (i *KumaInjector) addPreStopHook(container *kube_core.Container, drainTime time.Duration) {
+	if container.Lifecycle == nil {
+		container.Lifecycle = &kube_core.Lifecycle{}
+	}
+	if container.Lifecycle.PreStop != nil {
+		return
+	}
+	container.Lifecycle.PreStop = &kube_core.Handler{
+		Exec: &kube_core.ExecAction{
+			Command: []string{
+				"sleep",
+				fmt.Sprintf("%d", seconds(drainTime)),
+			},
+		},
+	}
+}
+
+// rewriteProbes makes HTTP probes of an application container go through the virtual probes port of the sidecar,
+// since kubelet cannot reach the application directly once inbound traffic is redirected to Envoy.
+// The original port becomes the first segment of the path, e.g. `/8080/healthz`.
+// Exec and TCP probes are left untouched, as well as HTTP probes of other hosts, HTTPS probes
+// and probes that already target the virtual probes port, so that rewriting is idempotent.
+//
+// Paths of virtual probes are returned, without a query, so that the sidecar serves only those.
+func (i *KumaInjector) rewriteProbes(container *kube_core.Container) []string {
+	var paths []string
+	virtualPort := int(i.cfg.SidecarContainer.VirtualProbes.Port)
+	for _, probe := range []*kube_core.Probe{container.LivenessProbe, container.ReadinessProbe} {
+		if probe == nil || probe.HTTPGet == nil {
+			continue
+		}
+		httpGet := probe.HTTPGet
+		if httpGet.Host != "" || httpGet.Scheme == kube_core.URISchemeHTTPS {
+			continue
+		}
+		// paths are passed to the sidecar as a comma-separated list
+		if strings.Contains(httpGet.Path, ",") {
+			continue
+		}
+		port, ok := containerPort(container, httpGet.Port)
+		if !ok {
+			continue
+		}
+		if port != virtualPort {
+			path := httpGet.Path
+			if !strings.HasPrefix(path, "/") {
+				path = "/" + path
+			}
+			httpGet.Path = fmt.Sprintf("/%d%s", port, path)
+			httpGet.Port = kube_intstr.FromInt(virtualPort)
+		}
+		paths = append(paths, strings.SplitN(httpGet.Path, "?", 2)[0])
+	}
+	return paths
+}
+
+// containerPort resolves a port of a probe, which might be a name of a port of a container.
+func containerPort(container *kube_core.Container, port kube_intstr.IntOrString) (int, bool) {
+	if port.Type == kube_intstr.Int {
+		return port.IntValue(), true
+	}
+	for _, containerPort := range container.Ports {
+		if containerPort.Name == port.StrVal {
+			return int(containerPort.ContainerPort), true
+		}
+	}
+	return 0, false
+}
+
+// terminationGracePeriodFor makes sure a Pod is given enough time to drain listeners.
+// User-defined grace periods that are long enough are left untouched.
+func (i *KumaInjector) terminationGracePeriodFor(pod *kube_core.Pod, drainTime time.Duration) *int64 {
+	required := seconds(drainTime + i.cfg.SidecarContainer.PreStop.ExtraTerminationGracePeriod)
+	current := int64(kube_core.DefaultTerminationGracePeriodSeconds)
+	if pod.Spec.TerminationGracePeriodSeconds != nil {
+		current = *pod.Spec.TerminationGracePeriodSeconds
+	}
+	if current < required {
+		return &required
+	}
+	return &current
+}
+
+func seconds(d time.Duration) int64 {
+	return int64(math.Ceil(d.Seconds()))
 }
 
 func (i *KumaInjector) NewVolumeMounts(pod *kube_core.Pod) []kube_core.VolumeMount {
@@ -256,12 +617,13 @@ func (i *KumaInjector) NewInitContainer(pod *kube_core.Pod) kube_core.Container
 			inboundPortsToIntercept,
 		},
 		SecurityContext: &kube_core.SecurityContext{
-			RunAsUser:  new(int64), // way to get pointer to int64(0)
-			RunAsGroup: new(int64),
+			RunAsUser:                new(int64), // way to get pointer to int64(0)
+			RunAsGroup:               new(int64),
+			AllowPrivilegeEscalation: &i.cfg.InitContainer.SecurityContext.AllowPrivilegeEscalation,
 			Capabilities: &kube_core.Capabilities{
-				Add: []kube_core.Capability{
-					kube_core.Capability("NET_ADMIN"),
-				},
+				// NET_ADMIN is required to configure iptables
+				Add:  append([]kube_core.Capability{"NET_ADMIN"}, capabilities(i.cfg.InitContainer.SecurityContext.AddCapabilities)...),
+				Drop: capabilities(i.cfg.InitContainer.SecurityContext.DropCapabilities),
 			},
 		},
 		Resources: kube_core.ResourceRequirements{
@@ -277,6 +639,13 @@ func (i *KumaInjector) NewInitContainer(pod *kube_core.Pod) kube_core.Container
 	}
 }
 
+func (i *KumaInjector) NewLabels(pod *kube_core.Pod, mesh *mesh_core.MeshResource) map[string]string {
+	return map[string]string{
+		metadata.KumaMeshLabel:            mesh.GetMeta().GetName(),
+		metadata.KumaSidecarInjectedLabel: metadata.KumaSidecarInjected,
+	}
+}
+
 func (i *KumaInjector) NewAnnotations(pod *kube_core.Pod, mesh *mesh_core.MeshResource) map[string]string {
 	annotations := map[string]string{
 		metadata.KumaMeshAnnotation:                    mesh.GetMeta().GetName(), // either user-defined value or default
@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
 
 	inject "github.com/Kong/kuma/app/kuma-injector/pkg/injector"
 	"github.com/Kong/kuma/pkg/config"
@@ -16,6 +17,8 @@ import (
 	"github.com/Kong/kuma/pkg/plugins/resources/k8s/native/api/v1alpha1"
 
 	kube_core "k8s.io/api/core/v1"
+	kube_apierrs "k8s.io/apimachinery/pkg/api/errors"
+	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 
 	"github.com/ghodss/yaml"
@@ -32,8 +35,9 @@ var _ = Describe("Injector", func() {
 	})
 
 	type testCase struct {
-		num  string
-		mesh string
+		num     string
+		mesh    string
+		cfgFile string
 	}
 
 	BeforeEach(func() {
@@ -54,6 +58,13 @@ var _ = Describe("Injector", func() {
 			err = k8sClient.Create(context.Background(), obj)
 			Expect(err).ToNot(HaveOccurred())
 
+			// and use a non-default config
+			if given.cfgFile != "" {
+				var cfg conf.Injector
+				Expect(config.Load(filepath.Join("testdata", given.cfgFile), &cfg)).To(Succeed())
+				injector = inject.New(cfg, k8sClient)
+			}
+
 			// given
 			pod := &kube_core.Pod{}
 
@@ -192,5 +203,311 @@ var _ = Describe("Injector", func() {
                 name: default
               spec: {}`,
 		}),
+		Entry("12. Pod with `kuma.io/drain-time` annotation", testCase{
+			num: "12",
+			mesh: `
+              apiVersion: kuma.io/v1alpha1
+              kind: Mesh
+              metadata:
+                name: default`,
+		}),
+		Entry("13. Pod with `kuma.io/drain-time` annotation and preStop hooks enabled", testCase{
+			num: "13",
+			mesh: `
+              apiVersion: kuma.io/v1alpha1
+              kind: Mesh
+              metadata:
+                name: default`,
+			cfgFile: "inject.pre-stop.config.yaml",
+		}),
+		Entry("14. Pod with restricted security context", testCase{
+			num: "14",
+			mesh: `
+              apiVersion: kuma.io/v1alpha1
+              kind: Mesh
+              metadata:
+                name: default`,
+			cfgFile: "inject.security-context.config.yaml",
+		}),
+		Entry("15. Pod with Kuma already injected", testCase{
+			num: "15",
+			mesh: `
+              apiVersion: kuma.io/v1alpha1
+              kind: Mesh
+              metadata:
+                name: default`,
+		}),
+		Entry("16. Pod with `kuma.io/sidecar-concurrency` annotation", testCase{
+			num: "16",
+			mesh: `
+              apiVersion: kuma.io/v1alpha1
+              kind: Mesh
+              metadata:
+                name: default`,
+		}),
+	)
+
+	DescribeTable("should reject a Pod with conflicting values of labels and annotations reserved for Kuma",
+		func(labels map[string]string, annotations map[string]string, expectedErr string) {
+			// given
+			err := k8sClient.Create(context.Background(), &v1alpha1.Mesh{
+				ObjectMeta: kube_meta.ObjectMeta{
+					Name: "default",
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			pod := &kube_core.Pod{
+				ObjectMeta: kube_meta.ObjectMeta{
+					Name:        "busybox",
+					Labels:      labels,
+					Annotations: annotations,
+				},
+			}
+
+			// when
+			err = injector.InjectKuma(pod)
+
+			// then
+			Expect(err).To(MatchError(expectedErr))
+		},
+		Entry("label of a different Mesh",
+			map[string]string{"kuma.io/mesh": "demo"}, nil,
+			`label "kuma.io/mesh" is reserved for Kuma and has to be either unset or set to "default", got "demo"`),
+		Entry("label of a not injected Pod",
+			map[string]string{"kuma.io/sidecar-injected": "false"}, nil,
+			`label "kuma.io/sidecar-injected" is reserved for Kuma and has to be either unset or set to "true", got "false"`),
+		Entry("annotation with a different transparent proxying port",
+			nil, map[string]string{"kuma.io/transparent-proxying-port": "8080"},
+			`annotation "kuma.io/transparent-proxying-port" is reserved for Kuma and has to be either unset or set to "15001", got "8080"`),
+	)
+
+	It("should reject a Pod with Kuma already injected and conflicting values of labels reserved for Kuma", func() {
+		// given
+		err := k8sClient.Create(context.Background(), &v1alpha1.Mesh{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "default",
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		pod := &kube_core.Pod{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name:   "busybox",
+				Labels: map[string]string{"kuma.io/mesh": "demo"},
+			},
+			Spec: kube_core.PodSpec{
+				Containers: []kube_core.Container{
+					{Name: "busybox"},
+					{Name: "kuma-sidecar"},
+				},
+			},
+		}
+
+		// when
+		err = injector.InjectKuma(pod)
+
+		// then
+		Expect(err).To(MatchError(`label "kuma.io/mesh" is reserved for Kuma and has to be either unset or set to "default", got "demo"`))
+	})
+
+	DescribeTable("should reject a Pod with invalid `kuma.io/drain-time` annotation",
+		func(drainTime string, expectedErr string) {
+			// given
+			pod := &kube_core.Pod{
+				ObjectMeta: kube_meta.ObjectMeta{
+					Name: "busybox",
+					Annotations: map[string]string{
+						"kuma.io/drain-time": drainTime,
+					},
+				},
+			}
+
+			// when
+			err := injector.InjectKuma(pod)
+
+			// then
+			Expect(err).To(MatchError(expectedErr))
+		},
+		Entry("not a duration", "forever",
+			`value of "kuma.io/drain-time" annotation must be a valid duration, e.g. 30s: time: invalid duration "forever"`),
+		Entry("duration without unit", "30",
+			`value of "kuma.io/drain-time" annotation must be a valid duration, e.g. 30s: time: missing unit in duration "30"`),
+		Entry("zero duration", "0s",
+			`value of "kuma.io/drain-time" annotation must be a positive duration, got "0s"`),
+		Entry("negative duration", "-5s",
+			`value of "kuma.io/drain-time" annotation must be a positive duration, got "-5s"`),
+	)
+
+	DescribeTable("should reject a Pod with invalid `kuma.io/sidecar-concurrency` annotation",
+		func(concurrency string, expectedErr string) {
+			// given
+			pod := &kube_core.Pod{
+				ObjectMeta: kube_meta.ObjectMeta{
+					Name: "busybox",
+					Annotations: map[string]string{
+						"kuma.io/sidecar-concurrency": concurrency,
+					},
+				},
+			}
+
+			// when
+			err := injector.InjectKuma(pod)
+
+			// then
+			Expect(err).To(MatchError(expectedErr))
+		},
+		Entry("not a number", "many",
+			`value of "kuma.io/sidecar-concurrency" annotation must be a positive integer, got "many"`),
+		Entry("zero", "0",
+			`value of "kuma.io/sidecar-concurrency" annotation must be a positive integer, got "0"`),
+		Entry("negative number", "-2",
+			`value of "kuma.io/sidecar-concurrency" annotation must be a positive integer, got "-2"`),
 	)
+
+	Describe("with namespace mesh label", func() {
+
+		BeforeEach(func() {
+			var cfg conf.Injector
+			Expect(config.Load(filepath.Join("testdata", "inject.config.yaml"), &cfg)).To(Succeed())
+			cfg.NamespaceMeshLabel = "example.com/mesh"
+			injector = inject.New(cfg, k8sClient)
+
+			// and
+			for _, name := range []string{"default", "demo"} {
+				err := k8sClient.Create(context.Background(), &v1alpha1.Mesh{
+					ObjectMeta: kube_meta.ObjectMeta{
+						Name: name,
+					},
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			// and
+			namespaces := map[string]map[string]string{
+				"team-demo":    {"example.com/mesh": "demo"},
+				"team-other":   {"example.com/mesh": "other"},
+				"team-unknown": nil,
+			}
+			for name, labels := range namespaces {
+				err := k8sClient.Create(context.Background(), &kube_core.Namespace{
+					ObjectMeta: kube_meta.ObjectMeta{
+						Name:   name,
+						Labels: labels,
+					},
+				})
+				if !kube_apierrs.IsAlreadyExists(err) {
+					Expect(err).ToNot(HaveOccurred())
+				}
+			}
+		})
+
+		meshEnv := func(pod *kube_core.Pod) string {
+			for _, container := range pod.Spec.Containers {
+				if container.Name != inject.KumaSidecarContainerName {
+					continue
+				}
+				for _, env := range container.Env {
+					if env.Name == "KUMA_DATAPLANE_MESH" {
+						return env.Value
+					}
+				}
+			}
+			return ""
+		}
+
+		DescribeTable("should inject a Pod into a Mesh",
+			func(namespace string, annotations map[string]string, expectedMesh string) {
+				// given
+				pod := &kube_core.Pod{
+					ObjectMeta: kube_meta.ObjectMeta{
+						Name:        "busybox",
+						Namespace:   namespace,
+						Annotations: annotations,
+					},
+					Spec: kube_core.PodSpec{
+						Containers: []kube_core.Container{{
+							Name:  "busybox",
+							Image: "busybox",
+						}},
+					},
+				}
+
+				// when
+				err := injector.InjectKuma(pod)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(pod.Spec.Containers).To(HaveLen(2))
+				Expect(meshEnv(pod)).To(Equal(expectedMesh))
+				Expect(pod.Labels).To(HaveKeyWithValue("kuma.io/mesh", expectedMesh))
+				Expect(pod.Annotations).To(HaveKeyWithValue("kuma.io/mesh", expectedMesh))
+			},
+			Entry("Mesh of a Namespace", "team-demo", nil, "demo"),
+			Entry("Mesh of an annotation overrides Mesh of a Namespace", "team-demo", map[string]string{"kuma.io/mesh": "default"}, "default"),
+			Entry("Mesh of an annotation in a Namespace without a label", "team-unknown", map[string]string{"kuma.io/mesh": "demo"}, "demo"),
+		)
+
+		It("should not inject a Pod in a Namespace without a label", func() {
+			// given
+			pod := &kube_core.Pod{
+				ObjectMeta: kube_meta.ObjectMeta{
+					Name:      "busybox",
+					Namespace: "team-unknown",
+				},
+				Spec: kube_core.PodSpec{
+					Containers: []kube_core.Container{{
+						Name:  "busybox",
+						Image: "busybox",
+					}},
+				},
+			}
+			expected := pod.DeepCopy()
+
+			// when
+			err := injector.InjectKuma(pod)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(pod).To(Equal(expected))
+		})
+
+		It("should reject a Pod in a Namespace of a Mesh that does not exist", func() {
+			// given
+			pod := &kube_core.Pod{
+				ObjectMeta: kube_meta.ObjectMeta{
+					Name:      "busybox",
+					Namespace: "team-other",
+				},
+			}
+
+			// when
+			err := injector.InjectKuma(pod)
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("could not retrieve mesh for pod: "))
+			Expect(kube_apierrs.IsNotFound(errors.Cause(err))).To(BeTrue())
+		})
+
+		It("should reject a Pod in a Namespace that does not exist", func() {
+			// given
+			pod := &kube_core.Pod{
+				ObjectMeta: kube_meta.ObjectMeta{
+					Name:      "busybox",
+					Namespace: "team-missing",
+				},
+			}
+
+			// when
+			err := injector.InjectKuma(pod)
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix(`could not retrieve namespace "team-missing" of pod: `))
+			Expect(kube_apierrs.IsNotFound(errors.Cause(err))).To(BeTrue())
+		})
+	})
 })
@@ -5,6 +5,8 @@ const (
 	// KumaMeshAnnotation defines a Pod annotation that
 	// associates a given Pod with a particular Mesh.
 	// Annotation value must be the name of a Mesh resource.
+	// If not set, a Mesh is derived from a label of the Pod's Namespace (if configured)
+	// or the default Mesh is used.
 	KumaMeshAnnotation = "kuma.io/mesh"
 
 	// KumaSidecarInjectionAnnotation defines a Pod annotation that
@@ -14,6 +16,46 @@ const (
 	// KumaSidecarInjectionDisabled defines a value of KumaSidecarInjectionAnnotation
 	// that will prevent Kuma from injecting a side-car into that Pod.
 	KumaSidecarInjectionDisabled = "disabled"
+
+	// KumaDrainTimeAnnotation defines a Pod annotation that
+	// overrides the time given to the side-car to drain its listeners,
+	// e.g. `45s`. Annotation value must be a positive duration.
+	KumaDrainTimeAnnotation = "kuma.io/drain-time"
+
+	// KumaSidecarConcurrencyAnnotation defines a Pod annotation that
+	// overrides the number of worker threads of the side-car,
+	// e.g. `2`. Annotation value must be a positive integer.
+	KumaSidecarConcurrencyAnnotation = "kuma.io/sidecar-concurrency"
+
+	// KumaSidecarLogLevelAnnotation defines a Pod annotation that
+	// overrides the log level of Envoy in the side-car,
+	// e.g. `debug`. Annotation value must be one of log levels supported by Envoy.
+	KumaSidecarLogLevelAnnotation = "kuma.io/sidecar-log-level"
+
+	// KumaSidecarLogFormatAnnotation defines a Pod annotation that
+	// overrides the format of Envoy logs in the side-car,
+	// e.g. `json`. Annotation value must be either `text` or `json`.
+	KumaSidecarLogFormatAnnotation = "kuma.io/sidecar-log-format"
+
+	// KumaSidecarEnvFromAnnotation defines a Pod annotation that
+	// lists ConfigMaps and Secrets whose keys become environment variables of the side-car,
+	// e.g. `configmap/sidecar-flags,secret/sidecar-tokens`.
+	// Annotation value must be a comma-separated list of `configmap/<name>` or `secret/<name>` references.
+	KumaSidecarEnvFromAnnotation = "kuma.io/sidecar-env-from"
+
+	// KumaSidecarNativeAnnotation defines a Pod annotation that
+	// overrides whether the side-car is injected as a restartable init container,
+	// e.g. `enabled`. Annotation value must be either `enabled` or `disabled`.
+	KumaSidecarNativeAnnotation = "kuma.io/sidecar-native"
+	KumaSidecarNativeEnabled    = "enabled"
+	KumaSidecarNativeDisabled   = "disabled"
+
+	// KumaVirtualProbesAnnotation defines a Pod annotation that
+	// overrides whether HTTP probes of application containers are rewritten to go through the side-car,
+	// e.g. `disabled`. Annotation value must be either `enabled` or `disabled`.
+	KumaVirtualProbesAnnotation = "kuma.io/virtual-probes"
+	KumaVirtualProbesEnabled    = "enabled"
+	KumaVirtualProbesDisabled   = "disabled"
 )
 
 // Annotations that are being automatically set by the Kuma Sidecar Injector.
@@ -35,3 +77,11 @@ const (
 	CNCFNetworkAnnotation = "k8s.v1.cni.cncf.io/networks"
 	KumaCNI               = "kuma-cni"
 )
+
+// Labels that are being automatically set by the Kuma Sidecar Injector.
+const (
+	// KumaMeshLabel makes it possible to select Pods of a particular Mesh.
+	KumaMeshLabel = "kuma.io/mesh"
+
+	KumaSidecarInjectedLabel = "kuma.io/sidecar-injected"
+)
@@ -2,10 +2,16 @@ package metadata
 
 import (
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/pkg/errors"
+
+	kuma_dp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
 	core_model "github.com/Kong/kuma/pkg/core/resources/model"
 
 	kube_core "k8s.io/api/core/v1"
+	kube_validation "k8s.io/apimachinery/pkg/util/validation"
 )
 
 func GetMesh(pod *kube_core.Pod) string {
@@ -34,3 +40,138 @@ func GetTransparentProxyingPort(pod *kube_core.Pod) uint32 {
 	}
 	return uint32(port)
 }
+
+// GetDrainTime returns the drain time set on a Pod by KumaDrainTimeAnnotation
+// or the given default if the annotation is not set.
+func GetDrainTime(pod *kube_core.Pod, defaultDrainTime time.Duration) (time.Duration, error) {
+	value, exists := pod.Annotations[KumaDrainTimeAnnotation]
+	if !exists {
+		return defaultDrainTime, nil
+	}
+	drainTime, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, errors.Wrapf(err, "value of %q annotation must be a valid duration, e.g. 30s", KumaDrainTimeAnnotation)
+	}
+	if drainTime <= 0 {
+		return 0, errors.Errorf("value of %q annotation must be a positive duration, got %q", KumaDrainTimeAnnotation, value)
+	}
+	return drainTime, nil
+}
+
+// GetConcurrency returns the number of worker threads set on a Pod by KumaSidecarConcurrencyAnnotation
+// or the given default if the annotation is not set.
+func GetConcurrency(pod *kube_core.Pod, defaultConcurrency uint32) (uint32, error) {
+	value, exists := pod.Annotations[KumaSidecarConcurrencyAnnotation]
+	if !exists {
+		return defaultConcurrency, nil
+	}
+	concurrency, err := strconv.ParseUint(value, 10, 32)
+	if err != nil || concurrency == 0 {
+		return 0, errors.Errorf("value of %q annotation must be a positive integer, got %q", KumaSidecarConcurrencyAnnotation, value)
+	}
+	return uint32(concurrency), nil
+}
+
+// GetSidecarLogLevel returns the Envoy log level set on a Pod by KumaSidecarLogLevelAnnotation
+// or the given default if the annotation is not set.
+func GetSidecarLogLevel(pod *kube_core.Pod, defaultLogLevel string) (string, error) {
+	value, exists := pod.Annotations[KumaSidecarLogLevelAnnotation]
+	if !exists {
+		return defaultLogLevel, nil
+	}
+	if err := kuma_dp.ValidateEnvoyLogLevel(value); err != nil {
+		return "", errors.Wrapf(err, "value of %q annotation is not valid", KumaSidecarLogLevelAnnotation)
+	}
+	return value, nil
+}
+
+// GetSidecarLogFormat returns the Envoy log format set on a Pod by KumaSidecarLogFormatAnnotation
+// or the given default if the annotation is not set.
+func GetSidecarLogFormat(pod *kube_core.Pod, defaultLogFormat string) (string, error) {
+	value, exists := pod.Annotations[KumaSidecarLogFormatAnnotation]
+	if !exists {
+		return defaultLogFormat, nil
+	}
+	if err := kuma_dp.ValidateEnvoyLogFormat(value); err != nil {
+		return "", errors.Wrapf(err, "value of %q annotation is not valid", KumaSidecarLogFormatAnnotation)
+	}
+	return value, nil
+}
+
+// GetNativeSidecar returns whether the side-car is injected as a restartable init container
+// as set on a Pod by KumaSidecarNativeAnnotation or the given default if the annotation is not set.
+func GetNativeSidecar(pod *kube_core.Pod, defaultNativeSidecar bool) (bool, error) {
+	value, exists := pod.Annotations[KumaSidecarNativeAnnotation]
+	if !exists {
+		return defaultNativeSidecar, nil
+	}
+	switch value {
+	case KumaSidecarNativeEnabled:
+		return true, nil
+	case KumaSidecarNativeDisabled:
+		return false, nil
+	default:
+		return false, errors.Errorf("value of %q annotation must be either %q or %q, got %q", KumaSidecarNativeAnnotation, KumaSidecarNativeEnabled, KumaSidecarNativeDisabled, value)
+	}
+}
+
+// GetVirtualProbes returns whether HTTP probes of application containers are rewritten to go through the side-car
+// as set on a Pod by KumaVirtualProbesAnnotation or the given default if the annotation is not set.
+func GetVirtualProbes(pod *kube_core.Pod, defaultVirtualProbes bool) (bool, error) {
+	value, exists := pod.Annotations[KumaVirtualProbesAnnotation]
+	if !exists {
+		return defaultVirtualProbes, nil
+	}
+	switch value {
+	case KumaVirtualProbesEnabled:
+		return true, nil
+	case KumaVirtualProbesDisabled:
+		return false, nil
+	default:
+		return false, errors.Errorf("value of %q annotation must be either %q or %q, got %q", KumaVirtualProbesAnnotation, KumaVirtualProbesEnabled, KumaVirtualProbesDisabled, value)
+	}
+}
+
+// GetSidecarEnvFrom returns the sources of side-car environment variables listed on a Pod by KumaSidecarEnvFromAnnotation.
+func GetSidecarEnvFrom(pod *kube_core.Pod) ([]kube_core.EnvFromSource, error) {
+	value, exists := pod.Annotations[KumaSidecarEnvFromAnnotation]
+	if !exists {
+		return nil, nil
+	}
+	var sources []kube_core.EnvFromSource
+	for _, ref := range strings.Split(value, ",") {
+		source, err := parseEnvFromRef(strings.TrimSpace(ref))
+		if err != nil {
+			return nil, errors.Wrapf(err, "value of %q annotation is not valid", KumaSidecarEnvFromAnnotation)
+		}
+		sources = append(sources, source)
+	}
+	return sources, nil
+}
+
+func parseEnvFromRef(ref string) (kube_core.EnvFromSource, error) {
+	parts := strings.SplitN(ref, "/", 2)
+	if len(parts) != 2 {
+		return kube_core.EnvFromSource{}, errors.Errorf("reference %q must be in the format `configmap/<name>` or `secret/<name>`", ref)
+	}
+	kind, name := parts[0], parts[1]
+	if msgs := kube_validation.IsDNS1123Subdomain(name); len(msgs) > 0 {
+		return kube_core.EnvFromSource{}, errors.Errorf("reference %q has invalid name: %s", ref, strings.Join(msgs, "; "))
+	}
+	switch kind {
+	case "configmap":
+		return kube_core.EnvFromSource{
+			ConfigMapRef: &kube_core.ConfigMapEnvSource{
+				LocalObjectReference: kube_core.LocalObjectReference{Name: name},
+			},
+		}, nil
+	case "secret":
+		return kube_core.EnvFromSource{
+			SecretRef: &kube_core.SecretEnvSource{
+				LocalObjectReference: kube_core.LocalObjectReference{Name: name},
+			},
+		}, nil
+	default:
+		return kube_core.EnvFromSource{}, errors.Errorf("reference %q has unknown kind %q. Allowed values: configmap, secret", ref, kind)
+	}
+}
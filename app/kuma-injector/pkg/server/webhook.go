@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/Kong/kuma/app/kuma-injector/pkg/injector"
 	"github.com/Kong/kuma/pkg/core"
 
 	kube_core "k8s.io/api/core/v1"
@@ -34,12 +35,42 @@ func (h *podMutatingHandler) Handle(ctx context.Context, req kube_webhook.Admiss
 	if err := json.Unmarshal(req.Object.Raw, &pod); err != nil {
 		return kube_admission.Errored(http.StatusBadRequest, err)
 	}
+	// Namespace of a Pod might not be set yet, e.g. in case of Pods created by a ReplicaSet
+	namespace := pod.Namespace
+	if pod.Namespace == "" {
+		pod.Namespace = req.Namespace
+	}
 	if err := h.mutator(&pod); err != nil {
 		return kube_admission.Errored(http.StatusInternalServerError, err)
 	}
+	pod.Namespace = namespace
 	mutatedRaw, err := json.Marshal(pod)
 	if err != nil {
 		return kube_admission.Errored(http.StatusInternalServerError, err)
 	}
+	mutatedRaw, err = withSidecarRestartPolicy(mutatedRaw)
+	if err != nil {
+		return kube_admission.Errored(http.StatusInternalServerError, err)
+	}
 	return kube_admission.PatchResponseFromRaw(req.Object.Raw, mutatedRaw)
 }
+
+// withSidecarRestartPolicy makes a sidecar injected as an init container restartable (`restartPolicy: Always`),
+// so that it keeps running next to application containers. Container of the Kubernetes API
+// Kuma is built against predates restartable init containers, hence the marshalled Pod is amended.
+func withSidecarRestartPolicy(podRaw []byte) ([]byte, error) {
+	var pod map[string]interface{}
+	if err := json.Unmarshal(podRaw, &pod); err != nil {
+		return nil, err
+	}
+	spec, _ := pod["spec"].(map[string]interface{})
+	initContainers, _ := spec["initContainers"].([]interface{})
+	for _, c := range initContainers {
+		container, _ := c.(map[string]interface{})
+		if container["name"] == injector.KumaSidecarContainerName {
+			container["restartPolicy"] = "Always"
+			return json.Marshal(pod)
+		}
+	}
+	return podRaw, nil
+}
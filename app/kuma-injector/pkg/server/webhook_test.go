@@ -0,0 +1,915 @@
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Kong/kuma/app/kuma-injector/pkg/injector"
+	"github.com/Kong/kuma/app/kuma-injector/pkg/server"
+	conf "github.com/Kong/kuma/pkg/config/app/kuma-injector"
+	mesh_k8s "github.com/Kong/kuma/pkg/plugins/resources/k8s/native/api/v1alpha1"
+
+	kube_core "k8s.io/api/core/v1"
+	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kube_runtime "k8s.io/apimachinery/pkg/runtime"
+	kube_intstr "k8s.io/apimachinery/pkg/util/intstr"
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+	kube_client_fake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	kube_webhook "sigs.k8s.io/controller-runtime/pkg/webhook"
+	kube_admission "sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("PodMutatingWebhook", func() {
+
+	var webhook *kube_admission.Webhook
+	var mutator server.PodMutator
+	var client kube_client.Client
+
+	BeforeEach(func() {
+		scheme := kube_runtime.NewScheme()
+		Expect(kube_core.AddToScheme(scheme)).To(Succeed())
+		Expect(mesh_k8s.AddToScheme(scheme)).To(Succeed())
+		client = kube_client_fake.NewFakeClientWithScheme(scheme, &mesh_k8s.Mesh{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "default",
+			},
+		})
+
+		cfg := conf.DefaultConfig().Injector
+		cfg.SidecarContainer.PreStop.Enabled = true
+
+		mutator = injector.New(cfg, client).InjectKuma
+		webhook = server.PodMutatingWebhook(mutator)
+	})
+
+	request := func(pod *kube_core.Pod) kube_webhook.AdmissionRequest {
+		raw, err := json.Marshal(pod)
+		Expect(err).ToNot(HaveOccurred())
+		req := kube_webhook.AdmissionRequest{}
+		req.Object = kube_runtime.RawExtension{Raw: raw}
+		return req
+	}
+
+	patchAt := func(resp kube_webhook.AdmissionResponse, path string) interface{} {
+		raw, err := json.Marshal(resp.Patches)
+		Expect(err).ToNot(HaveOccurred())
+		var patches []struct {
+			Path  string      `json:"path"`
+			Value interface{} `json:"value"`
+		}
+		Expect(json.Unmarshal(raw, &patches)).To(Succeed())
+		for _, patch := range patches {
+			if patch.Path == path {
+				return patch.Value
+			}
+		}
+		return nil
+	}
+
+	It("should inject a sidecar with a preStop hook", func() {
+		// given
+		pod := &kube_core.Pod{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "busybox",
+				Annotations: map[string]string{
+					"kuma.io/drain-time": "45s",
+				},
+			},
+			Spec: kube_core.PodSpec{
+				Containers: []kube_core.Container{
+					{Name: "busybox", Image: "busybox"},
+				},
+			},
+		}
+
+		// when
+		resp := webhook.Handle(context.Background(), request(pod))
+
+		// then
+		Expect(resp.Allowed).To(BeTrue())
+
+		// when
+		sidecar := patchAt(resp, "/spec/containers/1")
+		// then
+		Expect(sidecar).ToNot(BeNil())
+		Expect(sidecar).To(HaveKeyWithValue("name", "kuma-sidecar"))
+		Expect(sidecar).To(HaveKeyWithValue("lifecycle", map[string]interface{}{
+			"preStop": map[string]interface{}{
+				"exec": map[string]interface{}{
+					"command": []interface{}{"sleep", "45"},
+				},
+			},
+		}))
+		// and
+		Expect(patchAt(resp, "/spec/containers/0/lifecycle")).To(BeNil())
+		// and
+		Expect(patchAt(resp, "/spec/terminationGracePeriodSeconds")).To(BeNumerically("==", 50))
+	})
+
+	It("should deny a Pod with invalid `kuma.io/drain-time` annotation", func() {
+		// given
+		pod := &kube_core.Pod{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "busybox",
+				Annotations: map[string]string{
+					"kuma.io/drain-time": "forever",
+				},
+			},
+		}
+
+		// when
+		resp := webhook.Handle(context.Background(), request(pod))
+
+		// then
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Patches).To(BeEmpty())
+		Expect(resp.Result.Message).To(ContainSubstring(`value of "kuma.io/drain-time" annotation must be a valid duration`))
+	})
+
+	It("should inject a sidecar with concurrency from the `kuma.io/sidecar-concurrency` annotation", func() {
+		// given
+		pod := &kube_core.Pod{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "busybox",
+				Annotations: map[string]string{
+					"kuma.io/sidecar-concurrency": "4",
+				},
+			},
+			Spec: kube_core.PodSpec{
+				Containers: []kube_core.Container{
+					{Name: "busybox", Image: "busybox"},
+				},
+			},
+		}
+
+		// when
+		resp := webhook.Handle(context.Background(), request(pod))
+
+		// then
+		Expect(resp.Allowed).To(BeTrue())
+
+		// when
+		sidecar := patchAt(resp, "/spec/containers/1")
+		// then
+		Expect(sidecar).To(HaveKeyWithValue("env", ContainElement(map[string]interface{}{
+			"name":  "KUMA_DATAPLANE_CONCURRENCY",
+			"value": "4",
+		})))
+	})
+
+	It("should inject a sidecar with concurrency from the injector config", func() {
+		// given
+		cfg := conf.DefaultConfig().Injector
+		cfg.SidecarContainer.Concurrency = 2
+		webhook = server.PodMutatingWebhook(injector.New(cfg, client).InjectKuma)
+		// and
+		pod := &kube_core.Pod{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "busybox",
+			},
+			Spec: kube_core.PodSpec{
+				Containers: []kube_core.Container{
+					{Name: "busybox", Image: "busybox"},
+				},
+			},
+		}
+
+		// when
+		resp := webhook.Handle(context.Background(), request(pod))
+
+		// then
+		Expect(resp.Allowed).To(BeTrue())
+
+		// when
+		sidecar := patchAt(resp, "/spec/containers/1")
+		// then
+		Expect(sidecar).To(HaveKeyWithValue("env", ContainElement(map[string]interface{}{
+			"name":  "KUMA_DATAPLANE_CONCURRENCY",
+			"value": "2",
+		})))
+	})
+
+	It("should inject a sidecar with a readiness probe of Envoy", func() {
+		// given
+		pod := &kube_core.Pod{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "busybox",
+			},
+			Spec: kube_core.PodSpec{
+				Containers: []kube_core.Container{
+					{Name: "busybox", Image: "busybox"},
+				},
+			},
+		}
+
+		// when
+		resp := webhook.Handle(context.Background(), request(pod))
+
+		// then
+		Expect(resp.Allowed).To(BeTrue())
+
+		// when
+		sidecar := patchAt(resp, "/spec/containers/1")
+		// then
+		Expect(sidecar).To(HaveKeyWithValue("readinessProbe", HaveKeyWithValue("exec", map[string]interface{}{
+			"command": []interface{}{"wget", "-qO-", "http://127.0.0.1:9901/ready"},
+		})))
+	})
+
+	It("should deny a Pod with invalid `kuma.io/sidecar-concurrency` annotation", func() {
+		// given
+		pod := &kube_core.Pod{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "busybox",
+				Annotations: map[string]string{
+					"kuma.io/sidecar-concurrency": "0",
+				},
+			},
+		}
+
+		// when
+		resp := webhook.Handle(context.Background(), request(pod))
+
+		// then
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Patches).To(BeEmpty())
+		Expect(resp.Result.Message).To(Equal(`value of "kuma.io/sidecar-concurrency" annotation must be a positive integer, got "0"`))
+	})
+
+	It("should inject a sidecar with log level and format from `kuma.io/sidecar-log-*` annotations", func() {
+		// given
+		cfg := conf.DefaultConfig().Injector
+		cfg.SidecarContainer.EnvoyLogLevel = "warning"
+		webhook = server.PodMutatingWebhook(injector.New(cfg, client).InjectKuma)
+		// and
+		pod := &kube_core.Pod{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "busybox",
+				Annotations: map[string]string{
+					"kuma.io/sidecar-log-level":  "debug",
+					"kuma.io/sidecar-log-format": "json",
+				},
+			},
+			Spec: kube_core.PodSpec{
+				Containers: []kube_core.Container{
+					{Name: "busybox", Image: "busybox"},
+				},
+			},
+		}
+
+		// when
+		resp := webhook.Handle(context.Background(), request(pod))
+
+		// then
+		Expect(resp.Allowed).To(BeTrue())
+
+		// when
+		sidecar := patchAt(resp, "/spec/containers/1")
+		// then
+		Expect(sidecar).To(HaveKeyWithValue("args", []interface{}{
+			"run",
+			"--log-level=info",
+			"--envoy-log-level=debug",
+			"--envoy-log-format=json",
+		}))
+	})
+
+	It("should inject a sidecar with log level and format from the injector config", func() {
+		// given
+		cfg := conf.DefaultConfig().Injector
+		cfg.SidecarContainer.EnvoyLogLevel = "error"
+		cfg.SidecarContainer.EnvoyLogFormat = "text"
+		webhook = server.PodMutatingWebhook(injector.New(cfg, client).InjectKuma)
+		// and
+		pod := &kube_core.Pod{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "busybox",
+			},
+			Spec: kube_core.PodSpec{
+				Containers: []kube_core.Container{
+					{Name: "busybox", Image: "busybox"},
+				},
+			},
+		}
+
+		// when
+		resp := webhook.Handle(context.Background(), request(pod))
+
+		// then
+		Expect(resp.Allowed).To(BeTrue())
+
+		// when
+		sidecar := patchAt(resp, "/spec/containers/1")
+		// then
+		Expect(sidecar).To(HaveKeyWithValue("args", []interface{}{
+			"run",
+			"--log-level=info",
+			"--envoy-log-level=error",
+			"--envoy-log-format=text",
+		}))
+	})
+
+	It("should deny a Pod with unknown `kuma.io/sidecar-log-level` annotation", func() {
+		// given
+		pod := &kube_core.Pod{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "busybox",
+				Annotations: map[string]string{
+					"kuma.io/sidecar-log-level": "verbose",
+				},
+			},
+		}
+
+		// when
+		resp := webhook.Handle(context.Background(), request(pod))
+
+		// then
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Patches).To(BeEmpty())
+		Expect(resp.Result.Message).To(Equal(`value of "kuma.io/sidecar-log-level" annotation is not valid: unknown log level "verbose". Allowed values: trace, debug, info, warning, error, critical, off`))
+	})
+
+	It("should deny a Pod with unknown `kuma.io/sidecar-log-format` annotation", func() {
+		// given
+		pod := &kube_core.Pod{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "busybox",
+				Annotations: map[string]string{
+					"kuma.io/sidecar-log-format": "xml",
+				},
+			},
+		}
+
+		// when
+		resp := webhook.Handle(context.Background(), request(pod))
+
+		// then
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Patches).To(BeEmpty())
+		Expect(resp.Result.Message).To(Equal(`value of "kuma.io/sidecar-log-format" annotation is not valid: unknown log format "xml". Allowed values: text, json`))
+	})
+
+	It("should inject a sidecar with environment from the injector config and the `kuma.io/sidecar-env-from` annotation", func() {
+		// given
+		cfg := conf.DefaultConfig().Injector
+		cfg.SidecarContainer.EnvFrom = []conf.SidecarEnvFromSource{
+			{ConfigMap: "sidecar-flags"},
+		}
+		cfg.SidecarContainer.Env = []conf.SidecarEnvVar{
+			{Name: "KUMA_DATAPLANE_RUNTIME_TOKEN", SecretKeyRef: &conf.SidecarKeyRef{Name: "dataplane-token", Key: "token"}},
+		}
+		webhook = server.PodMutatingWebhook(injector.New(cfg, client).InjectKuma)
+		// and
+		pod := &kube_core.Pod{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "busybox",
+				Annotations: map[string]string{
+					"kuma.io/sidecar-env-from": "secret/sidecar-tokens, configmap/busybox-flags",
+				},
+			},
+			Spec: kube_core.PodSpec{
+				Containers: []kube_core.Container{
+					{Name: "busybox", Image: "busybox"},
+				},
+			},
+		}
+
+		// when
+		resp := webhook.Handle(context.Background(), request(pod))
+
+		// then
+		Expect(resp.Allowed).To(BeTrue())
+
+		// when
+		sidecar := patchAt(resp, "/spec/containers/1")
+		// then
+		Expect(sidecar).To(HaveKeyWithValue("envFrom", []interface{}{
+			map[string]interface{}{"configMapRef": map[string]interface{}{"name": "sidecar-flags"}},
+			map[string]interface{}{"secretRef": map[string]interface{}{"name": "sidecar-tokens"}},
+			map[string]interface{}{"configMapRef": map[string]interface{}{"name": "busybox-flags"}},
+		}))
+		// and
+		Expect(sidecar.(map[string]interface{})["env"]).To(ContainElement(map[string]interface{}{
+			"name": "KUMA_DATAPLANE_RUNTIME_TOKEN",
+			"valueFrom": map[string]interface{}{
+				"secretKeyRef": map[string]interface{}{"name": "dataplane-token", "key": "token"},
+			},
+		}))
+	})
+
+	It("should deny a Pod with malformed `kuma.io/sidecar-env-from` annotation", func() {
+		// given
+		pod := &kube_core.Pod{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "busybox",
+				Annotations: map[string]string{
+					"kuma.io/sidecar-env-from": "configmap/sidecar-flags,secret/Sidecar_Tokens",
+				},
+			},
+		}
+
+		// when
+		resp := webhook.Handle(context.Background(), request(pod))
+
+		// then
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Patches).To(BeEmpty())
+		Expect(resp.Result.Message).To(HavePrefix(`value of "kuma.io/sidecar-env-from" annotation is not valid: reference "secret/Sidecar_Tokens" has invalid name: a DNS-1123 subdomain must consist of lower case alphanumeric characters`))
+	})
+
+	It("should deny a Pod with `kuma.io/sidecar-env-from` annotation referring to an unknown kind", func() {
+		// given
+		pod := &kube_core.Pod{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "busybox",
+				Annotations: map[string]string{
+					"kuma.io/sidecar-env-from": "volume/sidecar-flags",
+				},
+			},
+		}
+
+		// when
+		resp := webhook.Handle(context.Background(), request(pod))
+
+		// then
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Patches).To(BeEmpty())
+		Expect(resp.Result.Message).To(Equal(`value of "kuma.io/sidecar-env-from" annotation is not valid: reference "volume/sidecar-flags" has unknown kind "volume". Allowed values: configmap, secret`))
+	})
+
+	It("should inject containers with restricted security contexts", func() {
+		// given
+		pod := &kube_core.Pod{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "busybox",
+			},
+			Spec: kube_core.PodSpec{
+				Containers: []kube_core.Container{
+					{Name: "busybox", Image: "busybox"},
+				},
+			},
+		}
+
+		// when
+		resp := webhook.Handle(context.Background(), request(pod))
+
+		// then
+		Expect(resp.Allowed).To(BeTrue())
+
+		// when
+		sidecar := patchAt(resp, "/spec/containers/1")
+		// then
+		Expect(sidecar).To(HaveKeyWithValue("securityContext", map[string]interface{}{
+			"runAsUser":                float64(5678),
+			"runAsGroup":               float64(5678),
+			"runAsNonRoot":             true,
+			"readOnlyRootFilesystem":   true,
+			"allowPrivilegeEscalation": false,
+			"capabilities": map[string]interface{}{
+				"drop": []interface{}{"ALL"},
+			},
+		}))
+		Expect(sidecar).To(HaveKeyWithValue("volumeMounts", ContainElement(map[string]interface{}{
+			"name":      "kuma-sidecar-tmp",
+			"mountPath": "/tmp",
+		})))
+		// and
+		Expect(patchAt(resp, "/spec/volumes")).To(ContainElement(map[string]interface{}{
+			"name":     "kuma-sidecar-tmp",
+			"emptyDir": map[string]interface{}{},
+		}))
+
+		// when
+		initContainers := patchAt(resp, "/spec/initContainers")
+		// then
+		Expect(initContainers).To(HaveLen(1))
+		Expect(initContainers.([]interface{})[0]).To(HaveKeyWithValue("securityContext", map[string]interface{}{
+			"runAsUser":                float64(0),
+			"runAsGroup":               float64(0),
+			"allowPrivilegeEscalation": false,
+			"capabilities": map[string]interface{}{
+				"add":  []interface{}{"NET_ADMIN", "NET_RAW"},
+				"drop": []interface{}{"ALL"},
+			},
+		}))
+	})
+
+	It("should inject a Pod without Namespace into a Mesh of the Namespace of a request", func() {
+		// given
+		Expect(client.Create(context.Background(), &kube_core.Namespace{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "team-demo",
+				Labels: map[string]string{
+					"example.com/mesh": "demo",
+				},
+			},
+		})).To(Succeed())
+		Expect(client.Create(context.Background(), &mesh_k8s.Mesh{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "demo",
+			},
+		})).To(Succeed())
+		// and
+		cfg := conf.DefaultConfig().Injector
+		cfg.NamespaceMeshLabel = "example.com/mesh"
+		webhook = server.PodMutatingWebhook(injector.New(cfg, client).InjectKuma)
+		// and
+		pod := &kube_core.Pod{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "busybox",
+			},
+		}
+		// and
+		req := request(pod)
+		req.Namespace = "team-demo"
+
+		// when
+		resp := webhook.Handle(context.Background(), req)
+
+		// then
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(patchAt(resp, "/metadata/namespace")).To(BeNil())
+		Expect(patchAt(resp, "/metadata/labels")).To(HaveKeyWithValue("kuma.io/mesh", "demo"))
+		Expect(patchAt(resp, "/metadata/annotations")).To(HaveKeyWithValue("kuma.io/mesh", "demo"))
+	})
+
+	It("should add Kuma labels preserving user-defined ones", func() {
+		// given
+		pod := &kube_core.Pod{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "busybox",
+				Labels: map[string]string{
+					"app": "busybox",
+				},
+			},
+		}
+
+		// when
+		resp := webhook.Handle(context.Background(), request(pod))
+
+		// then
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(patchAt(resp, "/metadata/labels/app")).To(BeNil())
+		Expect(patchAt(resp, "/metadata/labels/kuma.io~1mesh")).To(Equal("default"))
+		Expect(patchAt(resp, "/metadata/labels/kuma.io~1sidecar-injected")).To(Equal("true"))
+	})
+
+	It("should not change a Pod on re-injection", func() {
+		// given
+		pod := &kube_core.Pod{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "busybox",
+				Labels: map[string]string{
+					"app": "busybox",
+				},
+			},
+			Spec: kube_core.PodSpec{
+				Containers: []kube_core.Container{
+					{Name: "busybox", Image: "busybox"},
+				},
+			},
+		}
+		Expect(mutator(pod)).To(Succeed())
+
+		// when
+		resp := webhook.Handle(context.Background(), request(pod))
+
+		// then
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.Patches).To(BeEmpty())
+	})
+
+	It("should deny a Pod with a conflicting value of a label reserved for Kuma", func() {
+		// given
+		pod := &kube_core.Pod{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "busybox",
+				Labels: map[string]string{
+					"kuma.io/mesh": "demo",
+				},
+			},
+		}
+
+		// when
+		resp := webhook.Handle(context.Background(), request(pod))
+
+		// then
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Patches).To(BeEmpty())
+		Expect(resp.Result.Message).To(Equal(`label "kuma.io/mesh" is reserved for Kuma and has to be either unset or set to "default", got "demo"`))
+	})
+
+	It("should inject a sidecar as a restartable init container when enabled in the injector config", func() {
+		// given
+		cfg := conf.DefaultConfig().Injector
+		cfg.SidecarContainer.NativeSidecar = true
+		webhook = server.PodMutatingWebhook(injector.New(cfg, client).InjectKuma)
+		// and
+		pod := &kube_core.Pod{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "busybox",
+			},
+			Spec: kube_core.PodSpec{
+				Containers: []kube_core.Container{
+					{Name: "busybox", Image: "busybox"},
+				},
+			},
+		}
+
+		// when
+		resp := webhook.Handle(context.Background(), request(pod))
+
+		// then
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(patchAt(resp, "/spec/containers/1")).To(BeNil())
+
+		// when
+		initContainers := patchAt(resp, "/spec/initContainers")
+		// then
+		Expect(initContainers).To(HaveLen(2))
+		Expect(initContainers.([]interface{})[0]).To(HaveKeyWithValue("name", "kuma-init"))
+		Expect(initContainers.([]interface{})[1]).To(HaveKeyWithValue("name", "kuma-sidecar"))
+		Expect(initContainers.([]interface{})[1]).To(HaveKeyWithValue("restartPolicy", "Always"))
+	})
+
+	It("should inject a sidecar as a restartable init container when enabled by the `kuma.io/sidecar-native` annotation", func() {
+		// given
+		pod := &kube_core.Pod{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "job",
+				Annotations: map[string]string{
+					"kuma.io/sidecar-native": "enabled",
+				},
+			},
+			Spec: kube_core.PodSpec{
+				InitContainers: []kube_core.Container{
+					{Name: "migrate", Image: "busybox"},
+				},
+				Containers: []kube_core.Container{
+					{Name: "busybox", Image: "busybox"},
+				},
+				RestartPolicy: kube_core.RestartPolicyNever,
+			},
+		}
+
+		// when
+		resp := webhook.Handle(context.Background(), request(pod))
+
+		// then
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(patchAt(resp, "/spec/containers/1")).To(BeNil())
+		Expect(patchAt(resp, "/spec/initContainers/0/restartPolicy")).To(BeNil())
+		Expect(patchAt(resp, "/spec/initContainers/1")).To(HaveKeyWithValue("name", "kuma-init"))
+
+		// when
+		sidecar := patchAt(resp, "/spec/initContainers/2")
+		// then
+		Expect(sidecar).To(HaveKeyWithValue("name", "kuma-sidecar"))
+		Expect(sidecar).To(HaveKeyWithValue("restartPolicy", "Always"))
+	})
+
+	It("should inject a sidecar as a regular container when disabled by the `kuma.io/sidecar-native` annotation", func() {
+		// given
+		cfg := conf.DefaultConfig().Injector
+		cfg.SidecarContainer.NativeSidecar = true
+		webhook = server.PodMutatingWebhook(injector.New(cfg, client).InjectKuma)
+		// and
+		pod := &kube_core.Pod{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "busybox",
+				Annotations: map[string]string{
+					"kuma.io/sidecar-native": "disabled",
+				},
+			},
+			Spec: kube_core.PodSpec{
+				Containers: []kube_core.Container{
+					{Name: "busybox", Image: "busybox"},
+				},
+			},
+		}
+
+		// when
+		resp := webhook.Handle(context.Background(), request(pod))
+
+		// then
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(patchAt(resp, "/spec/containers/1")).To(HaveKeyWithValue("name", "kuma-sidecar"))
+		Expect(patchAt(resp, "/spec/containers/1")).ToNot(HaveKey("restartPolicy"))
+		Expect(patchAt(resp, "/spec/initContainers")).To(HaveLen(1))
+	})
+
+	It("should deny a Pod with invalid `kuma.io/sidecar-native` annotation", func() {
+		// given
+		pod := &kube_core.Pod{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "busybox",
+				Annotations: map[string]string{
+					"kuma.io/sidecar-native": "true",
+				},
+			},
+		}
+
+		// when
+		resp := webhook.Handle(context.Background(), request(pod))
+
+		// then
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Patches).To(BeEmpty())
+		Expect(resp.Result.Message).To(Equal(`value of "kuma.io/sidecar-native" annotation must be either "enabled" or "disabled", got "true"`))
+	})
+
+	Context("with virtual probes", func() {
+
+		BeforeEach(func() {
+			cfg := conf.DefaultConfig().Injector
+			cfg.SidecarContainer.VirtualProbes.Enabled = true
+			webhook = server.PodMutatingWebhook(injector.New(cfg, client).InjectKuma)
+		})
+
+		It("should rewrite HTTP probes of application containers to go through the sidecar", func() {
+			// given
+			pod := &kube_core.Pod{
+				ObjectMeta: kube_meta.ObjectMeta{
+					Name: "web",
+				},
+				Spec: kube_core.PodSpec{
+					Containers: []kube_core.Container{
+						{
+							Name:  "web",
+							Image: "nginx",
+							Ports: []kube_core.ContainerPort{
+								{Name: "http", ContainerPort: 8080},
+							},
+							LivenessProbe: &kube_core.Probe{
+								Handler: kube_core.Handler{
+									HTTPGet: &kube_core.HTTPGetAction{
+										Path: "/healthz",
+										Port: kube_intstr.FromInt(8080),
+									},
+								},
+							},
+							ReadinessProbe: &kube_core.Probe{
+								Handler: kube_core.Handler{
+									HTTPGet: &kube_core.HTTPGetAction{
+										Path: "ready",
+										Port: kube_intstr.FromString("http"),
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			// when
+			resp := webhook.Handle(context.Background(), request(pod))
+
+			// then
+			Expect(resp.Allowed).To(BeTrue())
+			Expect(patchAt(resp, "/spec/containers/0/livenessProbe/httpGet/path")).To(Equal("/8080/healthz"))
+			Expect(patchAt(resp, "/spec/containers/0/livenessProbe/httpGet/port")).To(BeNumerically("==", 9000))
+			Expect(patchAt(resp, "/spec/containers/0/readinessProbe/httpGet/path")).To(Equal("/8080/ready"))
+			Expect(patchAt(resp, "/spec/containers/0/readinessProbe/httpGet/port")).To(BeNumerically("==", 9000))
+
+			// when
+			sidecar := patchAt(resp, "/spec/containers/1")
+			// then
+			Expect(sidecar).To(HaveKeyWithValue("env", ContainElement(map[string]interface{}{
+				"name":  "KUMA_DATAPLANE_VIRTUAL_PROBES_PORT",
+				"value": "9000",
+			})))
+			Expect(sidecar).To(HaveKeyWithValue("env", ContainElement(map[string]interface{}{
+				"name":  "KUMA_DATAPLANE_VIRTUAL_PROBES_PATHS",
+				"value": "/8080/healthz,/8080/ready",
+			})))
+
+			// when
+			initContainers := patchAt(resp, "/spec/initContainers")
+			// then
+			Expect(initContainers).To(HaveLen(1))
+			Expect(initContainers.([]interface{})[0]).To(HaveKeyWithValue("args", ContainElement("-d")))
+			Expect(initContainers.([]interface{})[0]).To(HaveKeyWithValue("args", ContainElement("9000")))
+		})
+
+		It("should leave exec probes untouched", func() {
+			// given
+			pod := &kube_core.Pod{
+				ObjectMeta: kube_meta.ObjectMeta{
+					Name: "busybox",
+				},
+				Spec: kube_core.PodSpec{
+					Containers: []kube_core.Container{
+						{
+							Name:  "busybox",
+							Image: "busybox",
+							LivenessProbe: &kube_core.Probe{
+								Handler: kube_core.Handler{
+									Exec: &kube_core.ExecAction{
+										Command: []string{"cat", "/tmp/healthy"},
+									},
+								},
+							},
+							ReadinessProbe: &kube_core.Probe{
+								Handler: kube_core.Handler{
+									TCPSocket: &kube_core.TCPSocketAction{
+										Port: kube_intstr.FromInt(8080),
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			// when
+			resp := webhook.Handle(context.Background(), request(pod))
+
+			// then
+			Expect(resp.Allowed).To(BeTrue())
+			Expect(patchAt(resp, "/spec/containers/1")).To(HaveKeyWithValue("name", "kuma-sidecar"))
+			for _, patch := range resp.Patches {
+				Expect(patch.Path).ToNot(HavePrefix("/spec/containers/0/"))
+			}
+		})
+
+		It("should rewrite HTTP probes only once", func() {
+			// given
+			pod := &kube_core.Pod{
+				ObjectMeta: kube_meta.ObjectMeta{
+					Name: "web",
+				},
+				Spec: kube_core.PodSpec{
+					Containers: []kube_core.Container{
+						{
+							Name:  "web",
+							Image: "nginx",
+							LivenessProbe: &kube_core.Probe{
+								Handler: kube_core.Handler{
+									HTTPGet: &kube_core.HTTPGetAction{
+										Path: "/8080/healthz",
+										Port: kube_intstr.FromInt(9000),
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			// when
+			resp := webhook.Handle(context.Background(), request(pod))
+
+			// then
+			Expect(resp.Allowed).To(BeTrue())
+			for _, patch := range resp.Patches {
+				Expect(patch.Path).ToNot(HavePrefix("/spec/containers/0/"))
+			}
+			// and
+			Expect(patchAt(resp, "/spec/containers/1")).To(HaveKeyWithValue("env", ContainElement(map[string]interface{}{
+				"name":  "KUMA_DATAPLANE_VIRTUAL_PROBES_PATHS",
+				"value": "/8080/healthz",
+			})))
+		})
+
+		It("should leave HTTP probes untouched when disabled by the `kuma.io/virtual-probes` annotation", func() {
+			// given
+			pod := &kube_core.Pod{
+				ObjectMeta: kube_meta.ObjectMeta{
+					Name: "web",
+					Annotations: map[string]string{
+						"kuma.io/virtual-probes": "disabled",
+					},
+				},
+				Spec: kube_core.PodSpec{
+					Containers: []kube_core.Container{
+						{
+							Name:  "web",
+							Image: "nginx",
+							LivenessProbe: &kube_core.Probe{
+								Handler: kube_core.Handler{
+									HTTPGet: &kube_core.HTTPGetAction{
+										Path: "/healthz",
+										Port: kube_intstr.FromInt(8080),
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			// when
+			resp := webhook.Handle(context.Background(), request(pod))
+
+			// then
+			Expect(resp.Allowed).To(BeTrue())
+			for _, patch := range resp.Patches {
+				Expect(patch.Path).ToNot(HavePrefix("/spec/containers/0/"))
+			}
+			Expect(patchAt(resp, "/spec/containers/1")).ToNot(HaveKeyWithValue("env", ContainElement(HaveKeyWithValue("name", "KUMA_DATAPLANE_VIRTUAL_PROBES_PORT"))))
+		})
+	})
+})
@@ -16,6 +16,7 @@ import (
 	"github.com/spf13/cobra"
 
 	kumactl_cmd "github.com/Kong/kuma/app/kumactl/pkg/cmd"
+	"github.com/Kong/kuma/app/kumactl/pkg/install/data"
 	"github.com/Kong/kuma/app/kumactl/pkg/output"
 	"github.com/Kong/kuma/app/kumactl/pkg/output/printers"
 	"github.com/Kong/kuma/pkg/core/resources/apis/mesh"
@@ -91,7 +92,7 @@ func NewApplyCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 				return errors.Wrap(err, "error compiling config from template")
 			}
 
-			res, err := parseResource(configBytes)
+			resources, err := parseResources(configBytes)
 			if err != nil {
 				return errors.Wrap(err, "YAML contains invalid resource")
 			}
@@ -101,24 +102,41 @@ func NewApplyCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 				if err != nil {
 					return err
 				}
-				if err := p.Print(rest_types.From.Resource(res), cmd.OutOrStdout()); err != nil {
-					return err
+				for i, res := range resources {
+					if i > 0 {
+						if _, err := cmd.OutOrStdout().Write([]byte("---\n")); err != nil {
+							return err
+						}
+					}
+					if err := p.Print(rest_types.From.Resource(res), cmd.OutOrStdout()); err != nil {
+						return err
+					}
 				}
 				return nil
 			}
 
-			var rs store.ResourceStore
-			if res.GetType() == system.SecretType { // Secret is exposed via Admin Server. It will be merged into API Server eventually.
-				rs, err = pctx.CurrentAdminResourceStore()
-			} else {
-				rs, err = pctx.CurrentResourceStore()
-			}
-			if err != nil {
-				return err
+			// a single resource is validated by the Control Plane, while several ones are validated
+			// before any of them is applied, so that problems of all resources are reported at once
+			if len(resources) > 1 {
+				if verr := model.ValidateAll(resources); verr.HasViolations() {
+					return errors.Wrap(&verr, "YAML contains invalid resources")
+				}
 			}
 
-			if err := upsert(rs, res); err != nil {
-				return err
+			for _, res := range resources {
+				var rs store.ResourceStore
+				if res.GetType() == system.SecretType { // Secret is exposed via Admin Server. It will be merged into API Server eventually.
+					rs, err = pctx.CurrentAdminResourceStore()
+				} else {
+					rs, err = pctx.CurrentResourceStore()
+				}
+				if err != nil {
+					return err
+				}
+
+				if err := upsert(rs, res); err != nil {
+					return err
+				}
 			}
 			return nil
 		},
@@ -180,6 +198,28 @@ func upsert(rs store.ResourceStore, res model.Resource) error {
 	return rs.Update(context.Background(), newRes)
 }
 
+// parseResources parses a YAML stream of resources separated by `---`.
+// It fails on the first resource that cannot be parsed.
+func parseResources(bytes []byte) ([]model.Resource, error) {
+	docs := data.SplitYAML(data.File{Data: bytes})
+	if len(docs) == 0 {
+		res, err := parseResource(bytes)
+		if err != nil {
+			return nil, err
+		}
+		return []model.Resource{res}, nil
+	}
+	var resources []model.Resource
+	for _, doc := range docs {
+		res, err := parseResource(doc.Data)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, res)
+	}
+	return resources, nil
+}
+
 func parseResource(bytes []byte) (model.Resource, error) {
 	resMeta := rest.ResourceMeta{}
 	if err := yaml.Unmarshal(bytes, &resMeta); err != nil {
@@ -406,6 +406,73 @@ type: Dataplane
 		Expect(resource.Spec.Networking.Address).To(Equal("2.2.2.2"))
 	})
 
+	It("should apply several resources separated by `---`", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"apply", "-f", "-"},
+		)
+		rootCmd.SetIn(strings.NewReader(`
+type: Mesh
+name: demo
+---
+type: TrafficPermission
+name: allow-all
+mesh: demo
+sources:
+- match:
+    service: '*'
+destinations:
+- match:
+    service: '*'
+`))
+
+		// when
+		err := rootCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// and
+		Expect(store.Get(context.Background(), &mesh.MeshResource{}, core_store.GetByKey("demo", "demo"))).To(Succeed())
+		Expect(store.Get(context.Background(), &mesh.TrafficPermissionResource{}, core_store.GetByKey("allow-all", "demo"))).To(Succeed())
+	})
+
+	It("should report violations of all resources at once", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"apply", "-f", "-"},
+		)
+		rootCmd.SetIn(strings.NewReader(`
+type: Mesh
+name: demo
+mtls:
+  enabledBackend: ca-1
+---
+type: TrafficPermission
+name: allow-all
+mesh: demo
+---
+type: Dataplane
+name: dp-1
+mesh: demo
+networking:
+  address: 1.1.1.1
+`))
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError("YAML contains invalid resources: " +
+			"Mesh/demo.mtls.enabledBackend: has to be set to one of the backends in the mesh; " +
+			"TrafficPermission/allow-all.sources: must have at least one element; " +
+			"TrafficPermission/allow-all.destinations: must have at least one element; " +
+			"Dataplane/dp-1.networking: has to contain at least one inbound interface or gateway"))
+		// and nothing is applied
+		Expect(store.Get(context.Background(), &mesh.MeshResource{}, core_store.GetByKey("demo", "demo"))).ToNot(Succeed())
+	})
+
 	type testCase struct {
 		resource string
 		err      string
@@ -437,6 +504,16 @@ name: dp-1
 			resource: `
 type: Dataplane
 mesh: default
+`,
+			err: "YAML contains invalid resource: Name field cannot be empty",
+		}),
+		Entry("no name in one of several resources", testCase{
+			resource: `
+type: Mesh
+name: demo
+---
+type: TrafficPermission
+mesh: demo
 `,
 			err: "YAML contains invalid resource: Name field cannot be empty",
 		}),
@@ -0,0 +1,61 @@
+package inspect
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/Kong/kuma/app/kumactl/pkg/output"
+	"github.com/Kong/kuma/app/kumactl/pkg/output/printers"
+	kuma_cmd "github.com/Kong/kuma/pkg/cmd"
+)
+
+const (
+	configDumpType = "config-dump"
+)
+
+type inspectDataplaneContext struct {
+	*inspectContext
+
+	args struct {
+		inspectionType string
+	}
+}
+
+func newInspectDataplaneCmd(pctx *inspectContext) *cobra.Command {
+	ctx := inspectDataplaneContext{
+		inspectContext: pctx,
+	}
+	cmd := &cobra.Command{
+		Use:   "dataplane NAME",
+		Short: "Inspect Dataplane",
+		Long:  `Inspect Dataplane.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if ctx.args.inspectionType != configDumpType {
+				return errors.Errorf("unknown inspection type %q. Allowed types: %s", ctx.args.inspectionType, configDumpType)
+			}
+			client, err := pctx.CurrentConfigDumpClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create a config dump client")
+			}
+			configDump, err := client.ConfigDump(context.Background(), pctx.CurrentMesh(), args[0])
+			if err != nil {
+				return err
+			}
+
+			format := output.Format(pctx.args.outputFormat)
+			if format == output.TableFormat {
+				format = output.JSONFormat // xDS configuration cannot be presented as a table
+			}
+			printer, err := printers.NewGenericPrinter(format)
+			if err != nil {
+				return err
+			}
+			return printer.Print(configDump, cmd.OutOrStdout())
+		},
+	}
+	cmd.PersistentFlags().StringVarP(&ctx.args.inspectionType, "type", "", configDumpType, kuma_cmd.UsageOptions("inspection type", configDumpType))
+	return cmd
+}
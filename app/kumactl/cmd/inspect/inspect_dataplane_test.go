@@ -0,0 +1,126 @@
+package inspect_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	gomega_types "github.com/onsi/gomega/types"
+	"github.com/spf13/cobra"
+
+	"github.com/Kong/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/Kong/kuma/app/kumactl/pkg/cmd"
+	"github.com/Kong/kuma/app/kumactl/pkg/resources"
+	config_proto "github.com/Kong/kuma/pkg/config/app/kumactl/v1alpha1"
+)
+
+type testConfigDumpClient struct {
+	receivedMesh      string
+	receivedDataplane string
+	configDump        json.RawMessage
+}
+
+func (c *testConfigDumpClient) ConfigDump(_ context.Context, meshName string, dataplaneName string) (json.RawMessage, error) {
+	c.receivedMesh = meshName
+	c.receivedDataplane = dataplaneName
+	return c.configDump, nil
+}
+
+var _ resources.ConfigDumpClient = &testConfigDumpClient{}
+
+var _ = Describe("kumactl inspect dataplane", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+
+	var testClient *testConfigDumpClient
+
+	BeforeEach(func() {
+		// setup
+		configDump, err := ioutil.ReadFile(filepath.Join("testdata", "inspect-dataplane-config-dump.golden.json"))
+		Expect(err).ToNot(HaveOccurred())
+		testClient = &testConfigDumpClient{
+			configDump: configDump,
+		}
+
+		rootCtx := &kumactl_cmd.RootContext{
+			Runtime: kumactl_cmd.RootRuntime{
+				NewConfigDumpClient: func(*config_proto.ControlPlaneCoordinates_ApiServer) (resources.ConfigDumpClient, error) {
+					return testClient, nil
+				},
+			},
+		}
+
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+	})
+
+	type testCase struct {
+		args       []string
+		goldenFile string
+		matcher    func(interface{}) gomega_types.GomegaMatcher
+	}
+
+	DescribeTable("kumactl inspect dataplane --type=config-dump -o table|json|yaml",
+		func(given testCase) {
+			// given
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"inspect", "dataplane", "example"}, given.args...))
+
+			// when
+			err := rootCmd.Execute()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(testClient.receivedMesh).To(Equal("default"))
+			Expect(testClient.receivedDataplane).To(Equal("example"))
+
+			// when
+			expected, err := ioutil.ReadFile(filepath.Join("testdata", given.goldenFile))
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(buf.String()).To(given.matcher(expected))
+		},
+		Entry("should print config dump as JSON by default", testCase{
+			args:       nil,
+			goldenFile: "inspect-dataplane-config-dump.golden.json",
+			matcher:    MatchJSON,
+		}),
+		Entry("should print config dump as JSON for table output", testCase{
+			args:       []string{"--type=config-dump", "-otable"},
+			goldenFile: "inspect-dataplane-config-dump.golden.json",
+			matcher:    MatchJSON,
+		}),
+		Entry("should support JSON output", testCase{
+			args:       []string{"--type=config-dump", "-ojson"},
+			goldenFile: "inspect-dataplane-config-dump.golden.json",
+			matcher:    MatchJSON,
+		}),
+		Entry("should support YAML output", testCase{
+			args:       []string{"--type=config-dump", "-oyaml"},
+			goldenFile: "inspect-dataplane-config-dump.golden.yaml",
+			matcher:    MatchYAML,
+		}),
+	)
+
+	It("should reject unknown inspection type", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"inspect", "dataplane", "example", "--type=stats"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError(`unknown inspection type "stats". Allowed types: config-dump`))
+	})
+})
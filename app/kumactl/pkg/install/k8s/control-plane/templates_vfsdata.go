@@ -27,6 +27,13 @@ var Templates = func() http.FileSystem {
 			name:    "crds",
 			modTime: time.Date(2020, 3, 27, 15, 54, 16, 422752307, time.UTC),
 		},
+		"/crds/kuma.io_circuitbreakers.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "kuma.io_circuitbreakers.yaml",
+			modTime:          time.Date(2026, 10, 15, 21, 58, 23, 260882000, time.UTC),
+			uncompressedSize: 23696,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcd\x3c\xdb\x72\xdb\x48\x76\xef\xfc\x8a\x2e\xee\x83\xec\x2a\x92\xb6\xc7\xb3\xa9\xac\xde\xb4\xbe\x4c\x9c\x91\x65\x97\x25\x4f\x2a\x15\xa7\x52\x4d\xa0\x49\xf6\x0a\x44\x23\x0d\x40\x32\xf3\xf5\x39\x97\xee\x46\x83\xb8\x10\x92\x35\x4e\xa6\xe6\xc1\x22\x80\x83\xd3\xe7\x7e\xc5\x6c\xb9\x5c\xce\x64\xa1\xff\x50\xb6\xd4\x26\x3f\x17\xf0\x6f\xf5\xbd\x52\x39\xfe\x55\xae\x6e\xff\xb9\x5c\x69\xf3\xe2\xee\xd5\x5a\x55\xf2\xd5\xec\x56\xe7\xe9\xb9\x78\x53\x97\x95\xd9\x7f\x51\xa5\xa9\x6d\xa2\xde\xaa\x8d\xce\x75\x05\xb7\xcf\xf6\x70\x53\x2a\x2b\x79\x3e\x13\x22\xb1\x4a\xe2\x8f\x37\x7a\xaf\xca\x4a\xee\x8b\x73\x91\xd7\x59\x06\x57\x72\xb9\x57\xe7\x22\xd1\x36\xa9\x75\xb5\x86\xdb\x6e\xe1\xdd\xab\xdb\x7a\x2f\xe1\x55\xb3\xb2\x50\x09\x3e\xbf\xb5\xa6\x86\x67\xfc\xcf\xfc\x58\x89\x57\x84\x70\x68\x30\x84\xbf\x33\x04\xba\x50\x64\xb5\x95\x59\x07\x38\x5c\x2b\x13\x53\xc0\x5b\xe7\x73\xf8\xf7\x9d\xcc\x74\x4a\xc8\x31\x38\xb8\x92\x5f\x7c\xfe\xf0\xc7\xeb\xeb\x64\xa7\xf6\x92\x7f\x14\x22\x55\x65\x62\x75\x41\xf7\x1d\xbd\x4c\xe8\x52\x54\x3b\x25\xf8\x01\xb1\x31\x96\xfe\x3c\x7a\xad\x00\xa0\x0e\x56\x61\xe1\x25\xb6\xd2\xfe\x04\xf8\x5f\x44\xf5\xf0\xdb\xd1\x5b\xcf\x10\x2d\xbe\x07\x2e\x00\x9d\x15\xbf\xf7\x8e\x7f\x53\x29\x9c\x8b\x30\x30\x1b\xf8\x1d\x90\xb2\xaa\xb0\xaa\x54\x79\x45\xc7\x8b\xc0\x0a\xbc\x45\xe6\xc2\xac\xff\xa1\x92\x6a\x25\xae\x95\x45\x20\xa2\xdc\x99\x3a\x4b\x45\x62\x72\xf8\xb3\x82\xe7\x13\xb3\xcd\xf5\xff\x04\xc8\xf0\x3e\x43\xaf\xcc\x64\x05\x7c\x6c\x41\xd4\x79\xa5\x6c\x2e\x33\x24\x68\xad\x16\x00\x3e\x15\x7b\x79\x00\x20\xf8\x0e\x51\xe7\x11\x34\xba\xa5\x5c\x89\x8f\xc6\x2a\x78\x70\x63\xce\xc5\xae\xaa\x8a\xf2\xfc\xc5\x8b\xad\xae\xbc\x9c\x25\x66\xbf\xaf\x41\x98\x0e\xf0\xaf\xbc\xb2\x7a\x5d\x57\xc6\x96\x2f\x52\x75\xa7\xb2\x17\x40\xaf\x25\xe1\x99\x57\x24\x9b\xfb\xf4\x2f\xd6\xc9\x60\x79\x16\x21\x56\x1d\x90\xd3\x25\x3c\x9e\x6f\xc3\xcf\x24\x32\x83\x64\xfe\x1d\xae\x22\x4f\xa5\x7b\x8c\xd1\x6d\xa8\x89\x3f\x21\x11\xbe\xbc\xbb\xbe\x11\xfe\xa5\x44\xf1\x36\x89\x89\xb8\xcd\x63\x65\x43\x67\xa4\x0b\x1c\x5b\x59\xe6\xd3\xc6\x9a\x3d\x41\x54\x79\x5a\x18\x20\x24\xcb\x4f\xa6\xe1\xa9\x16\xc8\xb2\x5e\xef\x75\x85\x8c\xfd\x6f\xa0\x5f\x85\xec\x58\x89\x37\x32\xcf\x4d\x25\xd6\x4a\xd4\x05\x08\xb2\x4a\x57\xe2\x43\x0e\xbf\xee\x55\xf6\x46\x96\xea\xa9\xa9\x8c\x04\x2d\x97\x48\xc1\xd3\x74\x8e\x4d\x80\xff\xaf\x4f\xf8\x49\x01\xf0\x14\x24\xa8\x47\x17\xe0\x52\x9a\x92\x49\x91\xd9\xe7\x81\x87\x07\x31\xe8\x55\xa3\xe6\x4d\xc4\xe6\x1c\x84\x13\x1e\xab\x93\xaa\xb6\x20\x9c\xb7\xea\xe0\x38\xbe\x97\x05\xc0\x33\xf8\xe3\xbd\xae\x76\x9d\x37\xca\x98\xfb\xb2\x22\xb6\x02\x1b\x4a\x05\xdc\x38\x08\x34\x9c\xa4\x10\x95\x31\x19\x69\x0e\xc1\x22\xc5\xb0\x0a\xd0\x04\x0a\x77\x41\xda\xb5\xae\xac\xb4\x87\x40\xbb\x95\xb8\xd9\x01\x46\x12\x9e\x44\x36\x03\xe3\xed\x41\xae\x33\x86\xe3\x14\x16\x5e\x4a\x42\x06\xd2\x95\x76\x40\xde\xef\x54\x2e\xf6\x26\xd5\x9b\x03\x4a\x2e\x8b\x65\x57\xf9\x40\x2a\x6e\xeb\x35\x60\xac\x40\xb5\x51\x30\x52\x93\x94\x2f\x6a\x00\xba\xdc\xd6\x3a\x55\x2f\x22\x06\x9d\xcd\xfa\x48\xcf\x90\x5b\x97\x92\x0c\xbc\x83\xb2\x57\x68\xe4\xc7\x78\x02\x47\x24\x93\xce\xa6\x4b\xf9\xe7\x00\x77\x9d\xec\xe8\x17\xa7\x4d\x6b\x95\x99\x7c\xcb\x82\x7f\x73\xac\x71\x64\x86\x4a\x01\x38\xa7\x48\xee\x54\x97\xa8\xab\xb5\x2e\x77\x81\x51\x25\x71\x52\x94\xf8\x2e\x7a\x21\x52\x91\x9c\x49\x21\x13\x24\x07\x3c\xb5\x01\xc5\x3c\xd6\xbc\xe8\x30\x25\xbf\x59\x6c\xb4\xca\xc8\x4e\x20\x5b\x90\xe7\x32\x3f\x00\xad\x81\xa8\x56\x6f\x77\x15\xfc\x7c\x4f\xd0\x41\x87\x88\x33\xe8\x27\x3a\x30\xb7\x86\xac\x89\x11\x7a\x9b\x13\x3f\x2a\xa1\x37\x04\x0d\x50\x21\xaf\x09\x47\xb7\x4e\xb3\xbd\xde\xaf\x66\x13\x25\xbf\xeb\x76\xc7\x98\x30\x7f\x73\x7c\x3b\x5b\xc1\x2a\xfc\xd9\x31\x81\x7c\xb0\xae\x2a\xc2\x13\x2c\x77\x64\xdf\x1c\xef\xee\xc1\x7f\xf0\x91\xd0\x44\x55\x9e\x74\xdb\x5a\x5a\x09\xce\x83\x99\xc6\xfa\xd3\x65\x6b\x2e\x76\xb2\x00\xd7\x5c\x2e\xd7\x6a\x83\x94\x32\x36\x05\x92\xca\xc4\x9a\x12\x1c\x97\x2a\x00\x08\xd2\x0a\xcc\x03\xcb\x28\x18\x46\x32\xa0\x6c\x6d\xe1\x45\x1d\x98\x48\x65\xc2\x8f\xb4\xdd\xa3\x14\xce\x08\xf8\xc0\x5b\xbf\xbc\x7f\xf3\xfa\xf5\xeb\xbf\xa1\x57\xdf\x13\x3b\xe1\x16\xf8\xf9\xeb\xcd\x9b\x95\xf8\x96\x77\x60\x7e\x36\x45\x8d\xce\x31\x45\x0b\x40\x14\x3a\x80\xcc\xec\x57\xe2\x8b\x92\xe9\xd2\xe4\xd9\x61\x25\xae\x20\xf2\xa1\x28\x21\x03\x01\x7d\x72\x2f\xe8\xed\xc6\xfc\x08\x37\x3c\x80\xac\xce\x05\x0a\xd2\x12\x19\x34\x55\x88\x52\x95\x29\x84\xfe\x9b\x05\x0d\xf9\xac\xac\x36\xe9\x35\xb8\x72\xb0\xff\xa3\xd2\x74\x55\xef\xc1\x9a\xa0\x42\x97\x7c\xb7\x90\x59\x66\xee\x81\x32\x1c\x20\x35\x72\x01\x5c\xdf\x22\xec\x0d\xd0\xe5\xd0\x95\x25\x65\xf7\x3a\x47\xde\x3a\xc6\x83\x8a\xdc\x6b\xa0\xe0\x1a\xf5\x61\x6f\xee\x10\xa2\x77\xa0\x9e\xda\x9f\x80\xd0\xc4\x5f\x14\xc2\x0e\x48\x7f\xa2\xb6\x9c\x67\xa5\xc1\x47\x80\x1f\x20\x2f\xc8\x29\x92\xc5\x9d\xb1\x10\xff\xa2\xc4\x36\x1c\x1c\xa0\x2c\x38\xee\x7f\xfa\xb5\x97\xaa\x18\x1b\x6d\x8f\xf4\xa4\x83\xc4\xb8\x6e\xbe\xed\xc3\x19\x84\x53\x90\x74\x92\x75\x40\xe9\x24\xcd\x93\x55\x30\x9c\x3d\x26\x27\xf8\x2c\x4f\x45\xc2\x04\x4f\xd8\x36\x6b\xce\x8d\x35\x6a\xce\x1a\x2d\x03\xb3\x06\xe9\xca\x6a\x44\xa6\xaa\x51\x04\xf4\x24\x0b\xaf\x41\xa8\xf7\xa9\x86\x88\xb0\x62\x3e\x55\xe4\xd1\xd6\x87\x1e\x17\xcb\x61\x10\x79\xc1\x06\x75\x00\xa1\xbe\x43\x6e\x50\x05\xa3\xe1\x0e\x21\x9e\xe5\x46\xa0\x8b\x00\x74\xef\x74\xa9\x01\x6c\x07\x26\x49\x4b\x00\x45\x4a\xc8\x88\x21\x56\x60\x9f\x92\x9d\xc3\x86\x1c\xc3\x73\x21\x37\x95\xe2\x90\x9e\xa8\xab\xbb\x02\x55\x05\xc2\x2d\x40\x72\x28\x1c\x50\xf0\x37\x38\x7f\x08\x77\x21\xde\xc3\x77\x10\xce\xfb\xa2\x02\x03\x70\x51\x12\x8a\x42\x96\x47\x37\x76\x00\xd3\x83\xa8\xf7\x52\x63\xb0\x02\xb2\x0d\xa8\xc6\x64\x5e\x67\x26\xb9\x45\xde\x7d\xf2\xaf\xed\xc8\x55\x9f\x8b\x04\x92\x2f\x22\xdb\xe7\x4d\x24\x05\x91\x39\x32\x1e\x54\xd4\x45\x32\x9b\xda\x02\x58\x8b\x32\xcc\xb1\xff\xa6\xc6\x38\x69\xd1\x65\x55\x56\x41\x34\xb2\xdd\xa1\x82\xfa\x48\xc8\x6b\x8f\x70\x39\x51\xa0\xba\xbb\xc1\x73\xad\x00\xab\xd2\xe3\x46\x0c\xe3\x88\x64\x5f\x89\xf7\x00\x43\x7d\x87\x03\x65\x98\x5d\x90\x3c\xb9\x04\x83\x24\x8d\x43\x30\x29\x0a\x43\x12\xe6\x20\xf7\x39\x92\xd7\x2f\xbd\x49\x62\xa9\xfa\x1d\xc2\x9e\x4c\x39\xab\x82\xfc\x27\xb9\x07\x17\x90\xa2\x9b\x6b\xe4\x3d\x98\xa2\xe3\x64\x8a\x9c\x09\x38\x70\x8a\xf5\x38\x7e\x61\x96\x21\xef\x75\x4e\xbf\x00\x5a\xc0\x78\x27\x49\x80\x68\x83\xc4\x82\xae\x3b\x24\xba\xd1\x1b\x22\x85\xb8\x00\x22\x3b\x69\xd3\x18\x09\xff\xd2\x67\xd7\x1f\x7e\xfb\xfd\xc3\xe5\xe5\xf3\xce\xeb\x51\xac\xbb\x8c\x22\x2c\x92\x4c\xc9\xbc\x2e\x16\xce\x88\x7a\x24\x1b\x5b\x0a\xd9\x26\x65\x12\x74\x81\x5c\x62\x42\xf1\x19\x04\x88\xf7\xc6\xde\x76\xc0\x82\x07\xae\x28\x4c\x2f\x17\x2d\xf3\x8e\x3c\x82\x40\x0c\x8e\xa1\xbe\xa3\x38\x7b\x75\x72\x8c\x25\x19\x5d\x80\xd8\xc1\x2d\x5d\x54\xc1\xde\xa4\x70\x5c\x78\x0e\xdc\x3a\x08\x00\xfc\x2f\xc1\x01\xee\x25\x4b\x8d\x81\x88\x0e\x82\x0a\x89\x09\x31\x13\x46\xb5\xe5\xac\xc7\xfe\x91\x9b\x69\xdc\x0a\xc6\x22\x1b\x1f\xc3\x2d\x1a\x66\x07\x2d\x73\x21\xa9\x3b\xcd\x4e\x76\x21\xb2\xe6\x80\x6d\x0c\x46\x0f\x63\x83\xa1\x58\xe0\xd8\x8c\x86\x37\xf5\x29\x6a\x04\x31\x8a\x20\xfe\x9f\x47\x0c\x8d\x41\x1b\xf5\x69\x1f\x21\x9c\x46\x23\x40\x56\xd1\x7b\xf7\x88\xd4\x8d\x16\x37\x42\x69\xd5\x16\x65\xa1\xe3\x83\x85\x78\x07\x76\x1b\x12\x68\xb8\xe6\x92\x3a\xc8\x59\xe0\x8c\x60\x95\x6d\x28\xc9\x80\x58\x14\x70\x68\xf4\x0a\xa0\x23\x7b\xf8\xb7\xca\x9d\xe1\x40\x3d\xeb\x71\x95\x41\x35\x18\x72\xc0\x03\x0d\x33\x09\x4e\xaf\xc9\x6d\xcb\x4c\x1f\x5f\x73\x93\x2f\x73\x9d\x2d\x08\x2e\xa4\xb3\x6c\x26\xb4\x73\x15\x28\xd0\x3e\x02\x71\x31\xce\xf1\x81\xc9\x17\x3c\x28\x09\xe6\x4b\xd2\x5a\xd9\x76\xb3\x5b\x30\xd0\x18\x3b\x9f\x4c\xd2\xe6\xbf\x45\x77\x3a\x22\x9b\x82\x13\x73\xb4\x10\x1b\xfd\x7d\xc1\xc9\x57\x2b\x6c\x58\xf4\xd9\x75\xff\x52\x34\xe4\xb9\x06\x19\xe7\x6c\xec\xd3\xd5\xe5\xbf\x8b\x0f\xef\xe9\x69\x7a\x0b\x47\x23\xa0\x74\x8d\x92\x81\xd6\xdf\x01\x6f\xd3\xae\x08\x7c\xd8\x44\x9e\xd8\x67\x82\x6c\x5e\x09\x3a\x64\xdd\xb5\xcd\x39\x64\x68\x2a\x2c\x4d\x1c\x34\x98\xf9\x81\x84\xe4\x0d\x98\x42\x96\x65\x08\x97\xd8\x7f\x12\x08\x8a\x20\xd7\x24\x59\x6b\x9d\xbb\xa2\x41\x38\x60\xd7\x63\xd4\x1b\xa0\x18\xbb\x20\x7f\x26\x07\x6e\xe7\x22\x03\x4a\x53\x9b\x02\xa5\xb0\x75\x06\xa2\xe2\x2e\x22\x7d\xba\xc6\x8d\x83\x10\x5f\x7c\x03\x6c\x2a\x5b\xe7\x49\x6c\x85\x32\x95\x6f\x01\x2f\x27\xa2\x8c\x05\xd9\x19\x6d\x89\x34\x1d\x98\x7b\x79\xcb\x3a\xc0\xc8\x39\x7e\x99\x3c\xe2\x31\xd9\xbb\x0e\xf9\xb1\x7a\x8b\x0a\xd8\xe3\x82\x30\x54\x85\xa7\xbd\x18\x70\x0e\xce\x0e\xc2\x39\x44\x1f\x73\x22\x65\xaf\x3e\xdd\x38\xe6\x01\x39\x7f\x7d\xf9\x37\xb1\xec\xf1\xeb\x60\x25\x25\x1c\xdd\xa7\x07\x4a\x53\xd8\xe2\x1e\xfb\xe5\xe5\x2b\xf1\x86\x73\x4f\xf4\x21\x7f\x7d\xf9\x92\xb9\x03\x46\xb5\x84\x93\x70\x61\x0e\xf5\xd7\xd4\x7d\xc9\x67\xaa\x81\x84\x1c\x0d\xc4\xe2\x9a\x50\xf5\xc5\x05\x4e\x1b\x53\xe7\xa9\x77\xf7\x1c\x87\x43\xc2\x53\xc1\x1b\x17\x83\xe7\x77\x12\xe8\xca\x38\x58\x15\x3a\x88\x67\x5e\xa7\x40\xff\x3b\xa1\x27\x21\x42\x99\x69\x8f\x90\x2a\x38\x0e\x40\x58\x72\x98\xb1\x03\x72\x28\xfb\x9c\x58\x73\x51\x14\xf0\xa6\x94\x8d\x8a\xde\x08\xaf\xc1\xe4\xf6\x3c\x97\xba\x0a\xf5\xb4\x7e\x06\x04\x1c\x4c\x2e\xc4\x83\xc9\x61\x3e\xd5\x95\x38\x01\x39\x2a\x8b\x77\x4c\xd3\x05\x88\x0b\x70\x05\x23\x94\x9c\xf3\xce\x56\xa9\x42\xfa\x43\x26\x11\x40\xd0\x80\x5e\x1a\x02\x60\xd2\x04\xb0\xe4\x15\x84\x9d\x13\x32\xfa\x27\xc9\x07\xa9\x6d\x32\xc5\x6d\xce\x2f\xf2\xf8\x66\xae\xd1\x10\x07\x4c\x96\x85\x9a\x99\x02\xae\x51\xbd\xab\x34\x7b\x8f\x73\x8f\x60\xdf\x49\xab\x25\x48\x20\x78\x40\xe7\x75\x7d\xcd\xc8\x45\xdd\xed\x9c\x50\xb2\x7f\x02\xdb\x11\xa3\xdb\x67\x2f\x31\x52\xba\xe3\x92\xe5\x01\x6b\x63\x94\xaa\x99\x56\x41\x88\x03\x2f\x9d\xa1\x42\x52\x0c\xd0\x8a\x1b\x3b\x40\xd1\x28\x92\x03\x40\xcf\x8d\x61\x01\x8a\x72\xc0\x02\x53\x20\x54\xf8\x7b\x5d\xaa\xc5\x51\x14\x91\xa0\xcf\x07\x5d\xe8\x31\x44\x20\xbf\x0d\x08\x9f\x9d\xee\x74\x0a\xb1\x83\x78\x06\xba\x8c\xc7\x7d\x71\x2f\x2b\xa0\x28\x5e\xdc\x2a\x74\xce\x59\x56\x3e\xe7\x50\x80\xf5\x77\x84\x00\xf9\x59\x85\x99\x6a\xa6\x13\x8d\xa9\xae\x2c\x6f\xd9\xfd\x98\x35\xd9\xb7\xa3\xf7\x87\xda\x6c\x4f\x65\xe9\xdf\x28\x6a\xcc\xe3\x63\xb1\x3d\x5b\xb4\x62\x4b\x34\x7d\x85\x13\xd9\x28\xa2\xe8\xad\x5f\x93\x05\xaa\xad\x25\x13\xa4\x3a\x6c\x75\x65\x14\xc8\xcd\xee\x74\x06\xf2\x9a\x52\xce\xc5\xf5\x34\xce\x11\xbb\xae\x82\xca\xcc\xcd\x7b\x5d\x5e\xaa\x9b\xec\x77\xe1\xd3\x43\x67\x35\xe9\x09\x34\x4d\x2e\xcf\xec\x80\x04\xad\x93\xf9\x81\x5e\x4d\xa6\xec\xed\xbb\xcf\x5f\xde\xbd\xb9\xb8\x79\xf7\x56\x2c\x5b\xe8\x52\x89\x1c\x13\x86\xac\xd8\x49\x27\xb2\xc8\xb3\xde\xc8\x2e\x2a\x1e\x01\x97\xef\x5e\xad\x5e\xfd\x75\x75\x6c\x94\x8a\x91\x66\x43\xc1\xd9\x61\xf7\xc2\x91\xb2\x7e\x76\x59\xe4\xa0\xee\xb8\xce\x01\x86\xc2\xea\xbb\x4a\xea\x4a\xf5\x80\xa4\xb4\x95\x0b\x9e\x21\x4c\x6e\x12\x2c\x8c\x42\xb8\xd4\xb1\x62\x29\xe1\x0e\x1d\x40\x74\x58\x0e\x40\x6c\x99\x10\x47\x0d\x5f\x08\x11\x1b\xa9\x33\x44\x1c\x8c\x68\x9d\x55\x51\xcd\x40\x8d\xab\x3e\x45\x36\xd4\x4c\x09\x71\x15\xd5\x59\x0d\x69\xba\xf7\x7b\x7d\xba\x89\x71\x4d\xa4\x0c\xbd\x90\xf1\x79\x77\x56\x04\x09\x9a\xe8\x55\x70\xd5\x73\xff\x40\x8c\x7c\x8a\xb7\x2e\xa9\xeb\x09\x87\x07\x98\x1c\x77\x2e\x7c\x4e\x4a\x6c\x25\xba\x36\x29\x07\xa6\x21\xe1\x84\x43\x7c\x89\x2a\x4a\xce\x4c\x0e\xde\x36\x12\xec\xfb\xfc\x85\xa3\xba\xfe\x73\x2c\x09\xf1\xd9\x30\xe4\x01\x43\x3c\x9c\x4a\xb8\xf2\x22\x08\xcc\x49\xc5\x00\xd3\xdf\x12\x2d\x0e\xc7\x90\x82\xef\x41\xf2\x6a\xab\x7c\x28\x3b\x92\x47\x85\xfa\x08\xf6\xbf\xb0\x09\x5e\xba\x7a\x20\x36\xda\xe4\x56\x79\x71\xcb\x7d\x1e\x89\xe9\x56\x59\x5b\xee\x5e\xa0\xcb\xeb\xb5\x38\xdc\xeb\xe1\xde\x01\x65\x62\xce\x56\xc7\xa9\x5e\x1f\x53\x4e\xc9\x54\x7f\x8b\x7f\xa4\x4f\xf9\xb0\x76\xff\xa0\x98\xb4\xc7\x00\x1e\xda\xfa\x1f\x04\xdb\x3b\x12\xf0\x90\x31\x80\x41\xc8\x3f\x71\x3c\xe0\x41\xea\x94\x98\x54\x4d\x62\xdd\x75\xbd\xdd\x72\xf1\xfb\x5f\x6e\x6e\x3e\xfb\x1c\x04\x1f\x6f\x9a\x1f\x18\x5e\xd6\x90\xeb\xbc\x84\x78\x7c\x90\x12\xae\x2c\x35\x64\x02\xa2\x48\xf3\xf5\x2f\xa3\xa7\xea\x8b\x38\x1b\xd4\x2b\x50\xb9\x72\xd2\xc9\xde\xe1\x34\x10\x26\xaa\x58\x30\x02\x8b\x5d\x9a\x44\x53\x70\x1c\xd4\xd7\x52\x46\xb5\xe2\x82\xcc\x88\x4c\x52\xde\x85\x92\xc1\xb2\x2d\x70\xae\xc1\xdc\xe7\xd4\x36\xe7\x37\x30\x5a\x47\x21\xe8\x20\xc4\x50\x89\xf0\x3e\x86\x30\x0c\x29\x7f\x6f\xb3\x11\x24\x04\x49\x38\x6c\x60\x0d\xc5\x1e\x4e\xcf\xd4\xf7\x44\x15\xae\x5c\xc4\x48\x87\x9c\xc0\x1d\x07\x69\x3d\xc4\xab\xd3\x1e\x07\x04\x4c\x42\x98\x33\x72\xbd\xa7\x6b\xfe\x86\x1e\x61\x5b\x0c\x5c\x4e\xb2\x1a\x6e\x81\xa8\xca\x2a\x4f\xc0\x88\x4b\x23\x80\x45\xc3\xc1\x6b\x92\x4c\x97\x19\x6f\xd8\x1a\xaf\xc4\x15\x50\x0f\xfd\x6d\x7c\x95\x62\xc1\x51\xa0\xae\xb0\xe1\x70\x01\x82\xf1\x11\x57\x23\x0f\x8d\x78\xed\x87\xd0\x32\xaa\x87\x9c\xba\xe9\x38\xc1\xba\xd9\xf9\xc2\x93\x73\xea\xed\x31\x0f\x4c\x44\xe8\x18\xe9\x49\xb8\xce\x91\x2b\x6b\x0d\x36\xbf\x4a\xf2\xb8\x24\x35\x28\xee\xff\x7a\xfd\xe9\x0a\xeb\x1c\x14\x0f\xc8\x21\xb7\xd2\x49\xca\x1b\x46\x8b\x14\x99\x02\xf2\x5e\x98\xb2\xc2\x32\x8e\x9f\xd0\x20\x33\x93\x93\x09\x9a\x00\x51\x56\x6c\x3e\xd1\xe6\x5e\xa0\x20\x71\x2c\x0d\xf1\x9d\x59\x6a\xd0\xc5\xef\x98\x5d\x89\xf7\x48\x91\xd3\x1c\xf7\xbe\xae\x50\xd2\xb2\x1c\x52\xf5\x8c\xda\x62\x9a\x32\x18\x96\x55\x20\x2d\xcb\x82\x48\x6b\x35\x85\x90\x86\x79\x52\x62\x5e\x85\x1e\x7c\x0f\xc1\x83\x86\x44\x90\xa9\x8b\xd9\x8a\xb3\x00\x94\x26\xbc\xe3\x4e\x51\x79\x3e\x01\xf4\x37\xf8\x7f\x8e\x9c\xf9\x36\x87\xa0\xa8\x0a\xdc\x0f\x3f\xba\x5a\x97\xcb\x95\x26\x40\x0c\x02\x83\x90\x49\xa0\xff\xe3\xe5\x7f\xae\x46\x5e\x31\x01\xa6\x43\x62\xa3\x2d\x36\x51\x88\x86\xae\xdc\x9d\xfb\x97\x7c\x9b\x9f\x06\x74\xd2\xcb\x45\xd9\x1c\x04\xb2\x10\x46\x3d\x50\x7d\x2e\xc4\xae\xde\xcb\x7c\x09\x36\x31\xa5\x46\x6a\x74\x35\xcc\xf7\x20\xe7\xa7\x9c\x99\x6f\x27\x0e\x83\x08\x46\x9e\xc0\x55\x37\x9b\x59\x0d\x59\x2e\x47\xbc\x43\xdb\xa6\xa3\xbd\x86\x9c\x63\xf5\x94\xc4\x62\x17\xf0\x60\x5a\xed\xc1\x4b\x82\x27\x19\xa3\xd6\x84\x43\x11\x3d\x8f\xa8\xe5\xcb\xb1\x5c\xb5\xf5\xf9\x37\xde\x61\xa7\x80\x24\x87\x49\xd1\x17\xc6\x18\x88\x8d\xbc\x03\x13\x8e\x38\x3e\x21\xdd\x4e\x24\x1a\x53\x12\x8e\x50\x2a\xa4\x19\xe1\x87\xf8\x4e\x7a\xa2\xb1\x7e\x1d\x6b\xff\x50\xc7\xc9\x21\x5d\xcb\x43\xae\x66\x3f\x48\xa4\xe3\x51\xd5\xf1\x94\x01\x4f\x85\x4f\xfc\xc9\x87\x12\x9f\x72\xae\x2b\x36\xe3\x56\x1c\xca\x51\x07\x65\x14\x6e\xd4\xc9\xf3\x03\x22\x01\x35\x1c\xbc\xfd\x49\xe3\xaa\x8f\xe2\xc5\x78\x49\x60\x68\xa4\xf1\x4f\x65\x85\x78\xe6\xc6\xec\x70\xee\x90\x67\x96\xe1\x04\x99\x1a\x4e\xed\x9b\xb1\x50\x2c\x13\x63\x7e\xbb\xf6\x46\x67\xad\xd2\xe7\x3f\x2c\xb0\xd4\xc4\xa0\x0e\xc4\xc0\x94\xd8\x58\x49\x20\xf4\x22\x16\x71\xd3\x23\x4c\x90\x35\x3d\xe2\xd1\xa3\x05\xa9\x8c\xe6\x63\x79\xe2\x16\xc4\xeb\x1a\xe5\x96\x43\x06\x37\x87\xcd\x3d\x95\x71\x33\xd5\xf4\x6a\xa8\x54\x57\x61\x4b\x8c\x6a\x8d\x94\xed\x62\x1f\x33\xa1\x17\x2e\x5d\x82\x67\x4a\xff\x92\xd9\xc9\xe8\xb0\x71\x68\x1e\x17\xb1\x33\xf7\x3c\x22\x04\xde\xea\x5e\xea\x2a\x9c\x5c\xde\x9e\xb4\xa8\x3b\xd5\x41\x6b\x8c\xa9\x53\x72\xc8\x69\x79\x24\xd5\xd3\xf5\x03\xac\xd5\xd7\x0f\x6f\x8f\x75\x62\x35\x24\xd0\xb3\x49\xe1\xd6\x90\x50\x3f\x78\xd8\xb9\x19\x1e\x28\xff\x02\x3f\xfc\xa8\xed\x38\xe9\xe6\xc6\xcc\xfc\x13\x6c\x27\xcc\x26\xd4\x19\x1f\xb5\xa9\x30\x9b\xa0\x31\x8f\xda\x5a\x18\x04\xfc\xd3\xdd\xc3\x49\xf6\x9e\x08\x93\x1f\x1c\x1c\x3b\x33\x7f\xaa\xac\x17\xac\xdc\xea\xf1\x88\x77\xd7\x33\x86\x05\x0f\xdc\x4e\x9e\xe2\x04\x1a\xb5\x31\x9a\xf5\x84\x9f\xce\x90\x49\x95\x14\x83\x9a\x50\x4f\x77\xd7\xfe\x81\x78\x89\x43\x6f\xc2\xe4\x2a\x0f\xf8\xc3\xd1\x41\x92\x67\x13\xb2\xb4\x3c\x4c\x3f\x53\x62\x16\xea\x50\x6e\x02\xd6\xd9\x79\xd7\x26\x38\xe5\xcf\xdc\x28\x04\xd6\x1d\xb8\xb0\x43\xb5\xb7\x10\x8d\x53\xa8\x11\xa2\x7c\x53\x48\x9c\x4f\xe8\x1b\xfc\x6b\x0f\x75\xd0\x31\xfd\xae\x84\x2e\x4b\x7a\xc8\xb8\xa1\x09\x37\x52\x69\x8e\xd7\x92\x64\x75\x1a\xd3\x34\xea\x3b\x02\x04\xbf\xeb\xc2\x74\x51\xdf\x43\xaf\x31\x9c\x60\x9c\xa0\xbe\x27\xfa\x86\x39\xc4\xfd\x7c\x6a\x1b\x41\xf4\x08\x48\xb1\x38\x36\x1d\xc5\xc2\x94\xfd\x73\xbf\xad\xec\x66\x13\x0f\x99\x60\x1d\x50\x6f\x6b\x17\x34\x50\x7d\x67\x27\xf3\x2d\xcf\x8a\x34\x35\x0c\x39\x1e\xd9\xaa\x7b\xb1\x07\x04\x91\xad\xd4\xfb\x6e\xe6\x84\x1a\xff\xe6\x0b\xfa\xec\xf3\xbd\x54\x9c\x08\xd4\xc0\x1f\xd6\x25\xdb\x75\xee\x98\xb1\xa4\x46\xa3\x47\x70\x6c\xf6\x58\x49\x98\x41\x1d\x85\xe9\xa4\x25\xae\x28\xb8\x46\x95\xc2\x51\xcc\x0c\x3b\x58\x07\x53\xf3\x39\xac\x4a\x94\xbe\x3b\x81\x25\xcf\x73\x9a\x5b\xc0\x95\x9c\x04\x90\x8b\xe2\x1f\x6f\x1d\x9f\x20\xae\x6c\x51\x70\x7a\x94\x71\x5d\x35\x0d\x9f\xe0\xd6\xcb\x88\xfd\x67\x67\x65\x68\x5b\x8c\x53\xcd\x31\xcf\xef\x57\xfa\xfd\x05\x84\xec\x62\x0e\x3f\xfe\xe6\xfb\x47\x3d\xe3\x54\x47\xa5\x77\x37\xb5\x4a\x5c\x76\xb2\xce\x64\x77\x22\xb8\x12\x7f\xf0\x88\xb6\x9b\x96\xac\xb8\xeb\x3f\x0a\x56\x06\x33\x10\xa1\x42\x75\x42\x12\x49\xe0\x70\x68\xbb\xaf\x65\x72\x3b\x45\x62\xfc\x9c\xd7\x94\x05\x97\xc6\x23\x8c\x82\x7c\x02\x6f\x01\x7f\x72\x51\x2e\x39\x2c\xdd\x08\xcc\x12\xce\xb9\x0c\xe6\x21\x39\xfc\x70\xd6\x57\xaa\x6c\x73\xa9\xf3\xdb\xc9\x12\xe7\x1f\xe0\x28\xed\xeb\x97\xcb\xe3\xe0\x6c\x42\x6b\x77\xe2\x2e\xd1\x9f\x1c\x95\x8e\xd7\xb4\x1e\x58\xc9\xba\xdf\xb9\xc1\x90\x10\xb8\xcc\x46\x6a\x4f\xce\x36\xcd\x5d\x37\x78\xee\xa2\xa2\xf1\xb2\xd6\x58\x7f\x68\xb0\x98\x05\xa8\xbb\x5c\x3a\xc9\xa4\x65\xe3\x00\xca\x4c\x9d\x3b\x7e\xe9\x48\x94\x91\x82\x8a\xd5\x95\x48\x8d\xe2\xfe\x92\x01\x25\xb1\xd8\xf0\xd0\xd5\xa3\xc3\x32\x7e\xe9\xe4\xa0\x2c\xc4\x8a\x51\x39\x06\x2b\x34\x58\x6f\x38\x17\xf3\xeb\x3a\xc1\x81\x84\x79\xdf\xb8\x8e\xff\x2f\x50\xf9\xa9\xa3\x39\xcc\xe7\x49\x21\xf9\x4c\x8f\x0c\xb1\x47\xe4\x74\x68\xc2\x61\x39\x30\xfb\x32\x08\x0a\x64\x41\x65\x7f\xf6\xe6\xf1\x47\x49\xa3\xe1\x2e\x71\xbb\x55\x07\xb6\xca\xdc\xef\xee\xfa\x11\x9c\x13\xb3\x5b\x89\xcd\xf2\xde\x09\x52\x0c\x21\xb7\xc6\xc2\x65\xf1\x8c\x3e\x69\xc0\x3b\xc1\x2a\x83\xc3\x3d\x8f\x16\x7d\x21\x36\xda\xd3\x08\x1b\x5f\xc2\xca\x47\xcf\xec\x23\x18\xa9\x8c\xc6\x5c\x51\x13\xc2\x38\x61\xe9\x60\xda\x3b\x9d\xa8\x47\x6c\x0d\x33\x5d\x27\x2f\x0c\x43\x6e\x04\xc1\x42\xca\xbd\xa6\xf1\x31\xc8\x8f\xf1\xad\xb8\xab\x5d\x0a\xdc\x4b\xd9\x64\xe6\x7e\xa9\x79\xf4\xcb\x3b\x6c\x17\xc7\xf6\x2d\x96\x02\x3b\x5c\x5b\x89\xf7\x87\xac\xf2\x38\xb0\xd5\xc5\xd5\x00\x07\xd5\x75\xa2\x35\x46\xe1\x25\x4e\xf3\xf1\x3c\xcf\x40\xe0\xb0\x33\x40\x85\x5b\xa5\x0a\x60\x35\x47\xfd\x3c\x3d\x07\x47\xc7\x28\x0d\xf7\xde\xa8\x38\x85\x13\x82\xb9\xeb\x47\xbb\xcd\xab\x3a\x4f\xe1\xce\xaa\x2f\x84\x6f\x0a\x46\x68\xb7\x3c\x66\x5e\x6a\x7c\xb6\x72\xc6\x8d\xc6\x45\x6b\x30\xd4\xff\xd8\x25\x81\x6d\x66\xdb\x31\x2c\x6f\x86\x65\x65\x51\xe0\x00\xa0\xac\x76\x10\x65\xdf\x2a\xf1\x6d\x9e\x80\x8a\xa7\xdf\xe6\x1c\xd4\xba\x38\x9e\xe9\xd7\xb7\xe5\x20\xb3\x7b\x6c\x26\x3a\x5b\x1e\xb8\xe1\x72\x9e\x06\x7d\x92\xf6\xa3\x3d\xf5\xbe\x80\xc4\x0f\xad\x7c\xcb\x8f\xe7\x52\x69\xe6\x8f\x75\x82\x28\x11\xc5\xef\x7e\xce\x0f\xcb\xa8\x7d\xd3\xdd\x60\xb6\x41\xb4\x3b\xd3\x7f\x03\x6d\xe8\xf1\xe4\xf3\xd4\x88\x4f\xdb\x65\x8e\xce\xf7\x44\x5f\xf1\x88\x9a\xcf\xb3\x93\x75\x44\x4a\x54\x69\xdc\xdb\x6f\xc9\x2b\x57\xe3\x43\x42\xcd\xa9\xe7\xf1\xc2\xbd\x63\x2e\xfe\x51\x97\x43\x30\x89\xe3\x54\x85\x35\xc5\x32\x43\x0b\x1f\x63\xec\x64\xd0\xad\x71\x2b\x74\x31\xf8\xd5\x02\xd4\x34\x0b\x21\xe5\x6c\xb8\x2a\x14\x9d\x4f\x46\x38\xaf\x15\x37\xb1\x34\xd9\x40\x97\xcb\xb9\x5d\x2f\x56\x98\xd9\x60\xaa\x8f\x23\x4b\x7d\xf3\xeb\x13\x7c\xcb\xa6\xd7\xd2\x8c\x58\x7f\x5c\xa8\x50\xa7\x99\xeb\xcc\x52\x94\x70\xc8\xb6\xbe\xac\x1e\x33\x78\xc7\xa6\xc9\x4e\x10\x2e\xb6\x8e\xb6\xbb\x0b\xe5\x42\x85\xa0\x7b\x04\x72\x24\x46\xdc\x41\xe0\x3a\x01\xe5\x41\x02\x87\x98\x64\x02\xd2\x9f\x42\xe1\xde\x7d\x52\x07\x61\x23\xc6\x4d\x45\x9f\x2b\xbc\x19\xc4\x98\xc3\xb9\x15\x69\x43\xcb\x3d\xbc\xa3\x46\xf9\x5a\xa1\x61\x09\x9f\x20\x40\xcd\xa0\x85\x08\xda\xb0\x71\x5e\x78\x78\xd2\x2a\x56\x32\x74\x13\x67\xb8\x54\x71\x38\x23\xab\x73\xf6\x95\x8a\x98\x67\x8f\xa2\x10\x76\x39\x26\x10\xe7\x46\xf3\xce\x46\x15\x6f\x99\xf9\x62\x79\xe0\x91\xb8\xc7\x38\x78\x64\x66\xec\x43\x58\x37\x71\xd6\x39\x6c\xe0\xe9\x4d\x9b\x01\xee\x80\xb3\xb1\xae\xc1\xd0\x6e\xe0\x84\x83\x8f\x88\xfa\x50\xbb\x37\x3f\xb5\xa2\x76\x46\x8b\x2d\x3e\x55\x76\xab\x3a\x68\xf8\x71\xf2\xa4\xf9\xce\x07\xd8\xad\xb2\x59\x79\xea\xfd\x46\x00\xaf\x41\xf0\x00\x34\x8f\x0d\x2e\x9a\x0d\x67\xea\x7d\x36\x9f\x14\xc1\xe2\x13\x7d\xdc\x20\xac\xab\xf7\xc9\x66\xb3\xa7\xac\xda\x5b\x28\xd4\x48\x2a\xd0\xb1\x58\x6c\x06\xba\xae\x61\x6c\xf9\x56\xfd\xcb\x5e\xc0\x23\x78\x64\x0f\x49\x0b\xad\x42\xb8\xb9\x39\x14\xd5\xb0\xc4\xd1\xec\xdc\x70\x70\xd8\xae\x74\xa5\xe1\x6b\x5d\x5d\x69\xe9\x29\xd0\xff\x48\x13\x85\x68\x7f\x36\x75\xed\x27\x70\x6a\x3c\x04\xbc\x0a\x1f\x6e\x89\x1d\x28\xff\xe2\xb8\x8e\xeb\xfc\x4c\xd1\xb6\x54\x74\x0f\x7c\x91\x3b\x3d\x88\x3e\x07\x03\xd9\x25\x08\x09\x58\x06\xe6\x29\x81\xff\x36\x87\x97\xc9\x3a\xab\xbe\xcd\x9b\x5b\x17\x98\x06\x76\x40\xc6\xb7\x3a\x8b\x06\xbe\xcd\xe4\x54\xa6\x6b\x8f\xe5\x36\x03\x76\xbe\x08\x84\x36\xc6\xcb\x68\xdf\x0a\x25\x16\xa9\x31\xe8\x4f\x79\xa4\xa5\xc1\x7a\x19\x2d\xeb\x99\xd6\x4e\x5e\xd3\x9b\x74\x2f\x99\x0d\x8d\x53\xbb\x2f\x15\x40\xa0\xe5\xb7\x74\xa5\x78\x7b\x75\xfd\x5f\x97\x17\x7f\x7f\x77\xb9\x1a\x17\x8e\x59\x5f\xa1\xe7\xa4\xb0\x04\xfc\xcb\xc9\xcb\x61\xe6\x1e\xb4\xe8\x8b\xa2\xa5\xcd\x44\x8d\xa7\x0b\x97\x6e\xf7\xc2\x53\x37\x55\x05\xab\xcb\xfa\xd0\xd9\x49\xba\xb8\xbc\x1c\x24\x90\x8b\x65\xa9\xe8\x4c\x65\x3a\x5a\x49\x0a\xf3\xe5\xad\xef\xdd\x38\x5a\x6e\xa5\x5d\xe3\x34\x7a\x82\x61\x78\x52\x8d\x6d\xae\x36\x7b\x11\x51\x12\x12\x07\xf1\x0b\x9e\x67\xc7\xea\xaa\x9f\xfd\x0a\xc5\xf6\x7e\x66\xba\xca\xbd\x69\x8a\xc7\x1e\x52\x98\x2b\x88\x96\xc7\x9a\x78\x8c\x22\xb9\x3e\x3d\xb9\xa1\x4a\x4b\x13\xa3\xc5\x33\x7e\x2a\x84\x13\x11\xd0\xd5\xff\x45\x64\xdd\x0e\xa3\x51\x93\x78\xb7\xf7\x51\x1e\x9a\xbe\xb2\xf1\x09\xa5\xcd\x7f\x86\x65\x02\x12\xc8\x53\x8b\x23\xf0\x17\x57\x6f\x7d\xbf\x81\x24\x36\xac\xf7\xce\xb1\xa7\x8f\x01\x79\x9e\x7a\xb8\x43\xf3\x7b\x61\xa5\xde\x09\x40\x03\xac\x61\x44\x67\x59\xfe\x56\x1d\x96\x64\x06\x06\x80\xf2\xf7\xc8\xe8\xcb\x0b\x3e\xd5\x70\xba\x14\x6d\x04\xad\xc4\x5b\xb6\x61\x34\xe9\xbf\x91\x19\x7e\x52\xee\x66\x28\xf4\x0a\xdf\x54\xf2\x8b\xc8\xdc\x3d\xc3\x04\x17\x92\x0f\xc6\x70\x8e\xcb\x1a\x7b\x5d\xc6\xec\xa1\xb3\x2c\x06\x80\x1a\xbf\xd8\x27\x7e\xfd\xe5\x17\xf1\xec\x6b\xee\x96\x6c\xa8\xca\x08\xa1\x9d\xae\x0e\xcf\xa3\x6f\x02\x71\x4f\x65\x8c\xd1\x6b\x63\xf0\xeb\x17\xb3\xfe\xbe\x21\x4b\xed\x43\x38\x7c\x44\x3c\x52\xb9\xb0\x18\x31\x41\x23\xa6\xe1\x36\x3c\x23\xd0\x33\x21\x70\x2c\xf6\x3f\xbb\x4d\x7b\x42\xa3\x86\x47\xa9\x7a\xe2\xb9\x53\x67\xf9\xf1\x40\x64\x12\xce\x83\xb3\x2d\x23\x53\x2d\x4f\x81\xf1\xf0\xfc\xc9\x28\xc2\xc3\xcb\x5f\xcb\xc8\x9a\xf6\x5c\x44\xae\xf6\xfc\xdc\x3b\x51\xb6\x44\xaa\x3c\x45\x68\x7f\xa2\xbd\xd7\xd9\x80\x76\xfd\x2d\x8e\x72\xa8\xa2\xd4\x8c\xaf\xb8\x2d\x45\xbf\x88\x14\x1c\xc1\x6c\x6c\xfa\x65\xbc\x8b\x37\xd0\xa9\xeb\x59\x52\x8e\x3b\x77\x1f\xa3\x26\x3b\xc6\x5e\xb8\xa3\xb2\xc7\xaf\x12\x26\x22\xea\x5c\x2d\xdc\x03\xf4\x0e\x9a\xd7\x1a\xfe\x60\x00\xaf\x22\x37\xe9\x30\x76\x32\x9a\x0a\x91\xb1\xbe\xc6\x10\x92\x93\xf0\x19\xbc\xee\xae\x2e\x0d\xb2\x61\xa2\xe0\x12\x48\x57\x86\x96\xf1\x0c\xc1\x03\x3b\x86\xbe\x4b\x48\x9f\xac\xdc\x47\xdf\x51\xe3\x14\x1b\x69\x20\xf9\x43\x41\x49\x9d\x49\xdb\x83\xf9\xe0\xe7\xca\xca\xb1\x6f\xea\xb4\xda\x8f\xd3\xfa\xa5\x83\x3d\xd2\xa7\x36\x95\x13\x7a\x94\x93\x23\xde\xa1\x5e\x64\x7b\xfb\x6c\x7a\xff\xb1\x45\xcf\xde\xfd\xf0\x93\x3d\xc7\x41\x5c\x7b\xcc\x65\x5b\x8b\xd1\x50\xba\xac\xc8\x65\xea\x3a\x77\x1f\xce\xc0\x96\x03\xe5\x32\xac\xdf\x47\x5f\x0c\xec\x89\x9f\x29\x66\x6e\x4a\xeb\xcd\x77\x45\xda\x5f\xb0\xc3\x91\x50\xee\x87\xe1\x87\x97\x42\x96\xdc\x23\x76\x91\x56\x45\xdf\xac\xf3\x9f\x30\xc4\xdd\x31\xd6\x59\x78\xfa\xf3\xd7\x9b\xd6\x77\x27\x63\x31\xed\x5b\x67\x3f\xd9\x35\x7f\x9c\x8b\x98\x28\x44\xbd\xb6\x19\x5c\xe2\xee\xfc\xd4\xd7\x7c\xfd\xc7\xb8\x47\x20\x1d\xfd\xe4\x4c\x2f\x05\xf4\x4b\xf7\xa9\xef\xbb\x57\x54\xac\x7f\x35\x6b\xe6\x85\xa2\x9a\xaa\xdb\xdc\x75\xbf\xfc\x2f\x23\x0a\x1a\x92\x90\x5c\x00\x00"),
+		},
 		"/crds/kuma.io_dataplaneinsights.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "kuma.io_dataplaneinsights.yaml",
 			modTime:          time.Date(2020, 3, 21, 15, 26, 19, 985942557, time.UTC),
@@ -62,6 +69,13 @@ var Templates = func() http.FileSystem {
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5c\xeb\x73\xdb\x38\x92\xff\x9e\xbf\xa2\xcb\xfb\xc1\x49\x95\x24\x27\x93\xdd\xab\x5b\x7f\xf3\x39\xc9\x9c\x6f\xf2\xaa\xd8\x99\xab\xab\xcd\xd6\x15\x44\xb6\x24\xac\x29\x80\x03\x80\xb6\x35\x7f\xfd\x55\x77\x03\x7c\x88\x0f\xc9\x89\x67\xea\xf8\xcd\x32\xd9\x04\xfa\xf9\xeb\x07\xf8\x6c\x3e\x9f\x3f\x53\xa5\xfe\x15\x9d\xd7\xd6\x9c\x83\x2a\x35\x3e\x04\x34\xf4\x97\x5f\xdc\xfe\xbb\x5f\x68\x7b\x76\xf7\x6a\x89\x41\xbd\x7a\x76\xab\x4d\x7e\x0e\x97\x95\x0f\x76\xfb\x05\xbd\xad\x5c\x86\x6f\x70\xa5\x8d\x0e\xda\x9a\x67\x5b\x0c\x2a\x57\x41\x9d\x3f\x03\xc8\x1c\x2a\xfa\xf1\x46\x6f\xd1\x07\xb5\x2d\xcf\xc1\x54\x45\xf1\x0c\xc0\xa8\x2d\x9e\xc3\x16\xfd\x06\xfd\xe2\xb6\xda\xaa\x85\xb6\xcf\x7c\x89\x19\x3d\xb6\x76\xb6\x2a\xcf\x21\xfd\x2c\x77\x7b\xfa\x0f\x80\xbc\xfd\x03\xfa\x0d\xff\x59\x16\x95\x53\x45\xa2\xf4\x0c\xc0\x67\xb6\xc4\x73\xb8\x2c\x2a\x1f\xd0\x3d\x03\xb8\x53\x85\xce\x79\x15\x42\xc0\x96\x68\x2e\x3e\x5f\xfd\xfa\xfa\x3a\xdb\xe0\x56\xc9\x8f\x00\x39\xfa\xcc\xe9\x92\xef\x63\xf2\xa0\x3d\x84\x0d\x82\xdc\x06\x2b\xeb\xf8\x4f\x79\x11\x5c\x7c\xbe\x8a\x0f\x96\xce\x96\xe8\x82\x4e\x0b\xa4\xab\xc5\xcb\xfa\xb7\xbd\x57\x9c\xd2\x1a\xe4\x1e\xc8\x89\x7b\x28\xaf\xbb\x93\xdf\x30\x07\x2f\x2f\xb6\x2b\x08\x1b\xed\xc1\x61\xe9\xd0\xa3\x09\xbc\x97\x16\x59\xa0\x5b\x94\x01\xbb\xfc\x17\x66\x61\x01\xd7\xe8\x88\x08\xf8\x8d\xad\x8a\x1c\x32\x6b\xee\xd0\x05\x70\x98\xd9\xb5\xd1\xbf\xd7\x94\x3d\x04\xcb\xaf\x2c\x54\x40\x1f\x3a\x14\xb5\x09\xe8\x8c\x2a\x88\x7b\x15\xce\x40\x99\x1c\xb6\x6a\x07\x0e\xe9\x1d\x50\x99\x16\x35\xbe\xc5\x2f\xe0\x83\x75\x08\xda\xac\xec\x39\x6c\x42\x28\xfd\xf9\xd9\xd9\x5a\x87\xa4\x3d\x99\xdd\x6e\x2b\xa3\xc3\xee\x2c\xb3\x26\x38\xbd\xac\x82\x75\xfe\x2c\xc7\x3b\x2c\xce\x54\xa9\xe7\xbc\x4e\x13\x58\xe3\xb6\xf9\x5f\x5c\xd4\x2c\x7f\xda\x5a\x58\xd8\x91\x6c\x7d\x70\xda\xac\xeb\x9f\x59\x23\x46\xd9\xfc\x8b\x36\x39\x89\x52\xc5\xc7\x64\xb9\x0d\x37\xe9\x27\x62\xc2\x97\xb7\xd7\x37\x90\x5e\xca\x1c\xef\xb2\x98\x99\xdb\x3c\xe6\x1b\x3e\x13\x5f\xb4\x59\xa1\x13\x39\xad\x9c\xdd\x32\x45\x34\x79\x69\xb5\x09\xfc\x47\x56\x68\x34\x5d\x1e\xfb\x6a\xb9\xd5\x81\x04\xfb\x5b\x85\x3e\x90\x38\x16\x70\xa9\x8c\xb1\x01\x96\x08\x55\x99\xab\x80\xf9\x02\xae\x0c\x5c\xaa\x2d\x16\x97\xca\xe3\x53\x73\x99\x18\xea\xe7\xc4\xc1\xc3\x7c\x6e\x1b\x76\xba\x86\x94\x9f\x0d\x80\x76\xc1\x8a\xba\xf7\x0f\x00\x95\xe7\xec\x28\x54\xf1\x79\xe4\xe1\xd1\x15\x0c\x9a\x51\xf3\x26\x16\xb3\x81\xca\xf8\xe0\xaa\x2c\x54\x0e\x73\xb8\xc5\x5d\x94\xf8\x56\x95\xe0\x83\xa5\x1f\xef\x75\xd8\xf4\xde\xa8\xda\xd2\x57\x81\xc5\xba\x44\xf0\x18\x60\xb9\x03\x72\x87\x6c\x10\xc1\xda\x82\x2d\x87\x69\xb1\x61\x38\x0c\x4e\xe3\x1d\xf6\x49\xba\xa5\x0e\x4e\xb9\x5d\xcd\xbb\x05\xdc\x6c\x70\x07\xca\x21\x90\x98\x7f\xab\xd0\xed\xd4\xb2\x10\x3a\xd1\x60\x97\x08\xac\x64\xee\x0e\xf3\x1e\xc9\xfb\x0d\x1a\xd8\xda\x5c\xaf\x76\xa4\xb9\xa2\x96\x7d\xe3\x3b\x3f\x3b\xbb\xad\x96\xe8\x0c\x06\x64\xc5\xc8\x6d\xe6\xcf\x2a\x8f\x6e\xbe\xae\x74\x8e\x67\x2d\x01\x9d\x3e\x1b\x62\xbd\x50\xee\xfc\x2b\x13\x9f\xfa\x91\x5c\xf7\x94\x4c\x6e\x36\xc8\x1e\x5b\x5c\x17\xa6\xe7\xe0\x7e\xa3\xb3\x0d\xff\x12\xad\x69\x89\x85\x35\x6b\x51\xfc\x9b\x7d\x8b\xa3\x4b\x7b\xa8\x3c\xe6\xc4\xee\x5c\x7b\xb2\xd5\x4a\xfb\x4d\x2d\x28\xcf\x92\x04\x4f\xef\xe2\x17\x12\x17\x39\x56\x94\x2a\x23\x76\x40\xae\x57\x2b\x74\xfb\x96\xd7\xda\x8c\x97\x37\xc3\x4a\x63\xc1\x7e\x82\xc4\x42\x32\x57\x66\x77\xbf\x41\x87\xe0\xf4\x7a\x13\xc0\xd8\x7b\xa6\xae\x4a\xcd\x92\x71\x30\xb0\xdc\xb5\x65\x6f\x62\x41\xaf\x0d\xcb\x23\x80\x5e\x31\x35\x6d\x24\x16\x22\x58\x17\x2d\x3b\xd9\xfd\x62\x90\xfd\x03\x9a\xdf\x0f\xa6\x53\x42\x38\xb9\xdc\xbf\x5d\xbc\x60\xa8\xff\xec\xb9\x40\xd9\x58\xdf\x14\xf5\x16\x45\xef\xd8\xbf\x45\xd9\xdd\x2b\x1f\xb7\x44\x2e\x2a\x24\xd6\xad\x2b\xe5\x94\x09\x28\x42\x13\xfb\xe9\x8b\xd5\xc0\x46\x95\x25\x1a\x3f\x5f\xe2\x8a\x38\x65\x5d\x8e\x0e\x54\xe6\xac\xf7\xe0\xb1\x54\x8e\x79\x55\xa2\x13\x1d\x5d\xc0\x25\x3b\x50\xf1\xb6\xc6\xf6\x69\x12\x97\x79\x7d\x6c\xed\x69\x49\xf5\x1e\x31\xa7\xb7\x7e\x79\x77\xf9\xfa\xf5\xeb\xbf\x53\x30\xdf\xb2\x38\xb5\xa7\x9f\xbf\xde\x5c\x2e\xe0\x9b\xe9\xd1\xfc\x6c\xcb\x8a\x82\x63\x4e\x1e\x80\x39\xb4\xf3\x01\xb7\x0b\xf8\x82\x2a\x9f\x5b\x53\xec\x16\xf0\xb1\x2a\x0a\x06\x07\x85\xf6\x03\x86\xf8\x83\xfe\x39\xf9\x8d\x93\xbd\xb5\xd1\x06\x54\x38\x07\x52\xa4\x39\x09\xe8\x58\x25\xca\xb1\x40\xa2\xfe\xb3\x53\x19\x7e\x46\xa7\x6d\x7e\x8d\x99\x35\x79\xcf\x07\x77\xb4\xe9\x63\xb5\x5d\xa2\x23\x83\xf6\x72\x37\xa8\xa2\xb0\xf7\x98\x47\x5c\xd4\xe8\x45\xb0\xb0\x26\xda\xab\xaa\x28\x76\x7d\x5d\x42\xb7\xd5\x86\x64\x1b\x05\xaf\x03\xdc\xeb\xa2\x20\x4d\x71\xb8\xb5\x77\x44\x31\x05\xd0\xc4\xed\x4f\xa6\xd8\xb1\x7c\x49\x09\x7b\x24\xd3\x8e\xba\x7a\x5e\x78\x4b\x8f\x2c\xe0\x83\xda\x01\x49\x8a\x75\x71\x63\x5d\x40\x43\x1a\xdb\x48\x70\x84\xb3\xda\x84\x7f\xfb\xeb\x20\x57\x09\x1b\xad\xf7\xec\xa4\xb7\x88\x69\xdb\x7c\x33\xb4\xe6\x2f\xef\x2e\x81\xb5\x93\xbd\x03\x69\x27\x5b\x9e\x0a\xb5\xe3\x1c\x70\x39\x75\xcc\x4a\x5c\xe4\x95\xd0\x0e\xbb\x6e\x2d\x86\xb1\xc6\xcc\xc5\xa2\x55\x2d\xac\x51\xbe\x8a\x19\xb1\xab\x6a\x0c\x81\x22\xc9\x2c\x59\x10\xd9\x7d\xae\x1d\x66\x41\xe4\x14\x38\xa2\x2d\xfb\xd2\x57\x11\x06\x71\x14\x6c\x96\xae\x3d\xe0\x43\x89\x59\xa8\x9d\x46\xdc\x04\x3c\x37\x16\x28\x44\xa0\x83\x3b\xed\xf5\xb2\xe8\xc7\x58\xd6\x96\x9a\x14\x1b\xa1\x2c\x8c\x56\xe5\x50\x65\x9b\xb8\x1a\x0e\x0c\x2f\x40\xad\x02\x0a\x92\x67\xee\xea\xbe\x42\x85\x9a\x71\x33\xb0\x86\xe1\x00\xc2\x4a\x1b\x55\xe8\xdf\x09\xef\xd1\x3b\x78\xcd\xdb\x32\xec\x16\x70\xe1\x79\x89\xa0\xfc\xde\x8d\x3d\xc2\xfc\x20\xd9\xbd\xd2\x04\x56\x02\x6e\xfd\xac\xc3\xe6\x65\x61\xb3\x5b\x92\xdd\xa7\xf4\xda\x9e\x5e\x0d\x85\x48\x8f\x61\xd6\xf2\x7d\xc9\x45\x32\x88\x34\x24\x78\xeb\x12\x92\x59\x55\x2e\x6c\x28\x78\x99\x88\xfd\x57\x15\xe1\xa4\x59\x5f\x54\x45\xd8\xd8\x6a\xbd\x21\x03\x4d\x48\x28\x59\x0f\xc4\x54\xa8\xe6\x7a\xbc\x21\x49\xad\x74\xda\x0e\x84\x11\x2b\x6b\x24\xb6\x2f\xe0\x9d\x75\x80\x0f\x6a\x5b\x16\x94\x5d\xb0\x3e\xc5\x04\x83\x35\x4d\x20\x98\x82\xd2\xb2\x86\x45\xca\x43\x81\xe4\xf5\xcb\xe4\x92\x44\xab\x7e\xa9\x96\x74\xb3\xd8\x03\xc9\x9f\xf5\xde\xa3\xc9\x29\xcc\x35\xfa\x5e\xbb\xa2\xfd\x64\x8a\x2e\xaf\xd7\x82\xf5\x04\xbf\x88\xc8\x48\xf6\xda\xf0\x2f\xa5\xcd\x17\x70\x11\x35\x49\x85\xd6\x22\x66\xfc\xff\xb8\x88\x3e\x7a\xa3\x45\xd1\x5a\x40\xc1\x46\xb9\xbc\xbd\x88\xf4\xd2\xe7\xd7\x57\x3f\xff\x72\xf5\xfe\xfd\x8b\xde\xeb\x49\xad\xfb\x82\xe2\x55\x64\x05\x2a\x53\x95\xb3\xe8\x44\xd3\x22\x1b\x5f\x7a\xf1\xf9\x8a\x33\x09\xfe\x07\x87\xc4\x8c\xf1\x99\xc1\x70\x6f\xdd\x6d\x8f\x6c\xa9\x5c\x60\x98\xee\x67\x1d\xf7\x4e\x32\xf2\x81\xb6\x81\x0f\xa4\xce\xc9\x9c\xa2\x60\x59\x47\x67\x50\x99\xa0\xfb\x1e\x45\x19\x50\xf9\x56\x1b\xed\x83\x53\xc1\x3a\xd2\x23\x55\x05\xbb\x55\xa2\x35\x36\x43\xef\x21\x53\x94\x10\x0b\x63\xb0\xab\x67\x03\xfe\x8f\xc3\x4c\x13\x56\x08\x8b\xac\x12\x86\x9b\x35\xc2\xae\xad\x2c\x42\xd2\xb8\x9b\x8d\xea\x53\x14\xcb\x41\xd3\x38\x3d\xc2\x06\x63\x58\x60\xdf\x8d\xd6\x6f\x1a\x32\xd4\x16\xc5\x16\x82\xf8\x7f\x8e\x18\x1a\x87\x36\x19\xd3\x3e\x54\x9e\x3d\x0e\x7b\xc5\x14\xdd\x5b\xac\x6e\xac\xb8\x51\x4a\x87\x6b\xd2\x85\x5e\x0c\x06\x78\xab\xb2\x0d\xa0\x09\x6e\x17\x93\x3a\x9d\xd3\x1e\x57\x1a\x5d\x5d\x89\x71\xe8\x4b\x6b\x38\x2a\x40\x66\xb7\xa5\x35\x68\xa2\xe3\x20\x3b\x1b\x08\x95\xb5\x69\x08\xe5\x7a\x1d\xe4\x98\x59\x71\x06\x5d\x6e\x57\x67\x86\xe4\x6a\xac\x99\x1b\x5d\xcc\x98\xae\xc6\xe8\x26\x74\x0c\x15\xa4\xd0\x09\x81\x44\x8c\xb3\xbf\x61\x8e\x05\x8f\x4a\x82\xe5\x5f\xca\x39\xd5\x0d\xb3\x6b\x34\x84\x99\xf1\x60\x92\x76\xf2\x73\xeb\xce\xc8\x64\x5b\x4a\x62\x4e\x1e\x62\xa5\x1f\x66\x92\x7c\x75\x60\x43\x3f\x52\x10\xe0\x8b\xa4\xc8\x91\x1b\xfd\x5b\x15\xb3\xb1\x4f\x1f\xdf\xff\x0f\x5c\xbd\xe3\xa7\xf9\x2d\x82\x46\x36\xca\x37\x46\x56\x3a\x7b\xa7\xf3\x3e\x47\x40\xc4\xd1\x86\x30\xb4\x18\x71\xaf\x4c\xdd\x61\xa8\x9c\x11\xc8\xd0\x54\x58\x1a\x1c\x34\x9a\xf9\x85\x8d\x32\x0d\x99\x52\x79\x5f\xc3\x25\x89\x9f\x4c\x82\x11\xe4\x92\x35\x6b\xa9\x4d\x2c\x1a\xd4\x1b\xec\x47\x8c\x6a\xb5\xd2\x0f\x12\x82\xd2\x9e\x22\xb9\x4d\x44\x06\x9c\xa6\x36\xd5\x48\x70\x55\x81\x3e\xc1\x06\xe2\x4f\xdf\xb9\x09\x08\x49\xc5\xb7\x25\x42\x70\x95\xc9\xda\x5e\xa8\x40\xb3\x0e\x9b\xa4\xa2\xb2\x0a\xf6\x33\xda\x31\x6b\x7a\x34\xb7\xea\x56\x6c\x40\x16\x17\xe5\x65\x4d\x4b\xc6\xec\xef\x7a\xec\xf7\x25\x66\x64\x80\x03\x21\x88\xa0\xea\x06\x6b\x35\x90\x1c\x5c\x02\x44\x0c\x88\x09\x73\x12\x67\x3f\x7e\xba\x89\xc2\x03\x05\x7f\x7d\xf9\x77\x98\x0f\xc4\x75\x1f\x50\xe5\xb3\x3a\x3d\x40\xcd\xb0\x25\x3e\xf6\xd3\xcb\x57\x70\x29\xb9\x27\xc5\x90\xbf\xbd\x7c\x29\xd2\xf9\x82\xca\x5b\x13\x0b\x73\x64\xbf\xb6\x1a\x4a\x3e\x73\x9d\xa9\x20\x68\xa0\xad\xae\x19\x57\x5f\x22\x70\x5a\xd9\xca\xe4\x29\xdc\x0b\x0e\x2f\x0a\x1b\x02\xe6\x03\x58\x29\xee\x3f\x6a\x60\x2c\xe3\x38\x24\x1f\xf3\x3c\xd9\x54\xb1\xeb\x43\x4f\x5e\x08\x67\xa6\x03\x4a\x8a\xf0\x85\x28\xcc\x05\x66\x6c\x50\xe5\xe8\x5e\xb0\x68\x2e\xca\xb2\xd0\xb4\x75\x72\x2a\x7a\x05\xc9\x82\x39\xec\x25\x29\xf5\x0d\xea\x69\xe3\x8c\xce\x71\x5b\xda\x80\x26\xdb\xed\x87\x9a\x51\xb7\x15\x15\x64\xaf\x2c\x0e\xfb\xae\xe9\x02\x3c\x05\x4a\x42\x28\x46\xf2\xce\x4e\xa9\x42\xa5\x4d\x66\x2d\x82\x60\x57\x83\x3c\xcc\xd1\xb3\x25\xf8\xa0\x02\x2e\x8e\xc9\xe8\x9f\x24\x1f\xe4\x66\xc8\x31\x61\xf3\xe4\xc2\xb4\x6f\x96\x1a\x0d\x4b\xc0\x16\x45\x5d\x33\x43\xb3\xb2\x5c\xef\xf2\x76\x9b\xd6\x3c\xa0\xd8\x77\xca\x69\x65\x02\xa5\x8c\x31\xea\xa6\x9a\x51\x44\xdd\xdd\x9c\x50\x49\x7c\xb2\xab\xce\x72\x87\xfc\x25\x21\xa5\x3b\x29\x59\xee\x30\x80\xe2\x54\xcd\x76\x0a\x42\x02\xbc\x74\x41\x06\xc9\x18\xa0\x83\x1b\x7b\x44\xc9\x29\x72\x00\xa0\xc8\x4d\xb0\x80\x54\xb9\x5e\x05\xa5\x40\x64\xf0\xf7\xda\xe3\x6c\x0f\x45\x64\x14\xf3\x73\x74\x03\x8e\xa8\x32\x2d\x12\x29\x3b\xdd\xe8\x3c\x47\x03\xcf\xb5\xe1\xed\x9e\xdd\xab\x90\x6d\xf8\x9f\x6b\xa4\xe0\x5c\x14\xfe\x85\x40\x01\xb1\xdf\x09\x06\x98\xd3\x40\x99\x6a\xa1\x33\x4d\xa9\xae\xf2\xb7\x12\x7e\xec\x92\xfd\xdb\xde\xfb\xeb\xda\xec\x40\x65\xe9\xbf\x19\x35\x9a\xf6\xb6\xc4\x9f\xcd\x3a\xd8\x92\x5c\x5f\x19\x55\xb6\x85\x28\x06\xeb\xd7\xec\x81\x2a\xe7\xd8\x05\x61\x4f\xac\xb1\x8c\x52\x3a\x7d\xa7\x0b\x5c\x63\xce\x39\x97\xd4\xd3\x24\x47\xec\x87\x0a\x2e\x33\x37\xef\x8d\x79\xa9\x6e\xb2\xdf\x59\x4a\x0f\xa3\xd7\xe4\x27\xc8\x35\xc5\x3c\xb3\x47\x72\xb9\x03\x65\x76\xfc\x6a\x76\x65\x6f\xde\x7e\xfe\xf2\xf6\xf2\xe2\xe6\xed\x1b\x98\x77\x96\xcb\x25\x72\x4a\x18\x8a\x72\xa3\xa2\xca\x92\xcc\x06\x91\x5d\xab\x78\xa4\x0d\xdc\xbd\x5a\xbc\xfa\xdb\x62\xdf\x29\x8d\x75\x2a\xf8\x7f\x92\x1d\xf6\xff\xb1\x67\xac\x9f\x63\x16\x39\x6a\x3b\xb1\x73\x40\x50\x18\x1f\x30\xab\x42\x3f\xa6\x83\xa4\xad\x52\xf0\xac\x61\x72\x93\x60\x11\x0a\x91\x52\xc7\x42\xb4\x44\x3a\x74\x3e\xa4\x55\x8e\x50\xec\xb8\x90\xc8\x8d\x54\x08\x81\x95\xd2\x05\x2d\xdc\xa1\xaf\x8a\xd0\xaa\x19\xe0\xb4\xe9\xd3\x25\xcd\x94\x1a\x57\x71\x9d\xd5\xb2\xa5\xa7\xb8\x37\x64\x9b\x84\x6b\x5a\xc6\x30\x48\x99\x9e\x8f\x7b\x25\x92\xaa\x28\x92\x09\xf6\x83\xd7\x28\x46\x3e\x24\x5b\xb9\xcc\x00\x1c\x6e\xae\x8e\x90\xdb\x9d\x8b\x94\x93\xb2\x58\x99\xaf\x4d\xca\x41\x69\x48\xbd\xc3\x31\xb9\xc8\xd5\x76\x93\xa3\xb7\x4d\x80\x7d\xb9\x12\xaa\x1b\xde\xc7\x9c\x17\x3e\xf8\xaf\xd1\x86\x4e\xfb\xdf\xfd\x54\x42\xde\x49\x0a\x73\xd0\x30\xae\x56\x5d\xd5\x12\x38\x46\x1c\x7c\xa7\x74\x51\x39\x4c\x50\x76\x22\x8f\x82\x54\x1f\x59\x22\x94\xe8\xbc\xf6\xb1\x1e\xe8\x83\x75\x6a\x8d\x49\xdd\x4c\xca\x23\x29\xdd\xf2\x95\x93\xee\x05\x85\xbc\x41\x8f\x03\xdc\xeb\x91\xde\x01\x67\x62\xd1\x57\xb7\x53\xbd\x21\xa1\x1c\xd2\xa9\xe1\x16\xff\x28\x87\x1e\xdb\xee\x1f\x55\x93\xee\x18\xc0\x63\x5b\xff\xa3\x64\x07\x47\x02\x1e\x33\x06\x30\x4a\xf9\x4f\x1c\x0f\x68\x5f\x07\xcd\x29\xb3\xf9\xa8\x4b\xe8\x88\xee\xba\x5a\xaf\xa5\xf8\xfd\x9f\x37\x37\x9f\x53\x0e\x42\x8f\x37\xcd\x0f\x82\x97\x95\x9f\xc1\x4b\xd0\x7d\x1c\x9a\xae\x58\x96\x1a\x73\x01\x2d\xa4\xf9\xfa\xa7\xc9\x5d\x0d\x21\xce\x66\xe9\x41\xe9\x62\xd4\x11\x76\x76\xf6\xf6\x21\xa0\xa1\x44\x35\x57\x41\x81\xf2\xde\x66\x9a\xc1\x71\x6d\xbe\x8e\x33\xaa\x85\x14\x64\x26\x74\x92\xf3\x2e\xd2\x0c\xd1\x6d\xd0\xc1\x83\xbd\x37\xdc\x36\x97\x37\xc8\xb2\xf6\x20\xe8\x28\xc5\xba\x12\x91\x62\x0c\xaf\xb0\x4e\xf9\x07\x9b\x8d\x99\x25\x94\xdc\xc7\xc5\x35\xef\x2c\x63\x8f\x68\x67\xf8\x90\x61\x19\xcb\x45\xb2\xe8\x3a\x27\x88\xdb\x21\x5e\x8f\xc9\xea\x70\xc4\x01\xc8\x54\xe5\xa7\xfe\x3f\xd0\x35\xbf\xe4\x47\xc4\x17\x83\x36\x59\x51\xe5\xe8\x61\x4b\x96\x13\x19\xd8\x92\xd2\x04\x61\x68\x24\x78\xcd\x9a\x19\x33\xe3\x95\x78\xe3\x05\x7c\xb4\x81\xe3\x6d\xfb\xbf\x8c\x05\x27\x89\xc6\xc2\x46\x5c\x0b\xe6\x71\x8b\xe3\x31\x6d\x32\x6a\xb7\xa8\x1e\xe4\xa5\x5c\xac\x36\x87\x6e\xda\x4f\xb0\x6e\x36\xa9\xf0\x14\x83\x7a\x77\xcc\x83\x12\x11\xde\xc6\x34\x3f\xe5\x62\x5b\x47\xe7\xac\x9b\x11\xc0\xa1\x88\xcb\x5a\x43\xea\xfe\x5f\xd7\x9f\x3e\x82\x47\xc7\x78\x40\x8d\x85\x95\xfd\xeb\x43\x23\x68\xc8\x49\x28\x26\x87\xd2\xfa\xb0\xd2\x0f\x90\x26\x34\xd8\xcd\x18\x76\x41\x47\x50\x54\x41\xdc\x27\xf9\xdc\x0b\x52\x24\xc1\xd2\xbf\xa3\xb3\x73\x6d\x72\x7c\xa0\xec\x0a\xde\x11\x47\x0e\x4b\x3c\x92\x2c\x4b\x54\x4e\xf4\x90\xab\x67\xdc\x16\xd3\x9c\xc1\x88\xae\xda\x55\xd4\x05\xc8\x07\x8a\x63\x03\x8c\xb4\x22\x13\x4f\x79\x15\x45\xf0\x6d\x55\x04\x5d\x16\x28\xdc\xa5\x6c\x25\x7a\x00\x4e\x13\xde\x4a\xa7\xe8\xa0\x82\xd0\xf5\x0d\xe0\xdb\x09\x49\xe6\xdb\x09\xcc\x63\x4b\x8e\xa4\x5f\xff\x18\x6b\x5d\x31\x57\x3a\x82\x62\xad\x30\x44\x99\x15\xfa\x1f\x2f\xff\xb9\x98\x78\xc5\x11\x34\xe3\x22\x56\xda\xf9\x10\x79\x18\xcb\xdd\x26\xbd\xe4\xdb\xc9\x61\x42\x07\xa3\x5c\x73\x6d\xd1\x7b\xb5\x9e\x40\xc1\xe9\xda\xab\xc5\x6c\xaa\xad\x32\x73\x87\x2a\xe7\x46\x6a\xeb\xbf\xf5\x7c\x0f\x49\xfe\x98\x3d\xcb\xed\x2c\xe1\x05\xb4\x23\x41\xac\x6e\x36\xb3\x1a\xca\xcf\x27\xa2\x43\x6b\xff\x96\xe7\xb6\x54\x8e\xee\xb0\xb5\x3d\x82\x59\x12\x02\x1e\xcd\xab\xad\xca\x36\xda\xe0\x14\xb7\x8e\xd8\x14\xf3\x73\x8f\x5b\xa9\x1c\x2b\x55\xdb\x94\x7f\xd3\x1d\xee\x18\x92\x1c\x30\x19\x7d\x11\xc6\xa0\xd5\xa8\x3b\xa5\x0b\x5a\xe3\x13\xf2\xed\x40\xa2\xd1\xbd\x6d\x38\xe1\x48\x97\x8c\x00\x3f\x26\x76\xf2\x13\x8d\xf7\xeb\x79\xfb\xc7\x06\x4e\x81\x74\x9d\x08\x39\xc5\xaa\xa3\x98\xb4\x3f\xaa\x3a\xb9\xa9\x53\xda\x15\x3d\xf1\x07\x6f\x0a\x3e\x19\xa9\x2b\x36\xe3\x56\x02\xe5\xb8\x83\x32\x49\xb7\xd5\xc9\x4b\x03\x22\xf5\xd2\x7e\xd1\x26\xff\x93\xc6\x55\xbf\x4b\x16\xd3\x25\x81\xb1\x91\xc6\x3f\x54\x14\xf0\x3c\x8e\xd9\xa1\xc3\x38\xb3\xac\xcd\xba\xc0\xf1\xd4\xbe\xa6\xca\x65\x62\xca\x6f\x97\xc9\xe9\x2c\x31\x7f\xf1\xc3\x0a\xcb\x4d\x0c\xee\x40\x8c\x4c\x89\x8d\x72\xec\x6a\xd5\xf4\x22\x66\xed\xa6\x47\x3d\x41\xd6\xf4\x88\x27\xb7\x56\x6b\x65\x6b\x3e\x56\x26\x6e\xf3\x05\x5c\x93\xde\x0a\x64\x88\x73\xd8\xd2\x53\x99\x76\x53\x4d\xaf\x86\x4b\x75\x41\xdd\xc6\x5a\x23\x67\xbb\x01\x41\x65\xfc\xc2\x79\x4c\xf0\xac\x4f\x2f\x39\x40\xb7\x13\xd0\xd2\x5a\x60\x63\xef\x65\x44\x28\x58\xb8\x57\x3a\xd4\x3b\x57\xb7\x07\x3d\xea\x06\x7b\xcb\x9a\x12\xea\x31\x39\x24\x1c\x95\x47\xd2\x55\xe9\x47\x78\xab\xaf\x57\x6f\xf6\x6d\x62\x31\xa6\xd0\x93\x7b\x6e\x46\xda\x46\x94\xfa\xd1\xc3\xce\xcd\xf0\x80\xff\x4b\xa5\x7f\xd8\x77\x1c\x0c\x73\x53\x6e\xfe\x09\x4e\x27\x8c\x67\xb8\xad\x3a\xf2\xf7\x9c\x54\x98\x20\xdc\x74\x37\xbf\xe7\xd4\xc2\x28\xe1\x3f\x3d\x3c\x1c\x14\xef\x01\x98\xfc\x68\x70\x1c\xdd\xfc\xa1\xb2\x5e\xed\xe5\xc6\x78\x75\xc4\xc2\xfb\xc7\x33\x46\x57\x7e\x7a\x1d\x94\xc9\x95\xcb\xa5\x8d\xd1\x1c\x4f\xf8\xd3\x05\x72\x54\x25\xc5\x92\x25\x54\xc7\x87\xeb\xf4\x40\xfb\x10\x87\x5e\xd5\x93\xab\x32\xe0\x0f\x85\xde\xea\xe9\xfc\x2f\x66\x69\xa6\x9e\x7e\xe6\xc4\xac\xae\x43\xc5\x09\xd8\xe8\xe7\x63\x9b\xe0\x50\x3c\x8b\xa3\x10\x1b\x95\x0a\x3b\x5c\x7b\xab\xd1\x38\x43\x8d\x1a\xe5\xdb\x52\xfd\x56\xe1\xe0\xe0\x5f\xfb\x8a\xdb\x4c\x67\x25\xb4\xf7\xfc\x90\x8d\x43\x13\x71\xa4\xd2\xee\x1f\x4b\x52\xd3\xbb\x97\x23\x28\xad\xbe\x63\xb0\xf5\x59\x17\xe1\x0b\x3e\xd4\xbd\xc6\x7a\x07\xd3\x0c\x4d\x3d\xd1\x4b\x91\x90\xf4\xf3\xb9\x6d\xe4\x03\xb9\x17\x51\xc7\xa6\xa3\x58\x5a\x3f\x3c\xf7\xdb\xbe\xa2\x68\x23\x67\x33\x6b\x56\x7a\x5d\x45\xd0\xc0\xf5\x9d\x8d\x32\x6b\x99\x15\x69\x6a\x18\x6a\x1a\xd9\xe2\x3d\x6c\xb5\xa9\x48\xac\xdc\xfb\x6e\xe6\x84\x9a\xf8\x96\x0a\xfa\x12\xf3\x93\x56\x1c\x00\x6a\x68\xa0\xf2\xe2\xd7\xa5\x63\x26\x9a\xda\x1a\x3d\x5a\x62\x1c\x77\xcb\xea\x19\xd4\x49\x9a\x51\x5b\xda\x15\x85\xd8\xa8\xc2\x19\x54\xa6\x40\xef\x61\x67\x2b\xd9\x87\xc3\x0c\xf5\xd0\xc9\xa2\xf6\x25\xf3\x9c\xf6\x16\x8d\x04\x09\x65\x04\xff\x24\xef\xf8\x04\xb8\xb2\xc3\xc1\xe3\x51\xc6\x75\x68\x1a\x3e\x75\x58\xf7\x2d\xf1\x9f\x9e\xfa\xba\x6d\x31\xcd\xb5\x28\xbc\x74\xbe\x32\x9d\x5f\x20\xca\x11\x73\xa4\xf1\xb7\xd4\x3f\x1a\x18\xa7\xea\xae\x34\x4d\xad\xb2\x94\xa3\xae\x0b\xdb\xa3\x0a\x2e\xe0\x57\x19\xd1\x8e\xd3\x92\x41\xba\xfe\x93\x64\x55\xed\x06\x5a\x4b\xe1\x3a\x21\xab\x24\x54\xa6\x6e\xbb\x2f\x55\x76\x7b\x8c\xc6\xa4\x39\xaf\x63\x0e\xb8\x34\x11\x61\x92\xe4\x13\x44\x8b\xcc\x1a\x29\xca\x65\xbb\x79\x1c\x81\x99\x2b\x93\xcf\x6b\xf7\x90\xed\x7e\x38\xeb\xf3\x58\xac\xde\x6b\x73\x7b\xb4\xc6\xa5\x07\x04\xa5\x7d\xfd\xf2\x7e\x1f\x9c\x1d\xd1\xda\x85\xe3\xce\x12\xfd\xc1\xa8\x74\xba\xa6\xf5\xc8\x4a\xd6\xfd\x26\x0e\x86\xd4\xc0\x65\x74\xf5\xba\x1e\x9b\x3f\x89\xdd\xe0\x93\x88\x8a\xa6\xcb\x5a\x53\xfd\xa1\xd1\x62\x16\x5c\xa4\x29\xc0\xac\x50\x4e\x9c\x83\x32\xd2\xb9\x93\x97\x4e\xa0\x8c\x1c\x61\x59\x05\xc8\x2d\x4a\x7f\xc9\xde\xa1\x73\x3a\x47\xd0\xa3\xc2\x3d\x28\x18\x79\xe9\xd1\xa0\xac\xc6\x8a\xad\x72\xcc\x02\x3e\x19\x04\xbb\x3a\x87\x93\xeb\x2a\xcb\xd0\xfb\x93\xa1\x71\x9d\x74\xd5\x5c\x7e\x6a\x34\x47\xf9\x3c\x1b\xa4\xec\xe9\x3b\x21\xf6\x84\x9e\x8e\x4d\x38\xcc\x47\x66\x5f\x46\x49\x15\x6a\x89\xfd\x1e\xe8\x13\x9f\x3c\xfe\xa0\x78\x34\x3c\x26\x6e\xb7\xb8\x13\xaf\x2c\xfd\xee\x7e\x1c\x09\x16\xac\x5b\x2b\xa3\x7f\x1f\x38\x28\x6c\x72\x20\x08\xb9\xb6\x4e\xff\x8e\xf0\x9c\x3f\x62\x20\x67\x82\xb1\xc0\x2c\xbc\x68\x1d\xf4\x55\x3b\xd8\xf2\x08\x9b\xfc\xcb\x3a\x3f\x34\xfb\xe8\xb0\x2c\x78\xcc\x95\x2c\xa1\x1e\x27\xf4\x91\xa6\xbb\xd3\xd9\x40\x4f\xfe\x60\x22\x2d\x7c\x3d\xfa\xc0\xf0\x56\x19\xb5\xc6\x5c\x7a\x4d\xd3\x63\x90\x1f\xda\xb7\xc2\x56\x95\x1e\xee\xad\xbb\x5d\x15\xf6\x7e\xae\x65\xf4\x2b\x05\xec\x88\x63\x87\x0e\x96\xda\x55\x6a\x2b\xc9\xf9\x21\x87\x69\x0d\xe2\x75\x55\xa8\xa9\xc6\x4e\xb4\x26\x14\xee\x43\xb1\x8b\xf3\x3c\x23\xc0\x61\x63\x2b\x8f\xb7\x88\xa5\x36\x6b\x41\xfd\x32\x3d\x17\x76\x25\xa1\xb4\x62\x17\x8b\x53\xe6\x34\x80\x89\xfd\xe8\x78\xf2\xaa\x32\x39\x3a\x1f\x86\x20\x7c\x53\x30\x22\xbf\x95\x56\x96\xb4\x26\x65\x2b\xa7\xd2\x68\x9c\x75\x06\x43\xd3\x8f\x7d\x16\xb8\x66\xb6\x9d\x60\x79\x33\x2c\xab\xca\xb2\xd8\x41\xa9\xc2\x06\x0a\x7d\x8b\xf0\xed\x24\xd3\xf3\x2c\xff\x76\x22\xa0\x36\xe2\x78\xe1\x5f\x8f\x2c\x9f\xa9\xbc\x57\xbb\xda\x97\xd7\xd2\x88\x39\x4f\xb3\x7c\xd6\xf6\xbd\x73\xea\x43\x80\x24\x0d\xad\x7c\x33\xfb\x73\xa9\x3c\xf3\x27\x36\xc1\x9c\x68\xe1\xf7\x34\xe7\x77\xaf\xc3\x66\x68\xba\xdb\xd8\xa0\x33\xec\x4d\xff\x8d\xb4\xa1\xa7\x93\xcf\x43\x23\x3e\xdd\x90\x39\x39\xdf\xd3\xfa\x8a\x47\xab\xf9\x3c\xe6\x40\x1b\x6e\x70\xa2\xca\xe3\xde\xe9\x94\x3c\xc6\x1a\x1f\x31\xea\x84\x7b\x1e\x67\xf1\x1d\x27\xf0\xaf\xca\x8f\xd1\x64\x89\x73\x15\xd6\x96\xf3\x82\x3c\x7c\x7b\xc5\x51\x07\xe3\x31\x6e\xa4\x10\xa3\xdc\x8e\x2d\xcd\xa9\xac\x7f\x3a\x2c\xad\xb3\xb3\x3f\xd5\x5a\xf3\x12\xa5\x89\xa5\xd9\x07\xc6\x5c\x2e\x9e\xf5\x12\x83\x19\xa1\x19\x47\x96\x86\xe6\xd7\xe1\x70\x6c\x59\x0d\x7a\x1a\xb9\x06\xbd\x3f\x04\x37\xd2\xaf\xee\x08\x37\xba\xa5\x56\xc2\xa1\xba\xf6\x32\xb5\xda\x51\x48\x26\xae\xc9\x1d\xa1\x5c\xe2\x1d\x5d\xff\x2c\x54\x84\x0a\xb5\xed\x31\xc9\x09\x8c\xb8\x41\x8f\x47\x2c\x79\x94\xc1\x35\x26\x39\x62\xd1\x9f\xea\xc2\x7d\xfc\x92\x0e\xd1\xa6\x15\x37\x15\x7d\xa9\xf0\x16\xa8\x06\x8f\xaa\xa4\x35\x6b\x0f\x9d\xf0\xf0\x96\x1b\xe5\x4b\x24\xc7\x52\x7f\x82\x80\x2c\x83\x0f\x44\xf0\x09\x9b\x18\x85\x47\x48\xd6\x63\x5b\x71\xae\xd8\x21\x9c\x5e\x90\x77\x3c\x65\xaf\x73\xfa\x95\x8b\x98\xa7\xdf\xc5\xa1\xa0\xc7\xda\x4a\xdd\x86\x92\x96\x33\x1b\xa1\x7d\xca\x2c\x15\xcb\x6b\x19\xc1\x3d\xe1\xe0\x89\x99\xb1\xab\xfa\xb8\x49\xf4\xce\xf5\x09\x3c\xbd\xea\x0a\x20\x6e\x70\x90\xce\xa1\xb3\x81\x47\x6c\x7c\x42\xd5\xc7\xda\xbd\x43\x0d\xb8\x2e\xc2\xe2\x83\x2d\x29\x55\x8e\x47\x75\xc8\xf1\x6b\x03\xaa\xf9\xce\xc7\x02\xae\x7c\x73\xe4\x69\xf0\x1b\x01\x72\x0c\x42\x06\xa0\x65\x6c\x70\xd6\x9c\x70\xe6\xde\x67\xf3\x49\x91\xad\xda\xc9\xc7\x0d\xea\xe3\xea\x43\xba\xd9\x9c\x53\xc6\xee\x29\x14\x6e\x24\x95\x14\x58\x9c\x56\x21\x75\x0d\xdb\x9e\x6f\x31\x7c\xd8\x4b\x7b\x28\x9d\xde\x2a\xa7\xf9\x28\x44\x9c\x9b\x23\x55\xad\x0f\x71\x34\x67\x6e\x04\x1c\x76\x2b\x5d\x79\xfd\x0d\xae\xbe\xb6\x0c\x14\xe8\x7f\xa4\x89\xc2\xbc\x1f\x86\x81\x03\xfa\x51\x4b\x6a\x1a\x02\x7e\xac\x3f\xdc\xd2\x0e\xa0\xf2\x4b\x94\x3a\xaa\x6c\x23\x1c\xed\x6a\x45\x7f\xc3\x17\x26\xda\x41\xeb\x73\x30\x1e\x48\x49\xee\x54\x21\x32\x65\xf2\xdf\x4e\x72\x5c\xa9\xaa\x08\xdf\x4e\x9a\x5b\x67\x94\x06\xf6\x48\xb6\x6f\x8d\x1e\x2d\x53\xc6\x1a\x2e\xd3\x75\xc7\x72\x9b\x01\xbb\x54\x04\x22\x1f\x93\x74\xb4\x6f\x3c\xf2\xa5\x14\x02\xfd\xb9\x8c\xb4\x34\xab\x9e\xb7\x0e\xeb\xd9\xce\x99\xbc\xa6\x37\x19\x5f\xd2\xa3\x9b\xaa\x89\xf1\x4b\x05\xdf\x4c\x7d\x4a\x57\xc1\x9b\x8f\xd7\xff\xfb\xfe\xe2\x3f\xde\xbe\x1f\xec\xde\x4c\x14\x7d\x8e\x52\x96\x7a\xfd\xfe\xe8\xc3\x61\xf6\xde\xa0\xfb\x82\x7c\x68\x33\xeb\x03\xb2\x8e\xae\xbc\x8f\x67\x2f\x12\x77\x73\x2c\xc5\x5c\x96\xbb\xde\x99\xa4\x8b\xf7\xef\x47\x19\x14\xb1\x2c\x17\x9d\xb9\x4c\xc7\x47\x92\xea\xf9\xf2\xce\xf7\x6e\x22\x2f\xd7\xca\x2d\xd5\x1a\x21\x23\x18\x9e\x0d\x02\x95\xab\xd5\xfe\x89\x8e\x56\x12\xd2\x06\xf1\x33\x99\x67\x57\xa6\x99\xfd\xaa\x8b\xed\xc3\xc2\x8c\x95\x7b\xdb\x14\x8f\x13\xa5\x7a\xae\xa0\x75\x78\xac\xc1\x63\x8c\xe4\x86\xec\xe4\x86\x2b\x2d\x0d\x46\x6b\xcf\xf8\x61\x0d\x27\x5a\x44\x8f\x3c\xba\xfc\xb4\xc8\xba\x0b\xa3\xc9\x92\xe4\x6c\xef\x77\x45\x68\xfe\xca\xc6\x27\xd2\xb6\xf4\x19\x96\x23\x16\x41\x32\x75\x15\xce\xe0\xe2\xe3\x9b\xd4\x6f\x60\x8d\xad\x8f\xf7\x9e\xac\xac\x43\x02\xe4\x26\x4f\x74\xc7\xe6\xf7\xea\x23\xf5\x51\x01\x1a\x62\x8d\x20\x7a\x87\xe5\x6f\x71\x37\x67\x37\x30\x42\x54\xbe\x47\xc6\x5f\x5e\x48\xa9\x46\xb4\xa5\xd6\x89\xa0\x05\xbc\x11\x1f\xc6\x93\xfe\x2b\x55\x78\x5c\xc0\xcd\x18\xf4\xaa\xbf\xa9\x94\x0e\x22\x4b\xf7\x8c\x12\x5c\x0f\x27\xb2\xc2\x13\x28\xd1\x6d\xb5\x6f\x8b\x87\xf7\xd2\x4f\x4d\xe5\xb2\xe9\x60\x1f\xfc\xf5\xa7\x9f\xe0\xf9\x57\x13\x0f\xd9\x70\x95\xf1\xad\x09\x3a\xec\x5e\xb4\xbe\x09\x24\x3d\x95\x29\x41\x2f\xad\x2d\x50\x0d\xd5\x1f\x1b\xad\x7d\x8c\x84\xf7\x98\xc7\x26\x57\x1f\x8c\x38\xc2\x22\x8e\x5b\xdb\xf8\x8c\xc0\xc0\x84\xc0\xbe\xda\xff\xd9\x6d\xda\x03\x16\x35\x3e\x4a\x35\x80\xe7\x0e\xed\xe5\xc7\x81\xc8\x51\x6b\x1e\x9d\x6d\x99\x98\x6a\x79\x8a\x15\x8f\xcf\x9f\x4c\x2e\x78\xfc\xf0\xd7\xbc\xe5\x4d\x07\xfe\x49\x52\x1d\xf8\x79\x70\xa2\x6c\x4e\x5c\x79\x0a\x68\x7f\xa0\xbd\xd7\x3b\x01\x1d\xfb\x5b\x82\x72\xb8\xa2\xd4\x8c\xaf\xc4\x53\x8a\xe9\x20\x52\x1d\x08\x86\xab\x69\x47\x75\xf1\x46\x3a\x75\x7d\xa8\xd3\xe9\xdc\x7d\x68\x35\xd9\x09\x7b\xd9\x32\xe8\xad\xf6\x41\x67\xd0\xea\x5c\xcd\xe2\x03\xfc\x0e\x9e\xd7\x1a\xff\x60\x80\x1c\x45\x6e\xd2\x61\x6b\xda\x5f\xa1\xb4\x2e\xd5\x18\xea\xe4\xa4\xfe\x0c\x5e\x8f\xa4\x0c\xb2\x51\xa2\x10\x13\xc8\x58\x86\x56\xed\x19\x82\x47\x76\x0c\x53\x97\x90\x3f\x59\xb9\x6d\x7d\x47\x4d\x52\x6c\xe2\x81\x92\x0f\x05\x65\x55\xa1\xdc\xc0\xca\x07\xd4\xb8\xde\xc9\xf8\x37\x75\x3a\xed\xc7\xe3\xfa\xa5\xa3\x3d\xd2\xa7\x76\x95\x47\xf4\x28\x8f\x46\xbc\x63\xbd\xc8\xee\xe9\xb3\xe3\xfb\x8f\x1d\x7e\x0e\x98\xc7\x11\x3d\xc7\xd1\xb5\x0e\xb8\xcb\xae\x15\x93\xa3\x8c\x59\x51\xcc\xd4\xb5\x89\x1f\xce\x30\x79\xcc\xe2\xc4\xbe\xf7\xbe\x18\x38\x80\x9f\x19\x33\x37\xa5\xf5\xe6\xbb\x22\xdd\x2f\xd8\x59\x03\x5e\xfa\x61\xab\xaa\x68\xb2\xe4\x01\xb5\x6b\x59\x55\xeb\x9b\x75\xe9\x13\x86\xc1\x26\x9b\xb5\x06\x3e\x7f\xbd\xe9\x7c\x77\xb2\xad\xa6\x3d\xba\xc7\x74\xcd\xbf\x2f\x44\x1c\xa9\x44\x83\xbe\x39\x7d\x46\x7b\xfa\xa6\x5e\x5f\x72\xe0\xb6\xbd\x9f\xa2\xf3\xe5\xa7\xe6\xf1\x13\xde\x77\xaf\xb8\x5c\xff\x8a\x9f\x90\x89\xa1\x56\x55\x35\x9e\xdd\x8d\xbf\x34\xef\x54\x59\x86\x65\xc0\xfc\xe3\xfe\x87\xbd\xe3\x99\x97\xf4\x59\x6f\xfe\x33\xb3\x46\x4a\xb7\xfe\x1c\xfe\xf1\xcf\x67\x11\xea\xe6\xbf\xa6\xd5\xd0\x8f\xff\x17\x00\x00\xff\xff\x83\x48\xc2\x78\xc3\x5c\x00\x00"),
 		},
+		"/crds/kuma.io_meshgateways.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "kuma.io_meshgateways.yaml",
+			modTime:          time.Date(2026, 10, 15, 17, 9, 3, 81203000, time.UTC),
+			uncompressedSize: 23699,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\xcd\x3c\xdb\x72\xdb\x48\x76\xef\xfc\x8a\x2e\xee\x83\xec\x2a\x92\xb6\xc7\xb3\xa9\xac\xde\x14\x5f\x26\xce\xc8\xb2\xcb\x92\x27\x95\x8a\x53\xa9\x26\xd0\x24\x7b\x05\xa2\xb1\x0d\x40\x32\xf3\xf5\x39\x97\xee\x46\x83\xb8\x10\x92\x35\x4e\xa6\xe6\xc1\x22\x80\x83\xd3\xe7\x7e\xc5\x6c\xb9\x5c\xce\x64\xa1\xff\x50\xb6\xd4\x26\x3f\x17\xf0\x6f\xf5\xbd\x52\x39\xfe\x55\xae\x6e\xff\xb9\x5c\x69\xf3\xe2\xee\xd5\x5a\x55\xf2\xd5\xec\x56\xe7\xe9\xb9\x78\x53\x97\x95\xd9\x7f\x51\xa5\xa9\x6d\xa2\xde\xaa\x8d\xce\x75\x05\xb7\xcf\xf6\x70\x53\x2a\x2b\x79\x3e\x13\x22\xb1\x4a\xe2\x8f\x37\x7a\xaf\xca\x4a\xee\x8b\x73\x91\xd7\x59\x06\x57\x72\xb9\x57\xe7\x02\x7e\xdd\x6d\x65\xa5\xee\xe5\x01\x5e\x53\xef\x25\xbc\x67\x56\x16\x2a\xc1\x87\xb7\xd6\xd4\xf0\x80\xff\x99\x9f\x29\xf1\x8a\x10\x8c\xc3\x47\x78\xfc\x37\x7e\x9c\x7e\x2d\xb2\xda\xca\xac\x0d\x16\x2e\x94\x89\x29\xe0\x65\xf3\x39\xfc\xfb\x4e\x66\x3a\x25\x9c\x18\x10\x5c\xc9\x2f\x3e\x7f\xf8\xe3\xf5\x75\xb2\x53\x7b\xc9\x3f\x0a\x91\xaa\x32\xb1\xba\xa0\xfb\xe2\xd7\x08\x5d\x8a\x6a\xa7\x04\xdf\x2d\x36\xc6\xd2\x9f\xf1\x0b\x05\x80\x73\x50\x0a\x0b\xe0\x6d\xa5\x3d\xd6\xf8\x5f\x44\xe6\xf0\xdb\xd1\xfb\xce\x10\x21\xbe\x07\x2e\x00\x61\x15\xbf\xf4\x8e\x7f\x53\x29\x9c\x88\x5e\x6f\x36\xf0\x3b\x60\x64\x55\x61\x55\xa9\xf2\x8a\x0e\x16\x81\x15\x78\x8b\xcc\x85\x59\xff\x5d\x25\xd5\x4a\x5c\x2b\x8b\x40\x44\xb9\x33\x75\x96\x8a\xc4\xe4\xf0\x67\x05\xcf\x27\x66\x9b\xeb\xff\x09\x90\xe1\x7d\x86\x5e\x99\xc1\xa1\xca\xaa\x05\x51\xe7\x95\xb2\xb9\xcc\x90\x94\xb5\x5a\x00\xf8\x54\xec\x81\x32\x56\xe1\x3b\x44\x9d\x47\xd0\xe8\x96\x72\x25\x3e\x1a\xab\xe0\xc1\x8d\x39\x17\xbb\xaa\x2a\xca\xf3\x17\x2f\xb6\xba\xf2\x82\x95\x98\xfd\xbe\x06\xe9\x39\xc0\xbf\xf2\xca\xea\x75\x5d\x19\x5b\xbe\x48\xd5\x9d\xca\x5e\x00\xbd\x96\x84\x67\x5e\x91\x30\xee\xd3\xbf\x58\x27\x74\xe5\x59\x84\x58\x75\x40\x1e\x97\xf0\x78\xbe\x0d\x3f\x93\x98\x0c\x92\xf9\x77\xb8\x8a\x0c\x95\xee\x31\x46\xb7\xa1\x26\xfe\x84\x44\xf8\xf2\xee\xfa\x46\xf8\x97\x12\xc5\xdb\x24\x26\xe2\x36\x8f\x95\x0d\x9d\x91\x2e\x70\x6c\x65\x99\x4f\x1b\x6b\xf6\x04\x51\xe5\x69\x61\x80\x90\xf4\x47\x92\x69\x78\xaa\x05\xb2\xac\xd7\x7b\x5d\x21\x63\xff\x01\xf4\xab\x90\x1d\x2b\xf1\x46\xe6\xb9\xa9\xc4\x5a\x89\xba\x00\x11\x56\xe9\x4a\x7c\xc8\xe1\xd7\xbd\xca\xde\xc8\x52\x3d\x35\x95\x91\xa0\xe5\x12\x29\x78\x9a\xce\xb1\xce\xfb\xff\xfa\x84\x9f\x14\x00\x4f\x41\x82\x7a\x74\x01\x2e\xa5\x29\xd9\x10\x99\x7d\x1e\x78\x78\x10\x83\x5e\x35\x6a\xde\x44\x6c\xce\x41\x38\xe1\xb1\x3a\xa9\x6a\x0b\xc2\x79\xab\x0e\x8e\xe3\x7b\x59\x00\x3c\x83\x3f\xde\xeb\x6a\xd7\x79\xa3\x8c\xb9\x2f\x2b\x62\x2b\xb0\xa1\x54\xc0\x8d\x83\x40\x4b\x49\x0a\x51\x19\x93\x91\xe6\x10\x2c\x52\x0c\xab\x00\x4d\xa0\x70\x17\xa4\x5d\xeb\xca\x4a\x7b\x08\xb4\x5b\x89\x9b\x1d\x60\x24\xe1\x49\x64\x33\x30\xde\x1e\xe4\x3a\x63\x38\x4e\x61\xe1\xa5\x24\x64\x20\x5d\x69\x07\xe4\xfd\x4e\xe5\x62\x6f\x52\xbd\x39\xa0\xe4\xb2\x58\x76\x95\x0f\xa4\xe2\xb6\x5e\x03\xc6\x0a\x54\x1b\x05\x23\x35\x49\xf9\xa2\x06\xa0\xcb\x6d\xad\x53\xf5\x22\x62\xd0\xd9\xac\x8f\xf4\x0c\xb9\x75\x29\xc9\xc0\x1d\x28\x7b\x85\x56\x7d\x8c\x27\x70\x44\x32\xe3\x6c\xba\x94\x7f\x0e\x70\xd7\xc9\x8e\x7e\x71\xda\xb4\x56\x99\xc9\xb7\x2c\xf8\x37\xc7\x1a\x47\x66\xa8\x14\x80\x73\x8a\xe4\x4e\x75\x89\xba\x5a\xeb\x72\x17\x18\x55\x12\x27\x45\x89\xef\xa2\x17\x22\x15\xc9\x81\x14\x32\x41\x72\xc0\x53\x1b\x50\xcc\x63\xcd\x8b\x0e\x53\xf2\x9b\xc5\x46\xab\x8c\xec\x04\xb2\x05\x79\x2e\xf3\x03\xd0\x1a\x88\x6a\xf5\x76\x57\xc1\xcf\xf7\x04\x1d\x74\x88\x38\x63\x45\x0f\xba\x5b\x43\xd6\xc4\x08\xbd\xcd\x89\x1f\x95\xd0\x1b\x82\x06\xa8\x90\x9b\x84\xa3\x5b\xa7\xd9\x5e\xef\x57\xb3\x89\x92\xdf\xf5\xb3\x63\x4c\x98\xbf\x39\xbe\x9d\xad\x60\x15\xfe\xec\x98\x40\x3e\x58\x57\x15\xe1\x09\x96\x3b\xb2\x6f\x8e\x77\xf7\xe0\x3f\xf8\x48\x68\xa2\x2a\x4f\xba\x6d\x2d\xad\x04\xe7\xc1\x4c\x63\xfd\xe9\xb2\x35\x17\x3b\x59\x80\x53\x2e\x97\x6b\xb5\x41\x4a\x19\x9b\x02\x49\x65\x62\x4d\x09\x8e\x4b\x15\x00\x04\x69\x05\xe6\x81\x65\x14\x0c\x23\x19\x50\xb6\xb6\xf0\xa2\x0e\x4c\xa4\x32\xe1\x47\xda\xee\x51\x0a\x67\x04\x7c\xe0\xad\x5f\xde\xbf\x79\xfd\xfa\xf5\xdf\xd0\xa5\xef\x89\x9d\x70\x0b\xfc\xfc\xf5\xe6\xcd\x4a\x7c\xcb\x3b\x30\x3f\x9b\xa2\x46\xe7\x98\xa2\x05\x20\x0a\x1d\x40\x66\xf6\x2b\xf1\x45\xc9\x74\x69\xf2\xec\xb0\x12\x57\x10\xea\x50\x88\x90\x81\x80\x3e\xb9\x17\xf4\x76\x63\x7e\x84\x1b\x1e\x40\x56\xe7\x02\x05\x69\x89\x0c\x9a\x2a\x44\xa9\xca\x14\x42\xff\xcd\x82\x86\x7c\x56\x56\x9b\xf4\x1a\x5c\x39\xd8\xff\x51\x69\xba\xaa\xf7\x60\x4d\x50\xa1\x4b\xbe\x5b\xc8\x2c\x33\xf7\x40\x19\x8e\x8e\x1a\xb9\x00\xae\x6f\x11\xf6\x06\xe8\x72\xe8\xca\x92\xb2\x7b\x9d\x23\x6f\x1d\xe3\x41\x45\xee\x35\x50\x70\x8d\xfa\xb0\x37\x77\x08\xd1\x3b\x50\x4f\xed\x4f\x40\x68\xe2\x2f\x0a\x61\x07\xa4\x3f\x51\x5b\xce\xb3\xd2\xe0\x23\xc0\x0f\x90\x17\xe4\x14\xc9\xe2\xce\x58\x08\x78\x51\x62\x1b\x0e\x0e\x50\x16\x1c\xf7\x3f\xfd\xda\x4b\x55\x8c\x8d\xb6\x47\x7a\xd2\x41\x62\x5c\x37\xdf\xf6\xe1\x0c\xc2\x29\x48\x3a\xc9\x3a\xa0\x74\x92\xe6\xc9\x2a\x18\xce\x1e\x93\x13\x7c\x96\xa7\x22\x61\x82\x27\x6c\x9b\x35\xe7\xc6\x1a\x35\x67\x8d\x96\x81\x59\x83\x74\x65\x35\x22\x53\xd5\x28\x02\x7a\x92\x85\xd7\x20\xd4\xfb\x54\x43\x44\x58\x31\x9f\x2a\xf2\x68\xeb\x43\x8f\x8b\xe5\x30\x88\xbc\x60\x83\x3a\x80\x50\xdf\x21\x1f\xa8\x82\xd1\x70\x87\x10\xcf\x72\x23\xd0\x45\x00\xba\x77\xba\xd4\x00\xb6\x03\x93\xa4\x25\x80\x22\x25\x64\xc4\x10\x2b\xb0\x4f\xc9\xce\x61\x43\x8e\xe1\xb9\x90\x9b\x4a\x71\x3c\x4f\xd4\xd5\x5d\x81\xaa\x02\xe1\x16\x20\x39\x14\x0e\x28\xf8\x1b\x9c\x3f\x84\xbb\x10\xef\xe1\x3b\x08\xe7\x7d\x51\x81\x01\xb8\x28\x09\x45\x21\xcb\xa3\x1b\x3b\x80\xe9\x41\xd4\x7b\xa9\x31\x58\x01\xd9\x06\x54\x63\x32\xaf\x33\x93\xdc\x22\xef\x3e\xf9\xd7\x76\xe4\xaa\xcf\x45\x02\xc9\x17\x91\xed\xf3\x26\x92\x82\xc8\x1c\x19\x0f\x2a\xea\x22\x99\x4d\x6d\x01\xac\x45\x19\xe6\xd8\x7f\x53\x63\x9c\xb4\xe8\xb2\x2a\xab\x20\x1a\xd9\xee\x50\x41\x7d\x24\xe4\xb5\x47\xb8\x84\x28\x50\xdd\xdd\xe0\xb9\x56\x80\x55\xe9\x71\x23\x86\x71\x44\xb2\xaf\xc4\x7b\x80\xa1\xbe\xc3\x81\x32\xcc\x2e\x48\x9e\x5c\x82\x41\x92\xc6\x21\x98\x14\x85\x21\x09\x73\x90\xfb\x1c\xc9\xeb\x97\xde\x24\xb1\x54\xfd\x0e\x61\x4f\xa6\x9c\x55\x41\xfe\x93\xdc\x83\x0b\x48\xd1\xcd\x35\xf2\x1e\x4c\xd1\x71\x32\x45\xce\x04\x1c\x38\xc5\x7a\x1c\xbf\x30\xcb\x90\xf7\x3a\xa7\x5f\x00\x2d\x60\xbc\x93\x24\x40\xb4\x41\x62\x41\xd7\x1d\x12\xdd\xe8\x0d\x91\x42\x5c\x00\x91\x9d\xb4\x69\x8c\x84\x7f\xe9\xb3\xeb\x0f\xbf\xfd\xfe\xe1\xf2\xf2\x79\xe7\xf5\x28\xd6\x5d\x46\x11\x16\x49\xa6\x64\x5e\x17\x0b\x67\x44\x3d\x92\x8d\x2d\x85\x6c\x93\x32\x09\xba\x40\x2e\x31\xa1\xf8\x0c\x02\xc4\x7b\x63\x6f\x3b\x60\xc1\x03\x57\x14\xa6\x97\x8b\x96\x79\x47\x1e\x41\x20\x06\xc7\x50\xdf\x51\x9c\xbd\x3a\x39\xc6\x92\x8c\x2e\x40\xec\xe0\x96\x2e\xaa\x60\x6f\x52\x38\x2e\x3c\x07\x6e\x1d\x04\x00\xfe\x97\xe0\x00\xf7\x92\xa5\xc6\x40\x44\x07\x41\x85\xc4\x84\x98\x09\xa3\xda\x72\xd6\x63\xff\xc8\xcd\x34\x6e\x05\x63\x91\x8d\x8f\xe1\x16\x0d\xb3\x83\x96\xb9\x90\xd4\x9d\x66\x27\xbb\x10\x59\x73\xc0\x36\x06\xa3\x87\xb1\xc1\x50\x2c\x70\x6c\x46\xc3\x9b\xfa\x14\x35\x82\x18\x45\x10\xff\xcf\x23\x86\xc6\xa0\x8d\xfa\xb4\x8f\x10\x4e\xa3\x11\x20\xab\xe8\xbd\x7b\x44\xea\x46\x8b\x1b\xa1\xb4\x6a\x8b\xb2\xd0\xf1\xc1\x42\xbc\x03\xbb\x0d\x09\x34\x5c\x73\x49\x1d\xe4\x2c\x70\x46\xb0\xca\x36\xd4\x63\x40\x2c\x0a\x38\x34\x7a\x05\xd0\x91\x3d\xfc\x5b\xe5\xce\x70\xa0\x9e\xf5\xb8\xca\xa0\x1a\x0c\x39\xe0\x81\x86\x99\x04\xa7\xd7\xe4\xb6\x65\xa6\x8f\xaf\xb9\xc9\x97\xb9\xce\x16\x04\x17\xd2\x59\x36\x13\xda\xb9\x0a\x14\x68\x1f\x81\xb8\x18\xe7\xf8\xc0\xe4\x0b\x1e\x94\x04\xf3\x25\x69\xad\x6c\xbb\xd9\x2d\x18\x68\x8c\x9d\x4f\x26\x69\xf3\xdf\xa2\x3b\x1d\x91\x4d\xc1\x89\x39\x5a\x88\x8d\xfe\xbe\xe0\xe4\xab\x15\x36\x2c\xfa\xec\xba\x7f\x29\x1a\xf2\x5c\x83\x8c\x73\x36\xf6\xe9\xea\xf2\x3f\xc4\x87\xf7\xf4\x34\xbd\x85\xa3\x11\x50\xba\x46\xc9\x40\xeb\xef\x80\xb7\x69\x57\x04\x3e\x6c\x22\x4f\xec\x33\x41\x36\xaf\x04\x1d\xb2\xee\xda\xe6\x1c\x32\x34\x15\x96\x26\x0e\x1a\xcc\xfc\x40\x42\xf2\x06\x4c\x21\xcb\x32\x84\x4b\xec\x3f\x09\x04\x45\x90\x6b\x92\xac\xb5\xce\x5d\xd1\x20\x1c\xb0\xeb\x31\xea\x0d\x50\x8c\x5d\x90\x3f\x93\x03\xb7\x73\x91\x01\xa5\xa9\x4d\x69\x52\xd8\x3a\x03\x51\x71\x17\x91\x3e\x5d\xe3\xc6\x41\x88\x2f\xbe\x01\x36\x95\xad\xf3\x24\xb6\x42\x99\xca\xb7\x80\x97\x13\x51\xc6\x82\xec\x8c\xb6\x44\x9a\x0e\xcc\xbd\xbc\x65\x1d\x60\xe4\x1c\xbf\x4c\x1e\xf1\x98\xec\x5d\x87\xfc\x58\xb1\x45\x05\xec\x71\x41\x18\xaa\xc2\xd3\x5e\x0c\x38\x07\x67\x07\xe1\x1c\xa2\x8f\x39\x91\xb2\x57\x9f\x6e\x1c\xf3\x80\x9c\xbf\xbe\xfc\x9b\x58\xf6\xf8\x75\xb0\x92\x12\x8e\xee\xd3\x03\xa5\x29\x6c\x71\x8f\xfd\xf2\xf2\x95\x78\xc3\xb9\x27\xfa\x90\xbf\xbe\x7c\xc9\xdc\x01\xa3\x5a\xc2\x49\xb8\x30\x87\xfa\x6b\xea\xbe\xe4\x33\xd5\x40\x42\x8e\x06\x62\x71\x4d\xa8\xfa\xe2\x02\xa7\x8d\xa9\xf3\xd4\xbb\x7b\x8e\xc3\x21\xe1\xa9\xe0\x8d\x8b\xc1\xf3\x3b\x09\x74\x65\x1c\xac\x0a\x1d\xc4\x33\xaf\x53\xa0\xff\x9d\xd0\x93\x10\xa1\xcc\xb4\x47\x48\x15\x1c\x07\x20\x2c\x39\xcc\xd8\x01\x39\x94\x7d\x4e\xac\xb9\x28\x0a\x78\x53\xca\x46\x45\x6f\x84\xd7\x60\x72\x7b\x9e\x4b\x5d\x85\x7a\x5a\x3f\x03\x02\x0e\x26\x17\xe2\xc1\xe4\x30\x9f\xea\x4a\x9c\x80\x1c\x95\xc5\x3b\xa6\xe9\x02\xc4\x05\xb8\x82\x11\x4a\xce\x79\x67\xab\x54\x21\xfd\x21\x93\x08\x20\x68\x40\x2f\x0d\x01\x30\x69\x02\x58\xf2\x0a\xc2\xce\x09\x19\xfd\x93\xe4\x83\xd4\x27\x99\xe2\x36\xe7\x17\x79\x7c\x33\xd7\x68\x88\x03\x26\xcb\x42\xcd\x4c\x01\xd7\xa8\xde\x55\x9a\xbd\xc7\xb9\x47\xb0\xef\xa4\xd5\x12\x24\x10\x3c\xa0\xf3\xba\xbe\x66\xe4\xa2\xee\x76\x4e\x28\xd9\x3f\x81\xed\x88\xd1\xed\xb3\x97\x18\x29\xdd\x71\xc9\xf2\x80\xb5\x31\x4a\xd5\x4c\xab\x20\xc4\x81\x97\xce\x50\x21\x29\x06\x68\xc5\x8d\x1d\xa0\x68\x14\xc9\x01\xa0\xe7\xc6\xb0\x00\x45\x39\x60\x81\x29\x10\x2a\xfc\xbd\x2e\xd5\xe2\x28\x8a\x48\xd0\xe7\x83\x2e\xf4\x18\x22\x90\xdf\x06\x84\xcf\x4e\x77\x3a\x85\xd8\x41\x3c\x03\x5d\xc6\xe3\xbe\xb8\x97\x15\x50\x14\x2f\x6e\x15\x3a\xe7\x2c\x2b\x9f\x73\x28\xc0\xfa\x3b\x42\x80\xfc\xac\xc2\x4c\x35\xd3\x89\xc6\x54\x57\x96\xb7\xec\x7e\xcc\x9a\xec\xdb\xd1\xfb\x43\x6d\xb6\xa7\xb2\xf4\xef\x14\x35\xe6\xf1\xb1\xd8\x9e\x2d\x5a\xb1\x25\x9a\xbe\xc2\x89\x6c\x14\x51\xf4\xd6\xaf\xc9\x02\xd5\xd6\x92\x09\x52\x1d\xb6\xba\x32\x0a\xe4\x66\x77\x3a\x03\x79\x4d\x29\xe7\xe2\x7a\x1a\xe7\x88\x5d\x57\x41\x65\xe6\xe6\xbd\x2e\x2f\xd5\x4d\xf6\xbb\xf0\xe9\xa1\xb3\x9a\xf4\x04\x9a\x26\x97\x67\x76\x40\x82\xd6\xc9\xfc\x40\xaf\x26\x53\xf6\xf6\xdd\xe7\x2f\xef\xde\x5c\xdc\xbc\x7b\x2b\x96\x2d\x74\xa9\x44\x8e\x09\x43\x56\xec\xa4\x13\x59\xe4\x59\x6f\x64\x17\x15\x8f\x80\xcb\x77\xaf\x56\xaf\xfe\xba\x3a\x36\x4a\xc5\x48\xb3\xa1\xe0\xec\xb0\x7b\xe1\x48\x59\x3f\xbb\x2c\x72\x50\x77\x5c\xe7\x00\x43\x61\xf5\x5d\x25\x75\xa5\x7a\x40\x52\xda\xca\x05\xcf\x10\x26\x37\x09\x16\x46\x21\x5c\xea\x58\xb1\x94\x70\x87\x0e\x20\x3a\x2c\x07\x20\xb6\x4c\x88\xa3\x86\x2f\x84\x88\x8d\xd4\x19\x22\x0e\x46\xb4\xce\xaa\xa8\x66\xa0\xc6\x55\x9f\x22\x1b\x6a\xa6\x84\xb8\x8a\xea\xac\x86\x34\xdd\xfb\xbd\x3e\xdd\xc4\xb8\x26\x52\x86\x5e\xc8\xf8\xbc\x3b\x2b\x82\x04\x4d\xf4\x2a\xb8\xea\xb9\x7f\x20\x46\x3e\xc5\x5b\x97\xd4\xf5\x84\xc3\x03\x4c\x8e\x3b\x17\x3e\x27\x25\xb6\x12\x5d\x9b\x94\x03\xd3\x90\x70\xc2\x21\xbe\x44\x15\x25\x67\x26\x07\x6f\x1b\x09\xf6\x7d\xfe\xc2\x51\xdd\xd0\x39\x96\x84\xfa\x6c\x18\xf6\x80\x29\x1e\x4e\x26\x5c\x81\x11\x44\xe6\xa4\x6a\x80\xf1\x6f\x09\x17\x07\x64\x48\xc3\xf7\x20\x7b\xb5\x55\x3e\x98\x1d\xc9\xa4\x42\x85\x04\x3b\x60\xd8\x06\x2f\x5d\x45\x10\x5b\x6d\x72\xab\xbc\xc0\xe5\x3e\x93\xc4\x84\xab\xac\x2d\xf7\x2f\xd0\xe9\xf5\xda\x1c\xee\xf6\x70\xf7\x80\x72\x31\x67\xad\xe3\x64\xaf\x8f\x2d\xa7\xa4\xaa\xbf\xc9\x3f\xd2\xa9\x7c\x58\xc3\x7f\x50\x50\xda\x83\x00\x0f\x6d\xfe\x0f\x82\xed\x1d\x0a\x78\xc8\x20\xc0\x20\xe4\x9f\x38\x20\xf0\x20\x85\x4a\x4c\xaa\x26\xb1\xee\xba\xde\x6e\xb9\xfc\xfd\xaf\x37\x37\x9f\x7d\x16\x82\x8f\x37\xed\x0f\x0c\x30\x6b\xc8\x76\x5e\x42\x44\x3e\x48\x09\x57\x98\x1a\x32\x02\x51\xac\xf9\xfa\x97\xd1\x53\xf5\xc5\x9c\x0d\xea\x15\xa8\x5c\x39\xe9\x64\xef\x70\x00\x08\x53\x55\x2c\x19\x81\xcd\x2e\x4d\xa2\x29\x3c\x0e\xea\x6b\x29\xa7\x5a\x71\x49\x66\x44\x26\x29\xf3\x42\xc9\x60\xd9\x16\x38\xd9\x60\xee\x73\x6a\x9c\xf3\x1b\x18\xad\xa3\x20\x74\x10\x62\xa8\x45\x78\x2f\x43\x18\x86\xa4\xbf\xb7\xdd\x08\x12\x82\x24\x1c\x36\xb1\x86\xa2\x0f\xa7\x67\xea\x7b\xa2\x0a\x57\x30\x62\xa4\x43\x56\xe0\x8e\x83\xb4\x1e\xe2\xd5\x69\x9f\x03\x02\x26\x21\xd0\x19\xb9\xde\xd3\x37\x7f\x43\x8f\xb0\x2d\x06\x2e\x27\x59\x0d\xb7\x40\x5c\x65\x95\x27\x60\xc4\xa5\x11\xc0\xa2\xe1\xe0\x35\x49\xa6\xcb\x8d\x37\x6c\x8d\x57\xe2\x0a\xa8\x87\x1e\x37\xbe\x4a\xd1\xe0\x28\x50\x57\xda\x70\xb8\x00\xc1\xf8\x88\xab\x91\x87\x46\xfc\xf6\x43\x68\x19\x55\x44\x4e\xdd\x74\x9c\x62\xdd\xec\x7c\xe9\xc9\xb9\xf5\xf6\xa0\x07\xa6\x22\x74\x8c\xf4\x24\x5c\xe7\xca\x95\xb5\x06\xdb\x5f\x25\x79\x5c\x92\x1a\x14\xf7\x7f\xbb\xfe\x74\x85\x95\x0e\x8a\x08\xe4\x90\x5b\xe9\xa4\xe5\x0d\xa3\x45\x8a\x4c\x01\x79\x2f\x4c\x59\x61\x21\xc7\xcf\x68\x90\x99\xc9\xc9\x04\x4d\x80\x28\x2b\x36\x9f\x68\x73\x2f\x50\x90\x38\x9a\x86\x08\xcf\x2c\x35\xe8\xe2\x77\xcc\xaf\xc4\x7b\xa4\xc8\x69\x8e\x7b\x5f\x57\x28\x69\x59\x0e\xa9\x7e\x46\x8d\x31\x4d\x39\x0c\xcb\x2a\x90\x96\x65\x41\xa4\xb5\x9a\x42\x48\xc3\x3c\x29\x31\xb3\x42\x0f\xbe\x87\xe0\x41\x43\x2a\xc8\xd4\xc5\x7c\xc5\x59\x00\x4a\x14\xde\x71\xaf\xa8\x3c\x9f\x00\xfa\x1b\xfc\x3f\x47\xce\x7c\x9b\x43\x50\x54\x05\xee\x87\x1f\x5d\xb5\xcb\x65\x4b\x13\x20\x06\x81\x41\xc8\x24\xd0\xff\xf9\xf2\xbf\x56\x23\xaf\x98\x00\xd3\x21\xb1\xd1\x16\xdb\x28\x44\x43\x57\xf0\xce\xfd\x4b\xbe\xcd\x4f\x03\x3a\xe9\xe5\xa2\x7c\x0e\x42\x59\x08\xa3\x1e\xa8\x3e\x17\x62\x57\xef\x65\xbe\x04\x9b\x98\x52\x2b\x35\xba\x1a\x26\x7c\x90\xf3\x53\xce\xcc\xb7\x13\x87\x41\x04\x23\x4f\xe0\xea\x9b\xcd\xb4\x86\x2c\x97\x23\xde\xa1\x6d\xd3\xd1\x5e\x43\xd6\xb1\x7a\x4a\x62\xb1\x0b\x78\x30\xad\xf6\xe0\x25\xc1\x93\x8c\x51\x6b\xc2\xa1\x88\x9e\x47\xd4\xf2\x05\x59\xae\xdb\xfa\x0c\x1c\xef\xb0\x53\x40\x92\xc3\xa4\xe8\x0b\x63\x0c\xc4\x46\xde\x81\x09\x47\x1c\x9f\x90\x6e\x27\x12\x8d\x29\x09\x47\x28\x16\xd2\x64\xf0\x43\x7c\x27\x3d\xd1\x58\xbf\x8e\xb5\x7f\xa8\xe3\xe4\x90\xae\xe5\x21\x57\xb3\x1f\x24\xd2\xf1\xb0\xea\x78\xca\x80\xa7\xc2\x27\xfe\xe4\x43\x89\x4f\x39\x57\x16\x9b\x81\x2b\x0e\xe5\xa8\x87\x32\x0a\x37\xea\xe5\xf9\x11\x91\x80\x1a\x8e\xde\xfe\xa4\x81\xd5\x47\xf1\x62\xbc\x28\x30\x34\xd4\xf8\xa7\xb2\x42\x3c\x73\x83\x76\x38\x79\xc8\x53\xcb\x70\x82\x4c\x0d\xa7\xf6\xcd\x60\x28\x16\x8a\x31\xbf\x5d\x7b\xa3\xb3\x56\xe9\xf3\x1f\x16\x58\x6a\x63\x50\x0f\x62\x60\x4e\x6c\xac\x24\x10\xba\x11\x8b\xb8\xed\x11\x66\xc8\x9a\x2e\xf1\xe8\xd1\x82\x54\x46\x13\xb2\x3c\x73\x0b\xe2\x75\x8d\x72\xcb\x21\x83\x9b\xc4\xe6\xae\xca\xb8\x99\x6a\xba\x35\x54\xac\xab\xb0\x29\x46\xd5\x46\xca\x76\xb1\x93\x99\xd0\x0b\x97\x2e\xc1\x33\xa5\x7f\xc9\xec\x64\x74\xd8\x38\x34\x8f\x8b\xd8\x99\x7b\x1e\x12\x02\x6f\x75\x2f\x75\x15\x4e\x2e\x6f\x4f\x5a\xd4\x9d\xea\xa0\x35\xc6\xd4\x29\x39\xe4\xb4\x3c\x92\x2a\xea\xfa\x01\xd6\xea\xeb\x87\xb7\xc7\x3a\xb1\x1a\x12\xe8\xd9\xa4\x70\x6b\x48\xa8\x1f\x3c\xee\xdc\x8c\x0f\x94\x7f\x81\x1f\x7e\xd4\x76\x9c\x74\x73\x63\x66\xfe\x09\xf6\x13\x66\x13\x2a\x8d\x8f\xda\x55\x98\x4d\xd0\x98\x47\xed\x2d\x0c\x02\xfe\xe9\xee\xe1\x24\x7b\x4f\x84\xc9\x0f\x0e\x8e\x9d\x99\x3f\x55\xd6\x0b\x56\x6e\xf5\x78\xc4\xbb\x0b\x1a\xc3\x82\x07\x6e\x27\x4f\x71\x06\x8d\x1a\x19\xcd\x82\xc2\x4f\x67\xc8\xa4\x4a\x8a\x41\x4d\xa8\xa7\xbb\x6b\xff\x40\xbc\xc6\xa1\x37\x61\x76\x95\x47\xfc\xe1\xe8\x20\xc9\xb3\x09\x59\x5a\x1e\xe6\x9f\x29\x31\x0b\x75\x28\x37\x03\xeb\xec\xbc\x6b\x14\x9c\xf2\x67\x6e\x18\x02\xeb\x0e\x5c\xd8\xa1\xda\x5b\x88\xc6\x29\xd4\x08\x51\xbe\x29\x24\x4e\x28\xf4\x8d\xfe\xb5\xc7\x3a\xe8\x98\x7e\x5b\x42\x97\x25\x3d\x64\xdc\xd8\x84\x1b\xaa\x34\xc7\x8b\x49\xb2\x3a\x8d\x69\x1a\x75\x1e\x01\x82\xdf\x76\x61\xba\xa8\xef\xa1\xdb\x18\x4e\x30\x4e\x50\xdf\x15\x7d\xc3\x1c\xe2\x8e\x3e\x35\x8e\x20\x7a\x04\xa4\x58\x1c\x9b\x9e\x62\x61\xca\xfe\xc9\xdf\x56\x76\xb3\x89\xc7\x4c\xb0\x0e\xa8\xb7\xb5\x0b\x1a\xa8\xbe\xb3\x93\xf9\x96\xa7\x45\x9a\x1a\x86\x1c\x8f\x6c\xd5\xbd\xd8\x03\x82\xc8\x56\xea\x7e\x37\x93\x42\x8d\x7f\xf3\x05\x7d\xf6\xf9\x5e\x2a\x4e\x04\x6a\xe0\x0f\xeb\x92\xed\x3a\xf7\xcc\x58\x52\xa3\xe1\x23\x38\x36\x7b\xac\x24\x4c\xa1\x8e\xc2\x74\xd2\x12\x57\x14\x5c\xab\x4a\xe1\x30\x66\x86\x3d\xac\x83\xa9\xf9\x1c\x56\x25\x4a\xdf\x9d\xc0\x92\x27\x3a\xcd\x2d\xe0\x4a\x4e\x02\xc8\x45\xf1\x8f\xb7\x8e\x4f\x10\x57\xb6\x28\x38\x3d\xca\xb8\xae\x9a\x86\x4f\x70\xeb\x65\xc4\xfe\xb3\xb3\x32\xb4\x2d\xc6\xa9\xe6\x98\xe7\x37\x2c\xfd\x06\x03\x42\x76\x31\x87\x1f\x80\xf3\xfd\xa3\x9e\x81\xaa\xa3\xd2\xbb\x9b\x5b\x25\x2e\x3b\x59\x67\xb2\x3b\x11\x5c\x89\x3f\x78\x48\xdb\xcd\x4b\x56\xdc\xf7\x1f\x05\x2b\x83\x19\x88\x50\xa1\x3a\x21\x89\x24\x70\x38\x34\xde\xd7\x32\xb9\x9d\x22\x31\x7e\xd2\x6b\xca\x8a\x4b\xe3\x11\x46\x41\x3e\x81\xb7\x80\x3f\xb9\x28\x97\x1c\x96\x6e\x08\x66\x09\xe7\x5c\x06\xf3\x90\x1c\x7e\x38\xeb\x2b\x55\xb6\xb9\xd4\xf9\xed\x64\x89\xf3\x0f\x70\x94\xf6\xf5\xcb\xe5\x71\x70\x36\xa1\xb9\x3b\x71\x9b\xe8\x4f\x8e\x4a\xc7\x6b\x5a\x0f\xac\x64\xdd\xef\xdc\x68\x48\x08\x5c\x66\x23\xb5\x27\x67\x9b\xe6\xae\x1b\x3c\x77\x51\xd1\x78\x59\x6b\xac\x3f\x34\x58\xcc\x02\xd4\x5d\x2e\x9d\x64\xd2\xb2\x71\x00\x65\xa6\xce\x1d\xbf\x74\x24\xca\x48\x41\xc5\xea\x4a\xa4\x46\x71\x7f\xc9\x80\x92\x58\x6c\x78\xe8\xea\xd1\x61\x19\xbf\x74\x72\x50\x16\x62\xc5\xa8\x1c\x83\x15\x1a\xac\x37\x9c\x8b\xf9\x75\x9d\xe0\x48\xc2\xbc\x6f\x60\xc7\xff\x17\xa8\xfc\xd4\xd1\x1c\xe6\xf3\xa4\x90\x7c\xa6\x47\x86\xd8\x23\x72\x3a\x3c\xe3\xb0\x1c\x98\x7f\x19\x04\x06\xd2\xa0\xb2\x3f\x7b\xfb\xf8\xa3\xa4\xf1\x70\x97\xba\xdd\xaa\x03\xdb\x65\xee\x78\x77\x3d\x09\xce\x8a\xd9\xad\xc4\x76\x79\xef\x14\x29\x06\x91\x5b\x63\xe1\xb2\x78\x46\x1f\x34\xe0\xbd\x60\x95\xc1\xe1\x9e\x47\xcb\xbe\x10\x1d\xed\x69\x8c\x8d\x2f\x61\xed\xa3\x67\xfe\x11\xcc\x54\x46\xa3\xae\xa8\x0b\x61\xa4\xb0\x74\x30\xed\x9d\x4e\xd4\x23\x36\x87\x99\xae\x93\x97\x86\x21\x3b\x82\x70\x21\xe5\x6e\xd3\xf8\x28\xe4\xc7\xf8\x56\xdc\xd7\x2e\x05\xee\xa6\x6c\x32\x73\xbf\xd4\x3c\xfe\xe5\x5d\xb6\x8b\x64\xfb\x96\x4b\x81\x1d\xae\xb1\xc4\x3b\x44\x56\x79\x1c\xd8\xee\xe2\x7a\x80\x83\xea\x7a\xd1\x1a\xe3\xf0\x12\x27\xfa\x78\xa6\x67\x20\x74\xd8\x19\xa0\xc2\xad\x52\x05\xb0\x9a\xe3\x7e\x9e\xa0\x83\xa3\x63\x9c\x86\xbb\x6f\x54\x9e\xc2\x29\xc1\xdc\x75\xa4\xdd\xf6\x55\x9d\xa7\x70\x67\xd5\x17\xc4\x37\x25\x23\xb4\x5c\x1e\x33\x2f\x35\x3e\x5f\x39\xe3\x56\xe3\xa2\x35\x1c\xea\x7f\xec\x92\xc0\x36\xf3\xed\x18\x98\x37\x03\xb3\xb2\x28\x70\x08\x50\x56\x3b\x88\xb3\x6f\x95\xf8\x36\x4f\x40\xc9\xd3\x6f\x73\x0e\x6b\x5d\x24\xcf\xf4\xeb\xdb\x74\x90\x19\x7d\xfd\xc2\x59\xf3\xc0\x0d\x97\xf5\x34\xe8\x93\xb4\x1f\xed\xaa\xf7\x85\x24\x7e\x6c\xe5\x5b\x7e\x3c\x9b\x4a\x73\x7f\xac\x13\x44\x89\x28\x82\xf7\xb3\x7e\x58\x48\xed\x9b\xf0\x06\xc3\x0d\xa2\xdd\x99\x00\x1c\x68\x44\x8f\xa7\x9f\xa7\x86\x7c\xda\x4e\x73\x74\xc2\x27\xfa\x92\x47\xd4\x7e\x9e\x9d\xac\x24\x52\xaa\x4a\x23\xdf\x7e\x53\x5e\xb9\x2a\x1f\x12\x6a\x4e\x5d\x8f\x17\xee\x1d\x73\xf1\xf7\xba\x1c\x82\x49\x1c\xa7\x3a\xac\x29\x96\x19\xda\xf8\x18\x63\x27\x83\x6e\x95\x5b\xa1\x93\xc1\x2f\x17\xa0\xa6\x59\x08\x2a\x67\xc3\x75\xa1\xe8\x7c\x32\xc2\x79\xad\xb8\x8d\xa5\xc9\x06\xba\x6c\xce\xed\x7b\xb1\xc2\xcc\x06\x93\x7d\x1c\x5a\xea\x9b\x61\x9f\xe0\x5d\x36\xbd\x96\x66\xc4\xfa\xe3\x52\x85\x3a\xcd\x5c\x67\x96\xa2\x94\x43\xb6\xf5\x65\xf5\x98\xd1\x3b\x36\x4d\x76\x82\x70\xb1\x75\xb4\xdd\x7d\x28\x17\x2c\x04\xdd\x23\x90\x23\x51\xe2\x0e\x42\xd7\x09\x28\x0f\x12\x38\x44\x25\x13\x90\xfe\x14\x4a\xf7\xee\x9b\x3a\x08\x1b\x31\x6e\x6a\xfa\x5c\xe3\xcd\x20\xca\x1c\xce\xae\x48\x1b\x5a\xee\xe1\x1d\xb5\xca\xd7\x0a\x0d\x4b\xf8\x0c\x01\x6a\x06\x2d\x45\xd0\x96\x8d\xf3\xc2\xc3\xb3\x56\xb1\x92\xa1\x9b\x38\xc3\xc5\x8a\xc3\x19\x59\x9d\xb3\xaf\x54\xc6\x3c\x7b\x14\x85\xb0\xcf\x31\x81\x38\x37\x9a\xf7\x36\xaa\x78\xd3\xcc\x97\xcb\x03\x8f\xc4\x3d\x46\xc2\x23\x53\x63\x1f\xc2\xca\x89\xb3\xce\x61\x0b\x4f\x6f\xda\x0c\x70\x07\x9c\x8d\xf5\x0d\x86\xf6\x03\x27\x1c\x7c\x44\xd4\x87\x1a\xbe\xf9\xa9\x35\xb5\x33\x5a\x6e\xf1\xc9\xb2\x5b\xd7\x41\xc3\x8f\xb3\x27\xcd\xb7\x3e\xc0\x6e\x95\xcd\xda\x53\xef\x77\x02\x78\x15\x82\x87\xa0\x79\x70\x70\xd1\x6c\x39\x53\xf7\xb3\xf9\xac\x08\x96\x9f\xe8\x03\x07\x61\x65\xbd\x4f\x36\x9b\x5d\x65\xd5\xde\x44\xa1\x56\x52\x81\x8e\xc5\x62\x3b\xd0\xf5\x0d\x63\xcb\xb7\xea\x5f\xf8\x02\x1e\xc1\x23\x7b\x48\x5b\x68\x1d\xc2\x4d\xce\xa1\xa8\x86\x45\x8e\x66\xef\x86\x83\xc3\x76\xad\x2b\x0d\x9f\xe8\xea\x4a\x4b\x4f\x89\xfe\x47\xda\x28\x44\xfb\xb3\xa9\xab\x3f\x81\x53\xe3\x21\xe0\x55\xf8\x78\x4b\xec\x40\xf9\x17\xc7\x75\x5c\xe9\x67\x8a\xb6\xa5\xa2\x7b\xe0\x8b\xdc\xe9\x41\xf4\x49\x18\xc8\x2f\x41\x48\xc0\x32\x30\x4f\x09\xfc\xb7\x39\xbc\x4c\xd6\x59\xf5\x6d\xde\xdc\xba\xc0\x44\xb0\x03\x32\xbe\xd5\x59\x34\xf0\x6d\x26\xa7\x42\x5d\x7b\x30\xb7\x19\xb1\xf3\x65\x20\xb4\x31\x5e\x46\xfb\xd6\x28\xb1\x4c\x8d\x41\x7f\xca\x43\x2d\x0d\xd6\xcb\x68\x61\xcf\xb4\xf6\xf2\x9a\xee\xa4\x7b\xc9\x6c\x68\xa0\xda\x7d\xad\x00\x02\x2d\xbf\xa9\x2b\xc5\xdb\xab\xeb\xff\xbe\xbc\xf8\x97\x77\x97\xab\x71\xe1\x98\xf5\x95\x7a\x4e\x0a\x4b\xc0\xbf\x9c\xbc\x20\x66\xee\x41\x8b\xbe\x28\x5a\xdc\x4c\xd4\x78\xba\x70\xe9\xf6\x2f\x3c\x75\x53\x55\xb0\xba\xac\x0f\x9d\xbd\xa4\x8b\xcb\xcb\x41\x02\xb9\x58\x96\xca\xce\x54\xa8\xa3\xb5\xa4\x30\x61\xde\xfa\xe6\x8d\xa3\xe5\x56\xda\x35\xce\xa3\x27\x18\x86\x27\xd5\xd8\xf6\x6a\xb3\x1b\x11\x25\x21\x71\x10\xbf\xe0\x89\x76\xac\xaf\xfa\xe9\xaf\x50\x6e\xef\x67\xa6\xab\xdd\x9b\xa6\x7c\xec\x21\x85\xc9\x82\x68\x81\xac\x89\xc7\x28\x92\xeb\xd3\x93\x1b\xaa\xb5\x34\x31\x5a\x3c\xe5\xa7\x42\x38\x11\x01\x5d\xfd\x5f\x44\xd6\xed\x30\x1a\x35\x89\xf7\x7b\x1f\xe5\xa1\xe9\x4b\x1b\x9f\x50\xda\xfc\xa7\x58\x26\x20\x81\x3c\xb5\x38\x04\x7f\x71\xf5\xd6\x77\x1c\x48\x62\xc3\x8a\xef\x1c\xbb\xfa\x18\x90\xe7\xa9\x87\x3b\x34\xc1\x17\xd6\xea\x9d\x00\x34\xc0\x1a\x46\x74\x16\xe6\x6f\xd5\x61\x49\x66\x60\x00\x28\x7f\x93\x8c\xbe\xbe\xe0\x53\x0d\xa7\x4b\xd1\x56\xd0\x4a\xbc\x65\x1b\x46\xb3\xfe\x1b\x99\xe1\x67\xe5\x6e\x86\x42\xaf\xf0\x5d\x25\xbf\x8c\xcc\xfd\x33\x4c\x70\x21\xf9\x60\x0c\xe7\xb8\xae\xb1\xd7\x65\xcc\x1e\x3a\xcb\x62\x00\xa8\xf1\xcb\x7d\xe2\xd7\x5f\x7e\x11\xcf\xbe\xe6\x6e\xd1\x86\xea\x8c\x10\xda\xe9\xea\xf0\x3c\xfa\x2e\x10\x77\x55\xc6\x18\xbd\x36\x06\xbf\x80\x31\xeb\xef\x1c\xb2\xd4\x3e\x84\xc3\x47\xc4\x23\x95\x0b\xab\x11\x13\x34\x62\x1a\x6e\xc3\x53\x02\x3d\x33\x02\xc7\x62\xff\xb3\x1b\xb5\x27\x34\x6a\x78\x98\xaa\x27\x9e\x3b\x75\x96\x1f\x0f\x44\x26\xe1\x3c\x38\xdd\x32\x32\xd7\xf2\x14\x18\x0f\x4f\xa0\x8c\x22\x3c\xb6\x00\xb6\x8c\xec\x69\xef\x65\xe4\x6c\xef\x85\x81\xc9\xb2\x25\x52\xe7\x29\x42\xfc\x13\x8d\xbe\xce\x36\xb4\xeb\x74\x71\xb4\x43\x95\xa5\x66\x90\xc5\x6d\x2c\xfa\x95\xa4\xe0\x10\x66\x63\x73\x30\xe3\xfd\xbc\x81\x9e\x5d\xcf\xc2\x72\xdc\xc3\xfb\x18\xb5\xdb\x31\x06\xc3\x6d\x95\x3d\x7e\xa1\x30\x11\x51\x0f\x6b\xe1\x1e\xa0\x77\xd0\xe4\xd6\xf0\xc7\x03\x78\x2d\xb9\x49\x8b\xb1\xa7\xd1\x54\x8a\x8c\xf5\xb5\x86\x90\xa4\x84\x4f\xe2\x75\xf7\x76\x69\xa4\x0d\x13\x06\x97\x48\xba\x72\xb4\x8c\xa7\x09\x1e\xd8\x3b\xf4\xfd\x42\xfa\x7c\xe5\x3e\xfa\xa6\x1a\xa7\xda\x48\x03\xc9\x1f\x0d\x4a\xea\x4c\xda\x1e\xcc\x07\x3f\x5d\x56\x8e\x7d\x5f\xa7\xd5\x88\x9c\xd6\x39\x1d\xec\x96\x3e\xb5\xc9\x9c\xd0\xad\x9c\x1c\xf9\x0e\x75\x25\xdb\x7b\x68\xd3\x3b\x91\x2d\x7a\xf6\xee\x8a\x9f\xec\x3e\x0e\xe2\xda\x63\x36\xdb\x5a\x8c\x06\xd3\x65\x47\x2e\x63\xd7\xb9\xfb\x88\x06\xb6\x1e\x28\xa7\x61\xfd\x3e\xfa\x7a\x60\x4f\x1c\x4d\xb1\x73\x53\x62\x6f\xbe\x31\xd2\xfe\x9a\x1d\x0e\x87\x72\x67\x0c\x3f\xc2\x14\xb2\xe5\x1e\xb1\x8b\xb4\x2a\xfa\x7e\x9d\xff\x9c\x21\x6e\x91\xb1\xce\xc2\xd3\x9f\xbf\xde\xb4\xbe\x41\x19\x8b\x69\xdf\x6a\xfb\xc9\xfe\xf9\xe3\x5c\xc5\x44\x21\xea\xb5\xcd\xf8\xd1\xea\xf3\x53\x5f\xf6\xf5\x1f\xe3\x1e\x81\x74\xf4\x93\x33\xbd\x2e\xb0\x5f\xba\x2f\x7d\xdf\xbd\xa2\xb2\xfd\xab\x59\x3c\x3d\xd4\xaa\xaf\xba\x4d\x5e\xf7\xdb\xff\x02\x91\xab\xdb\x4d\x93\x5c\x00\x00"),
+		},
 		"/crds/kuma.io_proxytemplates.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "kuma.io_proxytemplates.yaml",
 			modTime:          time.Date(2020, 3, 21, 15, 26, 19, 986879330, time.UTC),
@@ -103,17 +117,17 @@ var Templates = func() http.FileSystem {
 		},
 		"/kuma-cp/app.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "app.yaml",
-			modTime:          time.Date(2020, 4, 17, 14, 40, 20, 226743060, time.UTC),
-			uncompressedSize: 6271,
+			modTime:          time.Date(2026, 10, 15, 21, 58, 23, 260882000, time.UTC),
+			uncompressedSize: 6324,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x58\x4f\x73\xda\xba\x16\xdf\xf3\x29\x34\x79\x6b\x43\x48\xd3\x94\x7a\xa6\x0b\x02\x6e\x1e\x93\x60\x18\x4c\xf2\x5e\x56\x54\xd8\x07\xd0\x8b\x6c\xf9\x49\xb2\x6f\x99\x36\xdf\xfd\x8e\x6c\xcb\xd8\x60\x1b\xd3\xdb\xbb\xba\x59\xc5\x3a\xe7\xfc\xce\xbf\x9f\x8e\x24\x0c\xc3\xe8\xe0\x90\xbc\x00\x17\x84\x05\x26\x8a\xfb\x9d\x37\x12\x78\x26\x72\x80\xc7\xc4\x85\x8e\x0f\x12\x7b\x58\x62\xb3\x83\x50\x80\x7d\x30\xd1\x8f\x1f\xa8\x3b\x62\x81\xe4\x8c\xce\x29\x0e\x20\xd3\xb4\xb1\x0f\xe8\xfd\x3d\x53\x13\x21\x76\x33\x5d\x5b\x7f\x2a\xa9\x08\xc1\x55\x50\x21\xe3\x52\xa8\x7f\x8c\xe4\x5f\x13\xdd\xde\x7e\xe8\x20\xa4\x7d\xec\xa4\x0c\x85\x81\x3d\x9f\x08\x15\x97\x21\x80\xc7\xc0\x13\x05\x89\xf9\x16\xe4\x3c\x31\xfa\x98\x5a\x69\x8c\x8f\x77\x9f\xee\x0a\x20\x3e\xf6\xc4\xc1\xb2\xa0\xf4\xa9\xa0\xb4\xe5\xa1\x6b\x08\x4f\x94\x35\x06\xc7\x1a\xdf\x8f\x35\x3e\x1f\x45\x7b\xa2\x31\xe8\x1f\x6b\xe0\x90\x54\x85\x33\xb8\x39\x56\x5c\x33\x26\x85\xe4\x38\xac\x54\x2f\xd6\x69\x1b\x15\x20\x05\x50\x70\x25\xe3\x66\xa2\x80\xc3\xd0\x44\x6f\x91\x8f\x0d\x37\x6d\x96\x11\xaa\x6e\x75\xce\x75\x7c\xe8\xba\x2c\x0a\x64\x45\xe3\x2b\xc0\x9a\x9b\xdd\xe0\xca\xe5\x20\x3b\x72\x1f\x26\xb0\x6b\xe0\x01\x48\x10\x5d\xc2\x7a\x92\x8a\x3a\xd7\xc2\x13\x86\xa4\xc2\x70\x81\xcb\x33\x9e\xb5\xb5\xa4\xa2\xeb\xaa\xba\x29\x0d\xc7\x13\x4b\x2a\x46\xc0\x25\xfa\x89\xd6\x77\xb7\x10\xb8\x29\x61\x95\xd6\x1b\xec\x8b\x5a\x8f\xb0\x2f\x29\xfd\xe6\x54\x8e\x99\xfd\x97\xf2\x1a\x6a\x30\x27\xc1\x6a\x91\xe3\xa9\x45\xeb\x7c\x47\x2c\xd8\x90\xed\x14\x87\xad\x08\xa2\xbe\x36\x64\xdb\x32\xab\x54\xb9\xbb\xc7\x3e\x35\xd1\xcf\x84\xc5\xff\x42\x91\x00\x24\x77\x44\xa0\x0d\xa1\x80\x24\x43\x2c\x06\xce\x89\x07\xc8\x83\x0d\x8e\xa8\xcc\xcc\x22\x8e\x25\x61\x01\x62\x1b\xf4\x2d\x0d\x24\xfc\x96\x42\x64\x40\x02\x20\x51\xed\x65\xd2\xae\xfa\x40\x1b\xc6\x11\x8e\x31\xa1\x78\x4d\x01\x09\x90\x92\x04\x5b\x71\x92\x3f\x0e\x43\xd1\xcb\x8b\x30\x86\x90\xb2\xbd\x0f\xbf\x67\x9b\x20\x44\xf1\x1a\xa8\x68\xde\xb7\x7a\x72\xaa\xc1\x20\x61\xbb\x4f\xb5\x39\xa3\x94\x04\xdb\xe7\xd0\xc3\x12\xd2\x25\x84\x7c\xfc\xdd\x89\xf8\x16\x4c\xd4\x3f\xac\x3c\x07\x79\x9a\x26\xba\x3e\x19\x17\x3e\x96\xee\xee\xa9\x10\x47\x7d\x24\x08\x49\xf0\x43\x9a\x3b\x2c\x96\x40\xfd\xd1\x12\x4a\x13\x0e\x42\x3a\xab\xe4\xff\xd2\x00\xb2\xeb\x8b\xa9\xfe\xd4\x1a\x26\x01\xf0\xdc\x91\x91\xd5\xbf\x4a\x1b\x21\xe2\xe3\x6d\xc5\xe1\x35\x51\xcb\xe8\xfd\xdd\x3c\x16\x64\x9d\x4f\xfb\x53\x80\x98\x47\x94\xce\x19\x25\x6e\xb6\x95\x26\xe5\xc5\xa2\x3e\x04\xf1\xa1\x08\x3a\xba\xc7\xe7\xe9\x70\x65\xd9\x2f\x93\xc5\xcc\x9e\x5a\xf6\x32\x57\x40\x28\xc6\x34\x02\x13\x5d\x1d\xa6\xc8\x55\xb5\xb9\xb3\x9c\x2d\xac\xd5\xf2\x75\x6e\xfd\xba\xf5\xe3\xf3\xbd\xb5\xb0\xad\xa5\xe5\xac\x9c\x57\x67\x69\x4d\x57\xf6\x70\x6a\x39\xf3\xe1\xa8\x02\xb4\x82\xb2\x15\xc0\x0f\x96\x6d\x2d\x86\x4f\xab\xe1\xf8\xc5\x5a\x2c\x27\x8e\x35\x5e\xfd\x7b\xe6\x2c\x15\x6e\x35\x64\xfd\x2d\xa2\xdb\xce\xa3\x33\x76\x56\x8e\xb5\x78\xb1\x16\xab\x87\xc5\x7c\xb4\x9a\xcf\x16\x55\x05\x55\x47\x7e\x4d\x31\xfe\xdb\x1a\x61\x50\x83\x30\x9c\x4f\x34\x42\xad\xf1\xa0\x5f\x63\x7c\x3f\x9b\x2d\x9d\xe5\x62\x38\x3f\x0f\x71\x53\xd7\xce\x43\x06\xcb\x27\x67\x35\xb2\x16\xcb\xd5\xd7\xc9\x53\x45\xc9\x7b\x31\xe6\x3d\x1e\x05\x3d\x91\x9c\x59\x22\x19\x84\xea\xa0\xd2\xa7\x6b\x4f\x9f\x42\xbd\xec\x7c\x69\xe5\xf1\xd1\x7a\xfd\x3d\x0e\xdf\x60\x5f\xed\xb0\xc0\xd5\xe1\x78\x3a\x71\x9c\xc9\xcc\x3e\x57\xb0\xdb\xdb\x0f\x35\x05\x6b\x42\x4b\xaa\x37\x9e\x2c\x2e\xcd\xe5\xf8\x3c\xef\x15\xce\xf3\x66\xce\x2c\xac\xe1\x78\x35\xb3\x9f\x5e\x2b\x92\x90\x3c\x82\xf3\x49\x8c\xec\xc9\xca\xb2\x87\xf7\x4f\xd6\xb8\x02\x23\xd9\x67\xf6\xc4\x0a\xd4\xe0\xf7\xd0\xfb\xfb\x01\x10\xf3\xad\x28\x0e\x28\x1e\x05\x85\x2f\xc3\xa0\x6c\x6b\x50\x88\x81\x7e\x21\xc1\x86\x95\x44\xe9\x91\x6b\xa8\x23\xf9\x4b\x0f\xa4\x5b\xae\x46\x69\x02\xf7\x0a\xa7\x7a\x8e\x91\x5f\xff\x35\x64\x3e\xce\x4b\x17\xfb\x3a\xa9\xbe\xc2\xd7\x49\x07\x8d\xd2\xcf\x4d\xd2\x41\xbf\x51\x7a\xd3\x28\x3d\xc4\x4c\x49\x0c\x01\x08\x31\xe7\x6c\x0d\x66\xa1\x2f\xea\x82\xff\x00\xb2\xb8\x84\x50\x88\xe5\xce\x44\xbd\x1d\x60\x2a\x77\xfb\xb2\x48\x63\x5f\xe7\xcb\x1c\xb0\x47\x2e\x06\x57\x56\x2d\xa0\x05\x8b\xb8\x0b\xa2\x08\xc1\xe1\xff\x11\x08\x29\xca\xb0\x6e\x18\x99\xa8\x7f\x7d\xed\x97\x56\x7d\xf0\x19\xdf\x9b\xe8\xe6\xe3\xdd\x94\xe4\x92\x98\xd1\xc8\x87\xa9\x3a\xd6\xc5\xe9\x91\x58\x75\xb9\xcf\xf1\x94\xcd\x3c\xcd\xa0\xf5\x34\x29\xc5\x8e\xbd\x59\x40\xf7\x26\x52\x9b\xa9\xda\x75\xd3\x65\xfc\xe2\x38\xce\x4f\x82\x76\x41\xd5\x5c\xa3\xab\xe2\x69\xde\x7f\xe7\xfc\xa6\xbd\x39\xb9\x45\xd5\x37\x25\x4d\xbb\x48\x86\x74\xc5\x6e\xb4\xbb\xb0\xe2\x2d\x9c\x9c\x03\x69\x5f\x4e\x57\xbf\x69\x8a\xfe\xce\x19\x9f\xbc\x10\x74\x38\x1c\xb6\x24\xb9\xa4\x13\x16\x74\xdf\x06\xc9\x53\x30\xee\xaf\x41\x62\xfd\x7c\x98\x46\x12\xab\x67\xc6\x7f\x60\xbd\x63\xec\x6d\x54\x7c\xbf\x9c\x7f\x31\xfa\x99\xb5\xf1\x47\x6a\x6e\x94\xde\x3f\x9d\x6c\x55\x98\x1d\x5d\x00\x1f\xc4\xae\x9b\x3d\x96\x80\x77\xcb\x70\xdd\x8c\x39\x1d\x84\x36\x98\xd0\x88\x83\xbe\xdd\x7e\xc5\x44\x0d\x6c\x97\x12\x08\x64\x1a\x63\x5a\x1f\x17\xdf\x47\x81\x47\xe1\x82\xd7\x67\x7e\xb9\xd7\x15\x6e\x7e\x0f\x1d\xea\x7f\xf6\xc7\x26\x74\x98\x70\x59\x8a\x46\x92\x20\x61\x46\xdc\xc7\x34\xdc\xe1\xbe\xa1\x0a\xd0\x41\x88\x47\x14\xb2\xdf\x9c\x70\x48\x1e\x38\x8b\xc2\x8c\xf6\x06\x3a\x54\x41\xbd\x57\x74\x57\x73\xb1\x86\x4a\x3e\x59\x08\x69\xad\x73\xf1\x68\x61\x0d\x97\x56\xf6\xf1\x3c\x1f\xeb\x8f\xa3\x71\x6a\x24\xad\x80\x8a\xd7\x65\x7b\xee\xbc\x60\x4a\xbc\x8b\xd9\x13\xe7\x56\x67\x59\x73\xd8\x38\x99\x11\x6b\xa0\x4c\x1d\x69\xaa\x68\xf3\x8b\xc4\x39\xa1\x4e\x1b\xf2\x5c\x44\x9f\x9c\x40\x59\xc2\x70\xc2\xa0\xb4\x99\x9a\x3e\x69\x2b\x8f\x28\xa4\x97\x8b\xb5\xa9\x24\x93\x56\x2c\x61\x57\xd1\x4a\x2b\x16\xc8\xa5\x97\x0a\x14\xab\xa0\x99\xd6\x4a\x76\x03\x09\xfe\x07\x6e\x82\x5a\x92\x49\x8e\x37\x1b\xe2\x52\xb6\xad\x5c\x0f\x81\x67\xcd\xa9\x14\x73\x16\x49\xa8\x94\x48\x8e\xdd\x23\x89\xa2\x63\x32\x39\xcb\xcb\xe9\x65\xc7\xdd\x81\xfb\x56\x16\x64\x7b\xa4\xb8\x14\x72\xf6\x7d\xaf\x7f\x74\x10\x05\x8a\x66\xd4\xe8\xfe\x73\xa9\x1a\xf7\x8d\xcc\xe9\x05\x24\xbd\xba\x6a\xc3\xcf\xbf\x8f\x99\x59\xc4\xa2\xf3\x67\x00\x00\x00\xff\xff\x98\xed\xf1\x6f\x7f\x18\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xe5\x58\xdd\x73\xda\x38\x10\x7f\xe7\xaf\xd0\xe4\x9e\x0d\x21\xd7\xa6\x94\x99\x3e\x10\x70\x73\x4c\x82\x61\x30\xc9\x5d\x9e\xa8\x30\x0b\xf8\x22\x7f\x9c\x24\xd3\x32\x6d\xff\xf7\x5b\xd9\x96\xb1\xc1\x36\xd0\xf6\x9e\x2e\x2f\xb1\xb4\x9f\xda\xfd\x69\x77\x85\x61\x18\x0d\x1a\xba\xcf\xc0\x85\x1b\xf8\x5d\xb2\x6d\x37\x5e\x5d\x7f\xd9\x25\x36\xf0\xad\xeb\x40\xc3\x03\x49\x97\x54\xd2\x6e\x83\x10\x9f\x7a\xd0\x25\x5f\xbf\x92\x66\x3f\xf0\x25\x0f\xd8\x84\x51\x1f\x52\x4e\x0b\x89\xe4\xfb\xf7\x94\x4d\x84\xd4\x49\x79\x2d\xbd\x54\x54\x11\x82\xa3\x54\x85\x01\x97\x42\x7d\x18\xf1\x67\x97\xbc\x79\xf3\x3b\xae\xb4\x8d\x8d\x94\xa1\x30\xe8\xd2\x73\x85\xf2\xcb\x10\x68\x03\x78\xcc\x20\x29\x5f\x83\x9c\xc4\x42\x6f\x13\x29\xad\xe3\xed\xed\xbb\xdb\x9c\x12\x8f\x2e\xc5\x5e\x32\xc7\xf4\x2e\xc7\xb4\xe6\xa1\x63\x88\xa5\x28\x72\x74\x0e\x39\xbe\x1c\x72\xbc\x3f\xf0\xf6\x88\xa3\xd3\x3e\xe4\xc0\x38\x97\xb9\xd3\xb9\x39\x64\x5c\x04\x81\x14\x92\xd3\xb0\x94\x3d\x1f\xa7\x75\x94\x53\x29\x80\x81\x23\x03\xde\x8d\x19\x68\x18\x76\xc9\x6b\xe4\x51\xc3\x49\x92\x65\x84\x2a\x5b\x0d\xe3\x44\xc6\x7b\x8e\x13\x44\xbe\x2c\x49\x7c\x89\xb2\xfa\x64\xd7\x98\x72\x38\xc8\x86\xdc\x85\xb1\xda\x05\x70\x1f\x24\x88\xa6\x1b\xb4\x24\x13\x55\xa6\x31\x4b\x06\x92\x0d\x07\xb8\x3c\x61\x59\x4b\x23\x7b\xd3\x51\x71\x53\x1c\xf6\x52\xcc\x98\xe8\xa3\x34\xf9\x46\x16\xb7\x6f\xc0\x77\x12\xc0\x2a\xae\x57\xd8\xe5\xb9\x1e\x60\x57\x60\xfa\xc5\x47\x39\x44\xf6\x4f\x9d\xab\xa7\x95\xd9\xb1\xae\x33\xce\x78\x2c\x71\xf6\x79\xf1\xe6\xaf\xdc\xf5\x88\x86\x67\x01\x44\xad\x90\xfd\xcc\x53\x25\xcc\xcd\x1d\xf5\x58\x97\x7c\x8b\x51\xfc\x1b\x89\x04\x10\xb9\x71\x05\x59\xb9\x0c\xbf\x02\x12\xa0\xc7\xdc\x5d\x02\x59\xc2\x8a\x46\x4c\xa6\x62\x11\xa7\x12\x5d\x25\xc1\x8a\x7c\x4a\x1c\x09\x3f\x25\x2a\x52\x45\x02\x20\x66\x6d\xa5\xd4\xa6\x5a\x90\x55\xc0\x09\xdd\x52\x97\xd1\x05\xaa\x17\x20\xa5\xeb\xaf\xc5\xd1\xf9\xf1\x36\x89\x56\x16\x84\x01\x84\x2c\xd8\x79\xf0\x6b\xae\x09\x21\x68\x1c\x98\xa8\xbf\xb7\xba\x72\xaa\xc2\x20\x61\xbd\x4b\xb8\x91\xce\xd0\xe1\xa7\x10\x7d\x80\x64\x8b\x60\xe1\xfb\x62\x47\x58\x25\xbb\xa4\xbd\xdf\x79\xf2\xb3\x63\x76\xc9\xf5\x51\xb9\xf0\xa8\x74\x36\x8f\x39\x3f\xaa\x3d\x41\x30\x81\x87\x9f\xda\x60\x3e\x04\xea\x8f\x15\xb4\xd4\xe9\x41\x27\xd2\x53\xc5\xdf\x85\x02\x64\x55\x07\x53\xfd\xa9\x3d\xea\xfa\x98\x1f\x2d\x6e\xa4\xf1\x2f\xe3\x26\xc4\xf5\xe8\xba\xa4\x79\x0d\xd5\x36\x26\xa1\x7b\x48\x48\x33\x9f\xe4\x27\xa7\x62\x12\x31\x36\x09\x98\xeb\xa4\x57\x69\x58\xdc\xcc\xf3\x83\xbf\xdd\x07\x41\x7b\xf7\xf0\x34\xea\xcd\x4d\xeb\x79\x38\x1d\x5b\x23\xd3\x9a\x65\x0c\x84\x6c\x29\x8b\x90\xe3\x6a\x5f\x45\xae\xca\xc5\xed\xd9\x78\x6a\xce\x67\x2f\x13\xf3\xc7\xa5\x1f\x9e\xee\xcc\xa9\x65\xce\x4c\x7b\x6e\xbf\xd8\x33\x73\x34\xb7\x7a\x23\xd3\x9e\xf4\xfa\x25\x4a\x4b\x20\x5b\xa2\xf8\xde\xb4\xcc\x69\xef\x71\xde\x1b\x3c\x9b\xd3\xd9\xd0\x36\x07\xf3\x3f\xc6\xf6\x4c\xe9\x2d\x57\x59\x3d\x45\x34\xcf\xb3\x68\x0f\xd0\x7b\x73\x8a\xe6\xe6\xf7\xd3\x49\x7f\x3e\x19\x4f\xcb\x02\xaa\x5a\x7e\x45\x30\xfe\x3a\x5b\x43\xa7\x42\x43\x6f\x32\xd4\x1a\x2a\x85\x3b\xed\x0a\xe1\xbb\xf1\x78\x66\xcf\xa6\xbd\xc9\x69\x15\x37\x57\x27\x63\x30\x7b\xb4\xe7\x7d\x0c\xfd\xfc\xe3\xf0\xb1\x24\xe4\xad\x2d\xe5\x2d\x1e\xf9\x2d\x11\xf7\x2c\x11\x17\x42\xd5\xa8\x74\x77\x6d\xe9\x2e\xd4\x4a\xfb\xcb\x59\x16\x1f\xcc\x97\x5f\x63\x10\xdb\x53\xb9\xc1\x1c\x56\x7b\x83\xd1\xd0\xb6\x87\x63\xeb\x54\xc0\x70\x32\xbc\xba\x5c\x5b\x1c\xbd\xc1\x70\x7a\xe9\x59\x0e\xfb\x79\x2b\xd7\xcf\xeb\x31\x33\x35\x7b\x83\xf9\xd8\x7a\x7c\x29\x39\x84\xe4\x11\x9c\x3e\x44\xdf\x1a\x62\x41\xe9\xdd\x3d\x9a\x83\x12\x1d\xf1\x3d\xb3\x86\xa6\xaf\x0a\xff\x12\x6f\xd2\x5e\x21\x0e\xd2\x22\x5f\xa0\xf0\x74\xb9\x95\x61\xb0\x60\x6d\x30\xd8\x02\xfb\xe0\xfa\xab\xa0\x40\x4a\x5a\xae\xa1\x5a\xf2\x87\x16\x48\xa7\x18\x8d\x42\x05\x6e\xe5\xba\x7a\xa6\x23\x1b\xff\xb5\xca\xac\x9c\x17\x06\xfb\x2a\xaa\x1e\xe1\xab\xa8\x9d\x5a\xea\xfb\x3a\x6a\xa7\x5d\x4b\xbd\xa9\xa5\xee\x7d\x66\xee\x16\x7c\x10\x62\xc2\x83\x05\x74\x73\x79\x51\x03\xfe\x3d\xc8\xfc\x16\x86\x83\xca\x0d\x42\x6c\x03\x94\xc9\xcd\xae\x48\xd2\xba\xaf\xb3\x6d\x0e\x74\xe9\x5e\xac\x5c\x49\x9d\xa1\x5a\x04\x11\x77\x40\xe4\x55\x70\xf8\x27\x02\x21\x45\x51\xad\x13\x46\x38\x5f\x5c\x5f\x7b\x85\x5d\x0f\xbc\x80\x63\x6b\xbc\x79\x7b\x3b\x72\x33\xca\x36\x60\x91\x07\x23\xd5\xd6\xc5\x71\x4b\x2c\x1b\xee\x33\x7d\x4a\x66\x92\x9c\xe0\xec\x6a\x52\xf0\x9d\x2e\xc7\x3e\x43\x8f\xd4\x65\x2a\x37\x5d\x37\x8c\x5f\xec\xc7\xe9\x4a\x70\x9e\x53\x15\x63\x74\x99\x3f\xf5\xf7\xef\x94\xdd\x24\x37\x47\x53\x54\x75\x52\x92\x63\xe7\xc1\x90\xec\x58\xb5\x72\x17\x46\xfc\x0c\x23\xa7\x94\x9c\x1f\x4e\x47\xbf\x69\xf2\xf6\x4e\x09\x1f\xbd\x10\xb4\x3b\x1c\xd6\x6e\x3c\xa4\xe3\x77\xf3\xb5\x13\x3f\x05\xb7\xed\x05\x0e\xc9\xfa\xf9\x30\x8a\x24\x55\xcf\x8c\x3f\x61\xb1\x09\x82\xd7\x7e\xfe\xfd\x72\xfa\xc5\xe8\xa5\xd2\xc6\xe7\x44\xdc\x28\xbc\x7f\x1a\xe9\x2e\x26\x54\x07\x00\xb3\xbb\x69\xa6\x8f\x25\xe0\xcd\xa2\xba\x66\x8a\x1c\xb4\xb6\xc2\xe7\x41\xc4\x41\x4f\xb7\x1f\x71\xa9\x1e\x65\xcc\xc5\x77\x4e\xe2\x63\x12\x1f\x87\xde\x45\xfe\x92\xc1\x05\xaf\xcf\x6c\xb8\xd7\x11\xae\x7f\x0f\xed\xe3\x7f\xf2\xc7\xa6\x5c\x85\x4b\x8f\x68\xc4\x07\x74\x03\x63\xdb\xa6\x2c\xdc\xd0\xb6\xa1\x02\x80\xac\x3c\x62\x90\xfe\xe6\x84\x89\xbb\xe7\x41\x14\xa6\xb0\x37\xc8\x3e\x0a\xea\xbd\xa2\xb3\x9a\x91\xb5\xaa\x78\x19\x84\x90\xc4\x3a\x23\xf7\xb1\x8b\xcf\xcc\x74\xf1\x34\x19\xe8\xc5\x41\x39\x35\xe2\x54\x80\xf8\x19\xec\x3c\x53\xe6\x2e\x2f\x46\xcf\x36\x93\x3a\x89\x9a\xfd\xc5\x49\x85\x82\x1a\xc8\x54\x81\xa6\x0c\x36\x3f\x08\x9c\x23\xe8\x9c\x03\x9e\x8b\xe0\x93\x01\x28\x3d\x30\x1c\x21\x28\x49\xa6\x86\x4f\x92\xca\x03\x08\xe9\xed\x7c\x6c\x4a\xc1\xa4\x19\x0b\xba\xcb\x60\xa5\x19\x73\xe0\xd2\x5b\x39\x88\x55\x76\x6d\x9c\x4f\x5c\xee\x44\xae\x5c\x60\xd9\x7f\x45\x17\x0a\xb4\xf8\xa6\xb8\xfe\xdf\xe0\xc4\x16\x0b\x34\x05\xd2\x35\x86\xe1\x33\xdd\x15\x09\x08\xcc\xd5\xca\x75\x70\x2e\x2c\xdd\x47\xff\xd3\x8c\x96\x92\x31\x58\xf8\x28\x2d\xa3\xe0\x3f\xe7\x80\xa2\x30\x1c\x97\xdb\xe2\x76\x32\x21\x39\x1b\x70\x5e\x8f\x7d\x3e\xe0\x0d\x79\xf0\x65\xa7\x7f\xa9\x10\x39\x5c\xa7\x78\x6a\xfe\x7f\xf1\xbd\x6d\x1b\xa9\xd1\x0b\x90\x7d\x75\x75\x0e\xa8\xff\x3b\x38\xa7\x1e\x8b\xc6\xbf\x9b\x1a\x74\xe5\xb4\x18\x00\x00"),
 		},
 		"/kuma-cp/rbac.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "rbac.yaml",
-			modTime:          time.Date(2020, 4, 17, 18, 48, 17, 819780963, time.UTC),
-			uncompressedSize: 2511,
+			modTime:          time.Date(2026, 10, 15, 21, 58, 23, 260882000, time.UTC),
+			uncompressedSize: 2731,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x55\xc1\x6e\xdb\x30\x0c\xbd\xfb\x2b\x88\xec\x36\xc0\x2e\x76\x2b\x7c\xdb\x8a\x61\x18\x30\xf4\xd0\x0e\xbb\x33\x32\x1d\x73\x91\x25\x83\xa2\xd2\xad\x46\xff\x7d\x90\x9d\xac\x4d\xdc\xa6\x71\xd7\xa1\xa7\x50\x8a\xf4\xde\x13\xfd\xa4\x97\xe5\x79\x9e\x61\xc7\x3f\x48\x02\x7b\x57\x82\x2c\xd1\x14\x18\xb5\xf1\xc2\xb7\xa8\xec\x5d\xb1\x3e\x0f\x05\xfb\xb3\xcd\x87\x6c\xcd\xae\x2a\xe1\xc2\xc6\xa0\x24\x57\xde\x52\xd6\x92\x62\x85\x8a\x65\x06\xe0\xb0\xa5\x12\xd6\xb1\xc5\xd2\x78\xa7\xe2\x6d\xde\x59\x74\x94\x49\xb4\x14\xca\x2c\x07\xec\xf8\x8b\xf8\xd8\x85\xb4\x3c\x87\xc5\x22\x03\x10\x0a\x3e\x8a\xa1\xed\x5c\x02\x09\x1d\x1a\x0a\xc3\xb0\xf3\xd5\x58\x04\x92\x0d\x8f\xb3\x1b\x92\xe5\x76\xf5\x8a\x74\xf8\xb5\x1c\xc6\xe2\x06\xd5\x34\x53\xa6\x24\xaa\x60\x3f\xa5\x4b\xda\x07\x91\x61\x7f\xc8\x2e\xf0\xaa\xd1\x71\xb6\xa5\xd0\x9c\xc8\x9c\x2a\x23\x84\x4a\x43\x19\xbb\x6a\x57\x76\x7f\xff\xaf\xc8\x92\xd2\x0c\x91\x0d\xa1\xd5\xc6\x34\x64\xd6\xaf\x7d\xfe\x4e\xfc\xaf\xdf\x4a\x6d\x67\x51\xdf\xf2\x88\xfb\x3a\xce\x82\xa2\xc6\x27\xe4\x4c\x08\x4f\x67\x51\xc1\xba\x66\xd3\x91\xb4\x1c\x92\xdf\x5f\xbb\x9d\x5b\x02\xeb\x57\xff\x09\x59\x7c\x3c\xf5\x3b\x1d\x60\x1f\x41\x7f\x80\xaf\x82\x07\x97\xec\x9e\xe1\x01\xc7\x4b\x59\x6a\x8c\x56\xd9\xfd\x24\xa3\x87\xdd\x9f\xcf\x33\x7d\x3e\x76\x2f\xc6\x59\xcd\x0e\x2d\xdf\x92\x4c\x28\x16\xef\x17\x33\x35\x8f\xb7\xff\x19\xc8\xbe\x07\xae\xa1\xb8\xb8\xfc\xfa\xd9\xe1\xd2\x52\x05\x77\x77\x8f\xf2\x9c\x87\xc2\x38\x2e\x8c\x33\xf5\x13\x7c\x8e\xf4\xc6\xcb\x3a\x47\x55\x34\x4d\x4b\x4e\xf3\x8a\x6a\x76\x3c\xb7\x65\x63\xbd\xbd\xaa\x7d\x0f\xe4\x06\x55\xef\x60\x83\x96\xd3\x1d\x4a\x6a\x40\xfd\x9a\x1c\x2c\xa9\xf6\x42\xc0\x21\x44\x62\xb7\x82\xf6\xfb\xb7\x6b\x30\x24\x3a\xb5\x68\x0a\x07\x72\xca\xe6\x61\x3a\x3c\x62\xd8\x84\x2b\xb4\x61\xba\x39\xf0\xeb\x56\xd1\xbf\x25\xcf\x27\x76\x15\xbb\xd5\x89\x01\xe4\x2d\x5d\x51\x9d\xd6\xec\x0e\x73\x84\x2f\x03\x98\x06\xdd\x11\xf4\x10\x97\xc9\xd0\x43\xc2\x8d\x1b\xaf\xc7\xb0\xfa\x68\x8c\x8f\x4e\xf7\xf6\xe6\xfb\x7b\xe1\x3e\xf0\x4a\xe8\x7b\x28\x2e\x77\xc3\xc1\x40\xf3\x5b\x74\x7a\x2a\x1f\xa7\x9e\x93\xd9\x81\x8c\x90\xbe\x7e\x7a\xbc\xec\xf4\xb3\x9c\xf1\x4c\x13\x5e\xe6\x9b\xb7\x33\xcc\x9f\x00\x00\x00\xff\xff\x92\x65\x52\x7d\xcf\x09\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc5\x56\x4d\x6f\xdb\x30\x0c\xbd\xfb\x57\x08\xd9\x6d\x80\x13\xec\x36\xf8\xb6\x15\xc3\x30\x60\xe8\xa1\x1d\x76\xa7\x65\xda\xd6\x2c\x4b\x86\x44\x25\x6b\x8b\xfe\xf7\x51\xb6\xb3\x26\x71\x9a\x26\x69\x8a\x1e\x02\x51\x8c\xc4\xf7\x48\xf1\xc3\x49\x9a\xa6\x09\x74\xea\x37\x3a\xaf\xac\xc9\x84\xcb\x41\xce\x21\x50\x6d\x9d\xba\x07\x62\xdd\xbc\xf9\xec\xe7\xca\x2e\x96\x9f\x92\x46\x99\x22\x13\x57\x3a\x78\x42\x77\x63\x35\x26\x2d\x12\x14\x40\x90\x25\x42\x18\x68\x31\x13\x4d\x68\x21\x93\xd6\x90\xb3\x3a\xed\x34\x18\x4c\x5c\xd0\xe8\xb3\x24\x15\x0c\xf4\xdd\xd9\xd0\xf9\x78\x3c\x15\xb3\x19\x2f\x0e\xbd\x0d\x4e\xe2\xa8\x8b\x46\x7c\x07\xbc\xef\xb7\x9d\x2d\x06\xc1\xa3\x5b\xaa\x41\xbb\x44\x97\x8f\xa7\x2b\xa4\x7e\xd5\xca\x0f\xc2\x0a\x48\xd6\x53\xa4\x48\x8a\x7d\x98\xc2\x45\xee\x3d\x49\xbf\xbd\x55\xc6\xab\xaa\xa6\x41\xcb\x8c\xea\x23\x91\xa3\x24\x1d\x02\x61\x2f\x86\xae\x58\x8b\xdd\xff\xff\x0b\xd4\xc8\xca\xe3\x49\xd6\x08\x9a\x6a\x59\xa3\x6c\x2e\xed\x7f\xe7\xec\xdf\x3b\xc2\x96\x9d\xa6\xf7\x74\x71\x9b\xc7\xc2\x13\x50\x78\x86\xce\x04\xf0\x78\x14\x72\x50\x96\x4a\x76\xe8\x5a\xe5\x63\xbe\x5f\x3a\x9c\x23\x80\xb6\xd5\x1b\x59\xe6\xa3\x74\x5e\x11\x1c\xb0\xbe\x61\x9f\x97\x9d\x22\x7b\x42\xd8\xc0\x38\x17\xa5\x84\xa0\x49\x99\x3f\x28\x69\x37\xfa\x17\xc5\x89\x15\x5b\x71\x96\xac\xe0\xee\xed\x40\xa4\x72\x32\x28\xca\xb9\x16\x1a\x6e\x9f\xaf\xc3\x99\xf6\xc2\x75\xfb\x5b\x94\xca\x80\x56\xf7\x7b\x20\x66\x1f\x67\x67\x04\x06\x5f\x32\xf9\xf0\x20\x54\x29\xe6\x57\xd7\x3f\xbe\x19\xc8\x35\x16\xe2\xf1\x71\x2f\x0e\x0f\x06\x69\x14\xff\x64\xf9\x0c\x9e\x41\x5a\x59\xd7\xa4\x40\x04\xb2\x6e\xd1\x50\x5a\x20\xa3\xab\x53\xdf\x7f\x0c\xf9\xd0\x77\x98\x20\x9a\x9e\xd5\x07\xb1\x64\x47\x62\x43\x88\x6c\x04\xd9\x06\x8d\xc8\xb1\xb4\x0e\x05\x97\x78\xe0\x46\x5e\x89\xf6\xd7\xcf\x5b\x21\xd1\xd1\xb4\xde\xe2\xa4\x63\x52\x4a\x6e\x8e\xba\x3d\xd5\x17\xed\x3a\x5c\x2a\x5c\xed\x14\xdf\xc8\xe8\x75\x63\xf4\x2b\x2b\x98\xe8\x91\xd3\x94\x2f\xdc\x60\x19\xcf\xac\x9d\x39\x80\xc7\xa7\xa6\x53\xfb\x80\x75\x1f\xf2\x58\x9d\xfd\xb8\x1e\x2e\xde\x0e\x93\xf7\x8b\x94\x36\x18\xda\xba\x9b\x6e\xdf\x15\x4f\xd3\x3b\x13\xfc\x46\xf3\xeb\xf5\xb6\x4f\xa0\xd3\x43\x74\xfc\x27\xc6\x61\xe8\x53\x3e\x40\x3c\xf2\x93\x8e\x83\x9f\x31\x4a\x55\xb5\xd0\x5d\x7e\x32\x9e\x17\x8c\x93\x12\xe5\x85\x98\x9c\x97\x46\xef\x97\x3f\xff\x00\xd2\x29\x77\x31\xab\x0a\x00\x00"),
 		},
 		"/kuma-injector": &vfsgen۰DirInfo{
 			name:    "kuma-injector",
@@ -146,11 +160,13 @@ var Templates = func() http.FileSystem {
 		fs["/namespace.yaml"].(os.FileInfo),
 	}
 	fs["/crds"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/crds/kuma.io_circuitbreakers.yaml"].(os.FileInfo),
 		fs["/crds/kuma.io_dataplaneinsights.yaml"].(os.FileInfo),
 		fs["/crds/kuma.io_dataplanes.yaml"].(os.FileInfo),
 		fs["/crds/kuma.io_faultinjection.yaml"].(os.FileInfo),
 		fs["/crds/kuma.io_healthchecks.yaml"].(os.FileInfo),
 		fs["/crds/kuma.io_meshes.yaml"].(os.FileInfo),
+		fs["/crds/kuma.io_meshgateways.yaml"].(os.FileInfo),
 		fs["/crds/kuma.io_proxytemplates.yaml"].(os.FileInfo),
 		fs["/crds/kuma.io_trafficlogs.yaml"].(os.FileInfo),
 		fs["/crds/kuma.io_trafficpermissions.yaml"].(os.FileInfo),
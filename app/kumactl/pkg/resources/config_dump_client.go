@@ -0,0 +1,61 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	config_proto "github.com/Kong/kuma/pkg/config/app/kumactl/v1alpha1"
+	"github.com/Kong/kuma/pkg/core/rest/errors/types"
+	kuma_http "github.com/Kong/kuma/pkg/util/http"
+)
+
+type ConfigDumpClient interface {
+	// ConfigDump returns xDS configuration of a dataplane held by the Control Plane.
+	ConfigDump(ctx context.Context, meshName string, dataplaneName string) (json.RawMessage, error)
+}
+
+func NewConfigDumpClient(coordinates *config_proto.ControlPlaneCoordinates_ApiServer) (ConfigDumpClient, error) {
+	client, err := apiServerClient(coordinates.Url)
+	if err != nil {
+		return nil, err
+	}
+	return &httpConfigDumpClient{
+		Client: client,
+	}, nil
+}
+
+type httpConfigDumpClient struct {
+	Client kuma_http.Client
+}
+
+func (c *httpConfigDumpClient) ConfigDump(ctx context.Context, meshName string, dataplaneName string) (json.RawMessage, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("/meshes/%s/dataplanes/%s/xds", meshName, dataplaneName), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		kumaErr := types.Error{}
+		if err := json.Unmarshal(b, &kumaErr); err == nil && kumaErr.Title != "" && kumaErr.Details != "" {
+			return nil, &kumaErr
+		}
+		return nil, errors.Errorf("(%d): %s", resp.StatusCode, string(b))
+	}
+	if !json.Valid(b) {
+		return nil, errors.New("xDS configuration returned by the Control Plane is not a valid JSON")
+	}
+	return b, nil
+}
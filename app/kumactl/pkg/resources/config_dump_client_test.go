@@ -0,0 +1,70 @@
+package resources
+
+import (
+	"bufio"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("httpConfigDumpClient", func() {
+	Describe("ConfigDump()", func() {
+		It("should fetch config dump of a dataplane", func() {
+			// given
+			client := httpConfigDumpClient{
+				Client: &http.Client{
+					Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						Expect(req.URL.String()).To(Equal("/meshes/default/dataplanes/example/xds"))
+
+						file, err := os.Open(filepath.Join("testdata", "config-dump.json"))
+						if err != nil {
+							return nil, err
+						}
+						return &http.Response{
+							StatusCode: http.StatusOK,
+							Body:       ioutil.NopCloser(bufio.NewReader(file)),
+						}, nil
+					}),
+				},
+			}
+
+			// when
+			configDump, err := client.ConfigDump(context.Background(), "default", "example")
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			expected, err := ioutil.ReadFile(filepath.Join("testdata", "config-dump.json"))
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect([]byte(configDump)).To(MatchJSON(expected))
+		})
+
+		It("should return error from the server", func() {
+			// given
+			client := httpConfigDumpClient{
+				Client: &http.Client{
+					Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: http.StatusNotFound,
+							Body:       ioutil.NopCloser(strings.NewReader(`{"title": "Could not retrieve xDS configuration", "details": "Not found"}`)),
+						}, nil
+					}),
+				},
+			}
+
+			// when
+			_, err := client.ConfigDump(context.Background(), "default", "example")
+
+			// then
+			Expect(err).To(MatchError("Could not retrieve xDS configuration (Not found)"))
+		})
+	})
+})
@@ -0,0 +1,69 @@
+package admin_server
+
+import (
+	"github.com/emicklei/go-restful"
+
+	"github.com/Kong/kuma/pkg/core"
+	"github.com/Kong/kuma/pkg/core/ca/revocation"
+	core_mesh "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+	rest_errors "github.com/Kong/kuma/pkg/core/rest/errors"
+)
+
+// revocationEndpoints revoke certificates of a compromised dataplane before they expire
+// and let a revoked dataplane be issued certificates again.
+type revocationEndpoints struct {
+	resManager        core_manager.ReadOnlyResourceManager
+	revocationManager revocation.Manager
+}
+
+func (r *revocationEndpoints) addRevokeEndpoint(ws *restful.WebService) {
+	ws.Route(ws.POST("/meshes/{mesh}/dataplanes/{name}/revoke").To(r.revokeDataplane).
+		Doc("Revokes certificates of a dataplane and stops issuing new ones to it").
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Param(ws.PathParameter("name", "Name of a dataplane").DataType("string")).
+		Returns(200, "OK", nil).
+		Returns(404, "Not found", nil))
+}
+
+func (r *revocationEndpoints) addUnrevokeEndpoint(ws *restful.WebService) {
+	ws.Route(ws.POST("/meshes/{mesh}/dataplanes/{name}/unrevoke").To(r.unrevokeDataplane).
+		Doc("Lets a revoked dataplane be issued certificates again. Revoked certificates stay revoked until they expire").
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Param(ws.PathParameter("name", "Name of a dataplane").DataType("string")).
+		Returns(200, "OK", nil))
+}
+
+func (r *revocationEndpoints) revokeDataplane(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	meshName := request.PathParameter("mesh")
+
+	dataplane := &core_mesh.DataplaneResource{}
+	if err := r.resManager.Get(request.Request.Context(), dataplane, core_store.GetByKey(name, meshName)); err != nil {
+		rest_errors.HandleError(response, err, "Could not revoke a dataplane")
+		return
+	}
+	if err := r.revocationManager.Revoke(request.Request.Context(), dataplane.GetMeta()); err != nil {
+		rest_errors.HandleError(response, err, "Could not revoke a dataplane")
+		return
+	}
+	log.Info("revoked certificates of a dataplane", "mesh", meshName, "name", name)
+	if err := response.WriteAsJson(map[string]interface{}{}); err != nil {
+		core.Log.Error(err, "Could not write the response")
+	}
+}
+
+func (r *revocationEndpoints) unrevokeDataplane(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	meshName := request.PathParameter("mesh")
+
+	if err := r.revocationManager.Unrevoke(request.Request.Context(), meshName, name); err != nil {
+		rest_errors.HandleError(response, err, "Could not unrevoke a dataplane")
+		return
+	}
+	log.Info("unrevoked a dataplane", "mesh", meshName, "name", name)
+	if err := response.WriteAsJson(map[string]interface{}{}); err != nil {
+		core.Log.Error(err, "Could not write the response")
+	}
+}
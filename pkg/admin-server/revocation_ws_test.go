@@ -0,0 +1,102 @@
+package admin_server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core/ca/revocation"
+	core_mesh "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+	"github.com/Kong/kuma/pkg/core/secrets/cipher"
+	secret_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
+	secret_store "github.com/Kong/kuma/pkg/core/secrets/store"
+	"github.com/Kong/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("Revocation Endpoints", func() {
+
+	var server *httptest.Server
+	var revocationManager revocation.Manager
+	var dataplane *core_mesh.DataplaneResource
+
+	BeforeEach(func() {
+		resourceStore := memory.NewStore()
+		resManager := core_manager.NewResourceManager(resourceStore)
+		revocationManager = revocation.NewManager(secret_manager.NewSecretManager(secret_store.NewSecretStore(resourceStore), cipher.None()))
+
+		err := resManager.Create(context.Background(), &core_mesh.MeshResource{}, core_store.CreateByKey("demo", "demo"))
+		Expect(err).ToNot(HaveOccurred())
+		dataplane = &core_mesh.DataplaneResource{
+			Spec: mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "127.0.0.1",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{
+							Port:        8080,
+							ServicePort: 80,
+							Tags: map[string]string{
+								"service": "web",
+							},
+						},
+					},
+				},
+			},
+		}
+		err = resManager.Create(context.Background(), dataplane, core_store.CreateByKey("web-01", "demo"))
+		Expect(err).ToNot(HaveOccurred())
+
+		ws := new(restful.WebService).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON)
+		endpoints := revocationEndpoints{resManager, revocationManager}
+		endpoints.addRevokeEndpoint(ws)
+		endpoints.addUnrevokeEndpoint(ws)
+		container := restful.NewContainer()
+		container.Add(ws)
+		server = httptest.NewServer(container)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should revoke a dataplane", func() {
+		// when
+		resp, err := http.Post(server.URL+"/meshes/demo/dataplanes/web-01/revoke", "application/json", nil)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(200))
+		Expect(revocationManager.IsRevoked(context.Background(), dataplane.GetMeta())).To(BeTrue())
+	})
+
+	It("should unrevoke a dataplane", func() {
+		// given
+		Expect(revocationManager.Revoke(context.Background(), dataplane.GetMeta())).To(Succeed())
+
+		// when
+		resp, err := http.Post(server.URL+"/meshes/demo/dataplanes/web-01/unrevoke", "application/json", nil)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(200))
+		Expect(revocationManager.IsRevoked(context.Background(), dataplane.GetMeta())).To(BeFalse())
+	})
+
+	It("should return 404 for a dataplane that does not exist", func() {
+		// when
+		resp, err := http.Post(server.URL+"/meshes/demo/dataplanes/non-existing/revoke", "application/json", nil)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(404))
+		Expect(revocationManager.RevokedCerts(context.Background(), "demo")).To(BeEmpty())
+	})
+})
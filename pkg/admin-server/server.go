@@ -165,6 +165,9 @@ func SetupServer(rt runtime.Runtime) error {
 	if !rt.Config().ApiServer.ReadOnly {
 		endpoints.addDeleteEndpoint(ws)
 		endpoints.addCreateOrUpdateEndpoint(ws)
+		revocation := revocationEndpoints{rt.ReadOnlyResourceManager(), rt.RevocationManager()}
+		revocation.addRevokeEndpoint(ws)
+		revocation.addUnrevokeEndpoint(ws)
 	}
 	webservices = append(webservices, ws)
 
@@ -0,0 +1,116 @@
+package api_server
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/emicklei/go-restful"
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache"
+
+	"github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	"github.com/Kong/kuma/pkg/core/resources/manager"
+	"github.com/Kong/kuma/pkg/core/resources/store"
+	rest_errors "github.com/Kong/kuma/pkg/core/rest/errors"
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+// configDumpEndpoints expose xDS configuration that is currently held in the cache of the Control Plane for a dataplane,
+// which is the configuration that has been or is about to be pushed to its Envoy.
+type configDumpEndpoints struct {
+	resManager manager.ResourceManager
+	snapshots  envoy_cache.SnapshotCache
+}
+
+// configDump is the xDS configuration of a dataplane.
+// Secrets are deliberately left out since they contain private keys.
+type configDump struct {
+	Node      string              `json:"node"`
+	Listeners configDumpResources `json:"listeners"`
+	Routes    configDumpResources `json:"routes"`
+	Clusters  configDumpResources `json:"clusters"`
+	Endpoints configDumpResources `json:"endpoints"`
+}
+
+type configDumpResources struct {
+	Version   string            `json:"version"`
+	Resources []json.RawMessage `json:"resources"`
+}
+
+func (r *configDumpEndpoints) addFindEndpoint(ws *restful.WebService, pathPrefix string) {
+	ws.Route(ws.GET(pathPrefix+"/dataplanes/{name}/xds").To(r.configDump).
+		Doc("Get xDS configuration of a dataplane").
+		Param(ws.PathParameter("name", "Name of a dataplane").DataType("string")).
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Returns(200, "OK", nil).
+		Returns(404, "Not found", nil))
+}
+
+func (r *configDumpEndpoints) configDump(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	meshName := request.PathParameter("mesh")
+
+	// make sure that the dataplane exists, so that a dump of a dataplane that is gone is not mistaken for an empty one
+	dataplane := mesh.DataplaneResource{}
+	if err := r.resManager.Get(request.Request.Context(), &dataplane, store.GetByKey(name, meshName)); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve xDS configuration")
+		return
+	}
+
+	proxyId := core_xds.ProxyId{Mesh: meshName, Name: name}
+	node := proxyId.String()
+	snapshot, err := r.snapshots.GetSnapshot(node)
+	if err != nil {
+		// a dataplane that is not connected to this Control Plane instance has no configuration yet
+		snapshot = envoy_cache.Snapshot{}
+	}
+	dump, err := newConfigDump(node, snapshot)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve xDS configuration")
+		return
+	}
+	if err := response.WriteAsJson(dump); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve xDS configuration")
+	}
+}
+
+func newConfigDump(node string, snapshot envoy_cache.Snapshot) (*configDump, error) {
+	dump := &configDump{Node: node}
+	for _, section := range []struct {
+		from envoy_cache.Resources
+		to   *configDumpResources
+	}{
+		{snapshot.Listeners, &dump.Listeners},
+		{snapshot.Routes, &dump.Routes},
+		{snapshot.Clusters, &dump.Clusters},
+		{snapshot.Endpoints, &dump.Endpoints},
+	} {
+		resources, err := newConfigDumpResources(section.from)
+		if err != nil {
+			return nil, err
+		}
+		*section.to = resources
+	}
+	return dump, nil
+}
+
+func newConfigDumpResources(resources envoy_cache.Resources) (configDumpResources, error) {
+	names := make([]string, 0, len(resources.Items))
+	for name := range resources.Items {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	dump := configDumpResources{
+		Version:   resources.Version,
+		Resources: []json.RawMessage{},
+	}
+	for _, name := range names {
+		content, err := util_proto.ToJSON(resources.Items[name])
+		if err != nil {
+			return configDumpResources{}, err
+		}
+		dump.Resources = append(dump.Resources, content)
+	}
+	return dump, nil
+}
@@ -0,0 +1,192 @@
+package api_server_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+
+	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Kong/kuma/api/mesh/v1alpha1"
+	api_server "github.com/Kong/kuma/pkg/api-server"
+	config "github.com/Kong/kuma/pkg/config/api-server"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	"github.com/Kong/kuma/pkg/core/resources/store"
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	"github.com/Kong/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("Config Dump Endpoints", func() {
+	var apiServer *api_server.ApiServer
+	var resourceStore store.ResourceStore
+	var xdsContext core_xds.XdsContext
+	var stop chan struct{}
+
+	BeforeEach(func() {
+		resourceStore = memory.NewStore()
+		xdsContext = core_xds.NewXdsContext()
+		apiServer = createTestApiServerWithXds(resourceStore, xdsContext, config.DefaultApiServerConfig())
+		client := resourceApiClient{
+			address: apiServer.Address(),
+			path:    "/meshes",
+		}
+		stop = make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			err := apiServer.Start(stop)
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		waitForServer(&client)
+	}, 5)
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	BeforeEach(func() {
+		// given
+		err := resourceStore.Create(context.Background(), &mesh_core.MeshResource{}, store.CreateByKey("mesh1", "mesh1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		dataplane := mesh_core.DataplaneResource{
+			Spec: v1alpha1.Dataplane{
+				Networking: &v1alpha1.Dataplane_Networking{
+					Address: "127.0.0.1",
+					Inbound: []*v1alpha1.Dataplane_Networking_Inbound{
+						{
+							Port:        9090,
+							ServicePort: 9091,
+							Tags: map[string]string{
+								"service": "sample",
+							},
+						},
+					},
+				},
+			},
+		}
+		err = resourceStore.Create(context.Background(), &dataplane, store.CreateByKey("dp1", "mesh1"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("On GET", func() {
+		It("should return xDS configuration of a dataplane held in the cache", func() {
+			// given
+			snapshot := envoy_cache.NewSnapshot("1",
+				nil,
+				[]envoy_cache.Resource{
+					&envoy.Cluster{Name: "sample"},
+					&envoy.Cluster{Name: "localhost:9091"},
+				},
+				nil,
+				[]envoy_cache.Resource{
+					&envoy.Listener{
+						Name: "inbound:127.0.0.1:9090",
+						Address: &envoy_core.Address{
+							Address: &envoy_core.Address_SocketAddress{
+								SocketAddress: &envoy_core.SocketAddress{
+									Address: "127.0.0.1",
+									PortSpecifier: &envoy_core.SocketAddress_PortValue{
+										PortValue: 9090,
+									},
+								},
+							},
+						},
+					},
+				},
+				nil,
+			)
+			err := xdsContext.Cache().SetSnapshot("mesh1.dp1", snapshot)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			response, err := http.Get("http://" + apiServer.Address() + "/meshes/mesh1/dataplanes/dp1/xds")
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(response.StatusCode).To(Equal(200))
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(`
+			{
+				"node": "mesh1.dp1",
+				"listeners": {
+					"version": "1",
+					"resources": [
+						{
+							"name": "inbound:127.0.0.1:9090",
+							"address": {
+								"socketAddress": {
+									"address": "127.0.0.1",
+									"portValue": 9090
+								}
+							}
+						}
+					]
+				},
+				"routes": {
+					"version": "1",
+					"resources": []
+				},
+				"clusters": {
+					"version": "1",
+					"resources": [
+						{
+							"name": "localhost:9091"
+						},
+						{
+							"name": "sample"
+						}
+					]
+				},
+				"endpoints": {
+					"version": "1",
+					"resources": []
+				}
+			}`))
+		})
+
+		It("should return empty configuration of a dataplane that is not connected", func() {
+			// when
+			response, err := http.Get("http://" + apiServer.Address() + "/meshes/mesh1/dataplanes/dp1/xds")
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(response.StatusCode).To(Equal(200))
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(`
+			{
+				"node": "mesh1.dp1",
+				"listeners": {
+					"version": "",
+					"resources": []
+				},
+				"routes": {
+					"version": "",
+					"resources": []
+				},
+				"clusters": {
+					"version": "",
+					"resources": []
+				},
+				"endpoints": {
+					"version": "",
+					"resources": []
+				}
+			}`))
+		})
+
+		It("should return 404 for a dataplane that does not exist", func() {
+			// when
+			response, err := http.Get("http://" + apiServer.Address() + "/meshes/mesh1/dataplanes/non-existing/xds")
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(response.StatusCode).To(Equal(404))
+		})
+	})
+})
@@ -114,7 +114,8 @@ var _ = Describe("Config WS", func() {
           },
           "environment": "universal",
           "general": {
-            "advertisedHostname": "localhost"
+            "advertisedHostname": "localhost",
+            "fipsMode": false
           },
           "guiServer": {
             "port": 5683,
@@ -134,6 +135,10 @@ var _ = Describe("Config WS", func() {
                 "certDir": "",
                 "port": 5443
               },
+              "caRootCertSync": {
+                "enabled": false,
+                "refreshInterval": "1m0s"
+              },
             "cniEnabled": false
             }
           },
@@ -165,10 +170,23 @@ var _ = Describe("Config WS", func() {
               "enabled": true,
               "expirationTime": "1s"
             },
+            "secrets": {
+              "encryption": {
+                "cipher": "none",
+                "masterKey": {
+                  "file": "",
+                  "env": "",
+                  "command": ""
+                },
+                "previousMasterKeys": [],
+                "migrateUnencrypted": false
+              }
+            },
             "type": "memory"
           },
           "xdsServer": {
             "dataplaneConfigurationRefreshInterval": "1s",
+            "dataplaneConfigurationDebounceInterval": "0s",
             "dataplaneStatusFlushInterval": "1s",
             "diagnosticsPort": 5680,
             "grpcPort": 5678,
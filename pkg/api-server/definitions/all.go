@@ -5,10 +5,12 @@ var All = []ResourceWsDefinition{
 	DataplaneWsDefinition,
 	DataplaneInsightWsDefinition,
 	HealthCheckWsDefinition,
+	CircuitBreakerWsDefinition,
 	ProxyTemplateWsDefinition,
 	TrafficPermissionWsDefinition,
 	TrafficLogWsDefinition,
 	TrafficRouteWsDefinition,
 	TrafficTraceWsDefinition,
 	FaultInjectionWsDefinition,
+	MeshGatewayWsDefinition,
 }
@@ -0,0 +1,17 @@
+package definitions
+
+import (
+	"github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	"github.com/Kong/kuma/pkg/core/resources/model"
+)
+
+var MeshGatewayWsDefinition = ResourceWsDefinition{
+	Name: "Mesh Gateway",
+	Path: "mesh-gateways",
+	ResourceFactory: func() model.Resource {
+		return &mesh.MeshGatewayResource{}
+	},
+	ResourceListFactory: func() model.ResourceList {
+		return &mesh.MeshGatewayResourceList{}
+	},
+}
@@ -10,6 +10,7 @@ import (
 	kuma_cp "github.com/Kong/kuma/pkg/config/app/kuma-cp"
 	"github.com/Kong/kuma/pkg/core/resources/manager"
 	"github.com/Kong/kuma/pkg/core/resources/store"
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
 	"github.com/Kong/kuma/pkg/test"
 	sample_proto "github.com/Kong/kuma/pkg/test/apis/sample/v1alpha1"
 	sample_model "github.com/Kong/kuma/pkg/test/resources/apis/sample"
@@ -99,6 +100,10 @@ func putSampleResourceIntoStore(resourceStore store.ResourceStore, name string,
 }
 
 func createTestApiServer(store store.ResourceStore, config *config_api_server.ApiServerConfig) *api_server.ApiServer {
+	return createTestApiServerWithXds(store, core_xds.NewXdsContext(), config)
+}
+
+func createTestApiServerWithXds(store store.ResourceStore, xdsContext core_xds.XdsContext, config *config_api_server.ApiServerConfig) *api_server.ApiServer {
 	// we have to manually search for port and put it into config. There is no way to retrieve port of running
 	// http.Server and we need it later for the client
 	port, err := test.GetFreePort()
@@ -108,7 +113,7 @@ func createTestApiServer(store store.ResourceStore, config *config_api_server.Ap
 	resources := manager.NewResourceManager(store)
 	cfg := kuma_cp.DefaultConfig()
 	cfg.ApiServer = config
-	apiServer, err := api_server.NewApiServer(resources, defs, cfg.ApiServer, &cfg)
+	apiServer, err := api_server.NewApiServer(resources, xdsContext, defs, cfg.ApiServer, &cfg)
 	Expect(err).ToNot(HaveOccurred())
 	return apiServer
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/Kong/kuma/pkg/core/resources/apis/mesh"
 	"github.com/Kong/kuma/pkg/core/resources/manager"
 	"github.com/Kong/kuma/pkg/core/runtime"
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
 )
 
 var (
@@ -50,7 +51,7 @@ func init() {
 	}
 }
 
-func NewApiServer(resManager manager.ResourceManager, defs []definitions.ResourceWsDefinition, serverConfig *api_server_config.ApiServerConfig, cfg config.Config) (*ApiServer, error) {
+func NewApiServer(resManager manager.ResourceManager, xdsContext core_xds.XdsContext, defs []definitions.ResourceWsDefinition, serverConfig *api_server_config.ApiServerConfig, cfg config.Config) (*ApiServer, error) {
 	container := restful.NewContainer()
 	srv := &http.Server{
 		Addr:    fmt.Sprintf(":%d", serverConfig.Port),
@@ -73,6 +74,7 @@ func NewApiServer(resManager manager.ResourceManager, defs []definitions.Resourc
 		Produces(restful.MIME_JSON)
 
 	addResourcesEndpoints(ws, defs, resManager, serverConfig)
+	addConfigDumpEndpoints(ws, resManager, xdsContext)
 	container.Add(ws)
 
 	if err := addIndexWsEndpoints(ws); err != nil {
@@ -132,6 +134,14 @@ func addResourcesEndpoints(ws *restful.WebService, defs []definitions.ResourceWs
 	}
 }
 
+func addConfigDumpEndpoints(ws *restful.WebService, resManager manager.ResourceManager, xdsContext core_xds.XdsContext) {
+	endpoints := configDumpEndpoints{
+		resManager: resManager,
+		snapshots:  xdsContext.Cache(),
+	}
+	endpoints.addFindEndpoint(ws, "/meshes/{mesh}")
+}
+
 func (a *ApiServer) Start(stop <-chan struct{}) error {
 	errChan := make(chan error)
 	go func() {
@@ -158,7 +168,7 @@ func (a *ApiServer) Start(stop <-chan struct{}) error {
 
 func SetupServer(rt runtime.Runtime) error {
 	cfg := rt.Config()
-	apiServer, err := NewApiServer(rt.ResourceManager(), definitions.All, rt.Config().ApiServer, &cfg)
+	apiServer, err := NewApiServer(rt.ResourceManager(), rt.XDS(), definitions.All, rt.Config().ApiServer, &cfg)
 	if err != nil {
 		return err
 	}
@@ -174,6 +174,9 @@ type GeneralConfig struct {
 	// Hostname that other components should use in order to connect to the Control Plane.
 	// Control Plane will use this value in configuration generated for dataplanes, in responses to `kumactl`, etc.
 	AdvertisedHostname string `yaml:"advertisedHostname" envconfig:"kuma_general_advertised_hostname"`
+	// If true, Control Plane restricts cryptographic algorithms to the ones approved by FIPS 140-2,
+	// e.g. builtin CA rejects backends that request keys on non-approved curves.
+	FIPSMode bool `yaml:"fipsMode" envconfig:"kuma_general_fips_mode"`
 }
 
 var _ config.Config = &GeneralConfig{}
@@ -188,5 +191,6 @@ func (g *GeneralConfig) Validate() error {
 func DefaultGeneralConfig() *GeneralConfig {
 	return &GeneralConfig{
 		AdvertisedHostname: "localhost",
+		FIPSMode:           false,
 	}
 }
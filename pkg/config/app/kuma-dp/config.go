@@ -2,6 +2,7 @@ package kumadp
 
 import (
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -70,6 +71,16 @@ type Dataplane struct {
 	AdminPort config_types.PortRange `yaml:"adminPort,omitempty" envconfig:"kuma_dataplane_admin_port"`
 	// Drain time for listeners.
 	DrainTime time.Duration `yaml:"drainTime,omitempty" envconfig:"kuma_dataplane_drain_time"`
+	// Number of Envoy worker threads.
+	// Zero value indicates that Envoy should use the number of hardware threads on the machine.
+	Concurrency uint32 `yaml:"concurrency,omitempty" envconfig:"kuma_dataplane_concurrency"`
+	// Port to serve virtual probes on, i.e. HTTP probes of applications rewritten by Kuma Injector
+	// to `/<application port>/<path>`, which are forwarded to the application over localhost.
+	// Zero value indicates that virtual probes are not served.
+	VirtualProbesPort uint32 `yaml:"virtualProbesPort,omitempty" envconfig:"kuma_dataplane_virtual_probes_port"`
+	// Paths of virtual probes, e.g. `/8080/healthz`, that are forwarded to the application.
+	// Requests to any other path are rejected, so that other ports of the application cannot be reached.
+	VirtualProbesPaths []string `yaml:"virtualProbesPaths,omitempty" envconfig:"kuma_dataplane_virtual_probes_paths"`
 }
 
 // DataplaneRuntime defines the context in which dataplane (Envoy) runs.
@@ -80,6 +91,40 @@ type DataplaneRuntime struct {
 	ConfigDir string `yaml:"configDir,omitempty" envconfig:"kuma_dataplane_runtime_config_dir"`
 	// Path to a file with dataplane token (use 'kumactl generate dataplane-token' to get one)
 	TokenPath string `yaml:"dataplaneTokenPath,omitempty" envconfig:"kuma_dataplane_runtime_token_path"`
+	// Log level of Envoy, one of EnvoyLogLevels. If empty, Envoy logs at its default level.
+	EnvoyLogLevel string `yaml:"envoyLogLevel,omitempty" envconfig:"kuma_dataplane_runtime_envoy_log_level"`
+	// Format of Envoy logs, one of EnvoyLogFormats. If empty, Envoy logs in text format.
+	EnvoyLogFormat string `yaml:"envoyLogFormat,omitempty" envconfig:"kuma_dataplane_runtime_envoy_log_format"`
+}
+
+const (
+	EnvoyLogFormatText = "text"
+	EnvoyLogFormatJSON = "json"
+)
+
+// EnvoyLogLevels are log levels supported by Envoy.
+var EnvoyLogLevels = []string{"trace", "debug", "info", "warning", "error", "critical", "off"}
+
+// EnvoyLogFormats are formats of Envoy logs supported by Kuma Dataplane Manager.
+var EnvoyLogFormats = []string{EnvoyLogFormatText, EnvoyLogFormatJSON}
+
+// ValidateEnvoyLogLevel returns an error if a given value is not one of EnvoyLogLevels.
+func ValidateEnvoyLogLevel(level string) error {
+	return validateOneOf("log level", level, EnvoyLogLevels)
+}
+
+// ValidateEnvoyLogFormat returns an error if a given value is not one of EnvoyLogFormats.
+func ValidateEnvoyLogFormat(format string) error {
+	return validateOneOf("log format", format, EnvoyLogFormats)
+}
+
+func validateOneOf(name string, value string, allowed []string) error {
+	for _, v := range allowed {
+		if value == v {
+			return nil
+		}
+	}
+	return errors.Errorf("unknown %s %q. Allowed values: %s", name, value, strings.Join(allowed, ", "))
 }
 
 var _ config.Config = &Config{}
@@ -126,6 +171,9 @@ func (d *Dataplane) Validate() (errs error) {
 	if d.DrainTime <= 0 {
 		errs = multierr.Append(errs, errors.Errorf(".DrainTime must be positive"))
 	}
+	if 65535 < d.VirtualProbesPort {
+		errs = multierr.Append(errs, errors.Errorf(".VirtualProbesPort must be in the range [0, 65535]"))
+	}
 	return
 }
 
@@ -138,6 +186,16 @@ func (d *DataplaneRuntime) Validate() (errs error) {
 	if d.BinaryPath == "" {
 		errs = multierr.Append(errs, errors.Errorf(".BinaryPath must be non-empty"))
 	}
+	if d.EnvoyLogLevel != "" {
+		if err := ValidateEnvoyLogLevel(d.EnvoyLogLevel); err != nil {
+			errs = multierr.Append(errs, errors.Wrapf(err, ".EnvoyLogLevel is not valid"))
+		}
+	}
+	if d.EnvoyLogFormat != "" {
+		if err := ValidateEnvoyLogFormat(d.EnvoyLogFormat); err != nil {
+			errs = multierr.Append(errs, errors.Wrapf(err, ".EnvoyLogFormat is not valid"))
+		}
+	}
 	return
 }
 
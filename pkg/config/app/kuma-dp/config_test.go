@@ -30,6 +30,11 @@ var _ = Describe("Config", func() {
 		Expect(cfg.ControlPlane.ApiServer.URL).To(Equal("https://kuma-control-plane.internal:5682"))
 		Expect(cfg.Dataplane.AdminPort).To(Equal(config_types.MustExactPort(2345)))
 		Expect(cfg.Dataplane.DrainTime).To(Equal(60 * time.Second))
+		Expect(cfg.Dataplane.Concurrency).To(Equal(uint32(4)))
+		Expect(cfg.Dataplane.VirtualProbesPort).To(Equal(uint32(9000)))
+		Expect(cfg.Dataplane.VirtualProbesPaths).To(Equal([]string{"/8080/healthz", "/8080/ready"}))
+		Expect(cfg.DataplaneRuntime.EnvoyLogLevel).To(Equal("debug"))
+		Expect(cfg.DataplaneRuntime.EnvoyLogFormat).To(Equal("json"))
 	})
 
 	Context("with modified environment variables", func() {
@@ -51,14 +56,19 @@ var _ = Describe("Config", func() {
 		It("should be loadable from environment variables", func() {
 			// setup
 			env := map[string]string{
-				"KUMA_CONTROL_PLANE_API_SERVER_URL":  "https://kuma-control-plane.internal:5682",
-				"KUMA_DATAPLANE_MESH":                "demo",
-				"KUMA_DATAPLANE_NAME":                "example",
-				"KUMA_DATAPLANE_ADMIN_PORT":          "2345",
-				"KUMA_DATAPLANE_DRAIN_TIME":          "60s",
-				"KUMA_DATAPLANE_RUNTIME_BINARY_PATH": "envoy.sh",
-				"KUMA_DATAPLANE_RUNTIME_CONFIG_DIR":  "/var/run/envoy",
-				"KUMA_DATAPLANE_RUNTIME_TOKEN_PATH":  "/tmp/token",
+				"KUMA_CONTROL_PLANE_API_SERVER_URL":       "https://kuma-control-plane.internal:5682",
+				"KUMA_DATAPLANE_MESH":                     "demo",
+				"KUMA_DATAPLANE_NAME":                     "example",
+				"KUMA_DATAPLANE_ADMIN_PORT":               "2345",
+				"KUMA_DATAPLANE_DRAIN_TIME":               "60s",
+				"KUMA_DATAPLANE_CONCURRENCY":              "4",
+				"KUMA_DATAPLANE_VIRTUAL_PROBES_PORT":      "9000",
+				"KUMA_DATAPLANE_VIRTUAL_PROBES_PATHS":     "/8080/healthz,/8080/ready",
+				"KUMA_DATAPLANE_RUNTIME_BINARY_PATH":      "envoy.sh",
+				"KUMA_DATAPLANE_RUNTIME_CONFIG_DIR":       "/var/run/envoy",
+				"KUMA_DATAPLANE_RUNTIME_TOKEN_PATH":       "/tmp/token",
+				"KUMA_DATAPLANE_RUNTIME_ENVOY_LOG_LEVEL":  "debug",
+				"KUMA_DATAPLANE_RUNTIME_ENVOY_LOG_FORMAT": "json",
 			}
 			for key, value := range env {
 				os.Setenv(key, value)
@@ -79,9 +89,14 @@ var _ = Describe("Config", func() {
 			Expect(cfg.Dataplane.Name).To(Equal("example"))
 			Expect(cfg.Dataplane.AdminPort).To(Equal(config_types.MustExactPort(2345)))
 			Expect(cfg.Dataplane.DrainTime).To(Equal(60 * time.Second))
+			Expect(cfg.Dataplane.Concurrency).To(Equal(uint32(4)))
+			Expect(cfg.Dataplane.VirtualProbesPort).To(Equal(uint32(9000)))
+			Expect(cfg.Dataplane.VirtualProbesPaths).To(Equal([]string{"/8080/healthz", "/8080/ready"}))
 			Expect(cfg.DataplaneRuntime.BinaryPath).To(Equal("envoy.sh"))
 			Expect(cfg.DataplaneRuntime.ConfigDir).To(Equal("/var/run/envoy"))
 			Expect(cfg.DataplaneRuntime.TokenPath).To(Equal("/tmp/token"))
+			Expect(cfg.DataplaneRuntime.EnvoyLogLevel).To(Equal("debug"))
+			Expect(cfg.DataplaneRuntime.EnvoyLogFormat).To(Equal("json"))
 		})
 	})
 
@@ -110,6 +125,6 @@ var _ = Describe("Config", func() {
 		err := config.Load(filepath.Join("testdata", "invalid-config.input.yaml"), &cfg)
 
 		// then
-		Expect(err).To(MatchError(`Invalid configuration: .ControlPlane is not valid: .ApiServer is not valid: .URL must be a valid absolute URI; .Dataplane is not valid: .Mesh must be non-empty; .Name must be non-empty; .DrainTime must be positive; .DataplaneRuntime is not valid: .BinaryPath must be non-empty`))
+		Expect(err).To(MatchError(`Invalid configuration: .ControlPlane is not valid: .ApiServer is not valid: .URL must be a valid absolute URI; .Dataplane is not valid: .Mesh must be non-empty; .Name must be non-empty; .DrainTime must be positive; .VirtualProbesPort must be in the range [0, 65535]; .DataplaneRuntime is not valid: .BinaryPath must be non-empty; .EnvoyLogLevel is not valid: unknown log level "verbose". Allowed values: trace, debug, info, warning, error, critical, off; .EnvoyLogFormat is not valid: unknown log format "xml". Allowed values: text, json`))
 	})
 })
@@ -3,14 +3,17 @@ package kumainjector
 import (
 	"net"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/Kong/kuma/pkg/config"
+	kuma_dp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
 
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
 
 	kube_api "k8s.io/apimachinery/pkg/api/resource"
+	kube_validation "k8s.io/apimachinery/pkg/util/validation"
 )
 
 func DefaultConfig() Config {
@@ -36,6 +39,17 @@ func DefaultConfig() Config {
 				GID:          5678,
 				AdminPort:    9901,
 				DrainTime:    30 * time.Second,
+				PreStop: SidecarPreStop{
+					Enabled:                     false,
+					AppContainers:               false,
+					ExtraTerminationGracePeriod: 5 * time.Second,
+				},
+				SecurityContext: SidecarSecurityContext{
+					RunAsNonRoot:             true,
+					ReadOnlyRootFilesystem:   true,
+					AllowPrivilegeEscalation: false,
+					DropCapabilities:         []string{"ALL"},
+				},
 
 				ReadinessProbe: SidecarReadinessProbe{
 					InitialDelaySeconds: 1,
@@ -43,6 +57,7 @@ func DefaultConfig() Config {
 					PeriodSeconds:       5,
 					SuccessThreshold:    1,
 					FailureThreshold:    12,
+					Path:                "/ready",
 				},
 				LivenessProbe: SidecarLivenessProbe{
 					InitialDelaySeconds: 60,
@@ -50,6 +65,10 @@ func DefaultConfig() Config {
 					PeriodSeconds:       5,
 					FailureThreshold:    12,
 				},
+				VirtualProbes: SidecarVirtualProbes{
+					Enabled: false,
+					Port:    9000,
+				},
 				Resources: SidecarResources{
 					Requests: SidecarResourceRequests{
 						CPU:    "50m",
@@ -64,6 +83,11 @@ func DefaultConfig() Config {
 			InitContainer: InitContainer{
 				Image:   "kuma/kuma-init:latest",
 				Enabled: true,
+				SecurityContext: InitSecurityContext{
+					AllowPrivilegeEscalation: false,
+					AddCapabilities:          []string{"NET_RAW"},
+					DropCapabilities:         []string{"ALL"},
+				},
 			},
 		},
 	}
@@ -100,6 +124,9 @@ type Injector struct {
 	SidecarContainer SidecarContainer `yaml:"sidecarContainer,omitempty"`
 	// InitContainer defines configuration of the Kuma init container.
 	InitContainer InitContainer `yaml:"initContainer,omitempty"`
+	// NamespaceMeshLabel defines a label of a Namespace whose value is the name of a Mesh of Pods in that Namespace.
+	// If set, Pods in Namespaces without that label are not injected unless they have `kuma.io/mesh` annotation.
+	NamespaceMeshLabel string `yaml:"namespaceMeshLabel,omitempty" envconfig:"kuma_injector_namespace_mesh_label"`
 }
 
 // ControlPlane defines coordinates of the Control Plane.
@@ -128,12 +155,57 @@ type SidecarContainer struct {
 	AdminPort uint32 `yaml:"adminPort,omitempty" envconfig:"kuma_injector_sidecar_container_admin_port"`
 	// Drain time for listeners.
 	DrainTime time.Duration `yaml:"drainTime,omitempty" envconfig:"kuma_injector_sidecar_container_drain_time"`
+	// Number of Envoy worker threads.
+	// Zero value indicates that Envoy should use the number of hardware threads on the node.
+	Concurrency uint32 `yaml:"concurrency,omitempty" envconfig:"kuma_injector_sidecar_container_concurrency"`
+	// Log level of Envoy, one of kuma_dp.EnvoyLogLevels. If empty, Envoy logs at its default level.
+	EnvoyLogLevel string `yaml:"envoyLogLevel,omitempty" envconfig:"kuma_injector_sidecar_container_envoy_log_level"`
+	// Format of Envoy logs, one of kuma_dp.EnvoyLogFormats. If empty, Envoy logs in text format.
+	EnvoyLogFormat string `yaml:"envoyLogFormat,omitempty" envconfig:"kuma_injector_sidecar_container_envoy_log_format"`
+	// PreStop hook that delays termination of a Pod until listeners are drained.
+	PreStop SidecarPreStop `yaml:"preStop,omitempty"`
+	// Security options of the sidecar container.
+	SecurityContext SidecarSecurityContext `yaml:"securityContext,omitempty"`
 	// Readiness probe.
 	ReadinessProbe SidecarReadinessProbe `yaml:"readinessProbe,omitempty"`
 	// Liveness probe.
 	LivenessProbe SidecarLivenessProbe `yaml:"livenessProbe,omitempty"`
+	// Virtual probes that let kubelet reach HTTP probes of application containers through the sidecar.
+	VirtualProbes SidecarVirtualProbes `yaml:"virtualProbes,omitempty"`
 	// Compute resource requirements.
 	Resources SidecarResources `yaml:"resources,omitempty"`
+	// ConfigMaps and Secrets whose keys become environment variables of the sidecar container, e.g. feature flags.
+	EnvFrom []SidecarEnvFromSource `yaml:"envFrom,omitempty"`
+	// Environment variables of the sidecar container sourced from keys of ConfigMaps and Secrets, e.g. tokens.
+	Env []SidecarEnvVar `yaml:"env,omitempty"`
+	// Whether the sidecar is injected as a restartable init container (`restartPolicy: Always`),
+	// so that it starts before and terminates after application containers and Pods of Jobs can complete.
+	// Requires a version of Kubernetes that supports sidecar containers. Can be overridden by `kuma.io/sidecar-native` annotation.
+	NativeSidecar bool `yaml:"nativeSidecar,omitempty" envconfig:"kuma_injector_sidecar_container_native_sidecar"`
+}
+
+// SidecarPreStop defines a preStop hook that delays termination of a Pod until listeners are drained.
+type SidecarPreStop struct {
+	// Enabled
+	Enabled bool `yaml:"enabled,omitempty" envconfig:"kuma_injector_sidecar_container_pre_stop_enabled"`
+	// Whether application containers should get the same preStop hook as the sidecar,
+	// so that they keep serving requests while listeners are being drained.
+	AppContainers bool `yaml:"appContainers,omitempty" envconfig:"kuma_injector_sidecar_container_pre_stop_app_containers"`
+	// Time on top of the drain time that a Pod is given to terminate gracefully.
+	ExtraTerminationGracePeriod time.Duration `yaml:"extraTerminationGracePeriod,omitempty" envconfig:"kuma_injector_sidecar_container_pre_stop_extra_termination_grace_period"`
+}
+
+// SidecarSecurityContext defines security options of the Kuma sidecar container.
+type SidecarSecurityContext struct {
+	// Whether the sidecar container must run as a non-root user.
+	RunAsNonRoot bool `yaml:"runAsNonRoot,omitempty" envconfig:"kuma_injector_sidecar_container_security_context_run_as_non_root"`
+	// Whether the sidecar container has a read-only root filesystem.
+	// In that case a writable emptyDir volume is mounted at /tmp for Envoy configuration.
+	ReadOnlyRootFilesystem bool `yaml:"readOnlyRootFilesystem,omitempty" envconfig:"kuma_injector_sidecar_container_security_context_read_only_root_filesystem"`
+	// Whether a process of the sidecar container can gain more privileges than its parent process.
+	AllowPrivilegeEscalation bool `yaml:"allowPrivilegeEscalation,omitempty" envconfig:"kuma_injector_sidecar_container_security_context_allow_privilege_escalation"`
+	// Linux capabilities to drop from the sidecar container, e.g. ALL.
+	DropCapabilities []string `yaml:"dropCapabilities,omitempty" envconfig:"kuma_injector_sidecar_container_security_context_drop_capabilities"`
 }
 
 // SidecarReadinessProbe defines periodic probe of container service readiness.
@@ -148,6 +220,8 @@ type SidecarReadinessProbe struct {
 	SuccessThreshold int32 `yaml:"successThreshold,omitempty" envconfig:"kuma_injector_sidecar_container_readiness_probe_success_threshold"`
 	// Minimum consecutive failures for the probe to be considered failed after having succeeded.
 	FailureThreshold int32 `yaml:"failureThreshold,omitempty" envconfig:"kuma_injector_sidecar_container_readiness_probe_failure_threshold"`
+	// Path of Envoy admin API to probe, e.g. /ready that succeeds only once Envoy has received its initial configuration.
+	Path string `yaml:"path,omitempty" envconfig:"kuma_injector_sidecar_container_readiness_probe_path"`
 }
 
 // SidecarLivenessProbe defines periodic probe of container service liveness.
@@ -162,6 +236,19 @@ type SidecarLivenessProbe struct {
 	FailureThreshold int32 `yaml:"failureThreshold,omitempty" envconfig:"kuma_injector_sidecar_container_liveness_probe_failure_threshold"`
 }
 
+// SidecarVirtualProbes defines rewriting of HTTP probes of application containers.
+//
+// Once inbound traffic is redirected to Envoy, kubelet can no longer probe application ports directly,
+// e.g. because Envoy requires mTLS. Rewritten probes target a port served by the sidecar instead,
+// which is excluded from the redirection, and have the original port as the first segment of the path,
+// e.g. `/8080/healthz`. The sidecar forwards them to the application over localhost.
+type SidecarVirtualProbes struct {
+	// Whether HTTP liveness and readiness probes of application containers are rewritten.
+	Enabled bool `yaml:"enabled,omitempty" envconfig:"kuma_injector_sidecar_container_virtual_probes_enabled"`
+	// Port the sidecar serves virtual probes on.
+	Port uint32 `yaml:"port,omitempty" envconfig:"kuma_injector_sidecar_container_virtual_probes_port"`
+}
+
 // SidecarResources defines compute resource requirements.
 type SidecarResources struct {
 	// Minimum amount of compute resources required.
@@ -186,12 +273,53 @@ type SidecarResourceLimits struct {
 	Memory string `yaml:"memory,omitempty" envconfig:"kuma_injector_sidecar_container_resources_limits_memory"`
 }
 
+// SidecarEnvFromSource defines a ConfigMap or a Secret whose keys become environment variables of the sidecar container.
+// Exactly one of them has to be set.
+type SidecarEnvFromSource struct {
+	// Name of a ConfigMap in the namespace of a Pod.
+	ConfigMap string `yaml:"configMap,omitempty"`
+	// Name of a Secret in the namespace of a Pod.
+	Secret string `yaml:"secret,omitempty"`
+}
+
+// SidecarEnvVar defines an environment variable of the sidecar container sourced from a key of a ConfigMap or a Secret.
+// Exactly one of them has to be set.
+type SidecarEnvVar struct {
+	// Name of the environment variable.
+	Name string `yaml:"name,omitempty"`
+	// Key of a ConfigMap in the namespace of a Pod.
+	ConfigMapKeyRef *SidecarKeyRef `yaml:"configMapKeyRef,omitempty"`
+	// Key of a Secret in the namespace of a Pod.
+	SecretKeyRef *SidecarKeyRef `yaml:"secretKeyRef,omitempty"`
+}
+
+// SidecarKeyRef selects a key of a ConfigMap or a Secret.
+type SidecarKeyRef struct {
+	// Name of a ConfigMap or a Secret.
+	Name string `yaml:"name,omitempty"`
+	// Key of the value.
+	Key string `yaml:"key,omitempty"`
+}
+
 // InitContainer defines configuration of the Kuma init container.
 type InitContainer struct {
 	// Enabled
 	Enabled bool `yaml:"enabled,omitempty" envconfig:"kuma_injector_init_container_enabled"`
 	// Image name.
 	Image string `yaml:"image,omitempty" envconfig:"kuma_injector_init_container_image"`
+	// Security options of the init container.
+	SecurityContext InitSecurityContext `yaml:"securityContext,omitempty"`
+}
+
+// InitSecurityContext defines security options of the Kuma init container.
+// The init container always runs as root with NET_ADMIN capability since it has to configure iptables.
+type InitSecurityContext struct {
+	// Whether a process of the init container can gain more privileges than its parent process.
+	AllowPrivilegeEscalation bool `yaml:"allowPrivilegeEscalation,omitempty" envconfig:"kuma_injector_init_container_security_context_allow_privilege_escalation"`
+	// Linux capabilities to add to the init container on top of NET_ADMIN, e.g. NET_RAW.
+	AddCapabilities []string `yaml:"addCapabilities,omitempty" envconfig:"kuma_injector_init_container_security_context_add_capabilities"`
+	// Linux capabilities to drop from the init container, e.g. ALL.
+	DropCapabilities []string `yaml:"dropCapabilities,omitempty" envconfig:"kuma_injector_init_container_security_context_drop_capabilities"`
 }
 
 var _ config.Config = &Config{}
@@ -280,9 +408,12 @@ func (s *ApiServer) Validate() (errs error) {
 var _ config.Config = &SidecarContainer{}
 
 func (c *SidecarContainer) Sanitize() {
+	c.PreStop.Sanitize()
+	c.SecurityContext.Sanitize()
 	c.Resources.Sanitize()
 	c.LivenessProbe.Sanitize()
 	c.ReadinessProbe.Sanitize()
+	c.VirtualProbes.Sanitize()
 }
 
 func (c *SidecarContainer) Validate() (errs error) {
@@ -298,27 +429,171 @@ func (c *SidecarContainer) Validate() (errs error) {
 	if c.DrainTime <= 0 {
 		errs = multierr.Append(errs, errors.Errorf(".DrainTime must be positive"))
 	}
+	if c.EnvoyLogLevel != "" {
+		if err := kuma_dp.ValidateEnvoyLogLevel(c.EnvoyLogLevel); err != nil {
+			errs = multierr.Append(errs, errors.Wrapf(err, ".EnvoyLogLevel is not valid"))
+		}
+	}
+	if c.EnvoyLogFormat != "" {
+		if err := kuma_dp.ValidateEnvoyLogFormat(c.EnvoyLogFormat); err != nil {
+			errs = multierr.Append(errs, errors.Wrapf(err, ".EnvoyLogFormat is not valid"))
+		}
+	}
+	if err := c.PreStop.Validate(); err != nil {
+		errs = multierr.Append(errs, errors.Wrapf(err, ".PreStop is not valid"))
+	}
+	if c.SecurityContext.RunAsNonRoot && c.UID <= 0 {
+		errs = multierr.Append(errs, errors.Errorf(".UID must be positive when .SecurityContext.RunAsNonRoot is enabled"))
+	}
+	if err := c.SecurityContext.Validate(); err != nil {
+		errs = multierr.Append(errs, errors.Wrapf(err, ".SecurityContext is not valid"))
+	}
 	if err := c.ReadinessProbe.Validate(); err != nil {
 		errs = multierr.Append(errs, errors.Wrapf(err, ".ReadinessProbe is not valid"))
 	}
 	if err := c.LivenessProbe.Validate(); err != nil {
 		errs = multierr.Append(errs, errors.Wrapf(err, ".LivenessProbe is not valid"))
 	}
+	if err := c.VirtualProbes.Validate(); err != nil {
+		errs = multierr.Append(errs, errors.Wrapf(err, ".VirtualProbes is not valid"))
+	}
+	if c.VirtualProbes.Enabled && (c.VirtualProbes.Port == c.AdminPort || c.VirtualProbes.Port == c.RedirectPort) {
+		errs = multierr.Append(errs, errors.Errorf(".VirtualProbes.Port must differ from .AdminPort and .RedirectPort"))
+	}
 	if err := c.Resources.Validate(); err != nil {
 		errs = multierr.Append(errs, errors.Wrapf(err, ".Resources is not valid"))
 	}
+	for i, source := range c.EnvFrom {
+		if err := source.Validate(); err != nil {
+			errs = multierr.Append(errs, errors.Wrapf(err, ".EnvFrom[%d] is not valid", i))
+		}
+	}
+	for i, env := range c.Env {
+		if err := env.Validate(); err != nil {
+			errs = multierr.Append(errs, errors.Wrapf(err, ".Env[%d] is not valid", i))
+		}
+	}
 	return
 }
 
+func (c *SidecarEnvFromSource) Validate() (errs error) {
+	switch {
+	case c.ConfigMap != "" && c.Secret != "":
+		errs = multierr.Append(errs, errors.Errorf(".ConfigMap and .Secret cannot be set together"))
+	case c.ConfigMap != "":
+		if err := validateObjectName(c.ConfigMap); err != nil {
+			errs = multierr.Append(errs, errors.Wrapf(err, ".ConfigMap is not valid"))
+		}
+	case c.Secret != "":
+		if err := validateObjectName(c.Secret); err != nil {
+			errs = multierr.Append(errs, errors.Wrapf(err, ".Secret is not valid"))
+		}
+	default:
+		errs = multierr.Append(errs, errors.Errorf("either .ConfigMap or .Secret must be set"))
+	}
+	return
+}
+
+func (c *SidecarEnvVar) Validate() (errs error) {
+	if msgs := kube_validation.IsEnvVarName(c.Name); len(msgs) > 0 {
+		errs = multierr.Append(errs, errors.Errorf(".Name is not valid: %s", strings.Join(msgs, "; ")))
+	}
+	switch {
+	case c.ConfigMapKeyRef != nil && c.SecretKeyRef != nil:
+		errs = multierr.Append(errs, errors.Errorf(".ConfigMapKeyRef and .SecretKeyRef cannot be set together"))
+	case c.ConfigMapKeyRef != nil:
+		if err := c.ConfigMapKeyRef.Validate(); err != nil {
+			errs = multierr.Append(errs, errors.Wrapf(err, ".ConfigMapKeyRef is not valid"))
+		}
+	case c.SecretKeyRef != nil:
+		if err := c.SecretKeyRef.Validate(); err != nil {
+			errs = multierr.Append(errs, errors.Wrapf(err, ".SecretKeyRef is not valid"))
+		}
+	default:
+		errs = multierr.Append(errs, errors.Errorf("either .ConfigMapKeyRef or .SecretKeyRef must be set"))
+	}
+	return
+}
+
+func (c *SidecarKeyRef) Validate() (errs error) {
+	if err := validateObjectName(c.Name); err != nil {
+		errs = multierr.Append(errs, errors.Wrapf(err, ".Name is not valid"))
+	}
+	if msgs := kube_validation.IsConfigMapKey(c.Key); len(msgs) > 0 {
+		errs = multierr.Append(errs, errors.Errorf(".Key is not valid: %s", strings.Join(msgs, "; ")))
+	}
+	return
+}
+
+// validateObjectName checks that a given value is a valid name of a ConfigMap or a Secret.
+func validateObjectName(name string) error {
+	if msgs := kube_validation.IsDNS1123Subdomain(name); len(msgs) > 0 {
+		return errors.New(strings.Join(msgs, "; "))
+	}
+	return nil
+}
+
 var _ config.Config = &InitContainer{}
 
 func (c *InitContainer) Sanitize() {
+	c.SecurityContext.Sanitize()
 }
 
 func (c *InitContainer) Validate() (errs error) {
 	if c.Image == "" {
 		errs = multierr.Append(errs, errors.Errorf(".Image must be non-empty"))
 	}
+	if err := c.SecurityContext.Validate(); err != nil {
+		errs = multierr.Append(errs, errors.Wrapf(err, ".SecurityContext is not valid"))
+	}
+	return
+}
+
+var _ config.Config = &SidecarSecurityContext{}
+
+func (c *SidecarSecurityContext) Sanitize() {
+}
+
+func (c *SidecarSecurityContext) Validate() (errs error) {
+	if err := validateCapabilities(c.DropCapabilities); err != nil {
+		errs = multierr.Append(errs, errors.Wrapf(err, ".DropCapabilities is not valid"))
+	}
+	return
+}
+
+var _ config.Config = &InitSecurityContext{}
+
+func (c *InitSecurityContext) Sanitize() {
+}
+
+func (c *InitSecurityContext) Validate() (errs error) {
+	if err := validateCapabilities(c.AddCapabilities); err != nil {
+		errs = multierr.Append(errs, errors.Wrapf(err, ".AddCapabilities is not valid"))
+	}
+	if err := validateCapabilities(c.DropCapabilities); err != nil {
+		errs = multierr.Append(errs, errors.Wrapf(err, ".DropCapabilities is not valid"))
+	}
+	return
+}
+
+func validateCapabilities(capabilities []string) (errs error) {
+	for i, capability := range capabilities {
+		if capability == "" {
+			errs = multierr.Append(errs, errors.Errorf("[%d] must be non-empty", i))
+		}
+	}
+	return
+}
+
+var _ config.Config = &SidecarPreStop{}
+
+func (c *SidecarPreStop) Sanitize() {
+}
+
+func (c *SidecarPreStop) Validate() (errs error) {
+	if c.ExtraTerminationGracePeriod < 0 {
+		errs = multierr.Append(errs, errors.Errorf(".ExtraTerminationGracePeriod must not be negative"))
+	}
 	return
 }
 
@@ -343,6 +618,9 @@ func (c *SidecarReadinessProbe) Validate() (errs error) {
 	if c.FailureThreshold < 1 {
 		errs = multierr.Append(errs, errors.Errorf(".FailureThreshold must be >= 1"))
 	}
+	if c.Path != "" && !strings.HasPrefix(c.Path, "/") {
+		errs = multierr.Append(errs, errors.Errorf(".Path must be either empty or start with /"))
+	}
 	return
 }
 
@@ -367,6 +645,18 @@ func (c *SidecarLivenessProbe) Validate() (errs error) {
 	return
 }
 
+var _ config.Config = &SidecarVirtualProbes{}
+
+func (c *SidecarVirtualProbes) Sanitize() {
+}
+
+func (c *SidecarVirtualProbes) Validate() (errs error) {
+	if c.Enabled && (c.Port < 1 || 65535 < c.Port) {
+		errs = multierr.Append(errs, errors.Errorf(".Port must be in the range [1, 65535]"))
+	}
+	return
+}
+
 var _ config.Config = &SidecarResources{}
 
 func (c *SidecarResources) Sanitize() {
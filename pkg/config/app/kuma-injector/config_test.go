@@ -37,25 +37,52 @@ var _ = Describe("Config", func() {
 		Expect(cfg.Injector.SidecarContainer.GID).To(Equal(int64(3456)))
 		Expect(cfg.Injector.SidecarContainer.AdminPort).To(Equal(uint32(45678)))
 		Expect(cfg.Injector.SidecarContainer.DrainTime).To(Equal(15 * time.Second))
+		Expect(cfg.Injector.SidecarContainer.Concurrency).To(Equal(uint32(2)))
+		Expect(cfg.Injector.SidecarContainer.EnvoyLogLevel).To(Equal("debug"))
+		Expect(cfg.Injector.SidecarContainer.EnvoyLogFormat).To(Equal("json"))
+		Expect(cfg.Injector.SidecarContainer.PreStop.Enabled).To(BeTrue())
+		Expect(cfg.Injector.SidecarContainer.PreStop.AppContainers).To(BeTrue())
+		Expect(cfg.Injector.SidecarContainer.PreStop.ExtraTerminationGracePeriod).To(Equal(7 * time.Second))
+		Expect(cfg.Injector.SidecarContainer.SecurityContext.RunAsNonRoot).To(BeTrue())
+		Expect(cfg.Injector.SidecarContainer.SecurityContext.ReadOnlyRootFilesystem).To(BeTrue())
+		Expect(cfg.Injector.SidecarContainer.SecurityContext.AllowPrivilegeEscalation).To(BeTrue())
+		Expect(cfg.Injector.SidecarContainer.SecurityContext.DropCapabilities).To(Equal([]string{"NET_RAW"}))
 		// and
 		Expect(cfg.Injector.SidecarContainer.ReadinessProbe.InitialDelaySeconds).To(Equal(int32(11)))
 		Expect(cfg.Injector.SidecarContainer.ReadinessProbe.TimeoutSeconds).To(Equal(int32((13))))
 		Expect(cfg.Injector.SidecarContainer.ReadinessProbe.PeriodSeconds).To(Equal(int32((15))))
 		Expect(cfg.Injector.SidecarContainer.ReadinessProbe.SuccessThreshold).To(Equal(int32((11))))
 		Expect(cfg.Injector.SidecarContainer.ReadinessProbe.FailureThreshold).To(Equal(int32((112))))
+		Expect(cfg.Injector.SidecarContainer.ReadinessProbe.Path).To(Equal("/ready?verbose"))
 		// and
 		Expect(cfg.Injector.SidecarContainer.LivenessProbe.InitialDelaySeconds).To(Equal(int32(260)))
 		Expect(cfg.Injector.SidecarContainer.LivenessProbe.TimeoutSeconds).To(Equal(int32(23)))
 		Expect(cfg.Injector.SidecarContainer.LivenessProbe.PeriodSeconds).To(Equal(int32(25)))
 		Expect(cfg.Injector.SidecarContainer.LivenessProbe.FailureThreshold).To(Equal(int32(212)))
 		// and
+		Expect(cfg.Injector.SidecarContainer.VirtualProbes.Enabled).To(BeTrue())
+		Expect(cfg.Injector.SidecarContainer.VirtualProbes.Port).To(Equal(uint32(19000)))
+		// and
 		Expect(cfg.Injector.SidecarContainer.Resources.Requests.CPU).To(Equal("150m"))
 		Expect(cfg.Injector.SidecarContainer.Resources.Requests.Memory).To(Equal("164Mi"))
 		Expect(cfg.Injector.SidecarContainer.Resources.Limits.CPU).To(Equal("1100m"))
 		Expect(cfg.Injector.SidecarContainer.Resources.Limits.Memory).To(Equal("1512Mi"))
+		Expect(cfg.Injector.SidecarContainer.NativeSidecar).To(BeTrue())
+		Expect(cfg.Injector.SidecarContainer.EnvFrom).To(Equal([]kuma_injector.SidecarEnvFromSource{
+			{ConfigMap: "sidecar-flags"},
+			{Secret: "sidecar-tokens"},
+		}))
+		Expect(cfg.Injector.SidecarContainer.Env).To(Equal([]kuma_injector.SidecarEnvVar{
+			{Name: "KUMA_DATAPLANE_RUNTIME_TOKEN", SecretKeyRef: &kuma_injector.SidecarKeyRef{Name: "dataplane-token", Key: "token"}},
+		}))
 		// and
 		Expect(cfg.Injector.InitContainer.Image).To(Equal("kuma-init:latest"))
 		Expect(cfg.Injector.InitContainer.Enabled).To(Equal(false))
+		Expect(cfg.Injector.InitContainer.SecurityContext.AllowPrivilegeEscalation).To(BeTrue())
+		Expect(cfg.Injector.InitContainer.SecurityContext.AddCapabilities).To(Equal([]string{"SYS_ADMIN"}))
+		Expect(cfg.Injector.InitContainer.SecurityContext.DropCapabilities).To(Equal([]string{"NET_RAW"}))
+		// and
+		Expect(cfg.Injector.NamespaceMeshLabel).To(Equal("example.com/mesh"))
 	})
 
 	It("should have consistent defaults", func() {
@@ -83,6 +110,6 @@ var _ = Describe("Config", func() {
 		err := config.Load(filepath.Join("testdata", "invalid-config.input.yaml"), &cfg)
 
 		// then
-		Expect(err).To(MatchError(`Invalid configuration: .WebHookServer is not valid: .Address must be either empty or a valid IPv4/IPv6 address; .Port must be in the range [0, 65535]; .CertDir must be non-empty; .Injector is not valid: .ControlPlane is not valid: .ApiServer is not valid: .URL must be a valid absolute URI; .SidecarContainer is not valid: .Image must be non-empty; .RedirectPort must be in the range [0, 65535]; .AdminPort must be in the range [0, 65535]; .DrainTime must be positive; .ReadinessProbe is not valid: .InitialDelaySeconds must be >= 1; .TimeoutSeconds must be >= 1; .PeriodSeconds must be >= 1; .SuccessThreshold must be >= 1; .FailureThreshold must be >= 1; .LivenessProbe is not valid: .InitialDelaySeconds must be >= 1; .TimeoutSeconds must be >= 1; .PeriodSeconds must be >= 1; .FailureThreshold must be >= 1; .Resources is not valid: .Requests is not valid: .CPU is not valid: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'; .Memory is not valid: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'; .Limits is not valid: .CPU is not valid: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'; .Memory is not valid: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'; .InitContainer is not valid: .Image must be non-empty`))
+		Expect(err).To(MatchError(`Invalid configuration: .WebHookServer is not valid: .Address must be either empty or a valid IPv4/IPv6 address; .Port must be in the range [0, 65535]; .CertDir must be non-empty; .Injector is not valid: .ControlPlane is not valid: .ApiServer is not valid: .URL must be a valid absolute URI; .SidecarContainer is not valid: .Image must be non-empty; .RedirectPort must be in the range [0, 65535]; .AdminPort must be in the range [0, 65535]; .DrainTime must be positive; .EnvoyLogLevel is not valid: unknown log level "verbose". Allowed values: trace, debug, info, warning, error, critical, off; .EnvoyLogFormat is not valid: unknown log format "xml". Allowed values: text, json; .PreStop is not valid: .ExtraTerminationGracePeriod must not be negative; .UID must be positive when .SecurityContext.RunAsNonRoot is enabled; .SecurityContext is not valid: .DropCapabilities is not valid: [0] must be non-empty; .ReadinessProbe is not valid: .InitialDelaySeconds must be >= 1; .TimeoutSeconds must be >= 1; .PeriodSeconds must be >= 1; .SuccessThreshold must be >= 1; .FailureThreshold must be >= 1; .LivenessProbe is not valid: .InitialDelaySeconds must be >= 1; .TimeoutSeconds must be >= 1; .PeriodSeconds must be >= 1; .FailureThreshold must be >= 1; .VirtualProbes is not valid: .Port must be in the range [1, 65535]; .Resources is not valid: .Requests is not valid: .CPU is not valid: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'; .Memory is not valid: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'; .Limits is not valid: .CPU is not valid: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'; .Memory is not valid: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'; .EnvFrom[0] is not valid: .ConfigMap is not valid: a DNS-1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*'); .EnvFrom[1] is not valid: either .ConfigMap or .Secret must be set; .Env[0] is not valid: .Name is not valid: a valid environment variable name must consist of alphabetic characters, digits, '_', '-', or '.', and must not start with a digit (e.g. 'my.env-name',  or 'MY_ENV.NAME',  or 'MyEnvName1', regex used for validation is '[-._a-zA-Z][-._a-zA-Z0-9]*'); .InitContainer is not valid: .Image must be non-empty; .SecurityContext is not valid: .AddCapabilities is not valid: [1] must be non-empty`))
 	})
 })
@@ -30,6 +30,8 @@ type StoreConfig struct {
 	Kubernetes *k8s.KubernetesStoreConfig `yaml:"kubernetes"`
 	// Cache configuration
 	Cache CacheStoreConfig `yaml:"cache"`
+	// Secrets configuration
+	Secrets SecretsStoreConfig `yaml:"secrets"`
 }
 
 func DefaultStoreConfig() *StoreConfig {
@@ -38,6 +40,7 @@ func DefaultStoreConfig() *StoreConfig {
 		Postgres:   postgres.DefaultPostgresStoreConfig(),
 		Kubernetes: k8s.DefaultKubernetesStoreConfig(),
 		Cache:      DefaultCacheStoreConfig(),
+		Secrets:    DefaultSecretsStoreConfig(),
 	}
 }
 
@@ -45,6 +48,7 @@ func (s *StoreConfig) Sanitize() {
 	s.Kubernetes.Sanitize()
 	s.Postgres.Sanitize()
 	s.Cache.Sanitize()
+	s.Secrets.Sanitize()
 }
 
 func (s *StoreConfig) Validate() error {
@@ -57,8 +61,14 @@ func (s *StoreConfig) Validate() error {
 		if err := s.Kubernetes.Validate(); err != nil {
 			return errors.Wrap(err, "Kubernetes validation failed")
 		}
+		if s.Secrets.Encryption.Cipher != NoneCipher {
+			return errors.New("Secrets cannot be encrypted by the Control Plane in the Kubernetes Store")
+		}
 		return nil
 	case MemoryStore:
+		if err := s.Secrets.Validate(); err != nil {
+			return errors.Wrap(err, "Secrets validation failed")
+		}
 		return nil
 	default:
 		return errors.Errorf("Type should be either %s, %s or %s", PostgresStore, KubernetesStore, MemoryStore)
@@ -66,6 +76,9 @@ func (s *StoreConfig) Validate() error {
 	if err := s.Cache.Validate(); err != nil {
 		return errors.Wrap(err, "Cache validation failed")
 	}
+	if err := s.Secrets.Validate(); err != nil {
+		return errors.Wrap(err, "Secrets validation failed")
+	}
 	return nil
 }
 
@@ -89,3 +102,101 @@ func DefaultCacheStoreConfig() CacheStoreConfig {
 		ExpirationTime: time.Second,
 	}
 }
+
+type CipherType = string
+
+const (
+	NoneCipher   CipherType = "none"
+	AESGCMCipher CipherType = "aes-gcm"
+)
+
+var _ config.Config = &SecretsStoreConfig{}
+
+type SecretsStoreConfig struct {
+	// Encryption of Secrets at rest
+	Encryption SecretsEncryptionConfig `yaml:"encryption"`
+}
+
+func (s *SecretsStoreConfig) Sanitize() {
+	s.Encryption.Sanitize()
+}
+
+func (s *SecretsStoreConfig) Validate() error {
+	return s.Encryption.Validate()
+}
+
+func DefaultSecretsStoreConfig() SecretsStoreConfig {
+	return SecretsStoreConfig{
+		Encryption: SecretsEncryptionConfig{
+			Cipher: NoneCipher,
+		},
+	}
+}
+
+var _ config.Config = &SecretsEncryptionConfig{}
+
+type SecretsEncryptionConfig struct {
+	// Cipher that encrypts Secrets at rest. Can be either "none" or "aes-gcm"
+	Cipher CipherType `yaml:"cipher" envconfig:"kuma_store_secrets_encryption_cipher"`
+	// Master key of the "aes-gcm" cipher
+	MasterKey MasterKeyConfig `yaml:"masterKey"`
+	// Master keys Secrets have been encrypted with before the current one.
+	// Secrets encrypted with any of them are re-encrypted with the current master key once they are read.
+	PreviousMasterKeys []MasterKeyConfig `yaml:"previousMasterKeys"`
+	// If true, Secrets stored unencrypted, e.g. before encryption has been turned on, are read as they are
+	// and encrypted once they are read.
+	MigrateUnencrypted bool `yaml:"migrateUnencrypted" envconfig:"kuma_store_secrets_encryption_migrate_unencrypted"`
+}
+
+func (e *SecretsEncryptionConfig) Sanitize() {
+}
+
+func (e *SecretsEncryptionConfig) Validate() error {
+	switch e.Cipher {
+	case NoneCipher:
+		if len(e.PreviousMasterKeys) > 0 {
+			return errors.New("PreviousMasterKeys cannot be defined without a cipher")
+		}
+		return nil
+	case AESGCMCipher:
+		if err := e.MasterKey.Validate(); err != nil {
+			return errors.Wrap(err, "MasterKey validation failed")
+		}
+		for i, key := range e.PreviousMasterKeys {
+			if err := key.Validate(); err != nil {
+				return errors.Wrapf(err, "PreviousMasterKeys[%d] validation failed", i)
+			}
+		}
+		return nil
+	default:
+		return errors.Errorf("Cipher should be either %s or %s", NoneCipher, AESGCMCipher)
+	}
+}
+
+var _ config.Config = &MasterKeyConfig{}
+
+// Source of a base64-encoded key of 16, 24 or 32 bytes. Exactly one of sources has to be defined.
+type MasterKeyConfig struct {
+	// Path to a file with the key
+	File string `yaml:"file" envconfig:"kuma_store_secrets_encryption_master_key_file"`
+	// Name of an environment variable with the key
+	Env string `yaml:"env" envconfig:"kuma_store_secrets_encryption_master_key_env"`
+	// Command that prints the key, e.g. one that decrypts the key with a KMS
+	Command string `yaml:"command" envconfig:"kuma_store_secrets_encryption_master_key_command"`
+}
+
+func (m *MasterKeyConfig) Sanitize() {
+}
+
+func (m *MasterKeyConfig) Validate() error {
+	sources := 0
+	for _, source := range []string{m.File, m.Env, m.Command} {
+		if source != "" {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return errors.New("exactly one of File, Env or Command has to be defined")
+	}
+	return nil
+}
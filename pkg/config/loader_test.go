@@ -85,6 +85,10 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.Store.Cache.Enabled).To(BeFalse())
 			Expect(cfg.Store.Cache.ExpirationTime).To(Equal(3 * time.Second))
 
+			Expect(cfg.Store.Secrets.Encryption.Cipher).To(Equal(store.AESGCMCipher))
+			Expect(cfg.Store.Secrets.Encryption.MasterKey.File).To(Equal("/path/to/master.key"))
+			Expect(cfg.Store.Secrets.Encryption.MigrateUnencrypted).To(BeTrue())
+
 			Expect(cfg.Store.Postgres.TLS.Mode).To(Equal(postgres.VerifyFull))
 			Expect(cfg.Store.Postgres.TLS.CertPath).To(Equal("/path/to/cert"))
 			Expect(cfg.Store.Postgres.TLS.KeyPath).To(Equal("/path/to/key"))
@@ -118,10 +122,13 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.Runtime.Kubernetes.AdmissionServer.Address).To(Equal("127.0.0.2"))
 			Expect(cfg.Runtime.Kubernetes.AdmissionServer.Port).To(Equal(uint32(9443)))
 			Expect(cfg.Runtime.Kubernetes.AdmissionServer.CertDir).To(Equal("/var/run/secrets/kuma.io/kuma-admission-server/tls-cert"))
+			Expect(cfg.Runtime.Kubernetes.CaRootCertSync.Enabled).To(BeTrue())
+			Expect(cfg.Runtime.Kubernetes.CaRootCertSync.RefreshInterval).To(Equal(5 * time.Minute))
 
 			Expect(cfg.Reports.Enabled).To(BeFalse())
 
 			Expect(cfg.General.AdvertisedHostname).To(Equal("kuma.internal"))
+			Expect(cfg.General.FIPSMode).To(BeTrue())
 
 			Expect(cfg.GuiServer.Port).To(Equal(uint32(8888)))
 			Expect(cfg.GuiServer.ApiServerUrl).To(Equal("http://localhost:1234"))
@@ -148,6 +155,12 @@ store:
   cache:
     enabled: false
     expirationTime: 3s
+  secrets:
+    encryption:
+      cipher: aes-gcm
+      masterKey:
+        file: /path/to/master.key
+      migrateUnencrypted: true
 xdsServer:
   grpcPort: 5000
   diagnosticsPort: 5003
@@ -196,10 +209,14 @@ runtime:
       address: 127.0.0.2
       port: 9443
       certDir: /var/run/secrets/kuma.io/kuma-admission-server/tls-cert
+    caRootCertSync:
+      enabled: true
+      refreshInterval: 5m
 reports:
   enabled: false
 general:
   advertisedHostname: kuma.internal
+  fipsMode: true
 guiServer:
   port: 8888
   apiServerUrl: http://localhost:1234
@@ -228,6 +245,9 @@ guiServer:
 				"KUMA_STORE_POSTGRES_TLS_CA_PATH":                               "/path/to/rootCert",
 				"KUMA_STORE_CACHE_ENABLED":                                      "false",
 				"KUMA_STORE_CACHE_EXPIRATION_TIME":                              "3s",
+				"KUMA_STORE_SECRETS_ENCRYPTION_CIPHER":                          "aes-gcm",
+				"KUMA_STORE_SECRETS_ENCRYPTION_MASTER_KEY_FILE":                 "/path/to/master.key",
+				"KUMA_STORE_SECRETS_ENCRYPTION_MIGRATE_UNENCRYPTED":             "true",
 				"KUMA_API_SERVER_READ_ONLY":                                     "true",
 				"KUMA_API_SERVER_PORT":                                          "9090",
 				"KUMA_DATAPLANE_TOKEN_SERVER_ENABLED":                           "true",
@@ -252,7 +272,10 @@ guiServer:
 				"KUMA_KUBERNETES_ADMISSION_SERVER_ADDRESS":                      "127.0.0.2",
 				"KUMA_KUBERNETES_ADMISSION_SERVER_PORT":                         "9443",
 				"KUMA_KUBERNETES_ADMISSION_SERVER_CERT_DIR":                     "/var/run/secrets/kuma.io/kuma-admission-server/tls-cert",
+				"KUMA_KUBERNETES_CA_ROOT_CERT_SYNC_ENABLED":                     "true",
+				"KUMA_KUBERNETES_CA_ROOT_CERT_SYNC_REFRESH_INTERVAL":            "5m",
 				"KUMA_GENERAL_ADVERTISED_HOSTNAME":                              "kuma.internal",
+				"KUMA_GENERAL_FIPS_MODE":                                        "true",
 				"KUMA_API_SERVER_CORS_ALLOWED_DOMAINS":                          "https://kuma,https://someapi",
 				"KUMA_GUI_SERVER_PORT":                                          "8888",
 				"KUMA_GUI_SERVER_API_SERVER_URL":                                "http://localhost:1234",
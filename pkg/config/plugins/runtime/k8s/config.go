@@ -1,6 +1,8 @@
 package k8s
 
 import (
+	"time"
+
 	"github.com/pkg/errors"
 
 	"github.com/Kong/kuma/pkg/config"
@@ -13,6 +15,10 @@ func DefaultKubernetesRuntimeConfig() *KubernetesRuntimeConfig {
 			Address: "", // all addresses
 			Port:    5443,
 		},
+		CaRootCertSync: CaRootCertSyncConfig{
+			Enabled:         false,
+			RefreshInterval: time.Minute,
+		},
 	}
 }
 
@@ -22,6 +28,8 @@ type KubernetesRuntimeConfig struct {
 	AdmissionServer AdmissionServerConfig `yaml:"admissionServer"`
 	// CNIEnabled if true runs kuma-cp in CNI compatible mode
 	CNIEnabled bool `yaml:"cniEnabled" envconfig:"kuma_kubernetes_cni_enabled"`
+	// Mirroring of root certificates of builtin CAs into ConfigMaps.
+	CaRootCertSync CaRootCertSyncConfig `yaml:"caRootCertSync"`
 }
 
 // Configuration of the Admission WebHook Server implemented by the Control Plane.
@@ -45,6 +53,9 @@ func (c *KubernetesRuntimeConfig) Validate() error {
 	if err := c.AdmissionServer.Validate(); err != nil {
 		return errors.Wrap(err, "Admission Server validation failed")
 	}
+	if err := c.CaRootCertSync.Validate(); err != nil {
+		return errors.Wrap(err, "CA Root Cert Sync validation failed")
+	}
 	return nil
 }
 
@@ -62,3 +73,25 @@ func (c *AdmissionServerConfig) Validate() error {
 	}
 	return nil
 }
+
+// Configuration of mirroring of root certificates of builtin CAs into ConfigMaps,
+// so that other systems, e.g. cert-manager or external gateways, can trust workloads of a Mesh.
+type CaRootCertSyncConfig struct {
+	// If true, root certificates of a builtin CA of every Mesh are kept in sync with a ConfigMap
+	// named `<mesh>.ca-root-cert` in the system namespace under the `ca.crt` key.
+	Enabled bool `yaml:"enabled" envconfig:"kuma_kubernetes_ca_root_cert_sync_enabled"`
+	// Interval at which root certificates are checked for changes, e.g. caused by rotation of the CA.
+	RefreshInterval time.Duration `yaml:"refreshInterval" envconfig:"kuma_kubernetes_ca_root_cert_sync_refresh_interval"`
+}
+
+var _ config.Config = &CaRootCertSyncConfig{}
+
+func (c *CaRootCertSyncConfig) Sanitize() {
+}
+
+func (c *CaRootCertSyncConfig) Validate() error {
+	if c.RefreshInterval <= 0 {
+		return errors.New("RefreshInterval must be positive")
+	}
+	return nil
+}
@@ -19,6 +19,9 @@ type XdsServerConfig struct {
 
 	// Interval for re-genarting configuration for Dataplanes connected to the Control Plane
 	DataplaneConfigurationRefreshInterval time.Duration `yaml:"dataplaneConfigurationRefreshInterval" envconfig:"kuma_xds_server_dataplane_configuration_refresh_interval"`
+	// Minimal interval between pushes of configuration to a Dataplane. Changes made within the interval
+	// after a push are coalesced into a single push at the end of it. If 0, every change is pushed right away
+	DataplaneConfigurationDebounceInterval time.Duration `yaml:"dataplaneConfigurationDebounceInterval" envconfig:"kuma_xds_server_dataplane_configuration_debounce_interval"`
 	// Interval for flushing status of Dataplanes connected to the Control Plane
 	DataplaneStatusFlushInterval time.Duration `yaml:"dataplaneStatusFlushInterval" envconfig:"kuma_xds_server_dataplane_status_flush_interval"`
 	// TlsCertFile defines a path to a file with PEM-encoded TLS cert.
@@ -40,6 +43,9 @@ func (x *XdsServerConfig) Validate() error {
 	if x.DataplaneConfigurationRefreshInterval <= 0 {
 		return errors.New("DataplaneConfigurationRefreshInterval must be positive")
 	}
+	if x.DataplaneConfigurationDebounceInterval < 0 {
+		return errors.New("DataplaneConfigurationDebounceInterval cannot be negative")
+	}
 	if x.DataplaneStatusFlushInterval <= 0 {
 		return errors.New("DataplaneStatusFlushInterval must be positive")
 	}
@@ -54,11 +60,12 @@ func (x *XdsServerConfig) Validate() error {
 
 func DefaultXdsServerConfig() *XdsServerConfig {
 	return &XdsServerConfig{
-		GrpcPort:                              5678,
-		DiagnosticsPort:                       5680,
-		DataplaneConfigurationRefreshInterval: 1 * time.Second,
-		DataplaneStatusFlushInterval:          1 * time.Second,
-		TlsCertFile:                           "",
-		TlsKeyFile:                            "",
+		GrpcPort:                               5678,
+		DiagnosticsPort:                        5680,
+		DataplaneConfigurationRefreshInterval:  1 * time.Second,
+		DataplaneConfigurationDebounceInterval: 0,
+		DataplaneStatusFlushInterval:           1 * time.Second,
+		TlsCertFile:                            "",
+		TlsKeyFile:                             "",
 	}
 }
@@ -6,8 +6,10 @@ import (
 	kuma_cp "github.com/Kong/kuma/pkg/config/app/kuma-cp"
 	config_core "github.com/Kong/kuma/pkg/config/core"
 	"github.com/Kong/kuma/pkg/config/core/resources/store"
+	"github.com/Kong/kuma/pkg/core/ca/revocation"
 	"github.com/Kong/kuma/pkg/core/datasource"
 	mesh_managers "github.com/Kong/kuma/pkg/core/managers/apis/mesh"
+	traffic_trace_managers "github.com/Kong/kuma/pkg/core/managers/apis/traffictrace"
 	core_plugins "github.com/Kong/kuma/pkg/core/plugins"
 	"github.com/Kong/kuma/pkg/core/resources/apis/mesh"
 	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
@@ -48,6 +50,8 @@ func buildRuntime(cfg kuma_cp.Config) (core_runtime.Runtime, error) {
 		return nil, err
 	}
 
+	builder.WithRevocationManager(revocation.NewManager(builder.SecretManager()))
+
 	initializeXds(builder)
 
 	rt, err := builder.Build()
@@ -82,9 +86,16 @@ func onStartup(runtime core_runtime.Runtime) error {
 	if err := createDefaultSigningKey(runtime); err != nil {
 		return err
 	}
+	if err := startRevocationGarbageCollector(runtime); err != nil {
+		return err
+	}
 	return startReporter(runtime)
 }
 
+func startRevocationGarbageCollector(runtime core_runtime.Runtime) error {
+	return runtime.Add(revocation.NewGarbageCollector(runtime.RevocationManager(), runtime.ReadOnlyResourceManager(), revocation.GarbageCollectionInterval))
+}
+
 func createDefaultSigningKey(runtime core_runtime.Runtime) error {
 	switch env := runtime.Config().Environment; env {
 	case config_core.KubernetesEnvironment:
@@ -175,7 +186,11 @@ func initializeSecretManager(cfg kuma_cp.Config, builder *core_runtime.Builder)
 		cipher = secret_cipher.None() // deliberately turn encryption off on Kubernetes
 	case store.MemoryStore, store.PostgresStore:
 		pluginName = core_plugins.Universal
-		cipher = secret_cipher.TODO() // get back to encryption in universal case
+		c, err := newSecretCipher(cfg.Store.Secrets.Encryption)
+		if err != nil {
+			return errors.Wrap(err, "could not configure encryption of secrets")
+		}
+		cipher = c
 	default:
 		return errors.Errorf("unknown store type %s", cfg.Store.Type)
 	}
@@ -186,11 +201,54 @@ func initializeSecretManager(cfg kuma_cp.Config, builder *core_runtime.Builder)
 	if secretStore, err := plugin.NewSecretStore(builder, pluginConfig); err != nil {
 		return err
 	} else {
-		builder.WithSecretManager(secret_manager.NewSecretManager(secretStore, cipher))
+		builder.WithSecretManager(secret_manager.NewSecretManager(secretStore, cipher)).
+			WithSecretCipher(cipher)
 		return nil
 	}
 }
 
+func newSecretCipher(cfg store.SecretsEncryptionConfig) (secret_cipher.Cipher, error) {
+	if cfg.Cipher == store.NoneCipher {
+		return secret_cipher.None(), nil
+	}
+	primary, err := newAESGCMCipher(cfg.MasterKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load master key")
+	}
+	var previous []secret_cipher.Cipher
+	for i, key := range cfg.PreviousMasterKeys {
+		c, err := newAESGCMCipher(key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not load previous master key %d", i)
+		}
+		previous = append(previous, c)
+	}
+	if cfg.MigrateUnencrypted {
+		previous = append(previous, secret_cipher.None())
+	}
+	if len(previous) == 0 {
+		return primary, nil
+	}
+	return secret_cipher.NewRotating(primary, previous...), nil
+}
+
+func newAESGCMCipher(cfg store.MasterKeyConfig) (secret_cipher.Cipher, error) {
+	var key []byte
+	var err error
+	switch {
+	case cfg.File != "":
+		key, err = secret_cipher.KeyFromFile(cfg.File)
+	case cfg.Env != "":
+		key, err = secret_cipher.KeyFromEnv(cfg.Env)
+	default:
+		key, err = secret_cipher.KeyFromCommand(cfg.Command)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return secret_cipher.NewAESGCM(key)
+}
+
 func initializeDiscovery(cfg kuma_cp.Config, builder *core_runtime.Builder) error {
 	var pluginName core_plugins.PluginName
 	var pluginConfig core_plugins.PluginConfig
@@ -239,11 +297,14 @@ func initializeResourceManager(builder *core_runtime.Builder) {
 	}
 	meshManager := mesh_managers.NewMeshManager(builder.ResourceStore(), customizableManager, builder.SecretManager(), builder.CaManagers(), registry.Global(), validator)
 	customManagers[mesh.MeshType] = meshManager
-	builder.WithResourceManager(customizableManager)
+	customManagers[mesh.TrafficTraceType] = traffic_trace_managers.NewTrafficTraceManager(builder.ResourceStore(), defaultManager)
 
 	if builder.Config().Store.Cache.Enabled {
-		builder.WithReadOnlyResourceManager(core_manager.NewCachedManager(customizableManager, builder.Config().Store.Cache.ExpirationTime))
+		cachedManager := core_manager.NewCachedManager(customizableManager, builder.Config().Store.Cache.ExpirationTime)
+		builder.WithResourceManager(core_manager.NewInvalidatingManager(customizableManager, cachedManager))
+		builder.WithReadOnlyResourceManager(cachedManager)
 	} else {
+		builder.WithResourceManager(customizableManager)
 		builder.WithReadOnlyResourceManager(customizableManager)
 	}
 }
@@ -19,4 +19,5 @@ import (
 
 	_ "github.com/Kong/kuma/pkg/plugins/ca/builtin"
 	_ "github.com/Kong/kuma/pkg/plugins/ca/provided"
+	_ "github.com/Kong/kuma/pkg/plugins/ca/vault"
 )
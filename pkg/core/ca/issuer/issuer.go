@@ -6,12 +6,14 @@ import (
 	"crypto/rsa"
 	"crypto/tls"
 	"crypto/x509"
-	"net/url"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spiffe/spire/pkg/common/x509util"
 
+	core_ca "github.com/Kong/kuma/pkg/core/ca"
 	util_tls "github.com/Kong/kuma/pkg/tls"
 )
 
@@ -19,9 +21,41 @@ const (
 	DefaultRsaBits                    = 2048
 	DefaultAllowedClockSkew           = 10 * time.Second
 	DefaultWorkloadCertValidityPeriod = 90 * 24 * time.Hour
+	DefaultCRLValidityPeriod          = 24 * time.Hour
 )
 
-func NewWorkloadCert(ca util_tls.KeyPair, mesh string, workload string) (*util_tls.KeyPair, error) {
+// WorkloadCertOptionFunc customizes a template of a Workload Identity cert.
+type WorkloadCertOptionFunc func(*x509.Certificate)
+
+// WithSignatureAlgorithm overrides the algorithm the CA uses to sign a Workload Identity cert.
+func WithSignatureAlgorithm(algorithm x509.SignatureAlgorithm) WorkloadCertOptionFunc {
+	return func(template *x509.Certificate) {
+		template.SignatureAlgorithm = algorithm
+	}
+}
+
+// WithValidityPeriod overrides how long a Workload Identity cert is valid for.
+func WithValidityPeriod(validity time.Duration) WorkloadCertOptionFunc {
+	return func(template *x509.Certificate) {
+		template.NotAfter = template.NotBefore.Add(DefaultAllowedClockSkew + validity)
+	}
+}
+
+// WithCommonName sets the Common Name of a Workload Identity cert.
+func WithCommonName(commonName string) WorkloadCertOptionFunc {
+	return func(template *x509.Certificate) {
+		template.Subject.CommonName = commonName
+	}
+}
+
+// WithDNSNames adds DNS SANs to a Workload Identity cert. The SPIFFE URI SAN is always kept.
+func WithDNSNames(dnsNames ...string) WorkloadCertOptionFunc {
+	return func(template *x509.Certificate) {
+		template.DNSNames = append(template.DNSNames, dnsNames...)
+	}
+}
+
+func NewWorkloadCert(ca util_tls.KeyPair, mesh string, workload string, fs ...WorkloadCertOptionFunc) (*util_tls.KeyPair, error) {
 	caPrivateKey, caCert, err := loadKeyPair(ca)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load CA key pair")
@@ -31,19 +65,15 @@ func NewWorkloadCert(ca util_tls.KeyPair, mesh string, workload string) (*util_t
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to generate a private key")
 	}
-	workloadCert, err := newWorkloadCert(caPrivateKey, caCert, mesh, workload, workloadKey.Public())
+	workloadCert, err := newWorkloadCert(caPrivateKey, caCert, mesh, workload, workloadKey.Public(), fs...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to generate X509 certificate")
 	}
 	return util_tls.ToKeyPair(workloadKey, workloadCert)
 }
 
-func newWorkloadCert(signer crypto.PrivateKey, parent *x509.Certificate, trustDomain string, workload string, publicKey crypto.PublicKey) ([]byte, error) {
-	spiffeID := &url.URL{
-		Scheme: "spiffe",
-		Host:   trustDomain,
-		Path:   workload,
-	}
+func newWorkloadCert(signer crypto.PrivateKey, parent *x509.Certificate, trustDomain string, workload string, publicKey crypto.PublicKey, fs ...WorkloadCertOptionFunc) ([]byte, error) {
+	spiffeID := core_ca.ServiceSpiffeID(trustDomain, workload)
 
 	now := time.Now()
 	notBefore := now.Add(-DefaultAllowedClockSkew)
@@ -54,14 +84,42 @@ func newWorkloadCert(signer crypto.PrivateKey, parent *x509.Certificate, trustDo
 		return nil, err
 	}
 
-	template, err := NewWorkloadTemplate(spiffeID.String(), trustDomain, publicKey, notBefore, notAfter, serialNumber)
+	template, err := NewWorkloadTemplate(spiffeID, trustDomain, publicKey, notBefore, notAfter, serialNumber)
 	if err != nil {
 		return nil, err
 	}
+	for _, f := range fs {
+		f(template)
+	}
 
 	return x509.CreateCertificate(rand.Reader, template, parent, publicKey, signer)
 }
 
+// NewCRL creates a PEM-encoded CRL signed by a CA that lists revoked certs issued by it.
+// The CRL is valid for DefaultCRLValidityPeriod, so it has to be re-created before then.
+func NewCRL(ca util_tls.KeyPair, revoked []core_ca.RevokedCert, now time.Time) ([]byte, error) {
+	caPrivateKey, caCert, err := loadKeyPair(ca)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load CA key pair")
+	}
+	signer, ok := caPrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("private key of the CA cannot sign a CRL")
+	}
+	revokedCerts := make([]pkix.RevokedCertificate, 0, len(revoked))
+	for _, cert := range revoked {
+		revokedCerts = append(revokedCerts, pkix.RevokedCertificate{
+			SerialNumber:   cert.SerialNumber,
+			RevocationTime: cert.RevokedAt,
+		})
+	}
+	crl, err := caCert.CreateCRL(rand.Reader, signer, revokedCerts, now, now.Add(DefaultCRLValidityPeriod))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create a CRL")
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: crl}), nil
+}
+
 func loadKeyPair(pair util_tls.KeyPair) (crypto.PrivateKey, *x509.Certificate, error) {
 	root, err := tls.X509KeyPair(pair.CertPEM, pair.KeyPEM)
 	if err != nil {
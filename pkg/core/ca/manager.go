@@ -2,15 +2,28 @@ package ca
 
 import (
 	"context"
+	"math/big"
+	"time"
 
 	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	"github.com/Kong/kuma/pkg/tls"
+
+	"github.com/pkg/errors"
 )
 
 type Cert = []byte
 
 type KeyPair = tls.KeyPair
 
+// RevokedCert is a Dataplane certificate that has been revoked before it expires
+type RevokedCert struct {
+	SerialNumber *big.Int  `json:"serialNumber"`
+	NotAfter     time.Time `json:"notAfter"`
+	RevokedAt    time.Time `json:"revokedAt"`
+	// Backend is the name of the CA backend that has issued the certificate
+	Backend string `json:"backend,omitempty"`
+}
+
 // Manager manages CAs by creating CAs and generating certificate. It is created per CA type and then may be used for different CA instances of the same type
 type Manager interface {
 	// ValidateBackend validates that backend configuration is correct
@@ -20,9 +33,51 @@ type Manager interface {
 
 	// GetRootCert returns root certificates of the CA
 	GetRootCert(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend) ([]Cert, error)
-	// GenerateDataplaneCert generates cert for a dataplanes with service tag
-	GenerateDataplaneCert(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend, service string) (KeyPair, error)
+	// GetTrustBundle returns root certificates of the CA as a SPIFFE trust bundle to federate with other meshes
+	GetTrustBundle(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend) ([]byte, error)
+	// GenerateDataplaneCert generates cert for a dataplanes with service tag that is valid for a given period.
+	// Zero validity means the default validity of certs issued by the backend
+	GenerateDataplaneCert(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend, service string, validity time.Duration) (KeyPair, error)
+	// GetCRL returns PEM-encoded CRLs that list given revoked Dataplane certificates,
+	// one for every CA that Dataplane certificates of the backend might be signed by.
+	// Once CRLs are configured, Envoy requires a CRL of every CA of the chain, so nil is returned
+	// if the backend cannot sign CRLs of all of them, e.g. of parents of an intermediate CA.
+	// A backend that keeps its own revocation list, e.g. Vault, revokes certificates even if no CRL is returned
+	GetCRL(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend, revoked []RevokedCert) ([]byte, error)
 }
 
-// Managers hold Manager instance for each type of backend available (by default: builtin, provided)
-type Managers = map[string]Manager
+// Managers hold Manager instance for each type of backend available (by default: builtin, provided, vault)
+type Managers map[string]Manager
+
+// Register registers Manager for backends of a given type, replacing the one registered before
+func (m Managers) Register(backendType string, manager Manager) {
+	m[backendType] = manager
+}
+
+// Get returns Manager for backends of a given type
+func (m Managers) Get(backendType string) (Manager, error) {
+	manager, exist := m[backendType]
+	if !exist {
+		return nil, errors.Errorf("CA manager of type %q does not exist", backendType)
+	}
+	return manager, nil
+}
+
+// GetEnabled returns the enabled CA backend of a Mesh together with Manager for its type
+func (m Managers) GetEnabled(mesh string, spec *mesh_proto.Mesh) (Manager, *mesh_proto.CertificateAuthorityBackend, error) {
+	enabledBackend := spec.GetMtls().GetEnabledBackend()
+	if enabledBackend == "" {
+		return nil, nil, errors.Errorf("CA backend in mesh %q is not enabled", mesh)
+	}
+	for _, backend := range spec.GetMtls().GetBackends() {
+		if backend.Name != enabledBackend {
+			continue
+		}
+		manager, err := m.Get(backend.Type)
+		if err != nil {
+			return nil, nil, err
+		}
+		return manager, backend, nil
+	}
+	return nil, nil, errors.Errorf("CA backend %q enabled in mesh %q is not defined", enabledBackend, mesh)
+}
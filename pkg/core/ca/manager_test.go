@@ -0,0 +1,134 @@
+package ca_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	core_ca "github.com/Kong/kuma/pkg/core/ca"
+)
+
+type fakeCaManager struct {
+	backendType string
+}
+
+var _ core_ca.Manager = &fakeCaManager{}
+
+func (f *fakeCaManager) ValidateBackend(context.Context, string, mesh_proto.CertificateAuthorityBackend) error {
+	return nil
+}
+
+func (f *fakeCaManager) Ensure(context.Context, string, mesh_proto.CertificateAuthorityBackend) error {
+	return nil
+}
+
+func (f *fakeCaManager) GetRootCert(context.Context, string, mesh_proto.CertificateAuthorityBackend) ([]core_ca.Cert, error) {
+	return []core_ca.Cert{core_ca.Cert(f.backendType)}, nil
+}
+
+func (f *fakeCaManager) GetTrustBundle(context.Context, string, mesh_proto.CertificateAuthorityBackend) ([]byte, error) {
+	return nil, nil
+}
+
+func (f *fakeCaManager) GenerateDataplaneCert(context.Context, string, mesh_proto.CertificateAuthorityBackend, string, time.Duration) (core_ca.KeyPair, error) {
+	return core_ca.KeyPair{}, nil
+}
+
+func (f *fakeCaManager) GetCRL(context.Context, string, mesh_proto.CertificateAuthorityBackend, []core_ca.RevokedCert) ([]byte, error) {
+	return nil, nil
+}
+
+var _ = Describe("Managers", func() {
+
+	var managers core_ca.Managers
+
+	BeforeEach(func() {
+		managers = core_ca.Managers{}
+		managers.Register("builtin", &fakeCaManager{backendType: "builtin"})
+		managers.Register("provided", &fakeCaManager{backendType: "provided"})
+	})
+
+	rootCertOf := func(manager core_ca.Manager) string {
+		certs, err := manager.GetRootCert(context.Background(), "demo", mesh_proto.CertificateAuthorityBackend{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(certs).To(HaveLen(1))
+		return string(certs[0])
+	}
+
+	Describe("Get()", func() {
+		It("should dispatch by backend type", func() {
+			for _, typ := range []string{"builtin", "provided"} {
+				// when
+				manager, err := managers.Get(typ)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(rootCertOf(manager)).To(Equal(typ))
+			}
+		})
+
+		It("should return an error for an unknown type", func() {
+			// when
+			_, err := managers.Get("vault")
+
+			// then
+			Expect(err).To(MatchError(`CA manager of type "vault" does not exist`))
+		})
+	})
+
+	Describe("GetEnabled()", func() {
+		mesh := func(enabledBackend string) *mesh_proto.Mesh {
+			return &mesh_proto.Mesh{
+				Mtls: &mesh_proto.Mesh_Mtls{
+					EnabledBackend: enabledBackend,
+					Backends: []*mesh_proto.CertificateAuthorityBackend{
+						{Name: "ca-1", Type: "builtin"},
+						{Name: "ca-2", Type: "provided"},
+						{Name: "ca-3", Type: "vault"},
+					},
+				},
+			}
+		}
+
+		It("should resolve the enabled backend to its manager", func() {
+			// when
+			manager, backend, err := managers.GetEnabled("demo", mesh("ca-2"))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(backend.Name).To(Equal("ca-2"))
+			Expect(rootCertOf(manager)).To(Equal("provided"))
+		})
+
+		type testCase struct {
+			spec     *mesh_proto.Mesh
+			expected string
+		}
+
+		DescribeTable("should return an error",
+			func(given testCase) {
+				// when
+				_, _, err := managers.GetEnabled("demo", given.spec)
+
+				// then
+				Expect(err).To(MatchError(given.expected))
+			},
+			Entry("when mTLS is not configured", testCase{
+				spec:     &mesh_proto.Mesh{},
+				expected: `CA backend in mesh "demo" is not enabled`,
+			}),
+			Entry("when the enabled backend is not defined", testCase{
+				spec:     mesh("ca-4"),
+				expected: `CA backend "ca-4" enabled in mesh "demo" is not defined`,
+			}),
+			Entry("when the enabled backend is of an unknown type", testCase{
+				spec:     mesh("ca-3"),
+				expected: `CA manager of type "vault" does not exist`,
+			}),
+		)
+	})
+})
@@ -0,0 +1,57 @@
+package revocation
+
+import (
+	"context"
+	"time"
+
+	"github.com/Kong/kuma/pkg/core"
+	core_mesh "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
+	core_model "github.com/Kong/kuma/pkg/core/resources/model"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+	"github.com/Kong/kuma/pkg/core/runtime/component"
+)
+
+var gcLog = core.Log.WithName("revocation-gc")
+
+// GarbageCollectionInterval is how often revocations of deleted dataplanes and expired certificates are pruned.
+const GarbageCollectionInterval = 10 * time.Minute
+
+// NewGarbageCollector returns a component that periodically prunes revocations of every Mesh.
+func NewGarbageCollector(manager Manager, resManager core_manager.ReadOnlyResourceManager, interval time.Duration) component.Component {
+	return component.ComponentFunc(func(stop <-chan struct{}) error {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := prune(context.Background(), manager, resManager); err != nil {
+					gcLog.Error(err, "could not prune revocations")
+				}
+			case <-stop:
+				return nil
+			}
+		}
+	})
+}
+
+func prune(ctx context.Context, manager Manager, resManager core_manager.ReadOnlyResourceManager) error {
+	meshes := &core_mesh.MeshResourceList{}
+	if err := resManager.List(ctx, meshes); err != nil {
+		return err
+	}
+	for _, mesh := range meshes.Items {
+		dataplanes := &core_mesh.DataplaneResourceList{}
+		if err := resManager.List(ctx, dataplanes, core_store.ListByMesh(mesh.GetMeta().GetName())); err != nil {
+			return err
+		}
+		metas := make([]core_model.ResourceMeta, 0, len(dataplanes.Items))
+		for _, dataplane := range dataplanes.Items {
+			metas = append(metas, dataplane.GetMeta())
+		}
+		if err := manager.Prune(ctx, mesh.GetMeta().GetName(), metas); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,356 @@
+package revocation
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/pkg/errors"
+
+	system_proto "github.com/Kong/kuma/api/system/v1alpha1"
+	core_ca "github.com/Kong/kuma/pkg/core/ca"
+	core_system "github.com/Kong/kuma/pkg/core/resources/apis/system"
+	core_model "github.com/Kong/kuma/pkg/core/resources/model"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+	secret_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
+)
+
+// Manager keeps track of Dataplane certificates issued by the Control Plane,
+// so that certificates of a compromised dataplane can be revoked before they expire.
+//
+// Dataplanes are identified by name and creation time, so that a dataplane re-created with the same name
+// is not revoked. Revoked certificates are identified by serial number and are dropped once they expire.
+type Manager interface {
+	// CertIssued keeps track of a certificate issued to a dataplane until it expires, so that it can be revoked later.
+	// Issued certificates are stored, so that they can be revoked after a restart of the Control Plane as well.
+	CertIssued(ctx context.Context, dataplane core_model.ResourceMeta, backend string, certPEM []byte) error
+	// Revoke revokes all certificates issued to a dataplane that have not expired yet.
+	// A revoked dataplane is not issued certificates anymore until it is unrevoked or deleted.
+	Revoke(ctx context.Context, dataplane core_model.ResourceMeta) error
+	// Unrevoke lets a revoked dataplane be issued certificates again.
+	// Certificates revoked before stay revoked until they expire.
+	Unrevoke(ctx context.Context, mesh string, dataplane string) error
+	// IsRevoked returns true if a dataplane has been revoked
+	IsRevoked(ctx context.Context, dataplane core_model.ResourceMeta) (bool, error)
+	// RevokedCerts returns revoked certificates of a Mesh that have not expired yet
+	RevokedCerts(ctx context.Context, mesh string) ([]core_ca.RevokedCert, error)
+	// Prune drops revocations of dataplanes of a Mesh that do not exist anymore and revoked certificates that have expired
+	Prune(ctx context.Context, mesh string, dataplanes []core_model.ResourceMeta) error
+}
+
+// revocations are revoked dataplanes of a Mesh together with their certificates that have not expired yet
+// and unexpired certificates issued to dataplanes that have not been revoked.
+type revocations struct {
+	Dataplanes []revokedDataplane    `json:"dataplanes,omitempty"`
+	Certs      []core_ca.RevokedCert `json:"certs,omitempty"`
+	Issued     []issuedCerts         `json:"issued,omitempty"`
+}
+
+type revokedDataplane struct {
+	Name         string    `json:"name"`
+	CreationTime time.Time `json:"creationTime"`
+	RevokedAt    time.Time `json:"revokedAt"`
+}
+
+// issuedCerts are certificates issued to a dataplane, which are revoked once the dataplane is revoked.
+type issuedCerts struct {
+	Name         string                `json:"name"`
+	CreationTime time.Time             `json:"creationTime"`
+	Certs        []core_ca.RevokedCert `json:"certs"`
+}
+
+func (r *revocations) dataplane(dataplane core_model.ResourceMeta) *revokedDataplane {
+	for i := range r.Dataplanes {
+		if r.Dataplanes[i].Name == dataplane.GetName() && r.Dataplanes[i].CreationTime.Equal(dataplane.GetCreationTime()) {
+			return &r.Dataplanes[i]
+		}
+	}
+	return nil
+}
+
+func (r *revocations) issued(name string, creationTime time.Time) *issuedCerts {
+	for i := range r.Issued {
+		if r.Issued[i].Name == name && r.Issued[i].CreationTime.Equal(creationTime) {
+			return &r.Issued[i]
+		}
+	}
+	return nil
+}
+
+// revokeIssued moves certificates issued to a revoked dataplane to revoked certificates.
+func (r *revocations) revokeIssued(dataplane revokedDataplane) {
+	var issued []issuedCerts
+	for _, certs := range r.Issued {
+		if certs.Name != dataplane.Name || !certs.CreationTime.Equal(dataplane.CreationTime) {
+			issued = append(issued, certs)
+			continue
+		}
+		for _, cert := range certs.Certs {
+			cert.RevokedAt = dataplane.RevokedAt
+			r.Certs = append(r.Certs, cert)
+		}
+	}
+	r.Issued = issued
+}
+
+// secretManager stores revocations of every Mesh as a Secret.
+// Certificates issued to dataplanes are stored in the same Secret, so that a dataplane revoked by any instance
+// of the Control Plane, including one that has been restarted since, loses all of them.
+type secretManager struct {
+	secretManager secret_manager.SecretManager
+	now           func() time.Time
+
+	// mu serializes updates of revocations, which are read, modified and written back
+	mu sync.Mutex
+}
+
+var _ Manager = &secretManager{}
+
+type OptionFunc func(*secretManager)
+
+// WithClock overrides the clock used to tell whether certificates have expired.
+func WithClock(now func() time.Time) OptionFunc {
+	return func(m *secretManager) {
+		m.now = now
+	}
+}
+
+func NewManager(manager secret_manager.SecretManager, fs ...OptionFunc) Manager {
+	m := &secretManager{
+		secretManager: manager,
+		now:           time.Now,
+	}
+	for _, f := range fs {
+		f(m)
+	}
+	return m
+}
+
+func revocationsSecretResKey(mesh string) core_model.ResourceKey {
+	return core_model.ResourceKey{
+		Mesh: mesh,
+		Name: fmt.Sprintf("%s.dataplane-revocations", mesh), // we add mesh as a prefix to have uniqueness of Secret names on K8S
+	}
+}
+
+func (m *secretManager) CertIssued(ctx context.Context, dataplane core_model.ResourceMeta, backend string, certPEM []byte) error {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return errors.New("not a valid PEM certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return errors.Wrap(err, "not a valid PEM certificate")
+	}
+
+	issued := core_ca.RevokedCert{
+		SerialNumber: cert.SerialNumber,
+		NotAfter:     cert.NotAfter,
+		Backend:      backend,
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	mesh := dataplane.GetMesh()
+	record := revocations{}
+	if err := m.load(ctx, mesh, &record); err != nil {
+		return err
+	}
+	// a certificate issued concurrently with a revocation of a dataplane is revoked right away
+	if revoked := record.dataplane(dataplane); revoked != nil {
+		issued.RevokedAt = revoked.RevokedAt
+		record.Certs = append(record.Certs, issued)
+		return m.save(ctx, mesh, &record)
+	}
+	certs := record.issued(dataplane.GetName(), dataplane.GetCreationTime())
+	if certs == nil {
+		record.Issued = append(record.Issued, issuedCerts{
+			Name:         dataplane.GetName(),
+			CreationTime: dataplane.GetCreationTime(),
+		})
+		certs = &record.Issued[len(record.Issued)-1]
+	}
+	certs.Certs = append(m.unexpired(certs.Certs), issued)
+	return m.save(ctx, mesh, &record)
+}
+
+func (m *secretManager) Revoke(ctx context.Context, dataplane core_model.ResourceMeta) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	mesh := dataplane.GetMesh()
+	record := revocations{}
+	if err := m.load(ctx, mesh, &record); err != nil {
+		return err
+	}
+	if record.dataplane(dataplane) != nil {
+		return nil
+	}
+	revoked := revokedDataplane{
+		Name:         dataplane.GetName(),
+		CreationTime: dataplane.GetCreationTime(),
+		RevokedAt:    m.now(),
+	}
+	record.Dataplanes = append(record.Dataplanes, revoked)
+	record.revokeIssued(revoked)
+	record.Certs = m.unexpired(record.Certs)
+	return m.save(ctx, mesh, &record)
+}
+
+func (m *secretManager) Unrevoke(ctx context.Context, mesh string, dataplane string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	record := revocations{}
+	if err := m.load(ctx, mesh, &record); err != nil {
+		return err
+	}
+	var dataplanes []revokedDataplane
+	for _, revoked := range record.Dataplanes {
+		if revoked.Name != dataplane {
+			dataplanes = append(dataplanes, revoked)
+		}
+	}
+	if len(dataplanes) == len(record.Dataplanes) {
+		return nil
+	}
+	record.Dataplanes = dataplanes
+	return m.save(ctx, mesh, &record)
+}
+
+func (m *secretManager) IsRevoked(ctx context.Context, dataplane core_model.ResourceMeta) (bool, error) {
+	record := revocations{}
+	if err := m.load(ctx, dataplane.GetMesh(), &record); err != nil {
+		return false, err
+	}
+	return record.dataplane(dataplane) != nil, nil
+}
+
+func (m *secretManager) RevokedCerts(ctx context.Context, mesh string) ([]core_ca.RevokedCert, error) {
+	record := revocations{}
+	if err := m.load(ctx, mesh, &record); err != nil {
+		return nil, err
+	}
+	return m.unexpired(record.Certs), nil
+}
+
+func (m *secretManager) Prune(ctx context.Context, mesh string, dataplanes []core_model.ResourceMeta) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// dataplanes are identified by name and creation time
+	type dataplaneKey struct {
+		name         string
+		creationTime int64
+	}
+	existing := map[dataplaneKey]bool{}
+	for _, dataplane := range dataplanes {
+		existing[dataplaneKey{name: dataplane.GetName(), creationTime: dataplane.GetCreationTime().UnixNano()}] = true
+	}
+
+	record := revocations{}
+	if err := m.load(ctx, mesh, &record); err != nil {
+		return err
+	}
+	changed := false
+	var revoked []revokedDataplane
+	for _, dataplane := range record.Dataplanes {
+		if existing[dataplaneKey{name: dataplane.Name, creationTime: dataplane.CreationTime.UnixNano()}] {
+			revoked = append(revoked, dataplane)
+		} else {
+			changed = true
+		}
+	}
+	certs := m.unexpired(record.Certs)
+	if len(certs) != len(record.Certs) {
+		changed = true
+	}
+	var issued []issuedCerts
+	for _, dataplane := range record.Issued {
+		unexpired := m.unexpired(dataplane.Certs)
+		if len(unexpired) != len(dataplane.Certs) {
+			changed = true
+		}
+		if len(unexpired) == 0 || !existing[dataplaneKey{name: dataplane.Name, creationTime: dataplane.CreationTime.UnixNano()}] {
+			changed = true
+			continue
+		}
+		dataplane.Certs = unexpired
+		issued = append(issued, dataplane)
+	}
+	if !changed {
+		return nil
+	}
+	if len(revoked) == 0 && len(certs) == 0 && len(issued) == 0 {
+		return m.delete(ctx, mesh)
+	}
+	record.Dataplanes = revoked
+	record.Certs = certs
+	record.Issued = issued
+	return m.save(ctx, mesh, &record)
+}
+
+// unexpired drops expired certificates, which do not have to be tracked anymore.
+func (m *secretManager) unexpired(certs []core_ca.RevokedCert) []core_ca.RevokedCert {
+	now := m.now()
+	var result []core_ca.RevokedCert
+	for _, cert := range certs {
+		if cert.NotAfter.After(now) {
+			result = append(result, cert)
+		}
+	}
+	return result
+}
+
+// load unmarshals revocations of a Mesh from a Secret, leaving them empty if the Secret does not exist.
+func (m *secretManager) load(ctx context.Context, mesh string, record *revocations) error {
+	key := revocationsSecretResKey(mesh)
+	secret := &core_system.SecretResource{}
+	err := m.secretManager.Get(ctx, secret, core_store.GetBy(key))
+	if core_store.IsResourceNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(secret.Spec.GetData().GetValue(), record); err != nil {
+		return errors.Wrapf(err, "could not unmarshal Secret %q", key.Name)
+	}
+	return nil
+}
+
+// save marshals revocations of a Mesh into a Secret, creating the Secret if it does not exist.
+func (m *secretManager) save(ctx context.Context, mesh string, record *revocations) error {
+	key := revocationsSecretResKey(mesh)
+	value, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	secret := &core_system.SecretResource{}
+	err = m.secretManager.Get(ctx, secret, core_store.GetBy(key))
+	if err != nil && !core_store.IsResourceNotFound(err) {
+		return err
+	}
+	data := &wrappers.BytesValue{Value: value}
+	if err == nil {
+		secret.Spec.Data = data
+		return m.secretManager.Update(ctx, secret)
+	}
+	secret.Spec = system_proto.Secret{Data: data}
+	return m.secretManager.Create(ctx, secret, core_store.CreateBy(key))
+}
+
+// delete deletes revocations of a Mesh once there are none left.
+func (m *secretManager) delete(ctx context.Context, mesh string) error {
+	err := m.secretManager.Delete(ctx, &core_system.SecretResource{}, core_store.DeleteBy(revocationsSecretResKey(mesh)))
+	if core_store.IsResourceNotFound(err) {
+		return nil
+	}
+	return err
+}
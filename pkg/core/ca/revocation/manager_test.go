@@ -0,0 +1,302 @@
+package revocation_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	core_ca "github.com/Kong/kuma/pkg/core/ca"
+	"github.com/Kong/kuma/pkg/core/ca/revocation"
+	core_system "github.com/Kong/kuma/pkg/core/resources/apis/system"
+	core_model "github.com/Kong/kuma/pkg/core/resources/model"
+	"github.com/Kong/kuma/pkg/core/secrets/cipher"
+	secret_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
+	secret_store "github.com/Kong/kuma/pkg/core/secrets/store"
+	"github.com/Kong/kuma/pkg/plugins/resources/memory"
+	test_model "github.com/Kong/kuma/pkg/test/resources/model"
+)
+
+var _ = Describe("Revocation Manager", func() {
+
+	var manager revocation.Manager
+	var secretManager secret_manager.SecretManager
+	var now time.Time
+
+	BeforeEach(func() {
+		now = time.Now().Truncate(time.Second)
+		secretManager = secret_manager.NewSecretManager(secret_store.NewSecretStore(memory.NewStore()), cipher.None())
+		manager = revocation.NewManager(secretManager, revocation.WithClock(func() time.Time {
+			return now
+		}))
+	})
+
+	certPEM := func(serialNumber int64, notAfter time.Time) []byte {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(serialNumber),
+			NotBefore:    notAfter.Add(-24 * time.Hour),
+			NotAfter:     notAfter,
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+		Expect(err).ToNot(HaveOccurred())
+		return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	}
+
+	dataplane := func(name string, creationTime time.Time) core_model.ResourceMeta {
+		return &test_model.ResourceMeta{
+			Mesh:         "demo",
+			Name:         name,
+			CreationTime: creationTime,
+		}
+	}
+
+	It("should revoke certificates issued to a dataplane", func() {
+		// given
+		web01 := dataplane("web-01", now.Add(-time.Hour))
+		web02 := dataplane("web-02", now.Add(-time.Hour))
+		Expect(manager.CertIssued(context.Background(), web01, "ca-1", certPEM(1, now.Add(time.Hour)))).To(Succeed())
+		Expect(manager.CertIssued(context.Background(), web01, "ca-1", certPEM(2, now.Add(2*time.Hour)))).To(Succeed())
+		Expect(manager.CertIssued(context.Background(), web02, "ca-1", certPEM(3, now.Add(time.Hour)))).To(Succeed())
+
+		// when
+		err := manager.Revoke(context.Background(), web01)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		revoked, err := manager.RevokedCerts(context.Background(), "demo")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revoked).To(HaveLen(2))
+		Expect(revoked[0].SerialNumber).To(Equal(big.NewInt(1)))
+		Expect(revoked[0].NotAfter).To(BeTemporally("==", now.Add(time.Hour)))
+		Expect(revoked[0].RevokedAt).To(BeTemporally("==", now))
+		Expect(revoked[0].Backend).To(Equal("ca-1"))
+		Expect(revoked[1].SerialNumber).To(Equal(big.NewInt(2)))
+
+		// and
+		Expect(manager.IsRevoked(context.Background(), web01)).To(BeTrue())
+		Expect(manager.IsRevoked(context.Background(), web02)).To(BeFalse())
+
+		// and other meshes are not affected
+		Expect(manager.RevokedCerts(context.Background(), "default")).To(BeEmpty())
+	})
+
+	It("should not revoke a dataplane re-created with the same name", func() {
+		// given
+		Expect(manager.Revoke(context.Background(), dataplane("web-01", now.Add(-time.Hour)))).To(Succeed())
+
+		// when
+		revoked, err := manager.IsRevoked(context.Background(), dataplane("web-01", now))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revoked).To(BeFalse())
+	})
+
+	It("should revoke certificates issued by another instance of the Control Plane", func() {
+		// given
+		web01 := dataplane("web-01", now.Add(-time.Hour))
+		other := revocation.NewManager(secretManager, revocation.WithClock(func() time.Time {
+			return now
+		}))
+		Expect(other.CertIssued(context.Background(), web01, "ca-1", certPEM(1, now.Add(time.Hour)))).To(Succeed())
+
+		// when
+		Expect(manager.Revoke(context.Background(), web01)).To(Succeed())
+
+		// then
+		revoked, err := manager.RevokedCerts(context.Background(), "demo")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revoked).To(HaveLen(1))
+		Expect(revoked[0].SerialNumber).To(Equal(big.NewInt(1)))
+		// and
+		Expect(other.RevokedCerts(context.Background(), "demo")).To(HaveLen(1))
+	})
+
+	It("should revoke certificates issued before a restart of the Control Plane", func() {
+		// given
+		web01 := dataplane("web-01", now.Add(-time.Hour))
+		Expect(manager.CertIssued(context.Background(), web01, "ca-1", certPEM(1, now.Add(time.Hour)))).To(Succeed())
+
+		// when the Control Plane is restarted
+		restarted := revocation.NewManager(secretManager, revocation.WithClock(func() time.Time {
+			return now
+		}))
+		// and
+		Expect(restarted.Revoke(context.Background(), web01)).To(Succeed())
+
+		// then
+		revoked, err := restarted.RevokedCerts(context.Background(), "demo")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revoked).To(HaveLen(1))
+		Expect(revoked[0].SerialNumber).To(Equal(big.NewInt(1)))
+		Expect(revoked[0].RevokedAt).To(BeTemporally("==", now))
+	})
+
+	It("should revoke a certificate issued to a dataplane that has been revoked", func() {
+		// given
+		web01 := dataplane("web-01", now.Add(-time.Hour))
+		Expect(manager.Revoke(context.Background(), web01)).To(Succeed())
+
+		// when
+		err := manager.CertIssued(context.Background(), web01, "ca-1", certPEM(1, now.Add(time.Hour)))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		revoked, err := manager.RevokedCerts(context.Background(), "demo")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revoked).To(HaveLen(1))
+		Expect(revoked[0].SerialNumber).To(Equal(big.NewInt(1)))
+	})
+
+	It("should unrevoke a dataplane", func() {
+		// given
+		web01 := dataplane("web-01", now.Add(-time.Hour))
+		Expect(manager.CertIssued(context.Background(), web01, "ca-1", certPEM(1, now.Add(time.Hour)))).To(Succeed())
+		Expect(manager.Revoke(context.Background(), web01)).To(Succeed())
+
+		// when
+		err := manager.Unrevoke(context.Background(), "demo", "web-01")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(manager.IsRevoked(context.Background(), web01)).To(BeFalse())
+		// and revoked certificates stay revoked
+		Expect(manager.RevokedCerts(context.Background(), "demo")).To(HaveLen(1))
+	})
+
+	It("should drop revoked certificates once they expire", func() {
+		// given
+		web01 := dataplane("web-01", now.Add(-time.Hour))
+		Expect(manager.CertIssued(context.Background(), web01, "ca-1", certPEM(1, now.Add(time.Hour)))).To(Succeed())
+		Expect(manager.CertIssued(context.Background(), web01, "ca-1", certPEM(2, now.Add(2*time.Hour)))).To(Succeed())
+		Expect(manager.Revoke(context.Background(), web01)).To(Succeed())
+
+		// when
+		now = now.Add(time.Hour)
+
+		// then
+		revoked, err := manager.RevokedCerts(context.Background(), "demo")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revoked).To(HaveLen(1))
+		Expect(revoked[0].SerialNumber).To(Equal(big.NewInt(2)))
+
+		// when
+		now = now.Add(time.Hour)
+
+		// then
+		Expect(manager.RevokedCerts(context.Background(), "demo")).To(BeEmpty())
+	})
+
+	It("should not revoke certificates that have already expired", func() {
+		// given
+		web01 := dataplane("web-01", now.Add(-time.Hour))
+		Expect(manager.CertIssued(context.Background(), web01, "ca-1", certPEM(1, now.Add(time.Hour)))).To(Succeed())
+		now = now.Add(time.Hour)
+
+		// when
+		err := manager.Revoke(context.Background(), web01)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(manager.RevokedCerts(context.Background(), "demo")).To(Equal([]core_ca.RevokedCert(nil)))
+		Expect(manager.IsRevoked(context.Background(), web01)).To(BeTrue())
+	})
+
+	It("should not revoke certificates of dataplanes that are not revoked", func() {
+		// when
+		err := manager.CertIssued(context.Background(), dataplane("web-01", now), "ca-1", certPEM(1, now.Add(time.Hour)))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(manager.RevokedCerts(context.Background(), "demo")).To(BeEmpty())
+	})
+
+	Context("Prune", func() {
+		It("should drop revocations of deleted dataplanes", func() {
+			// given
+			web01 := dataplane("web-01", now.Add(-time.Hour))
+			web02 := dataplane("web-02", now.Add(-time.Hour))
+			Expect(manager.CertIssued(context.Background(), web01, "ca-1", certPEM(1, now.Add(time.Hour)))).To(Succeed())
+			Expect(manager.Revoke(context.Background(), web01)).To(Succeed())
+			Expect(manager.Revoke(context.Background(), web02)).To(Succeed())
+
+			// when web-01 is deleted and re-created with the same name
+			err := manager.Prune(context.Background(), "demo", []core_model.ResourceMeta{dataplane("web-01", now), web02})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(manager.IsRevoked(context.Background(), web01)).To(BeFalse())
+			Expect(manager.IsRevoked(context.Background(), web02)).To(BeTrue())
+			// and revoked certificates stay revoked until they expire
+			Expect(manager.RevokedCerts(context.Background(), "demo")).To(HaveLen(1))
+		})
+
+		It("should drop certificates issued to deleted dataplanes", func() {
+			// given
+			web01 := dataplane("web-01", now.Add(-time.Hour))
+			Expect(manager.CertIssued(context.Background(), web01, "ca-1", certPEM(1, now.Add(time.Hour)))).To(Succeed())
+
+			// when web-01 is deleted and re-created with the same name
+			err := manager.Prune(context.Background(), "demo", []core_model.ResourceMeta{dataplane("web-01", now)})
+
+			// then nothing is stored anymore
+			Expect(err).ToNot(HaveOccurred())
+			secrets := &core_system.SecretResourceList{}
+			Expect(secretManager.List(context.Background(), secrets)).To(Succeed())
+			Expect(secrets.Items).To(BeEmpty())
+		})
+
+		It("should drop issued certificates that have expired", func() {
+			// given
+			web01 := dataplane("web-01", now.Add(-time.Hour))
+			Expect(manager.CertIssued(context.Background(), web01, "ca-1", certPEM(1, now.Add(time.Hour)))).To(Succeed())
+
+			// when the certificate expires
+			now = now.Add(time.Hour)
+			err := manager.Prune(context.Background(), "demo", []core_model.ResourceMeta{web01})
+
+			// then nothing is stored anymore
+			Expect(err).ToNot(HaveOccurred())
+			secrets := &core_system.SecretResourceList{}
+			Expect(secretManager.List(context.Background(), secrets)).To(Succeed())
+			Expect(secrets.Items).To(BeEmpty())
+			// and
+			Expect(manager.Revoke(context.Background(), web01)).To(Succeed())
+			Expect(manager.RevokedCerts(context.Background(), "demo")).To(BeEmpty())
+		})
+
+		It("should drop revoked certificates that have expired", func() {
+			// given
+			web01 := dataplane("web-01", now.Add(-time.Hour))
+			Expect(manager.CertIssued(context.Background(), web01, "ca-1", certPEM(1, now.Add(time.Hour)))).To(Succeed())
+			Expect(manager.Revoke(context.Background(), web01)).To(Succeed())
+
+			// when web-01 is deleted and its certificate expires
+			now = now.Add(time.Hour)
+			err := manager.Prune(context.Background(), "demo", nil)
+
+			// then nothing is stored anymore
+			Expect(err).ToNot(HaveOccurred())
+			secrets := &core_system.SecretResourceList{}
+			Expect(secretManager.List(context.Background(), secrets)).To(Succeed())
+			Expect(secrets.Items).To(BeEmpty())
+		})
+	})
+
+	It("should reject a certificate that is not a valid PEM certificate", func() {
+		// when
+		err := manager.CertIssued(context.Background(), dataplane("web-01", now), "ca-1", []byte("invalid"))
+
+		// then
+		Expect(err).To(MatchError("not a valid PEM certificate"))
+	})
+})
@@ -0,0 +1,38 @@
+package ca
+
+import (
+	"net/url"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// ServiceSpiffeID returns the SPIFFE ID of a service in a mesh, i.e. `spiffe://<mesh>/<service>`.
+// Characters of the service name that are not allowed in a URI path are percent-encoded.
+func ServiceSpiffeID(mesh string, service string) string {
+	spiffeID := &url.URL{
+		Scheme: "spiffe",
+		Host:   mesh,
+		Path:   service,
+	}
+	return spiffeID.String()
+}
+
+// ValidateServiceName checks that a service name can be a path of a SPIFFE ID. Names with whitespace,
+// control characters or slashes are rejected, since peers would either reject such a SPIFFE ID
+// or read it as a different one.
+func ValidateServiceName(service string) error {
+	if service == "" {
+		return errors.New("service name must not be empty")
+	}
+	if strings.Contains(service, "/") {
+		return errors.Errorf("service name %q must not contain slashes", service)
+	}
+	for _, r := range service {
+		if unicode.IsSpace(r) || unicode.IsControl(r) {
+			return errors.Errorf("service name %q must not contain whitespace or control characters", service)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package ca_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	core_ca "github.com/Kong/kuma/pkg/core/ca"
+)
+
+var _ = Describe("ServiceSpiffeID()", func() {
+
+	DescribeTable("should build SPIFFE ID of a service",
+		func(service string, expected string) {
+			// expect
+			Expect(core_ca.ServiceSpiffeID("default", service)).To(Equal(expected))
+		},
+		Entry("plain name", "web", "spiffe://default/web"),
+		Entry("name with characters allowed in a path", "backend_v1.svc:8080", "spiffe://default/backend_v1.svc:8080"),
+		Entry("name with characters that need encoding", "café", "spiffe://default/caf%C3%A9"),
+	)
+})
+
+var _ = Describe("ValidateServiceName()", func() {
+
+	It("should accept a name with characters that need encoding", func() {
+		// expect
+		Expect(core_ca.ValidateServiceName("café")).To(Succeed())
+	})
+
+	DescribeTable("should reject names that cannot be a path of SPIFFE ID",
+		func(service string, expected string) {
+			// when
+			err := core_ca.ValidateServiceName(service)
+
+			// then
+			Expect(err).To(MatchError(expected))
+		},
+		Entry("empty name", "", `service name must not be empty`),
+		Entry("name with a space", "web app", `service name "web app" must not contain whitespace or control characters`),
+		Entry("name with a tab", "web\tapp", `service name "web\tapp" must not contain whitespace or control characters`),
+		Entry("name with a slash", "web/v1", `service name "web/v1" must not contain slashes`),
+	)
+})
@@ -0,0 +1,100 @@
+package ca
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// TrustBundle is a SPIFFE trust bundle, i.e. a JWK Set with root X.509 authorities.
+// See https://github.com/spiffe/spiffe/blob/master/standards/SPIFFE_Trust_Domain_and_Bundle.md
+type TrustBundle struct {
+	Keys []TrustBundleKey `json:"keys"`
+}
+
+// TrustBundleKey is a JWK that represents an X.509 authority of a SPIFFE trust bundle.
+type TrustBundleKey struct {
+	Use string `json:"use"`
+	Kty string `json:"kty"`
+	// RSA public key parameters
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// EC public key parameters
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+	// X5c contains exactly one base64-encoded DER certificate
+	X5c []string `json:"x5c"`
+}
+
+const x509SvidUse = "x509-svid"
+
+// NewTrustBundle creates a SPIFFE trust bundle out of PEM-encoded root certificates.
+// Keys of the bundle follow the order of certificates, so the output is stable for the same input.
+func NewTrustBundle(certs []Cert) ([]byte, error) {
+	bundle := TrustBundle{
+		Keys: []TrustBundleKey{},
+	}
+	for _, cert := range certs {
+		rest := cert
+		for {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			if block.Type != "CERTIFICATE" {
+				continue
+			}
+			key, err := newTrustBundleKey(block.Bytes)
+			if err != nil {
+				return nil, err
+			}
+			bundle.Keys = append(bundle.Keys, key)
+		}
+	}
+	if len(bundle.Keys) == 0 {
+		return nil, errors.New("there are no PEM-encoded certificates to put into a trust bundle")
+	}
+	return json.MarshalIndent(bundle, "", "  ")
+}
+
+func newTrustBundleKey(der []byte) (TrustBundleKey, error) {
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return TrustBundleKey{}, errors.Wrap(err, "failed to parse a root certificate")
+	}
+	key := TrustBundleKey{
+		Use: x509SvidUse,
+		X5c: []string{base64.StdEncoding.EncodeToString(der)},
+	}
+	switch pub := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		key.Kty = "RSA"
+		key.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		key.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case *ecdsa.PublicKey:
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		key.Kty = "EC"
+		key.Crv = pub.Curve.Params().Name
+		key.X = base64.RawURLEncoding.EncodeToString(padded(pub.X.Bytes(), size))
+		key.Y = base64.RawURLEncoding.EncodeToString(padded(pub.Y.Bytes(), size))
+	default:
+		return TrustBundleKey{}, errors.Errorf("unsupported public key type %T of a root certificate %q", pub, cert.Subject)
+	}
+	return key, nil
+}
+
+// padded left-pads coordinates of EC keys to the size of a curve as required by RFC 7518.
+func padded(b []byte, size int) []byte {
+	if len(b) >= size {
+		return b
+	}
+	return append(make([]byte, size-len(b)), b...)
+}
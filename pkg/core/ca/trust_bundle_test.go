@@ -0,0 +1,113 @@
+package ca_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	core_ca "github.com/Kong/kuma/pkg/core/ca"
+)
+
+var _ = Describe("NewTrustBundle()", func() {
+
+	newEcdsaRootCert := func(curve elliptic.Curve) (core_ca.Cert, *x509.Certificate) {
+		key, err := ecdsa.GenerateKey(curve, rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+		template := &x509.Certificate{
+			SerialNumber:          big.NewInt(1),
+			Subject:               pkix.Name{CommonName: "default"},
+			NotBefore:             time.Now(),
+			NotAfter:              time.Now().Add(time.Hour),
+			IsCA:                  true,
+			BasicConstraintsValid: true,
+			KeyUsage:              x509.KeyUsageCertSign,
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+		Expect(err).ToNot(HaveOccurred())
+		cert, err := x509.ParseCertificate(der)
+		Expect(err).ToNot(HaveOccurred())
+		return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), cert
+	}
+
+	It("should export ECDSA root certs", func() {
+		// given
+		p256, p256Cert := newEcdsaRootCert(elliptic.P256())
+		p384, p384Cert := newEcdsaRootCert(elliptic.P384())
+
+		// when
+		bundle, err := core_ca.NewTrustBundle([]core_ca.Cert{append(p256, p384...)})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		jwks := core_ca.TrustBundle{}
+		err = json.Unmarshal(bundle, &jwks)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(jwks.Keys).To(HaveLen(2))
+		for i, cert := range []*x509.Certificate{p256Cert, p384Cert} {
+			key := jwks.Keys[i]
+			Expect(key.Use).To(Equal("x509-svid"))
+			Expect(key.Kty).To(Equal("EC"))
+			Expect(key.Crv).To(Equal(cert.PublicKey.(*ecdsa.PublicKey).Curve.Params().Name))
+			Expect(key.X5c).To(Equal([]string{base64.StdEncoding.EncodeToString(cert.Raw)}))
+
+			// and coordinates match the public key
+			x, err := base64.RawURLEncoding.DecodeString(key.X)
+			Expect(err).ToNot(HaveOccurred())
+			y, err := base64.RawURLEncoding.DecodeString(key.Y)
+			Expect(err).ToNot(HaveOccurred())
+			size := (cert.PublicKey.(*ecdsa.PublicKey).Curve.Params().BitSize + 7) / 8
+			Expect(x).To(HaveLen(size))
+			Expect(y).To(HaveLen(size))
+			Expect(new(big.Int).SetBytes(x)).To(Equal(cert.PublicKey.(*ecdsa.PublicKey).X))
+			Expect(new(big.Int).SetBytes(y)).To(Equal(cert.PublicKey.(*ecdsa.PublicKey).Y))
+		}
+	})
+
+	It("should produce stable output", func() {
+		// given
+		cert, _ := newEcdsaRootCert(elliptic.P256())
+
+		// when
+		first, err := core_ca.NewTrustBundle([]core_ca.Cert{cert})
+		Expect(err).ToNot(HaveOccurred())
+		second, err := core_ca.NewTrustBundle([]core_ca.Cert{cert})
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(first).To(Equal(second))
+	})
+
+	It("should throw an error when there are no certificates", func() {
+		// when
+		_, err := core_ca.NewTrustBundle([]core_ca.Cert{[]byte("not a PEM")})
+
+		// then
+		Expect(err).To(MatchError("there are no PEM-encoded certificates to put into a trust bundle"))
+	})
+
+	It("should throw an error on invalid certificates", func() {
+		// given
+		cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("not a DER")})
+
+		// when
+		_, err := core_ca.NewTrustBundle([]core_ca.Cert{cert})
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(HavePrefix("failed to parse a root certificate"))
+	})
+})
@@ -2,7 +2,11 @@ package datasource
 
 import (
 	"context"
+	"fmt"
 	"io/ioutil"
+	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/pkg/errors"
 
@@ -16,41 +20,116 @@ type Loader interface {
 	Load(ctx context.Context, mesh string, source *system_proto.DataSource) ([]byte, error)
 }
 
+// DataSourceResolver loads data of a single type of DataSource.
+type DataSourceResolver interface {
+	Resolve(ctx context.Context, mesh string, source *system_proto.DataSource) ([]byte, error)
+}
+
+type DataSourceResolverFunc func(ctx context.Context, mesh string, source *system_proto.DataSource) ([]byte, error)
+
+func (f DataSourceResolverFunc) Resolve(ctx context.Context, mesh string, source *system_proto.DataSource) ([]byte, error) {
+	return f(ctx, mesh, source)
+}
+
+type LoaderOption func(*loader)
+
+// WithResolver registers a resolver of DataSources of a given type, e.g. "file".
+// It replaces a resolver registered before for the same type, including the default ones.
+func WithResolver(typ string, resolver DataSourceResolver) LoaderOption {
+	return func(l *loader) {
+		l.resolvers[typ] = resolver
+	}
+}
+
 type loader struct {
 	secretManager manager.SecretManager
+	// resolvers are indexed by names of DataSource types
+	resolvers map[string]DataSourceResolver
 }
 
 var _ Loader = &loader{}
 
-func NewDataSourceLoader(secretManager manager.SecretManager) Loader {
-	return &loader{
+func NewDataSourceLoader(secretManager manager.SecretManager, opts ...LoaderOption) Loader {
+	l := &loader{
 		secretManager: secretManager,
 	}
+	l.resolvers = map[string]DataSourceResolver{
+		"secret": DataSourceResolverFunc(func(ctx context.Context, mesh string, source *system_proto.DataSource) ([]byte, error) {
+			return l.loadSecret(ctx, mesh, source.GetSecret())
+		}),
+		"file": DataSourceResolverFunc(func(_ context.Context, _ string, source *system_proto.DataSource) ([]byte, error) {
+			return ioutil.ReadFile(source.GetFile())
+		}),
+		"inline": DataSourceResolverFunc(func(_ context.Context, _ string, source *system_proto.DataSource) ([]byte, error) {
+			return source.GetInline().GetValue(), nil
+		}),
+		"projected": newProjectedResolver(),
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
 }
 
 func (l *loader) Load(ctx context.Context, mesh string, source *system_proto.DataSource) ([]byte, error) {
-	var data []byte
-	var err error
-	switch source.GetType().(type) {
-	case *system_proto.DataSource_Secret:
-		data, err = l.loadSecret(ctx, mesh, source.GetSecret())
-	case *system_proto.DataSource_Inline:
-		data, err = source.GetInline().GetValue(), nil
-	case *system_proto.DataSource_File:
-		data, err = ioutil.ReadFile(source.GetFile())
-	default:
-		return nil, errors.New("unsupported type of the DataSource")
+	resolver, ok := l.resolvers[TypeOf(source)]
+	if !ok {
+		return nil, errors.Errorf("data source has to be chosen. Available sources: %s", strings.Join(l.types(), ", "))
 	}
+	data, err := resolver.Resolve(ctx, mesh, source)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not load data")
 	}
 	return data, nil
 }
 
+// types returns names of DataSource types that have a resolver.
+func (l *loader) types() []string {
+	var types []string
+	for typ := range l.resolvers {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// TypeOf returns a name of the type of a DataSource, i.e. the name of the field of the type oneof that is set,
+// e.g. "file". It returns an empty string if no type is set.
+func TypeOf(source *system_proto.DataSource) string {
+	if source.GetType() == nil {
+		return ""
+	}
+	// every oneof wrapper generated by protoc-gen-go is a struct with a single field tagged with its name
+	wrapper := reflect.TypeOf(source.GetType()).Elem()
+	if wrapper.Kind() != reflect.Struct || wrapper.NumField() != 1 {
+		return ""
+	}
+	for _, part := range strings.Split(wrapper.Field(0).Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(part, "name=") {
+			return strings.TrimPrefix(part, "name=")
+		}
+	}
+	return ""
+}
+
 func (l *loader) loadSecret(ctx context.Context, mesh string, secret string) ([]byte, error) {
 	resource := &system.SecretResource{}
 	if err := l.secretManager.Get(ctx, resource, core_store.GetByKey(secret, mesh)); err != nil {
 		return nil, err
 	}
+	// Not every secret store scopes names by mesh (e.g. on Kubernetes all Secrets share the same namespace),
+	// that is why we have to make sure that a secret from another mesh is never exposed.
+	if resource.GetMeta().GetMesh() != mesh {
+		return nil, ErrorSecretAccessDenied(secret, mesh)
+	}
 	return resource.Spec.GetData().GetValue(), nil
 }
+
+func ErrorSecretAccessDenied(name, mesh string) error {
+	return fmt.Errorf("Access denied: secret %q is not accessible from mesh %q", name, mesh)
+}
+
+func IsSecretAccessDenied(err error) bool {
+	err = errors.Cause(err)
+	return err != nil && strings.HasPrefix(err.Error(), "Access denied")
+}
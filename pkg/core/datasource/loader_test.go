@@ -2,7 +2,9 @@ package datasource_test
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"path/filepath"
 
 	"io/ioutil"
 
@@ -16,9 +18,12 @@ import (
 	"github.com/Kong/kuma/pkg/core/secrets/manager"
 	secret_store "github.com/Kong/kuma/pkg/core/secrets/store"
 	"github.com/Kong/kuma/pkg/plugins/resources/memory"
+	secrets_k8s "github.com/Kong/kuma/pkg/plugins/secrets/k8s"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+
+	kube_client_fake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 var _ = Describe("DataSource Loader", func() {
@@ -67,6 +72,55 @@ var _ = Describe("DataSource Loader", func() {
 			// then
 			Expect(err).To(MatchError(`could not load data: Resource not found: type="Secret" name="test-secret" mesh="default"`))
 		})
+
+		Context("store that does not scope secret names by mesh", func() {
+
+			BeforeEach(func() {
+				kubeClient := kube_client_fake.NewFakeClient()
+				secretStore, err := secrets_k8s.NewStore(kubeClient, kubeClient, "kuma-system")
+				Expect(err).ToNot(HaveOccurred())
+				secretManager = manager.NewSecretManager(secretStore, cipher.None())
+				dataSourceLoader = datasource.NewDataSourceLoader(secretManager)
+			})
+
+			BeforeEach(func() {
+				secretResource := system.SecretResource{
+					Spec: system_proto.Secret{
+						Data: &wrappers.BytesValue{
+							Value: []byte("abc"),
+						},
+					},
+				}
+				err := secretManager.Create(context.Background(), &secretResource, store.CreateByKey("test-secret", "mesh-a"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should load secret from the same mesh", func() {
+				// when
+				data, err := dataSourceLoader.Load(context.Background(), "mesh-a", &system_proto.DataSource{
+					Type: &system_proto.DataSource_Secret{
+						Secret: "test-secret",
+					},
+				})
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(data).To(Equal([]byte("abc")))
+			})
+
+			It("should deny access to secret from another mesh", func() {
+				// when
+				_, err := dataSourceLoader.Load(context.Background(), "mesh-b", &system_proto.DataSource{
+					Type: &system_proto.DataSource_Secret{
+						Secret: "test-secret",
+					},
+				})
+
+				// then
+				Expect(err).To(MatchError(`could not load data: Access denied: secret "test-secret" is not accessible from mesh "mesh-b"`))
+				Expect(datasource.IsSecretAccessDenied(err)).To(BeTrue())
+			})
+		})
 	})
 
 	Context("File", func() {
@@ -102,6 +156,100 @@ var _ = Describe("DataSource Loader", func() {
 		})
 	})
 
+	Context("Projected", func() {
+		var dir string
+
+		// project simulates how kubelet rotates a projected file: it writes a new timestamped directory,
+		// atomically swaps the `..data` symlink to point at it and removes the previous directory
+		project := func(timestamp string, content string) {
+			Expect(os.Mkdir(filepath.Join(dir, timestamp), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(dir, timestamp, "token"), []byte(content), 0644)).To(Succeed())
+			previous, _ := os.Readlink(filepath.Join(dir, "..data"))
+			Expect(os.Symlink(timestamp, filepath.Join(dir, "..data_tmp"))).To(Succeed())
+			Expect(os.Rename(filepath.Join(dir, "..data_tmp"), filepath.Join(dir, "..data"))).To(Succeed())
+			if previous != "" {
+				Expect(os.RemoveAll(filepath.Join(dir, previous))).To(Succeed())
+			}
+		}
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "projected")
+			Expect(err).ToNot(HaveOccurred())
+			project("..2020_01_01_00_00_00.1", "abc")
+			Expect(os.Symlink(filepath.Join("..data", "token"), filepath.Join(dir, "token"))).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		source := func() *system_proto.DataSource {
+			return &system_proto.DataSource{
+				Type: &system_proto.DataSource_Projected{
+					Projected: filepath.Join(dir, "token"),
+				},
+			}
+		}
+
+		It("should pick up content rotated by kubelet", func() {
+			// when
+			data, err := dataSourceLoader.Load(context.Background(), "default", source())
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(Equal([]byte("abc")))
+
+			// when
+			project("..2020_01_01_01_00_00.2", "def")
+			data, err = dataSourceLoader.Load(context.Background(), "default", source())
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(Equal([]byte("def")))
+
+			// when
+			project("..2020_01_01_02_00_00.3", "ghi")
+			data, err = dataSourceLoader.Load(context.Background(), "default", source())
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(Equal([]byte("ghi")))
+		})
+
+		It("should throw an error on a projected file that does not exist", func() {
+			// when
+			_, err := dataSourceLoader.Load(context.Background(), "default", &system_proto.DataSource{
+				Type: &system_proto.DataSource_Projected{
+					Projected: filepath.Join(dir, "non-existent-file"),
+				},
+			})
+
+			// then
+			Expect(err).To(MatchError(fmt.Sprintf("could not load data: lstat %s: no such file or directory", filepath.Join(dir, "non-existent-file"))))
+		})
+
+		It("should validate that a projected file can be read", func() {
+			// when
+			verr := datasource.Validate(source())
+
+			// then
+			Expect(verr.HasViolations()).To(BeFalse())
+
+			// when
+			verr = datasource.Validate(&system_proto.DataSource{
+				Type: &system_proto.DataSource_Projected{
+					Projected: filepath.Join(dir, "non-existent-file"),
+				},
+			})
+
+			// then
+			Expect(verr.Violations).To(HaveLen(1))
+			Expect(verr.Violations[0].Field).To(Equal("projected"))
+			Expect(verr.Violations[0].Message).To(Equal(fmt.Sprintf("could not be read: open %s: no such file or directory", filepath.Join(dir, "non-existent-file"))))
+		})
+	})
+
 	Context("Inline", func() {
 		It("should load from inline", func() {
 			// when
@@ -118,4 +266,34 @@ var _ = Describe("DataSource Loader", func() {
 			Expect(data).To(Equal([]byte("abc")))
 		})
 	})
+
+	Context("Resolvers", func() {
+		It("should load through a registered resolver", func() {
+			// given
+			dataSourceLoader = datasource.NewDataSourceLoader(secretManager, datasource.WithResolver("file",
+				datasource.DataSourceResolverFunc(func(_ context.Context, mesh string, source *system_proto.DataSource) ([]byte, error) {
+					return []byte(mesh + ":" + source.GetFile()), nil
+				}),
+			))
+
+			// when
+			data, err := dataSourceLoader.Load(context.Background(), "default", &system_proto.DataSource{
+				Type: &system_proto.DataSource_File{
+					File: "non-existent-file",
+				},
+			})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(Equal([]byte("default:non-existent-file")))
+		})
+
+		It("should throw an error on a type without a resolver", func() {
+			// when
+			_, err := dataSourceLoader.Load(context.Background(), "default", &system_proto.DataSource{})
+
+			// then
+			Expect(err).To(MatchError("data source has to be chosen. Available sources: file, inline, projected, secret"))
+		})
+	})
 })
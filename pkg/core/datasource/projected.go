@@ -0,0 +1,90 @@
+package datasource
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	system_proto "github.com/Kong/kuma/api/system/v1alpha1"
+)
+
+const (
+	// projectedReadAttempts is how many times a projected file is read when it disappears in the middle of a rotation.
+	projectedReadAttempts = 3
+	// projectedRetryInterval is how long a swap of the symlink by kubelet is waited for.
+	projectedRetryInterval = 10 * time.Millisecond
+)
+
+// projectedResolver reads files projected by Kubernetes, e.g. service account tokens or downward API files.
+//
+// Kubelet rotates such a file by writing a new timestamped directory, atomically swapping the `..data` symlink
+// to point at it and removing the old directory. The resolver follows the symlinks on every load, so that
+// rotated content is picked up, and retries when a target is removed before it could be read.
+// Content is cached by the target of the symlinks, so that a file is only read again once it has been rotated.
+type projectedResolver struct {
+	mu    sync.Mutex
+	files map[string]projectedFile
+}
+
+// projectedFile is the last content read from a projected file.
+type projectedFile struct {
+	target  string
+	modTime time.Time
+	size    int64
+	data    []byte
+}
+
+var _ DataSourceResolver = &projectedResolver{}
+
+func newProjectedResolver() *projectedResolver {
+	return &projectedResolver{
+		files: map[string]projectedFile{},
+	}
+}
+
+func (r *projectedResolver) Resolve(_ context.Context, _ string, source *system_proto.DataSource) ([]byte, error) {
+	var err error
+	for attempt := 1; ; attempt++ {
+		var data []byte
+		data, err = r.read(source.GetProjected())
+		if err == nil || !os.IsNotExist(err) || attempt == projectedReadAttempts {
+			return data, err
+		}
+		time.Sleep(projectedRetryInterval)
+	}
+}
+
+func (r *projectedResolver) read(path string) ([]byte, error) {
+	target, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(target)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	cached, ok := r.files[path]
+	r.mu.Unlock()
+	if ok && cached.target == target && cached.modTime.Equal(info.ModTime()) && cached.size == info.Size() {
+		return cached.data, nil
+	}
+
+	data, err := ioutil.ReadFile(target)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	r.files[path] = projectedFile{
+		target:  target,
+		modTime: info.ModTime(),
+		size:    info.Size(),
+		data:    data,
+	}
+	r.mu.Unlock()
+	return data, nil
+}
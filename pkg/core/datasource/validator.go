@@ -1,6 +1,9 @@
 package datasource
 
 import (
+	"fmt"
+	"os"
+
 	system_proto "github.com/Kong/kuma/api/system/v1alpha1"
 	"github.com/Kong/kuma/pkg/core/validators"
 )
@@ -8,7 +11,7 @@ import (
 func Validate(source *system_proto.DataSource) validators.ValidationError {
 	verr := validators.ValidationError{}
 	if source == nil || source.Type == nil {
-		verr.AddViolation("", "data source has to be chosen. Available sources: secret, file, inline")
+		verr.AddViolation("", "data source has to be chosen. Available sources: secret, file, inline, projected")
 	}
 	switch source.GetType().(type) {
 	case *system_proto.DataSource_Secret:
@@ -23,6 +26,21 @@ func Validate(source *system_proto.DataSource) validators.ValidationError {
 		if source.GetFile() == "" {
 			verr.AddViolation("file", "cannot be empty")
 		}
+	case *system_proto.DataSource_Projected:
+		if source.GetProjected() == "" {
+			verr.AddViolation("projected", "cannot be empty")
+		} else if err := checkReadable(source.GetProjected()); err != nil {
+			verr.AddViolation("projected", fmt.Sprintf("could not be read: %s", err))
+		}
 	}
 	return verr
 }
+
+// checkReadable checks that a file can be opened for reading, following symlinks.
+func checkReadable(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	return file.Close()
+}
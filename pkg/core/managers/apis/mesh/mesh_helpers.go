@@ -38,9 +38,9 @@ func CreateDefaultMesh(resManager core_manager.ResourceManager, template mesh_pr
 func EnsureEnabledCA(ctx context.Context, caManagers core_ca.Managers, mesh *mesh_core.MeshResource, meshName string) error {
 	if mesh.GetEnabledCertificateAuthorityBackend() != nil {
 		backend := mesh.GetEnabledCertificateAuthorityBackend()
-		caManager, exist := caManagers[backend.Type]
-		if !exist { // this should be caught by validator earlier
-			return errors.Errorf("CA manager for type %s does not exist", backend.Type)
+		caManager, err := caManagers.Get(backend.Type)
+		if err != nil { // this should be caught by validator earlier
+			return err
 		}
 		if err := caManager.Ensure(ctx, meshName, *backend); err != nil {
 			return errors.Wrapf(err, "could not create CA of backend name %s", backend.Name)
@@ -139,6 +139,9 @@ var _ = Describe("Mesh Manager", func() {
                       prometheus:
                         port: 5670
                         path: /metrics
+                    networking:
+                      outbound:
+                        passthrough: true
 `,
 				}),
 				Entry("when `metrics.prometheus.port` is not set", testCase{
@@ -152,6 +155,9 @@ var _ = Describe("Mesh Manager", func() {
                       prometheus:
                         port: 5670
                         path: /non-standard-path
+                    networking:
+                      outbound:
+                        passthrough: true
 `,
 				}),
 				Entry("when `metrics.prometheus.path` is not set", testCase{
@@ -165,6 +171,9 @@ var _ = Describe("Mesh Manager", func() {
                       prometheus:
                         port: 1234
                         path: /metrics
+                    networking:
+                      outbound:
+                        passthrough: true
 `,
 				}),
 			)
@@ -359,6 +368,9 @@ var _ = Describe("Mesh Manager", func() {
 `,
 					expected: `
                     metrics: {}
+                    networking:
+                      outbound:
+                        passthrough: true
 `,
 				}),
 				Entry("when `metrics` is unset", testCase{
@@ -366,8 +378,12 @@ var _ = Describe("Mesh Manager", func() {
                     metrics:
                       prometheus: {}
 `,
-					updated:  ``,
-					expected: `{}`,
+					updated: ``,
+					expected: `
+                    networking:
+                      outbound:
+                        passthrough: true
+`,
 				}),
 				Entry("when both `metrics.prometheus.port` and `metrics.prometheus.path` are unset", testCase{
 					initial: `
@@ -383,6 +399,9 @@ var _ = Describe("Mesh Manager", func() {
                       prometheus:
                         port: 5670
                         path: /metrics
+                    networking:
+                      outbound:
+                        passthrough: true
 `,
 				}),
 				Entry("when `metrics.prometheus.port` is unset", testCase{
@@ -400,6 +419,9 @@ var _ = Describe("Mesh Manager", func() {
                       prometheus:
                         port: 5670
                         path: /non-standard-path
+                    networking:
+                      outbound:
+                        passthrough: true
 `,
 				}),
 				Entry("when `metrics.prometheus.path` is unset", testCase{
@@ -417,6 +439,9 @@ var _ = Describe("Mesh Manager", func() {
                       prometheus:
                         port: 1234
                         path: /metrics
+                    networking:
+                      outbound:
+                        passthrough: true
 `,
 				}),
 				Entry("when both `metrics.prometheus.port` and `metrics.prometheus.path` are changed", testCase{
@@ -435,6 +460,9 @@ var _ = Describe("Mesh Manager", func() {
                       prometheus:
                         port: 1234
                         path: /non-standard-path
+                    networking:
+                      outbound:
+                        passthrough: true
 `,
 				}),
 				Entry("when both `metrics.prometheus.port` and `metrics.prometheus.path` remain unchanged", testCase{
@@ -455,6 +483,9 @@ var _ = Describe("Mesh Manager", func() {
                       prometheus:
                         port: 1234
                         path: /non-standard-path
+                    networking:
+                      outbound:
+                        passthrough: true
 `,
 				}),
 			)
@@ -2,6 +2,8 @@ package mesh
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
 	core_ca "github.com/Kong/kuma/pkg/core/ca"
 	core_mesh "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
@@ -21,17 +23,17 @@ func (m *MeshValidator) ValidateCreate(ctx context.Context, name string, resourc
 
 func (m *MeshValidator) validateMTLSBackends(ctx context.Context, name string, resource *core_mesh.MeshResource) error {
 	verr := validators.ValidationError{}
-	path := validators.RootedAt("mtls").Field("backends")
+	path := validators.RootedAt("mtls")
 	for idx, backend := range resource.Spec.GetMtls().GetBackends() {
 		caManager, exist := m.CaManagers[backend.Type]
 		if !exist {
-			verr.AddViolationAt(path.Index(idx).Field("type"), "could not find installed plugin for this type")
-			return verr.OrNil()
-		} else if err := caManager.ValidateBackend(ctx, name, *backend); err != nil {
+			verr.AddViolationAt(path.Field("backends").Index(idx).Field("type"), fmt.Sprintf("could not find installed plugin for this type. %s", core_mesh.AllowedValuesHint(m.caTypes()...)))
+			continue
+		}
+		if err := caManager.ValidateBackend(ctx, name, *backend); err != nil {
 			if configErr, ok := err.(*validators.ValidationError); ok {
-				verr.AddErrorAt(path.Index(idx).Field("config"), *configErr)
+				verr.AddErrorAt(path.Field("backends").Index(idx).Field("config"), *configErr)
 			} else {
-				verr.AddViolationAt(path.Index(idx), err.Error())
 				return err
 			}
 		}
@@ -39,6 +41,16 @@ func (m *MeshValidator) validateMTLSBackends(ctx context.Context, name string, r
 	return verr.OrNil()
 }
 
+// caTypes returns sorted types of all installed CA plugins.
+func (m *MeshValidator) caTypes() []string {
+	var types []string
+	for typ := range m.CaManagers {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+	return types
+}
+
 func (m *MeshValidator) ValidateUpdate(ctx context.Context, previousMesh *core_mesh.MeshResource, newMesh *core_mesh.MeshResource) error {
 	if err := m.validateMTLSBackendChange(previousMesh, newMesh); err != nil {
 		return err
@@ -0,0 +1,90 @@
+package mesh
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	core_ca "github.com/Kong/kuma/pkg/core/ca"
+	"github.com/Kong/kuma/pkg/core/datasource"
+	core_mesh "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	"github.com/Kong/kuma/pkg/core/secrets/cipher"
+	secrets_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
+	secrets_store "github.com/Kong/kuma/pkg/core/secrets/store"
+	ca_builtin "github.com/Kong/kuma/pkg/plugins/ca/builtin"
+	"github.com/Kong/kuma/pkg/plugins/ca/provided"
+	"github.com/Kong/kuma/pkg/plugins/resources/memory"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+var _ = Describe("Mesh Validator", func() {
+
+	var validator MeshValidator
+
+	BeforeEach(func() {
+		secretManager := secrets_manager.NewSecretManager(secrets_store.NewSecretStore(memory.NewStore()), cipher.None())
+		validator = MeshValidator{
+			CaManagers: core_ca.Managers{
+				"builtin":  ca_builtin.NewBuiltinCaManager(secretManager),
+				"provided": provided.NewProvidedCaManager(datasource.NewDataSourceLoader(secretManager)),
+			},
+		}
+	})
+
+	It("should pass validation of a mesh with known CA backends", func() {
+		// given
+		mesh := core_mesh.MeshResource{}
+		err := util_proto.FromYAML([]byte(`
+        mtls:
+          enabledBackend: builtin-1
+          backends:
+          - name: builtin-1
+            type: builtin
+          - name: builtin-2
+            type: builtin
+`), &mesh.Spec)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		err = validator.ValidateCreate(context.Background(), "mesh-1", &mesh)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	type testCase struct {
+		mesh     string
+		expected string
+	}
+
+	DescribeTable("should validate CA backends",
+		func(given testCase) {
+			// given
+			mesh := core_mesh.MeshResource{}
+			err := util_proto.FromYAML([]byte(given.mesh), &mesh.Spec)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = validator.ValidateCreate(context.Background(), "mesh-1", &mesh)
+
+			// then
+			Expect(err).To(MatchError(given.expected))
+		},
+		Entry("backend of unknown type", testCase{
+			mesh: `
+            mtls:
+              enabledBackend: builtin-1
+              backends:
+              - name: builtin-1
+                type: builtin
+              - name: provided-1
+                type: providd
+              - name: provided-2
+                type: unknown`,
+			expected: "mtls.backends[1].type: could not find installed plugin for this type. Allowed values: builtin, provided; " +
+				"mtls.backends[2].type: could not find installed plugin for this type. Allowed values: builtin, provided",
+		}),
+	)
+})
@@ -0,0 +1,69 @@
+package traffictrace
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	core_mesh "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
+	core_model "github.com/Kong/kuma/pkg/core/resources/model"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+)
+
+// NewTrafficTraceManager creates a manager that, on top of otherManagers, makes sure
+// that TrafficTrace refers to one of the tracing backends of its Mesh.
+func NewTrafficTraceManager(store core_store.ResourceStore, otherManagers core_manager.ResourceManager) core_manager.ResourceManager {
+	return &trafficTraceManager{
+		ResourceManager: otherManagers,
+		store:           store,
+	}
+}
+
+type trafficTraceManager struct {
+	core_manager.ResourceManager
+	store core_store.ResourceStore
+}
+
+func (m *trafficTraceManager) Create(ctx context.Context, resource core_model.Resource, fs ...core_store.CreateOptionsFunc) error {
+	trafficTrace, err := m.trafficTrace(resource)
+	if err != nil {
+		return err
+	}
+	opts := core_store.NewCreateOptions(fs...)
+	if err := m.validateBackend(ctx, opts.Mesh, trafficTrace); err != nil {
+		return err
+	}
+	return m.ResourceManager.Create(ctx, resource, fs...)
+}
+
+func (m *trafficTraceManager) Update(ctx context.Context, resource core_model.Resource, fs ...core_store.UpdateOptionsFunc) error {
+	trafficTrace, err := m.trafficTrace(resource)
+	if err != nil {
+		return err
+	}
+	if err := m.validateBackend(ctx, resource.GetMeta().GetMesh(), trafficTrace); err != nil {
+		return err
+	}
+	return m.ResourceManager.Update(ctx, resource, fs...)
+}
+
+func (m *trafficTraceManager) validateBackend(ctx context.Context, meshName string, trafficTrace *core_mesh.TrafficTraceResource) error {
+	mesh := &core_mesh.MeshResource{}
+	if err := m.store.Get(ctx, mesh, core_store.GetByKey(meshName, meshName)); err != nil {
+		if core_store.IsResourceNotFound(err) {
+			// missing Mesh is reported by other managers
+			return nil
+		}
+		return err
+	}
+	return trafficTrace.ValidateBackend(mesh)
+}
+
+func (m *trafficTraceManager) trafficTrace(resource core_model.Resource) (*core_mesh.TrafficTraceResource, error) {
+	trafficTrace, ok := resource.(*core_mesh.TrafficTraceResource)
+	if !ok {
+		return nil, errors.Errorf("invalid resource type: expected=%T, got=%T", (*core_mesh.TrafficTraceResource)(nil), resource)
+	}
+	return trafficTrace, nil
+}
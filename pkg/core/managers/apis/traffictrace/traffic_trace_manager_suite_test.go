@@ -0,0 +1,13 @@
+package traffictrace_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestTrafficTraceManager(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "TrafficTrace Manager Suite")
+}
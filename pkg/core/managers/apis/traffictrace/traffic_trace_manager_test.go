@@ -0,0 +1,110 @@
+package traffictrace_test
+
+import (
+	"context"
+
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core/managers/apis/traffictrace"
+	core_mesh "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	"github.com/Kong/kuma/pkg/core/resources/manager"
+	"github.com/Kong/kuma/pkg/core/resources/store"
+	"github.com/Kong/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("TrafficTrace Manager", func() {
+
+	var resManager manager.ResourceManager
+
+	BeforeEach(func() {
+		resStore := memory.NewStore()
+		resManager = traffictrace.NewTrafficTraceManager(resStore, manager.NewResourceManager(resStore))
+
+		mesh := &core_mesh.MeshResource{
+			Spec: mesh_proto.Mesh{
+				Tracing: &mesh_proto.Tracing{
+					Backends: []*mesh_proto.TracingBackend{
+						{
+							Name: "zipkin-us",
+						},
+					},
+				},
+			},
+		}
+		err := resStore.Create(context.Background(), mesh, store.CreateByKey("default", "default"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	newTrafficTrace := func(backend string) *core_mesh.TrafficTraceResource {
+		return &core_mesh.TrafficTraceResource{
+			Spec: mesh_proto.TrafficTrace{
+				Selectors: []*mesh_proto.Selector{
+					{
+						Match: map[string]string{
+							"service": "*",
+						},
+					},
+				},
+				Conf: &mesh_proto.TrafficTrace_Conf{
+					Backend: backend,
+				},
+			},
+		}
+	}
+
+	Describe("Create()", func() {
+		It("should create TrafficTrace that refers a backend of the Mesh", func() {
+			// when
+			err := resManager.Create(context.Background(), newTrafficTrace("zipkin-us"), store.CreateByKey("tt-1", "default"))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should not create TrafficTrace that refers unknown backend", func() {
+			// when
+			err := resManager.Create(context.Background(), newTrafficTrace("jaeger-eu"), store.CreateByKey("tt-1", "default"))
+
+			// then
+			actual, yamlErr := yaml.Marshal(err)
+			Expect(yamlErr).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(`
+            violations:
+            - field: conf.backend
+              message: has to be set to one of the tracing backends in the mesh`))
+
+			// and
+			err = resManager.Get(context.Background(), &core_mesh.TrafficTraceResource{}, store.GetByKey("tt-1", "default"))
+			Expect(store.IsResourceNotFound(err)).To(BeTrue())
+		})
+
+		It("should report missing Mesh", func() {
+			// when
+			err := resManager.Create(context.Background(), newTrafficTrace("zipkin-us"), store.CreateByKey("tt-1", "other"))
+
+			// then
+			Expect(manager.IsMeshNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Describe("Update()", func() {
+		It("should not update TrafficTrace to refer unknown backend", func() {
+			// given
+			err := resManager.Create(context.Background(), newTrafficTrace("zipkin-us"), store.CreateByKey("tt-1", "default"))
+			Expect(err).ToNot(HaveOccurred())
+			trafficTrace := &core_mesh.TrafficTraceResource{}
+			err = resManager.Get(context.Background(), trafficTrace, store.GetByKey("tt-1", "default"))
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			trafficTrace.Spec.Conf.Backend = "jaeger-eu"
+			err = resManager.Update(context.Background(), trafficTrace)
+
+			// then
+			Expect(err).To(MatchError("conf.backend: has to be set to one of the tracing backends in the mesh"))
+		})
+	})
+})
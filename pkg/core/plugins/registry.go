@@ -25,6 +25,7 @@ const (
 
 	CaBuiltin  PluginName = "builtin"
 	CaProvided PluginName = "provided"
+	CaVault    PluginName = "vault"
 )
 
 type Registry interface {
@@ -0,0 +1,88 @@
+package mesh
+
+import (
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core/resources/model"
+	"github.com/Kong/kuma/pkg/core/resources/registry"
+)
+
+const (
+	CircuitBreakerType model.ResourceType = "CircuitBreaker"
+)
+
+var _ model.Resource = &CircuitBreakerResource{}
+
+type CircuitBreakerResource struct {
+	Meta model.ResourceMeta
+	Spec mesh_proto.CircuitBreaker
+}
+
+func (r *CircuitBreakerResource) GetType() model.ResourceType {
+	return CircuitBreakerType
+}
+func (r *CircuitBreakerResource) GetMeta() model.ResourceMeta {
+	return r.Meta
+}
+func (r *CircuitBreakerResource) SetMeta(m model.ResourceMeta) {
+	r.Meta = m
+}
+func (r *CircuitBreakerResource) GetSpec() model.ResourceSpec {
+	return &r.Spec
+}
+func (r *CircuitBreakerResource) SetSpec(value model.ResourceSpec) error {
+	spec, ok := value.(*mesh_proto.CircuitBreaker)
+	if !ok {
+		return errors.New("invalid type of spec")
+	} else {
+		r.Spec = *spec
+		return nil
+	}
+}
+func (t *CircuitBreakerResource) Sources() []*mesh_proto.Selector {
+	return t.Spec.GetSources()
+}
+func (t *CircuitBreakerResource) Destinations() []*mesh_proto.Selector {
+	return t.Spec.GetDestinations()
+}
+
+var _ model.ResourceList = &CircuitBreakerResourceList{}
+
+type CircuitBreakerResourceList struct {
+	Items      []*CircuitBreakerResource
+	Pagination model.Pagination
+}
+
+func (l *CircuitBreakerResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+func (l *CircuitBreakerResourceList) GetItemType() model.ResourceType {
+	return CircuitBreakerType
+}
+func (l *CircuitBreakerResourceList) NewItem() model.Resource {
+	return &CircuitBreakerResource{}
+}
+func (l *CircuitBreakerResourceList) AddItem(r model.Resource) error {
+	if item, ok := r.(*CircuitBreakerResource); ok {
+		l.Items = append(l.Items, item)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*CircuitBreakerResource)(nil), r)
+	}
+}
+func (l *CircuitBreakerResourceList) GetPagination() model.Pagination {
+	return l.Pagination
+}
+func (l *CircuitBreakerResourceList) SetPagination(pagination model.Pagination) {
+	l.Pagination = pagination
+}
+
+func init() {
+	registry.RegisterType(&CircuitBreakerResource{})
+	registry.RegistryListType(&CircuitBreakerResourceList{})
+}
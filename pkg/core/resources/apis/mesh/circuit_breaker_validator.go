@@ -0,0 +1,83 @@
+package mesh
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core/validators"
+)
+
+func (r *CircuitBreakerResource) HasThresholds() bool {
+	thresholds := r.Spec.Conf.GetThresholds()
+	return thresholds != nil && !proto.Equal(thresholds, &mesh_proto.CircuitBreaker_Conf_Thresholds{})
+}
+
+func (r *CircuitBreakerResource) HasOutlierDetection() bool {
+	outlierDetection := r.Spec.Conf.GetOutlierDetection()
+	return outlierDetection != nil && !proto.Equal(outlierDetection, &mesh_proto.CircuitBreaker_Conf_OutlierDetection{})
+}
+
+func (d *CircuitBreakerResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(d.validateSources())
+	err.Add(d.validateDestinations())
+	err.Add(d.validateConf())
+	return err.OrNil()
+}
+
+func (d *CircuitBreakerResource) validateSources() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("sources"), d.Spec.Sources, ValidateSelectorsOpts{
+		RequireAtLeastOneSelector: true,
+		ValidateSelectorOpts: ValidateSelectorOpts{
+			RequireAtLeastOneTag: true,
+			RequireService:       true,
+		},
+	})
+}
+
+func (d *CircuitBreakerResource) validateDestinations() (err validators.ValidationError) {
+	return ValidateSelectors(validators.RootedAt("destinations"), d.Spec.Destinations, OnlyServiceTagAllowed)
+}
+
+func (d *CircuitBreakerResource) validateConf() (err validators.ValidationError) {
+	root := validators.RootedAt("conf")
+	if !d.HasThresholds() && !d.HasOutlierDetection() {
+		err.AddViolationAt(root, "must have either thresholds or outlier detection configured")
+	}
+	if d.HasThresholds() {
+		path := root.Field("thresholds")
+		thresholds := d.Spec.Conf.GetThresholds()
+		err.Add(validateOptionalThreshold(path.Field("maxConnections"), thresholds.MaxConnections))
+		err.Add(validateOptionalThreshold(path.Field("maxPendingRequests"), thresholds.MaxPendingRequests))
+		err.Add(validateOptionalThreshold(path.Field("maxRetries"), thresholds.MaxRetries))
+	}
+	if d.HasOutlierDetection() {
+		path := root.Field("outlierDetection")
+		outlierDetection := d.Spec.Conf.GetOutlierDetection()
+		err.Add(validateOptionalThreshold(path.Field("consecutiveErrors"), outlierDetection.ConsecutiveErrors))
+		err.Add(validateOptionalDuration(path.Field("interval"), outlierDetection.Interval))
+		err.Add(validateOptionalDuration(path.Field("baseEjectionTime"), outlierDetection.BaseEjectionTime))
+		if percent := outlierDetection.MaxEjectionPercent; percent != nil && (percent.Value == 0 || percent.Value > 100) {
+			err.AddViolationAt(path.Field("maxEjectionPercent"), "has to be in [1 - 100] range")
+		}
+	}
+	return
+}
+
+// validateOptionalThreshold validates a threshold only if it is set, leaving the Envoy default otherwise.
+func validateOptionalThreshold(path validators.PathBuilder, threshold *wrappers.UInt32Value) (err validators.ValidationError) {
+	if threshold == nil {
+		return
+	}
+	return ValidateThreshold(path, threshold.Value)
+}
+
+// validateOptionalDuration validates a duration only if it is set, leaving the Envoy default otherwise.
+func validateOptionalDuration(path validators.PathBuilder, d *duration.Duration) (err validators.ValidationError) {
+	if d == nil {
+		return
+	}
+	return ValidateDuration(path, d)
+}
@@ -0,0 +1,180 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	. "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+var _ = Describe("CircuitBreaker", func() {
+	Describe("Validate()", func() {
+		It("should pass validation", func() {
+			// given
+			spec := `
+            sources:
+            - match:
+                service: web
+            destinations:
+            - match:
+                service: backend
+            conf:
+              thresholds:
+                maxConnections: 100
+                maxPendingRequests: 50
+                maxRetries: 3
+              outlierDetection:
+                consecutiveErrors: 5
+                interval: 10s
+                baseEjectionTime: 30s
+                maxEjectionPercent: 50
+`
+			circuitBreaker := CircuitBreakerResource{}
+
+			// when
+			err := util_proto.FromYAML([]byte(spec), &circuitBreaker.Spec)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = circuitBreaker.Validate()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		type testCase struct {
+			circuitBreaker string
+			expected       string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				circuitBreaker := CircuitBreakerResource{}
+
+				// when
+				err := util_proto.FromYAML([]byte(given.circuitBreaker), &circuitBreaker.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := circuitBreaker.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty spec", testCase{
+				circuitBreaker: ``,
+				expected: `
+                violations:
+                - field: sources
+                  message: must have at least one element
+                - field: destinations
+                  message: must have at least one element
+                - field: conf
+                  message: must have either thresholds or outlier detection configured
+`,
+			}),
+			Entry("selectors without tags", testCase{
+				circuitBreaker: `
+                sources:
+                - match: {}
+                destinations:
+                - match: {}
+                conf:
+                  thresholds: {}
+                  outlierDetection: {}
+`,
+				expected: `
+                violations:
+                - field: sources[0].match
+                  message: must have at least one tag
+                - field: sources[0].match
+                  message: mandatory tag "service" is missing
+                - field: destinations[0].match
+                  message: must consist of exactly one tag "service"
+                - field: destinations[0].match
+                  message: mandatory tag "service" is missing
+                - field: conf
+                  message: must have either thresholds or outlier detection configured
+`,
+			}),
+			Entry("invalid thresholds and outlier detection", testCase{
+				circuitBreaker: `
+                sources:
+                - match:
+                    service: web
+                destinations:
+                - match:
+                    service: backend
+                conf:
+                  thresholds:
+                    maxConnections: 0
+                    maxPendingRequests: 0
+                    maxRetries: 0
+                  outlierDetection:
+                    consecutiveErrors: 0
+                    interval: 0s
+                    baseEjectionTime: 0s
+                    maxEjectionPercent: 101
+`,
+				expected: `
+                violations:
+                - field: conf.thresholds.maxConnections
+                  message: must have a positive value
+                - field: conf.thresholds.maxPendingRequests
+                  message: must have a positive value
+                - field: conf.thresholds.maxRetries
+                  message: must have a positive value
+                - field: conf.outlierDetection.consecutiveErrors
+                  message: must have a positive value
+                - field: conf.outlierDetection.interval
+                  message: must have a positive value
+                - field: conf.outlierDetection.baseEjectionTime
+                  message: must have a positive value
+                - field: conf.outlierDetection.maxEjectionPercent
+                  message: has to be in [1 - 100] range
+`,
+			}),
+		)
+	})
+
+	Describe("HasThresholds() and HasOutlierDetection()", func() {
+		It("should ignore internal state of empty blocks", func() {
+			// given blocks that have been marshaled before their fields were cleared
+			circuitBreaker := CircuitBreakerResource{
+				Spec: mesh_proto.CircuitBreaker{
+					Conf: &mesh_proto.CircuitBreaker_Conf{
+						Thresholds: &mesh_proto.CircuitBreaker_Conf_Thresholds{
+							MaxConnections: &wrappers.UInt32Value{Value: 100},
+						},
+						OutlierDetection: &mesh_proto.CircuitBreaker_Conf_OutlierDetection{
+							ConsecutiveErrors: &wrappers.UInt32Value{Value: 5},
+						},
+					},
+				},
+			}
+			Expect(circuitBreaker.HasThresholds()).To(BeTrue())
+			Expect(circuitBreaker.HasOutlierDetection()).To(BeTrue())
+			_, err := proto.Marshal(&circuitBreaker.Spec)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			circuitBreaker.Spec.Conf.Thresholds.MaxConnections = nil
+			circuitBreaker.Spec.Conf.OutlierDetection.ConsecutiveErrors = nil
+
+			// then
+			Expect(circuitBreaker.HasThresholds()).To(BeFalse())
+			Expect(circuitBreaker.HasOutlierDetection()).To(BeFalse())
+		})
+	})
+})
@@ -16,6 +16,7 @@ const (
 	ProtocolUnknown = "<unknown>"
 	ProtocolTCP     = "tcp"
 	ProtocolHTTP    = "http"
+	ProtocolGRPC    = "grpc"
 )
 
 func ParseProtocol(tag string) Protocol {
@@ -24,6 +25,8 @@ func ParseProtocol(tag string) Protocol {
 		return ProtocolHTTP
 	case ProtocolTCP:
 		return ProtocolTCP
+	case ProtocolGRPC:
+		return ProtocolGRPC
 	default:
 		return ProtocolUnknown
 	}
@@ -42,6 +45,7 @@ func (l ProtocolList) Strings() []string {
 
 // SupportedProtocols is a list of supported protocols that will be communicated to a user.
 var SupportedProtocols = ProtocolList{
+	ProtocolGRPC,
 	ProtocolHTTP,
 	ProtocolTCP,
 }
@@ -312,7 +312,7 @@ var _ = Describe("Dataplane", func() {
 			expected: `
                 violations:
                 - field: 'networking.inbound[0].tags["protocol"]'
-                  message: 'tag "protocol" has an invalid value "". Allowed values: http, tcp'
+                  message: 'tag "protocol" has an invalid value "". Allowed values: grpc, http, tcp'
                 - field: 'networking.inbound[0].tags["protocol"]'
                   message: tag value cannot be empty`,
 		}),
@@ -334,7 +334,7 @@ var _ = Describe("Dataplane", func() {
 			expected: `
                 violations:
                 - field: 'networking.inbound[0].tags["protocol"]'
-                  message: 'tag "protocol" has an invalid value "not-yet-supported-protocol". Allowed values: http, tcp'`,
+                  message: 'tag "protocol" has an invalid value "not-yet-supported-protocol". Allowed values: grpc, http, tcp'`,
 		}),
 		Entry("networking.gateway: empty service tag", testCase{
 			dataplane: `
@@ -2,6 +2,7 @@ package mesh
 
 import (
 	"reflect"
+	"strings"
 
 	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	"github.com/Kong/kuma/pkg/core/validators"
@@ -51,6 +52,9 @@ func (d *HealthCheckResource) validateConf() (err validators.ValidationError) {
 		err.Add(ValidateDuration(path.Field("timeout"), activeChecks.Timeout))
 		err.Add(ValidateThreshold(path.Field("unhealthyThreshold"), activeChecks.UnhealthyThreshold))
 		err.Add(ValidateThreshold(path.Field("healthyThreshold"), activeChecks.HealthyThreshold))
+		if activeChecks.Http != nil {
+			err.Add(validateHttpHealthCheck(path.Field("http"), activeChecks.Http))
+		}
 	}
 	if d.HasPassiveChecks() {
 		path := root.Field("passiveChecks")
@@ -60,3 +64,13 @@ func (d *HealthCheckResource) validateConf() (err validators.ValidationError) {
 	}
 	return
 }
+
+func validateHttpHealthCheck(path validators.PathBuilder, http *mesh_proto.HealthCheck_Conf_Active_Http) (err validators.ValidationError) {
+	switch {
+	case http.Path == "":
+		err.AddViolationAt(path.Field("path"), "cannot be empty")
+	case !strings.HasPrefix(http.Path, "/"):
+		err.AddViolationAt(path.Field("path"), "has to start with /")
+	}
+	return
+}
@@ -145,6 +145,29 @@ var _ = Describe("HealthCheck", func() {
                   message: must have a positive value
                 - field: conf.activeChecks.healthyThreshold
                   message: must have a positive value
+`,
+			}),
+			Entry("invalid http active checks conf", testCase{
+				healthCheck: `
+                sources:
+                - match:
+                    service: web
+                destinations:
+                - match:
+                    service: backend
+                conf:
+                  activeChecks:
+                    interval: 5s
+                    timeout: 4s
+                    unhealthyThreshold: 3
+                    healthyThreshold: 2
+                    http:
+                      path: health
+`,
+				expected: `
+                violations:
+                - field: conf.activeChecks.http.path
+                  message: has to start with /
 `,
 			}),
 			Entry("invalid passive checks conf", testCase{
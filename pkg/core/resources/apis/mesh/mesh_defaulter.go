@@ -1,15 +1,85 @@
 package mesh
 
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+var defaultPrometheusMetrics = mesh_proto.Metrics_Prometheus{
+	Port: 5670,
+	Path: "/metrics",
+}
+
+var defaultNetworking = mesh_proto.Mesh_Networking{
+	Outbound: &mesh_proto.Mesh_Networking_Outbound{
+		Passthrough: &wrappers.BoolValue{Value: true},
+	},
+}
+
+var defaultMtlsRotation = mesh_proto.Mesh_Mtls_Rotation{
+	Expiration:     ptypes.DurationProto(720 * time.Hour),
+	RotationWindow: ptypes.DurationProto(24 * time.Hour),
+}
+
+var defaultMtlsTls = mesh_proto.Mesh_Mtls_Tls{
+	MinVersion: "TLSv1_2",
+	CipherSuites: []string{
+		"ECDHE-ECDSA-AES128-GCM-SHA256",
+		"ECDHE-RSA-AES128-GCM-SHA256",
+		"ECDHE-ECDSA-AES256-GCM-SHA384",
+		"ECDHE-RSA-AES256-GCM-SHA384",
+		"ECDHE-ECDSA-CHACHA20-POLY1305",
+		"ECDHE-RSA-CHACHA20-POLY1305",
+	},
+}
+
+// Default fills in settings that are omitted in the Mesh. It is applied before Validate,
+// so it only defaults sections that are in use and leaves the other ones for Validate to report,
+// e.g. a Prometheus block of a Mesh with disabled metrics is neither defaulted nor hidden.
 func (mesh *MeshResource) Default() {
-	// default settings for Prometheus metrics
-	if mesh.Spec.Metrics != nil {
-		if mesh.Spec.Metrics.Prometheus != nil {
-			if mesh.Spec.Metrics.Prometheus.Port == 0 {
-				mesh.Spec.Metrics.Prometheus.Port = 5670
-			}
-			if mesh.Spec.Metrics.Prometheus.Path == "" {
-				mesh.Spec.Metrics.Prometheus.Path = "/metrics"
-			}
+	// default settings for rotation of dataplane certificates
+	if mesh.Spec.GetMtls().GetEnabledBackend() != "" {
+		if mesh.Spec.Mtls.Rotation == nil {
+			mesh.Spec.Mtls.Rotation = &mesh_proto.Mesh_Mtls_Rotation{}
+		}
+		defaultRotationWindow(mesh.Spec.Mtls.Rotation)
+		util_proto.ApplyDefaults(mesh.Spec.Mtls.Rotation, &defaultMtlsRotation)
+		// default TLS version and cipher suites of connections between dataplanes
+		if mesh.Spec.Mtls.Tls == nil {
+			mesh.Spec.Mtls.Tls = &mesh_proto.Mesh_Mtls_Tls{}
 		}
+		util_proto.ApplyDefaults(mesh.Spec.Mtls.Tls, &defaultMtlsTls)
+	}
+	// default settings for Prometheus metrics
+	if mesh.HasPrometheusMetricsEnabled() {
+		util_proto.ApplyDefaults(mesh.Spec.Metrics.Prometheus, &defaultPrometheusMetrics)
+	}
+	// default settings for outbound traffic
+	if mesh.Spec.Networking == nil {
+		mesh.Spec.Networking = &mesh_proto.Mesh_Networking{}
+	}
+	util_proto.ApplyDefaults(mesh.Spec.Networking, &defaultNetworking)
+}
+
+// defaultRotationWindow defaults the rotation window of certificates with a custom expiration
+// to a third of the expiration, unless it exceeds the default window,
+// so that a user who shortens expiration only does not get a window longer than expiration.
+func defaultRotationWindow(rotation *mesh_proto.Mesh_Mtls_Rotation) {
+	if rotation.RotationWindow != nil || rotation.Expiration == nil {
+		return
+	}
+	expiration, err := ptypes.Duration(rotation.Expiration)
+	if err != nil || expiration <= 0 {
+		return // left for Validate to report
+	}
+	window, _ := ptypes.Duration(defaultMtlsRotation.RotationWindow)
+	if expiration/3 < window {
+		window = expiration / 3
 	}
+	rotation.RotationWindow = ptypes.DurationProto(window)
 }
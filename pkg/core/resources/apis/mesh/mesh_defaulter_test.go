@@ -1,6 +1,9 @@
 package mesh_test
 
 import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -38,6 +41,123 @@ var _ = Describe("MeshResource", func() {
 
 		DescribeTable("should apply defaults on a target MeshResource",
 			applyDefaultsScenario,
+			Entry("when `networking` is not set", testCase{
+				input: `
+                mtls:
+                  enabledBackend: ca-1
+`,
+				expected: `
+                mtls:
+                  enabledBackend: ca-1
+                  rotation:
+                    expiration: 2592000s
+                    rotationWindow: 86400s
+                  tls:
+                    minVersion: TLSv1_2
+                    cipherSuites:
+                    - ECDHE-ECDSA-AES128-GCM-SHA256
+                    - ECDHE-RSA-AES128-GCM-SHA256
+                    - ECDHE-ECDSA-AES256-GCM-SHA384
+                    - ECDHE-RSA-AES256-GCM-SHA384
+                    - ECDHE-ECDSA-CHACHA20-POLY1305
+                    - ECDHE-RSA-CHACHA20-POLY1305
+                networking:
+                  outbound:
+                    passthrough: true
+`,
+			}),
+			Entry("when `mtls.rotation.rotationWindow` is not set", testCase{
+				input: `
+                mtls:
+                  enabledBackend: ca-1
+                  rotation:
+                    expiration: 48h
+`,
+				expected: `
+                mtls:
+                  enabledBackend: ca-1
+                  rotation:
+                    expiration: 172800s
+                    rotationWindow: 57600s
+                  tls:
+                    minVersion: TLSv1_2
+                    cipherSuites:
+                    - ECDHE-ECDSA-AES128-GCM-SHA256
+                    - ECDHE-RSA-AES128-GCM-SHA256
+                    - ECDHE-ECDSA-AES256-GCM-SHA384
+                    - ECDHE-RSA-AES256-GCM-SHA384
+                    - ECDHE-ECDSA-CHACHA20-POLY1305
+                    - ECDHE-RSA-CHACHA20-POLY1305
+                networking:
+                  outbound:
+                    passthrough: true
+`,
+			}),
+			Entry("when `mtls.rotation.rotationWindow` is not set and `mtls.rotation.expiration` is long", testCase{
+				input: `
+                mtls:
+                  enabledBackend: ca-1
+                  rotation:
+                    expiration: 240h
+`,
+				expected: `
+                mtls:
+                  enabledBackend: ca-1
+                  rotation:
+                    expiration: 864000s
+                    rotationWindow: 86400s
+                  tls:
+                    minVersion: TLSv1_2
+                    cipherSuites:
+                    - ECDHE-ECDSA-AES128-GCM-SHA256
+                    - ECDHE-RSA-AES128-GCM-SHA256
+                    - ECDHE-ECDSA-AES256-GCM-SHA384
+                    - ECDHE-RSA-AES256-GCM-SHA384
+                    - ECDHE-ECDSA-CHACHA20-POLY1305
+                    - ECDHE-RSA-CHACHA20-POLY1305
+                networking:
+                  outbound:
+                    passthrough: true
+`,
+			}),
+			Entry("when `mtls.tls.cipherSuites` is not set", testCase{
+				input: `
+                mtls:
+                  enabledBackend: ca-1
+                  tls:
+                    minVersion: TLSv1_3
+`,
+				expected: `
+                mtls:
+                  enabledBackend: ca-1
+                  rotation:
+                    expiration: 2592000s
+                    rotationWindow: 86400s
+                  tls:
+                    minVersion: TLSv1_3
+                    cipherSuites:
+                    - ECDHE-ECDSA-AES128-GCM-SHA256
+                    - ECDHE-RSA-AES128-GCM-SHA256
+                    - ECDHE-ECDSA-AES256-GCM-SHA384
+                    - ECDHE-RSA-AES256-GCM-SHA384
+                    - ECDHE-ECDSA-CHACHA20-POLY1305
+                    - ECDHE-RSA-CHACHA20-POLY1305
+                networking:
+                  outbound:
+                    passthrough: true
+`,
+			}),
+			Entry("when `networking.outbound.passthrough` is not set", testCase{
+				input: `
+                networking:
+                  outbound: {}
+`,
+				expected: `
+                networking:
+                  outbound:
+                    passthrough: true
+`,
+			}),
 			Entry("when both `metrics.prometheus.port` and `metrics.prometheus.path` are not set", testCase{
 				input: `
                 metrics:
@@ -48,6 +168,9 @@ var _ = Describe("MeshResource", func() {
                   prometheus:
                     port: 5670
                     path: /metrics
+                networking:
+                  outbound:
+                    passthrough: true
 `,
 			}),
 			Entry("when `metrics.prometheus.port` is not set", testCase{
@@ -61,6 +184,26 @@ var _ = Describe("MeshResource", func() {
                   prometheus:
                     port: 5670
                     path: /non-standard-path
+                networking:
+                  outbound:
+                    passthrough: true
+`,
+			}),
+			Entry("when metrics are explicitly enabled", testCase{
+				input: `
+                metrics:
+                  enabled: true
+                  prometheus: {}
+`,
+				expected: `
+                metrics:
+                  enabled: true
+                  prometheus:
+                    port: 5670
+                    path: /metrics
+                networking:
+                  outbound:
+                    passthrough: true
 `,
 			}),
 			Entry("when `metrics.prometheus.path` is not set", testCase{
@@ -74,18 +217,190 @@ var _ = Describe("MeshResource", func() {
                   prometheus:
                     port: 1234
                     path: /metrics
+                networking:
+                  outbound:
+                    passthrough: true
 `,
 			}),
 		)
 
 		DescribeTable("should not override user-defined configuration in a target MeshResource",
 			applyDefaultsScenario,
+			Entry("when `networking.outbound.passthrough` is set to false", testCase{
+				input: `
+                networking:
+                  outbound:
+                    passthrough: false
+`,
+				expected: `
+                networking:
+                  outbound:
+                    passthrough: false
+`,
+			}),
+			Entry("when `networking.outbound.passthrough` is set to true", testCase{
+				input: `
+                networking:
+                  outbound:
+                    passthrough: true
+`,
+				expected: `
+                networking:
+                  outbound:
+                    passthrough: true
+`,
+			}),
 			Entry("when `metrics.prometheus` field is not set", testCase{
 				input: `
                 metrics: {}
 `,
 				expected: `
                 metrics: {}
+                networking:
+                  outbound:
+                    passthrough: true
+`,
+			}),
+			Entry("when metrics are disabled", testCase{
+				input: `
+                metrics:
+                  enabled: false
+                  prometheus: {}
+`,
+				expected: `
+                metrics:
+                  enabled: false
+                  prometheus: {}
+                networking:
+                  outbound:
+                    passthrough: true
+`,
+			}),
+			Entry("when `mtls.rotation` is set", testCase{
+				input: `
+                mtls:
+                  enabledBackend: ca-1
+                  rotation:
+                    expiration: 10h
+                    rotationWindow: 1h
+`,
+				expected: `
+                mtls:
+                  enabledBackend: ca-1
+                  rotation:
+                    expiration: 36000s
+                    rotationWindow: 3600s
+                  tls:
+                    minVersion: TLSv1_2
+                    cipherSuites:
+                    - ECDHE-ECDSA-AES128-GCM-SHA256
+                    - ECDHE-RSA-AES128-GCM-SHA256
+                    - ECDHE-ECDSA-AES256-GCM-SHA384
+                    - ECDHE-RSA-AES256-GCM-SHA384
+                    - ECDHE-ECDSA-CHACHA20-POLY1305
+                    - ECDHE-RSA-CHACHA20-POLY1305
+                networking:
+                  outbound:
+                    passthrough: true
+`,
+			}),
+			Entry("when `mtls.tls` is set", testCase{
+				input: `
+                mtls:
+                  enabledBackend: ca-1
+                  tls:
+                    minVersion: TLSv1_2
+                    cipherSuites:
+                    - ECDHE-RSA-AES256-GCM-SHA384
+`,
+				expected: `
+                mtls:
+                  enabledBackend: ca-1
+                  rotation:
+                    expiration: 2592000s
+                    rotationWindow: 86400s
+                  tls:
+                    minVersion: TLSv1_2
+                    cipherSuites:
+                    - ECDHE-RSA-AES256-GCM-SHA384
+                networking:
+                  outbound:
+                    passthrough: true
+`,
+			}),
+			Entry("when `mtls.exclusions` is not set", testCase{
+				input: `
+                mtls:
+                  enabledBackend: ca-1
+                  rotation:
+                    expiration: 10h
+                    rotationWindow: 1h
+                  tls:
+                    minVersion: TLSv1_2
+                    cipherSuites:
+                    - ECDHE-RSA-AES256-GCM-SHA384
+`,
+				expected: `
+                mtls:
+                  enabledBackend: ca-1
+                  rotation:
+                    expiration: 36000s
+                    rotationWindow: 3600s
+                  tls:
+                    minVersion: TLSv1_2
+                    cipherSuites:
+                    - ECDHE-RSA-AES256-GCM-SHA384
+                networking:
+                  outbound:
+                    passthrough: true
+`,
+			}),
+			Entry("when `mtls.exclusions` is set", testCase{
+				input: `
+                mtls:
+                  enabledBackend: ca-1
+                  exclusions:
+                  - match:
+                      service: legacy
+`,
+				expected: `
+                mtls:
+                  enabledBackend: ca-1
+                  rotation:
+                    expiration: 2592000s
+                    rotationWindow: 86400s
+                  tls:
+                    minVersion: TLSv1_2
+                    cipherSuites:
+                    - ECDHE-ECDSA-AES128-GCM-SHA256
+                    - ECDHE-RSA-AES128-GCM-SHA256
+                    - ECDHE-ECDSA-AES256-GCM-SHA384
+                    - ECDHE-RSA-AES256-GCM-SHA384
+                    - ECDHE-ECDSA-CHACHA20-POLY1305
+                    - ECDHE-RSA-CHACHA20-POLY1305
+                  exclusions:
+                  - match:
+                      service: legacy
+                networking:
+                  outbound:
+                    passthrough: true
+`,
+			}),
+			Entry("when `mtls.enabledBackend` is not set", testCase{
+				input: `
+                mtls:
+                  backends:
+                  - name: ca-1
+                    type: builtin
+`,
+				expected: `
+                mtls:
+                  backends:
+                  - name: ca-1
+                    type: builtin
+                networking:
+                  outbound:
+                    passthrough: true
 `,
 			}),
 			Entry("when `mtls.ca.type` field is not set", testCase{
@@ -100,8 +415,34 @@ var _ = Describe("MeshResource", func() {
                   prometheus:
                     port: 1234
                     path: /non-standard-path
+                networking:
+                  outbound:
+                    passthrough: true
 `,
 			}),
 		)
+
+		It("should default `mtls.rotation.rotationWindow` to a valid value for a short expiration", func() {
+			// given
+			mesh := &MeshResource{}
+			err := util_proto.FromYAML([]byte(`
+            mtls:
+              enabledBackend: ca-1
+              backends:
+              - name: ca-1
+                type: builtin
+              rotation:
+                expiration: 12h
+`), &mesh.Spec)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			mesh.Default()
+
+			// then
+			Expect(mesh.Spec.Mtls.Rotation.RotationWindow).To(Equal(ptypes.DurationProto(4 * time.Hour)))
+			// and
+			Expect(mesh.Validate()).To(Succeed())
+		})
 	})
 })
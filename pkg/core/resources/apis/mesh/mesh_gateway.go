@@ -0,0 +1,96 @@
+package mesh
+
+import (
+	"errors"
+
+	"github.com/Kong/kuma/pkg/core/resources/registry"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core/resources/model"
+)
+
+const (
+	MeshGatewayType model.ResourceType = "MeshGateway"
+)
+
+var _ model.Resource = &MeshGatewayResource{}
+
+type MeshGatewayResource struct {
+	Meta model.ResourceMeta
+	Spec mesh_proto.MeshGateway
+}
+
+func (g *MeshGatewayResource) GetType() model.ResourceType {
+	return MeshGatewayType
+}
+
+func (g *MeshGatewayResource) GetMeta() model.ResourceMeta {
+	return g.Meta
+}
+
+func (g *MeshGatewayResource) SetMeta(m model.ResourceMeta) {
+	g.Meta = m
+}
+
+func (g *MeshGatewayResource) GetSpec() model.ResourceSpec {
+	return &g.Spec
+}
+
+func (g *MeshGatewayResource) SetSpec(spec model.ResourceSpec) error {
+	meshGateway, ok := spec.(*mesh_proto.MeshGateway)
+	if !ok {
+		return errors.New("invalid type of spec")
+	} else {
+		g.Spec = *meshGateway
+		return nil
+	}
+}
+
+var _ model.ResourceList = &MeshGatewayResourceList{}
+
+type MeshGatewayResourceList struct {
+	Items      []*MeshGatewayResource
+	Pagination model.Pagination
+}
+
+func (l *MeshGatewayResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *MeshGatewayResourceList) GetItemType() model.ResourceType {
+	return MeshGatewayType
+}
+
+func (l *MeshGatewayResourceList) NewItem() model.Resource {
+	return &MeshGatewayResource{}
+}
+
+func (l *MeshGatewayResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*MeshGatewayResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*MeshGatewayResource)(nil), r)
+	}
+}
+
+func (l *MeshGatewayResourceList) GetPagination() model.Pagination {
+	return l.Pagination
+}
+
+func (l *MeshGatewayResourceList) SetPagination(pagination model.Pagination) {
+	l.Pagination = pagination
+}
+
+func init() {
+	registry.RegisterType(&MeshGatewayResource{})
+	registry.RegistryListType(&MeshGatewayResourceList{})
+}
+
+func (g *MeshGatewayResource) Selectors() []*mesh_proto.Selector {
+	return g.Spec.GetSelectors()
+}
@@ -0,0 +1,94 @@
+package mesh
+
+import (
+	"fmt"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core/validators"
+)
+
+func (g *MeshGatewayResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(g.validateSelectors())
+	err.Add(g.validateListeners())
+	return err.OrNil()
+}
+
+func (g *MeshGatewayResource) validateSelectors() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("selectors"), g.Spec.GetSelectors(), ValidateSelectorsOpts{
+		RequireAtLeastOneSelector: true,
+		ValidateSelectorOpts: ValidateSelectorOpts{
+			RequireAtLeastOneTag: true,
+		},
+	})
+}
+
+func (g *MeshGatewayResource) validateListeners() (err validators.ValidationError) {
+	root := validators.RootedAt("listeners")
+	if len(g.Spec.GetListeners()) == 0 {
+		err.AddViolationAt(root, "must have at least one listener")
+		return
+	}
+	ports := map[uint32]int{}
+	for i, listener := range g.Spec.GetListeners() {
+		path := root.Index(i)
+		err.Add(validateListener(path, listener))
+		if other, used := ports[listener.GetPort()]; used {
+			err.AddViolationAt(path.Field("port"), fmt.Sprintf("port %d is already used by listeners[%d]", listener.GetPort(), other))
+		} else {
+			ports[listener.GetPort()] = i
+		}
+	}
+	return
+}
+
+func validateListener(path validators.PathBuilder, listener *mesh_proto.MeshGateway_Listener) (err validators.ValidationError) {
+	if listener.GetPort() < 1 || listener.GetPort() > 65535 {
+		err.AddViolationAt(path.Field("port"), "port has to be in range of [1, 65535]")
+	}
+	protocol := listener.GetProtocol()
+	if protocol == mesh_proto.MeshGateway_Listener_NONE {
+		err.AddViolationAt(path.Field("protocol"), "cannot be empty")
+	} else if _, ok := mesh_proto.MeshGateway_Listener_Protocol_name[int32(protocol)]; !ok {
+		err.AddViolationAt(path.Field("protocol"), fmt.Sprintf("protocol %d is not supported. %s", protocol, AllowedValuesHint("TCP", "TLS", "HTTP", "HTTPS")))
+	}
+	for i, hostname := range listener.GetHostnames() {
+		if hostname == "" {
+			err.AddViolationAt(path.Field("hostnames").Index(i), "cannot be empty")
+		}
+	}
+	err.Add(validateListenerTLS(path.Field("tls"), protocol, listener.GetTls()))
+	return
+}
+
+func validateListenerTLS(path validators.PathBuilder, protocol mesh_proto.MeshGateway_Listener_Protocol, tls *mesh_proto.MeshGateway_TLS) (err validators.ValidationError) {
+	secure := protocol == mesh_proto.MeshGateway_Listener_TLS || protocol == mesh_proto.MeshGateway_Listener_HTTPS
+	if tls == nil {
+		if secure {
+			err.AddViolationAt(path, fmt.Sprintf("cannot be empty for %s protocol", protocol))
+		}
+		return
+	}
+	if !secure {
+		err.AddViolationAt(path, "can be defined only for TLS and HTTPS protocols")
+		return
+	}
+	switch tls.GetMode() {
+	case mesh_proto.MeshGateway_TLS_NONE:
+		err.AddViolationAt(path.Field("mode"), "cannot be empty")
+	case mesh_proto.MeshGateway_TLS_TERMINATE:
+		if tls.GetCertificate() == "" {
+			err.AddViolationAt(path.Field("certificate"), "cannot be empty when mode is TERMINATE")
+		}
+	case mesh_proto.MeshGateway_TLS_PASSTHROUGH:
+		if tls.GetCertificate() != "" {
+			err.AddViolationAt(path.Field("certificate"), "must be empty when mode is PASSTHROUGH")
+		}
+		if protocol == mesh_proto.MeshGateway_Listener_HTTPS {
+			err.AddViolationAt(path.Field("mode"), "HTTPS protocol requires mode TERMINATE")
+		}
+	default:
+		err.AddViolationAt(path.Field("mode"), fmt.Sprintf("mode %d is not supported. %s", tls.GetMode(), AllowedValuesHint("TERMINATE", "PASSTHROUGH")))
+	}
+	return
+}
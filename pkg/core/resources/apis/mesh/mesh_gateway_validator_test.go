@@ -0,0 +1,190 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+var _ = Describe("MeshGateway", func() {
+	Describe("Validate()", func() {
+		DescribeTable("should pass validation",
+			func(meshGatewayYAML string) {
+				// setup
+				meshGateway := MeshGatewayResource{}
+
+				// when
+				err := util_proto.FromYAML([]byte(meshGatewayYAML), &meshGateway.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := meshGateway.Validate()
+
+				// then
+				Expect(verr).ToNot(HaveOccurred())
+			},
+			Entry("full example", `
+                selectors:
+                - match:
+                    service: edge-gateway
+                listeners:
+                - port: 80
+                  protocol: HTTP
+                  hostnames:
+                  - example.com
+                  - '*.example.com'
+                - port: 443
+                  protocol: HTTPS
+                  hostnames:
+                  - example.com
+                  tls:
+                    mode: TERMINATE
+                    certificate: example-com-cert
+                - port: 8443
+                  protocol: TLS
+                  tls:
+                    mode: PASSTHROUGH
+                - port: 9000
+                  protocol: TCP`,
+			),
+		)
+
+		type testCase struct {
+			meshGateway string
+			expected    string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				meshGateway := MeshGatewayResource{}
+
+				// when
+				err := util_proto.FromYAML([]byte(given.meshGateway), &meshGateway.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := meshGateway.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty spec", testCase{
+				meshGateway: ``,
+				expected: `
+                violations:
+                - field: selectors
+                  message: must have at least one element
+                - field: listeners
+                  message: must have at least one listener
+`,
+			}),
+			Entry("duplicate ports", testCase{
+				meshGateway: `
+                selectors:
+                - match:
+                    service: edge-gateway
+                listeners:
+                - port: 80
+                  protocol: HTTP
+                - port: 8080
+                  protocol: HTTP
+                - port: 80
+                  protocol: TCP
+`,
+				expected: `
+                violations:
+                - field: listeners[2].port
+                  message: port 80 is already used by listeners[0]
+`,
+			}),
+			Entry("invalid listener", testCase{
+				meshGateway: `
+                selectors:
+                - match:
+                    service: edge-gateway
+                listeners:
+                - port: 70000
+                  hostnames:
+                  - ""
+`,
+				expected: `
+                violations:
+                - field: listeners[0].port
+                  message: port has to be in range of [1, 65535]
+                - field: listeners[0].protocol
+                  message: cannot be empty
+                - field: listeners[0].hostnames[0]
+                  message: cannot be empty
+`,
+			}),
+			Entry("PASSTHROUGH with a certificate", testCase{
+				meshGateway: `
+                selectors:
+                - match:
+                    service: edge-gateway
+                listeners:
+                - port: 8443
+                  protocol: TLS
+                  tls:
+                    mode: PASSTHROUGH
+                    certificate: example-com-cert
+`,
+				expected: `
+                violations:
+                - field: listeners[0].tls.certificate
+                  message: must be empty when mode is PASSTHROUGH
+`,
+			}),
+			Entry("invalid TLS combinations", testCase{
+				meshGateway: `
+                selectors:
+                - match:
+                    service: edge-gateway
+                listeners:
+                - port: 443
+                  protocol: HTTPS
+                  tls:
+                    mode: PASSTHROUGH
+                - port: 8443
+                  protocol: TLS
+                  tls:
+                    mode: TERMINATE
+                - port: 9443
+                  protocol: TLS
+                  tls:
+                    certificate: example-com-cert
+                - port: 10443
+                  protocol: HTTPS
+                - port: 80
+                  protocol: HTTP
+                  tls:
+                    mode: TERMINATE
+                    certificate: example-com-cert
+`,
+				expected: `
+                violations:
+                - field: listeners[0].tls.mode
+                  message: HTTPS protocol requires mode TERMINATE
+                - field: listeners[1].tls.certificate
+                  message: cannot be empty when mode is TERMINATE
+                - field: listeners[2].tls.mode
+                  message: cannot be empty
+                - field: listeners[3].tls
+                  message: cannot be empty for HTTPS protocol
+                - field: listeners[4].tls
+                  message: can be defined only for TLS and HTTPS protocols
+`,
+			}),
+		)
+	})
+})
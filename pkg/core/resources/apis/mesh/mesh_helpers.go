@@ -6,14 +6,44 @@ import (
 	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 )
 
+// HasPrometheusMetricsEnabled returns whether dataplanes of the Mesh expose Prometheus metrics,
+// i.e. Prometheus is configured and metrics are not explicitly disabled.
 func (m *MeshResource) HasPrometheusMetricsEnabled() bool {
-	return m != nil && m.Spec.GetMetrics().GetPrometheus() != nil
+	if m == nil || m.Spec.GetMetrics().GetPrometheus() == nil {
+		return false
+	}
+	enabled := m.Spec.GetMetrics().GetEnabled()
+	return enabled == nil || enabled.GetValue()
 }
 
 func (m *MeshResource) MTLSEnabled() bool {
 	return m != nil && m.Spec.GetMtls().GetEnabledBackend() != ""
 }
 
+// MTLSExcluded returns whether an inbound interface with given tags is exempt from mTLS
+// by one of the exclusions of the Mesh.
+func (m *MeshResource) MTLSExcluded(tags map[string]string) bool {
+	if !m.MTLSEnabled() {
+		return false
+	}
+	for _, selector := range m.Spec.GetMtls().GetExclusions() {
+		if mesh_proto.TagSelector(selector.GetMatch()).Matches(tags) {
+			return true
+		}
+	}
+	return false
+}
+
+// PassthroughEnabled returns whether traffic to destinations unknown to the Mesh is passed through.
+// Meshes that have not been defaulted yet fall back to passthrough.
+func (m *MeshResource) PassthroughEnabled() bool {
+	if m == nil {
+		return true
+	}
+	passthrough := m.Spec.GetNetworking().GetOutbound().GetPassthrough()
+	return passthrough == nil || passthrough.GetValue()
+}
+
 func (m *MeshResource) GetTracingBackend(name string) *mesh_proto.TracingBackend {
 	backends := map[string]*mesh_proto.TracingBackend{}
 	for _, backend := range m.Spec.GetTracing().GetBackends() {
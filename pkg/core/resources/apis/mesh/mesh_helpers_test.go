@@ -1,6 +1,7 @@
 package mesh_test
 
 import (
+	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -49,6 +50,28 @@ var _ = Describe("MeshResource", func() {
 				},
 				expected: true,
 			}),
+			Entry("mesh.metrics.enabled == true", testCase{
+				mesh: &MeshResource{
+					Spec: mesh_proto.Mesh{
+						Metrics: &mesh_proto.Metrics{
+							Enabled:    &wrappers.BoolValue{Value: true},
+							Prometheus: &mesh_proto.Metrics_Prometheus{},
+						},
+					},
+				},
+				expected: true,
+			}),
+			Entry("mesh.metrics.enabled == false", testCase{
+				mesh: &MeshResource{
+					Spec: mesh_proto.Mesh{
+						Metrics: &mesh_proto.Metrics{
+							Enabled:    &wrappers.BoolValue{Value: false},
+							Prometheus: &mesh_proto.Metrics_Prometheus{},
+						},
+					},
+				},
+				expected: false,
+			}),
 		)
 	})
 
@@ -259,4 +282,63 @@ var _ = Describe("MeshResource", func() {
 			Expect(backends).To(Equal(""))
 		})
 	})
+
+	Describe("MTLSExcluded", func() {
+
+		type testCase struct {
+			mesh     *MeshResource
+			tags     map[string]string
+			expected bool
+		}
+
+		exclusions := []*mesh_proto.Selector{
+			{Match: map[string]string{"service": "legacy"}},
+		}
+
+		DescribeTable("should determine whether an inbound is exempt from mTLS",
+			func(given testCase) {
+				Expect(given.mesh.MTLSExcluded(given.tags)).To(Equal(given.expected))
+			},
+			Entry("mesh == nil", testCase{
+				mesh:     nil,
+				tags:     map[string]string{"service": "legacy"},
+				expected: false,
+			}),
+			Entry("mTLS disabled", testCase{
+				mesh: &MeshResource{
+					Spec: mesh_proto.Mesh{
+						Mtls: &mesh_proto.Mesh_Mtls{
+							Exclusions: exclusions,
+						},
+					},
+				},
+				tags:     map[string]string{"service": "legacy"},
+				expected: false,
+			}),
+			Entry("tags matched by an exclusion", testCase{
+				mesh: &MeshResource{
+					Spec: mesh_proto.Mesh{
+						Mtls: &mesh_proto.Mesh_Mtls{
+							EnabledBackend: "ca-1",
+							Exclusions:     exclusions,
+						},
+					},
+				},
+				tags:     map[string]string{"service": "legacy", "version": "v1"},
+				expected: true,
+			}),
+			Entry("tags not matched by any exclusion", testCase{
+				mesh: &MeshResource{
+					Spec: mesh_proto.Mesh{
+						Mtls: &mesh_proto.Mesh_Mtls{
+							EnabledBackend: "ca-1",
+							Exclusions:     exclusions,
+						},
+					},
+				},
+				tags:     map[string]string{"service": "backend"},
+				expected: false,
+			}),
+		)
+	})
 })
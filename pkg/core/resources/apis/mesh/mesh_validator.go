@@ -5,6 +5,8 @@ import (
 	"net"
 	"net/url"
 
+	"github.com/golang/protobuf/ptypes"
+
 	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	"github.com/Kong/kuma/pkg/core/validators"
 	"github.com/Kong/kuma/pkg/envoy/accesslog"
@@ -15,9 +17,36 @@ func (m *MeshResource) Validate() error {
 	verr.AddError("mtls", validateMtls(m.Spec.Mtls))
 	verr.AddError("logging", validateLogging(m.Spec.Logging))
 	verr.AddError("tracing", validateTracing(m.Spec.Tracing))
+	verr.AddError("networking", validateMeshNetworking(m.Spec.Networking))
+	verr.AddError("metrics", validateMetrics(m.Spec.Metrics))
 	return verr.OrNil()
 }
 
+func validateMetrics(metrics *mesh_proto.Metrics) validators.ValidationError {
+	var verr validators.ValidationError
+	if metrics.GetEnabled() == nil {
+		return verr
+	}
+	switch {
+	case metrics.GetEnabled().GetValue() && metrics.GetPrometheus() == nil:
+		verr.AddViolation("prometheus", "has to be defined when metrics are enabled")
+	case !metrics.GetEnabled().GetValue() && metrics.GetPrometheus() != nil:
+		verr.AddViolation("prometheus", "must not be defined when metrics are disabled")
+	}
+	return verr
+}
+
+func validateMeshNetworking(networking *mesh_proto.Mesh_Networking) validators.ValidationError {
+	var verr validators.ValidationError
+	if networking.GetOutbound() == nil {
+		return verr
+	}
+	if networking.GetOutbound().GetPassthrough() == nil {
+		verr.AddViolationAt(validators.RootedAt("outbound").Field("passthrough"), "has to be defined")
+	}
+	return verr
+}
+
 func validateMtls(mtls *mesh_proto.Mesh_Mtls) validators.ValidationError {
 	var verr validators.ValidationError
 	if mtls == nil {
@@ -33,6 +62,85 @@ func validateMtls(mtls *mesh_proto.Mesh_Mtls) validators.ValidationError {
 	if mtls.GetEnabledBackend() != "" && !usedNames[mtls.GetEnabledBackend()] {
 		verr.AddViolation("enabledBackend", "has to be set to one of the backends in the mesh")
 	}
+	verr.AddError("rotation", validateMtlsRotation(mtls.GetRotation()))
+	verr.AddError("tls", validateMtlsTls(mtls.GetTls()))
+	verr.Add(ValidateSelectors(validators.RootedAt("exclusions"), mtls.GetExclusions(), ValidateSelectorsOpts{
+		ValidateSelectorOpts: ValidateSelectorOpts{
+			RequireAtLeastOneTag: true,
+		},
+	}))
+	return verr
+}
+
+// tlsVersions lists TLS versions that can be required between dataplanes.
+var tlsVersions = []string{"TLSv1_2", "TLSv1_3"}
+
+// downgradedTlsVersions lists TLS versions that are known but no longer considered secure.
+var downgradedTlsVersions = []string{"TLSv1_0", "TLSv1_1"}
+
+// tlsCipherSuites lists TLS 1.2 cipher suites supported by Envoy for certificate based authentication.
+var tlsCipherSuites = []string{
+	"ECDHE-ECDSA-AES128-GCM-SHA256",
+	"ECDHE-RSA-AES128-GCM-SHA256",
+	"ECDHE-ECDSA-AES256-GCM-SHA384",
+	"ECDHE-RSA-AES256-GCM-SHA384",
+	"ECDHE-ECDSA-CHACHA20-POLY1305",
+	"ECDHE-RSA-CHACHA20-POLY1305",
+	"ECDHE-ECDSA-AES128-SHA",
+	"ECDHE-RSA-AES128-SHA",
+	"ECDHE-ECDSA-AES256-SHA",
+	"ECDHE-RSA-AES256-SHA",
+	"AES128-GCM-SHA256",
+	"AES256-GCM-SHA384",
+	"AES128-SHA",
+	"AES256-SHA",
+}
+
+func validateMtlsTls(tls *mesh_proto.Mesh_Mtls_Tls) validators.ValidationError {
+	var verr validators.ValidationError
+	if tls == nil {
+		return verr
+	}
+	switch {
+	case tls.GetMinVersion() == "" || isOneOf(tls.GetMinVersion(), tlsVersions):
+	case isOneOf(tls.GetMinVersion(), downgradedTlsVersions):
+		verr.AddViolation("minVersion", fmt.Sprintf("must not be lower than %s", tlsVersions[0]))
+	default:
+		verr.AddViolation("minVersion", fmt.Sprintf("has invalid value. %s", AllowedValuesHint(tlsVersions...)))
+	}
+	for i, suite := range tls.GetCipherSuites() {
+		if !isOneOf(suite, tlsCipherSuites) {
+			verr.AddViolationAt(validators.RootedAt("cipherSuites").Index(i), fmt.Sprintf("unknown cipher suite %q. %s", suite, AllowedValuesHint(tlsCipherSuites...)))
+		}
+	}
+	return verr
+}
+
+func isOneOf(value string, values []string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func validateMtlsRotation(rotation *mesh_proto.Mesh_Mtls_Rotation) validators.ValidationError {
+	var verr validators.ValidationError
+	if rotation == nil {
+		return verr
+	}
+	expiration, err := ptypes.Duration(rotation.GetExpiration())
+	if rotation.GetExpiration() != nil && (err != nil || expiration <= 0) {
+		verr.AddViolation("expiration", "must be positive")
+	}
+	window, err := ptypes.Duration(rotation.GetRotationWindow())
+	if rotation.GetRotationWindow() != nil && (err != nil || window <= 0) {
+		verr.AddViolation("rotationWindow", "must be positive")
+	}
+	if rotation.GetExpiration() != nil && rotation.GetRotationWindow() != nil && window >= expiration {
+		verr.AddViolation("rotationWindow", "must be shorter than expiration")
+	}
 	return verr
 }
 
@@ -119,8 +227,22 @@ func validateTracingBackend(backend *mesh_proto.TracingBackend) validators.Valid
 	if backend.Sampling.GetValue() < 0.0 || backend.Sampling.GetValue() > 100.0 {
 		verr.AddViolation("sampling", "has to be in [0.0 - 100.0] range")
 	}
-	if zipkin, ok := backend.GetType().(*mesh_proto.TracingBackend_Zipkin_); ok {
-		verr.AddError("zipkin", validateZipkin(zipkin.Zipkin))
+	switch backendType := backend.GetType().(type) {
+	case *mesh_proto.TracingBackend_Zipkin_:
+		verr.AddError("zipkin", validateZipkin(backendType.Zipkin))
+	case *mesh_proto.TracingBackend_Datadog_:
+		verr.AddError("datadog", validateDatadog(backendType.Datadog))
+	}
+	return verr
+}
+
+func validateDatadog(datadog *mesh_proto.TracingBackend_Datadog) validators.ValidationError {
+	var verr validators.ValidationError
+	if datadog.Address == "" {
+		verr.AddViolation("address", "cannot be empty")
+	}
+	if datadog.Port == 0 || datadog.Port > 65535 {
+		verr.AddViolation("port", "has to be in [1 - 65535] range")
 	}
 	return verr
 }
@@ -23,6 +23,20 @@ var _ = Describe("Mesh", func() {
               backends:
               - name: builtin-1
                 type: builtin
+              rotation:
+                expiration: 720h
+                rotationWindow: 24h
+              tls:
+                minVersion: TLSv1_3
+                cipherSuites:
+                - ECDHE-ECDSA-AES128-GCM-SHA256
+                - AES256-GCM-SHA384
+              exclusions:
+              - match:
+                  service: legacy
+              - match:
+                  service: '*'
+                  version: v1
             logging:
               backends:
               - name: file-1
@@ -51,7 +65,19 @@ var _ = Describe("Mesh", func() {
               - name: zipkin-eu
                 zipkin:
                   url: http://zipkin.local:9411/v2/spans
+              - name: datadog
+                datadog:
+                  address: datadog-agent.local
+                  port: 8126
               defaultBackend: zipkin-us
+            networking:
+              outbound:
+                passthrough: false
+            metrics:
+              enabled: true
+              prometheus:
+                port: 5670
+                path: /metrics
 `
 			mesh := MeshResource{}
 
@@ -67,6 +93,51 @@ var _ = Describe("Mesh", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		DescribeTable("should pass validation of metrics",
+			func(spec string) {
+				// given
+				mesh := MeshResource{}
+				err := util_proto.FromYAML([]byte(spec), &mesh.Spec)
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				err = mesh.Validate()
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+			},
+			Entry("metrics enabled implicitly", `
+                metrics:
+                  prometheus: {}`),
+			Entry("metrics enabled explicitly", `
+                metrics:
+                  enabled: true
+                  prometheus: {}`),
+			Entry("metrics disabled", `
+                metrics:
+                  enabled: false`),
+		)
+
+		It("should report a stray prometheus block of disabled metrics after defaults are applied", func() {
+			// given
+			mesh := MeshResource{}
+			err := util_proto.FromYAML([]byte(`
+                metrics:
+                  enabled: false
+                  prometheus: {}`), &mesh.Spec)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			mesh.Default()
+			err = mesh.Validate()
+
+			// then
+			Expect(err).To(MatchError("metrics.prometheus: must not be defined when metrics are disabled"))
+			// and
+			Expect(mesh.Spec.Metrics.Prometheus.Port).To(BeZero())
+			Expect(mesh.HasPrometheusMetricsEnabled()).To(BeFalse())
+		})
+
 		DescribeTable("should validate fields",
 			func(given testCase) {
 				// given
@@ -112,6 +183,93 @@ var _ = Describe("Mesh", func() {
                 violations:
                 - field: mtls.enabledBackend
                   message: has to be set to one of the backends in the mesh`,
+			}),
+			Entry("rotation window equal to expiration", testCase{
+				mesh: `
+                mtls:
+                  rotation:
+                    expiration: 24h
+                    rotationWindow: 24h`,
+				expected: `
+                violations:
+                - field: mtls.rotation.rotationWindow
+                  message: must be shorter than expiration`,
+			}),
+			Entry("rotation window longer than expiration", testCase{
+				mesh: `
+                mtls:
+                  rotation:
+                    expiration: 1h
+                    rotationWindow: 2h`,
+				expected: `
+                violations:
+                - field: mtls.rotation.rotationWindow
+                  message: must be shorter than expiration`,
+			}),
+			Entry("rotation with non-positive durations", testCase{
+				mesh: `
+                mtls:
+                  rotation:
+                    expiration: 0s
+                    rotationWindow: -1h`,
+				expected: `
+                violations:
+                - field: mtls.rotation.expiration
+                  message: must be positive
+                - field: mtls.rotation.rotationWindow
+                  message: must be positive`,
+			}),
+			Entry("tls with downgraded min version", testCase{
+				mesh: `
+                mtls:
+                  tls:
+                    minVersion: TLSv1_1`,
+				expected: `
+                violations:
+                - field: mtls.tls.minVersion
+                  message: must not be lower than TLSv1_2`,
+			}),
+			Entry("tls with unknown min version", testCase{
+				mesh: `
+                mtls:
+                  tls:
+                    minVersion: SSLv3`,
+				expected: `
+                violations:
+                - field: mtls.tls.minVersion
+                  message: 'has invalid value. Allowed values: TLSv1_2, TLSv1_3'`,
+			}),
+			Entry("tls with unknown cipher suites", testCase{
+				mesh: `
+                mtls:
+                  tls:
+                    cipherSuites:
+                    - ECDHE-RSA-AES128-GCM-SHA256
+                    - RC4-MD5
+                    - TLS_AES_128_GCM_SHA256`,
+				expected: `
+                violations:
+                - field: mtls.tls.cipherSuites[1]
+                  message: 'unknown cipher suite "RC4-MD5". Allowed values: ECDHE-ECDSA-AES128-GCM-SHA256, ECDHE-RSA-AES128-GCM-SHA256, ECDHE-ECDSA-AES256-GCM-SHA384, ECDHE-RSA-AES256-GCM-SHA384, ECDHE-ECDSA-CHACHA20-POLY1305, ECDHE-RSA-CHACHA20-POLY1305, ECDHE-ECDSA-AES128-SHA, ECDHE-RSA-AES128-SHA, ECDHE-ECDSA-AES256-SHA, ECDHE-RSA-AES256-SHA, AES128-GCM-SHA256, AES256-GCM-SHA384, AES128-SHA, AES256-SHA'
+                - field: mtls.tls.cipherSuites[2]
+                  message: 'unknown cipher suite "TLS_AES_128_GCM_SHA256". Allowed values: ECDHE-ECDSA-AES128-GCM-SHA256, ECDHE-RSA-AES128-GCM-SHA256, ECDHE-ECDSA-AES256-GCM-SHA384, ECDHE-RSA-AES256-GCM-SHA384, ECDHE-ECDSA-CHACHA20-POLY1305, ECDHE-RSA-CHACHA20-POLY1305, ECDHE-ECDSA-AES128-SHA, ECDHE-RSA-AES128-SHA, ECDHE-ECDSA-AES256-SHA, ECDHE-RSA-AES256-SHA, AES128-GCM-SHA256, AES256-GCM-SHA384, AES128-SHA, AES256-SHA'`,
+			}),
+			Entry("exclusions with invalid selectors", testCase{
+				mesh: `
+                mtls:
+                  exclusions:
+                  - match: {}
+                  - match:
+                      service:
+                      region: eu/west`,
+				expected: `
+                violations:
+                - field: mtls.exclusions[0].match
+                  message: must have at least one tag
+                - field: mtls.exclusions[1].match["region"]
+                  message: tag value must consist of alphanumeric characters, dots, dashes and underscores or be "*"
+                - field: mtls.exclusions[1].match["service"]
+                  message: tag value must be non-empty`,
 			}),
 			Entry("logging backend with empty name", testCase{
 				mesh: `
@@ -295,6 +453,20 @@ var _ = Describe("Mesh", func() {
                 violations:
                 - field: tracing.backends[0].zipkin.apiVersion
                   message: 'has invalid value. Allowed values: httpJsonV1, httpJson, httpProto'`,
+			}),
+			Entry("tracing with datadog without address and port", testCase{
+				mesh: `
+                tracing:
+                  backends:
+                  - name: datadog
+                    datadog:
+                      address: ""`,
+				expected: `
+                violations:
+                - field: tracing.backends[0].datadog.address
+                  message: cannot be empty
+                - field: tracing.backends[0].datadog.port
+                  message: has to be in [1 - 65535] range`,
 			}),
 			Entry("default backend has to be set to one of the backends", testCase{
 				mesh: `
@@ -308,6 +480,35 @@ var _ = Describe("Mesh", func() {
                 violations:
                 - field: tracing.defaultBackend
                   message: has to be set to one of the tracing backend in mesh`,
+			}),
+			Entry("outbound passthrough has to be defined", testCase{
+				mesh: `
+                networking:
+                  outbound: {}`,
+				expected: `
+                violations:
+                - field: networking.outbound.passthrough
+                  message: has to be defined`,
+			}),
+			Entry("prometheus defined when metrics are disabled", testCase{
+				mesh: `
+                metrics:
+                  enabled: false
+                  prometheus:
+                    port: 1234`,
+				expected: `
+                violations:
+                - field: metrics.prometheus
+                  message: must not be defined when metrics are disabled`,
+			}),
+			Entry("prometheus not defined when metrics are enabled", testCase{
+				mesh: `
+                metrics:
+                  enabled: true`,
+				expected: `
+                violations:
+                - field: metrics.prometheus
+                  message: has to be defined when metrics are enabled`,
 			}),
 			Entry("multiple errors", testCase{
 				mesh: `
@@ -1,6 +1,9 @@
 package mesh
 
 import (
+	"regexp"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	"github.com/Kong/kuma/pkg/core/validators"
 )
 
@@ -9,6 +12,7 @@ func (d *TrafficRouteResource) Validate() error {
 	err.Add(d.validateSources())
 	err.Add(d.validateDestinations())
 	err.Add(d.validateConf())
+	err.Add(d.validateHttp())
 	return err.OrNil()
 }
 
@@ -27,15 +31,65 @@ func (d *TrafficRouteResource) validateDestinations() (err validators.Validation
 }
 
 func (d *TrafficRouteResource) validateConf() (err validators.ValidationError) {
-	root := validators.RootedAt("conf")
-	if len(d.Spec.Conf) == 0 {
+	return validateSplit(validators.RootedAt("conf"), d.Spec.Conf)
+}
+
+func (d *TrafficRouteResource) validateHttp() (err validators.ValidationError) {
+	for i, route := range d.Spec.Http {
+		path := validators.RootedAt("http").Index(i)
+		err.Add(validateHttpMatch(path.Field("match"), route.GetMatch()))
+		err.Add(validateSplit(path.Field("split"), route.GetSplit()))
+	}
+	return
+}
+
+func validateHttpMatch(path validators.PathBuilder, match *mesh_proto.TrafficRoute_Http_Match) (err validators.ValidationError) {
+	if match == nil {
+		err.AddViolationAt(path, "must be defined")
+		return
+	}
+	if match.GetPath() == nil && match.GetMethod() == nil && len(match.GetHeaders()) == 0 {
+		err.AddViolationAt(path, "must have at least one of path, method or headers")
+	}
+	if match.GetPath() != nil {
+		err.Add(validateStringMatcher(path.Field("path"), match.GetPath()))
+	}
+	if match.GetMethod() != nil {
+		err.Add(validateStringMatcher(path.Field("method"), match.GetMethod()))
+	}
+	for _, name := range match.HeaderNames() {
+		err.Add(validateStringMatcher(path.Field("headers").Key(name), match.GetHeaders()[name]))
+	}
+	return
+}
+
+func validateStringMatcher(path validators.PathBuilder, matcher *mesh_proto.TrafficRoute_Http_Match_StringMatcher) (err validators.ValidationError) {
+	switch matcher.GetMatcherType().(type) {
+	case *mesh_proto.TrafficRoute_Http_Match_StringMatcher_Prefix, *mesh_proto.TrafficRoute_Http_Match_StringMatcher_Exact:
+	case *mesh_proto.TrafficRoute_Http_Match_StringMatcher_Regex:
+		if _, rerr := regexp.Compile(matcher.GetRegex()); rerr != nil {
+			err.AddViolationAt(path.Field("regex"), "must be a valid regular expression")
+		}
+	default:
+		err.AddViolationAt(path, "must have one of prefix, exact or regex")
+	}
+	return
+}
+
+func validateSplit(root validators.PathBuilder, split []*mesh_proto.TrafficRoute_WeightedDestination) (err validators.ValidationError) {
+	if len(split) == 0 {
 		err.AddViolationAt(root, "must have at least one element")
 	}
-	for i, routeEntry := range d.Spec.Conf {
+	var totalWeight uint32
+	for i, routeEntry := range split {
 		err.Add(ValidateSelector(root.Index(i).Field("destination"), routeEntry.GetDestination(), ValidateSelectorOpts{
 			RequireAtLeastOneTag: true,
 			RequireService:       true,
 		}))
+		totalWeight += routeEntry.GetWeight()
+	}
+	if len(split) > 0 && totalWeight == 0 {
+		err.AddViolationAt(root, "must have at least one destination with a positive weight")
 	}
 	return
 }
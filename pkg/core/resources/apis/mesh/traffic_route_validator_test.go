@@ -71,6 +71,8 @@ var _ = Describe("TrafficRoute", func() {
                   message: must have at least one tag
                 - field: conf[0].destination
                   message: mandatory tag "service" is missing
+                - field: conf
+                  message: must have at least one destination with a positive weight
 `,
 			}),
 			Entry("selectors with empty tags values", testCase{
@@ -106,6 +108,8 @@ var _ = Describe("TrafficRoute", func() {
                   message: tag value must be non-empty
                 - field: conf[0].destination["service"]
                   message: tag value must be non-empty
+                - field: conf
+                  message: must have at least one destination with a positive weight
 `,
 			}),
 			Entry("multiple selectors", testCase{
@@ -156,6 +160,84 @@ var _ = Describe("TrafficRoute", func() {
                   message: must have at least one tag
                 - field: conf[1].destination
                   message: mandatory tag "service" is missing
+                - field: conf
+                  message: must have at least one destination with a positive weight
+`,
+			}),
+			Entry("destinations without weights", testCase{
+				route: `
+                sources:
+                - match:
+                    service: web
+                destinations:
+                - match:
+                    service: backend
+                conf:
+                - weight: 0
+                  destination:
+                    service: backend
+                    version: v1
+                - destination:
+                    service: backend
+                    version: v2
+`,
+				expected: `
+                violations:
+                - field: conf
+                  message: must have at least one destination with a positive weight
+`,
+			}),
+			Entry("http routes", testCase{
+				route: `
+                sources:
+                - match:
+                    service: web
+                destinations:
+                - match:
+                    service: backend
+                conf:
+                - weight: 100
+                  destination:
+                    service: backend
+                http:
+                - match: {}
+                  split:
+                  - weight: 100
+                    destination:
+                      service: backend
+                      version: v2
+                - match:
+                    path:
+                      regex: "/api/(v1"
+                    method: {}
+                    headers:
+                      x-version:
+                        exact: v2
+                      x-canary: {}
+                  split:
+                  - weight: 0
+                    destination:
+                      version: v2
+                - split: []
+`,
+				expected: `
+                violations:
+                - field: http[0].match
+                  message: must have at least one of path, method or headers
+                - field: http[1].match.path.regex
+                  message: must be a valid regular expression
+                - field: http[1].match.method
+                  message: must have one of prefix, exact or regex
+                - field: http[1].match.headers["x-canary"]
+                  message: must have one of prefix, exact or regex
+                - field: http[1].split[0].destination
+                  message: mandatory tag "service" is missing
+                - field: http[1].split
+                  message: must have at least one destination with a positive weight
+                - field: http[2].match
+                  message: must be defined
+                - field: http[2].split
+                  message: must have at least one element
 `,
 			}),
 		)
@@ -8,6 +8,7 @@ func (d *TrafficTraceResource) Validate() error {
 	var err validators.ValidationError
 	err.Add(d.validateSelectors())
 	// d.Spec.Conf and d.Spec.Conf.DefaultBackend can be empty, then default backend of the mesh is chosen.
+	err.Add(d.validateConf())
 	return err.OrNil()
 }
 
@@ -19,3 +20,19 @@ func (d *TrafficTraceResource) validateSelectors() validators.ValidationError {
 		},
 	})
 }
+
+func (d *TrafficTraceResource) validateConf() (err validators.ValidationError) {
+	if sampling := d.Spec.GetConf().GetSampling(); sampling != nil && (sampling.GetValue() < 0.0 || sampling.GetValue() > 100.0) {
+		err.AddViolationAt(validators.RootedAt("conf").Field("sampling"), "has to be in [0.0 - 100.0] range")
+	}
+	return
+}
+
+// ValidateBackend checks that the backend referenced by TrafficTrace is one of the tracing backends of a given Mesh.
+func (d *TrafficTraceResource) ValidateBackend(mesh *MeshResource) error {
+	var err validators.ValidationError
+	if backend := d.Spec.GetConf().GetBackend(); backend != "" && mesh.GetTracingBackend(backend) == nil {
+		err.AddViolationAt(validators.RootedAt("conf").Field("backend"), "has to be set to one of the tracing backends in the mesh")
+	}
+	return err.OrNil()
+}
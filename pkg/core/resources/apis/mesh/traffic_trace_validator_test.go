@@ -6,6 +6,7 @@ import (
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	. "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
 	util_proto "github.com/Kong/kuma/pkg/util/proto"
 )
@@ -33,7 +34,8 @@ var _ = Describe("TrafficTrace", func() {
                 - match:
                     region: eu
                 conf:
-                  backend: zipkin-eu`,
+                  backend: zipkin-eu
+                  sampling: 12.5`,
 			),
 			Entry("empty backend", `
                 selectors:
@@ -105,6 +107,35 @@ var _ = Describe("TrafficTrace", func() {
                   message: tag value must be non-empty
                 - field: selectors[0].match["service"]
                   message: tag value must be non-empty
+`,
+			}),
+			Entry("sampling out of range", testCase{
+				trafficTrace: `
+                selectors:
+                - match:
+                    region: eu
+                conf:
+                  backend: zipkin-eu
+                  sampling: 100.1
+`,
+				expected: `
+                violations:
+                - field: conf.sampling
+                  message: has to be in [0.0 - 100.0] range
+`,
+			}),
+			Entry("negative sampling", testCase{
+				trafficTrace: `
+                selectors:
+                - match:
+                    region: eu
+                conf:
+                  sampling: -1
+`,
+				expected: `
+                violations:
+                - field: conf.sampling
+                  message: has to be in [0.0 - 100.0] range
 `,
 			}),
 			Entry("multiple selectors", testCase{
@@ -127,4 +158,58 @@ var _ = Describe("TrafficTrace", func() {
 			}),
 		)
 	})
+
+	Describe("ValidateBackend()", func() {
+		var mesh *MeshResource
+
+		BeforeEach(func() {
+			mesh = &MeshResource{}
+			err := util_proto.FromYAML([]byte(`
+                tracing:
+                  defaultBackend: zipkin-us
+                  backends:
+                  - name: zipkin-us
+                    zipkin:
+                      url: http://zipkin.us:9411/api/v1/spans
+                  - name: jaeger-eu
+                    zipkin:
+                      url: http://jaeger.eu:9411/api/v2/spans`), &mesh.Spec)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		DescribeTable("should pass validation",
+			func(backend string) {
+				// given
+				trafficTrace := TrafficTraceResource{}
+				trafficTrace.Spec.Conf = &mesh_proto.TrafficTrace_Conf{Backend: backend}
+
+				// when
+				err := trafficTrace.ValidateBackend(mesh)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+			},
+			Entry("known backend", "jaeger-eu"),
+			Entry("empty backend", ""),
+		)
+
+		It("should not allow a dangling backend reference", func() {
+			// given
+			trafficTrace := TrafficTraceResource{}
+			trafficTrace.Spec.Conf = &mesh_proto.TrafficTrace_Conf{Backend: "zipkin-eu"}
+
+			// when
+			err := trafficTrace.ValidateBackend(mesh)
+			// and
+			actual, yamlErr := yaml.Marshal(err)
+
+			// then
+			Expect(yamlErr).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(`
+                violations:
+                - field: conf.backend
+                  message: has to be set to one of the tracing backends in the mesh`))
+		})
+	})
 })
@@ -3,45 +3,65 @@ package manager
 import (
 	"context"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/patrickmn/go-cache"
 
+	"github.com/Kong/kuma/pkg/core/resources/apis/mesh"
 	"github.com/Kong/kuma/pkg/core/resources/model"
 	"github.com/Kong/kuma/pkg/core/resources/store"
 )
 
+// CachedManager is a ReadOnlyResourceManager that caches results of the underlying manager.
+type CachedManager interface {
+	ReadOnlyResourceManager
+	// Invalidate evicts cached results of a given resource type, so that the next read hits the underlying manager.
+	// Since deletion of a Mesh cascades to resources of all types, invalidation of a Mesh evicts the whole cache.
+	Invalidate(resourceType model.ResourceType)
+}
+
 // Cached version of the ReadOnlyResourceManager designed to be used only for use cases of eventual consistency.
 //
 // This cache is NOT consistent across instances of the control plane.
-// This cache is mutex free for performance with consideration that: values can be overridden by other goroutine.
+// Writes made through a ResourceManager returned by NewInvalidatingManager invalidate the cache,
+// so that they are visible to subsequent reads on this instance. Otherwise, changes become visible once the cache expires.
+//
+// This cache does not serialize reads for performance with consideration that: values can be overridden by other goroutine.
 // * if cache expires and multiple goroutines tries to fetch the resource, they may fetch underlying manager multiple times.
 // * if cache expires and multiple goroutines tries to fetch the resource, they fetch underlying manager multiple times
 //   and the value returned is different, the older value may be persisted. This is ok, since this cache is designed
 //   to have low expiration time (like 1s) and having old value just extends propagation of new config for 1 more second.
+// A value fetched before invalidation is never persisted after it though.
 type cachedManager struct {
 	delegate ReadOnlyResourceManager
 	cache    *cache.Cache
+
+	// generation is incremented on every invalidation
+	generation uint64
+	mu         sync.Mutex
 }
 
-var _ ReadOnlyResourceManager = &cachedManager{}
+var _ CachedManager = &cachedManager{}
 
-func NewCachedManager(delegate ReadOnlyResourceManager, expirationTime time.Duration) ReadOnlyResourceManager {
+func NewCachedManager(delegate ReadOnlyResourceManager, expirationTime time.Duration) CachedManager {
 	return &cachedManager{
 		delegate: delegate,
 		cache:    cache.New(expirationTime, time.Duration(int64(float64(expirationTime)*0.9))),
 	}
 }
 
-func (c cachedManager) Get(ctx context.Context, res model.Resource, fs ...store.GetOptionsFunc) error {
+func (c *cachedManager) Get(ctx context.Context, res model.Resource, fs ...store.GetOptionsFunc) error {
 	opts := store.NewGetOptions(fs...)
 	cacheKey := fmt.Sprintf("GET:%s:%s", res.GetType(), opts.HashCode())
 	obj, found := c.cache.Get(cacheKey)
 	if !found {
+		generation := c.currentGeneration()
 		if err := c.delegate.Get(ctx, res, fs...); err != nil {
 			return err
 		}
-		c.cache.SetDefault(cacheKey, res)
+		c.set(cacheKey, generation, res)
 	} else {
 		cached := obj.(model.Resource)
 		if err := res.SetSpec(cached.GetSpec()); err != nil {
@@ -52,15 +72,16 @@ func (c cachedManager) Get(ctx context.Context, res model.Resource, fs ...store.
 	return nil
 }
 
-func (c cachedManager) List(ctx context.Context, list model.ResourceList, fs ...store.ListOptionsFunc) error {
+func (c *cachedManager) List(ctx context.Context, list model.ResourceList, fs ...store.ListOptionsFunc) error {
 	opts := store.NewListOptions(fs...)
 	cacheKey := fmt.Sprintf("LIST:%s:%s", list.GetItemType(), opts.HashCode())
 	obj, found := c.cache.Get(cacheKey)
 	if !found {
+		generation := c.currentGeneration()
 		if err := c.delegate.List(ctx, list, fs...); err != nil {
 			return err
 		}
-		c.cache.SetDefault(cacheKey, list.GetItems())
+		c.set(cacheKey, generation, list.GetItems())
 	} else {
 		resources := obj.([]model.Resource)
 		for _, res := range resources {
@@ -71,3 +92,70 @@ func (c cachedManager) List(ctx context.Context, list model.ResourceList, fs ...
 	}
 	return nil
 }
+
+func (c *cachedManager) Invalidate(resourceType model.ResourceType) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	if resourceType == mesh.MeshType {
+		c.cache.Flush()
+		return
+	}
+	for key := range c.cache.Items() {
+		if strings.HasPrefix(key, fmt.Sprintf("GET:%s:", resourceType)) || strings.HasPrefix(key, fmt.Sprintf("LIST:%s:", resourceType)) {
+			c.cache.Delete(key)
+		}
+	}
+}
+
+func (c *cachedManager) currentGeneration() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generation
+}
+
+// set caches a value unless the cache has been invalidated since the value was fetched.
+func (c *cachedManager) set(key string, generation uint64, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.generation == generation {
+		c.cache.SetDefault(key, value)
+	}
+}
+
+// NewInvalidatingManager returns a ResourceManager that invalidates cached resources of a given type
+// after every write, so that the writer reads its own writes through the cache.
+func NewInvalidatingManager(delegate ResourceManager, cache CachedManager) ResourceManager {
+	return &invalidatingManager{
+		ResourceManager: delegate,
+		cache:           cache,
+	}
+}
+
+type invalidatingManager struct {
+	ResourceManager
+	cache CachedManager
+}
+
+var _ ResourceManager = &invalidatingManager{}
+
+func (m *invalidatingManager) Create(ctx context.Context, res model.Resource, fs ...store.CreateOptionsFunc) error {
+	defer m.cache.Invalidate(res.GetType())
+	return m.ResourceManager.Create(ctx, res, fs...)
+}
+
+func (m *invalidatingManager) Update(ctx context.Context, res model.Resource, fs ...store.UpdateOptionsFunc) error {
+	defer m.cache.Invalidate(res.GetType())
+	return m.ResourceManager.Update(ctx, res, fs...)
+}
+
+func (m *invalidatingManager) Delete(ctx context.Context, res model.Resource, fs ...store.DeleteOptionsFunc) error {
+	defer m.cache.Invalidate(res.GetType())
+	return m.ResourceManager.Delete(ctx, res, fs...)
+}
+
+func (m *invalidatingManager) DeleteAll(ctx context.Context, list model.ResourceList, fs ...store.DeleteAllOptionsFunc) error {
+	defer m.cache.Invalidate(list.GetItemType())
+	return m.ResourceManager.DeleteAll(ctx, list, fs...)
+}
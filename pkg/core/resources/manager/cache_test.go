@@ -36,7 +36,7 @@ var _ core_manager.ReadOnlyResourceManager = &countingResourcesManager{}
 var _ = Describe("Cached Resource Manager", func() {
 
 	var store core_store.ResourceStore
-	var cachedManager core_manager.ReadOnlyResourceManager
+	var cachedManager core_manager.CachedManager
 	var countingManager *countingResourcesManager
 	var res *core_mesh.DataplaneResource
 	expiration := 100 * time.Millisecond
@@ -58,6 +58,9 @@ var _ = Describe("Cached Resource Manager", func() {
 						{
 							Port:        80,
 							ServicePort: 8080,
+							Tags: map[string]string{
+								"service": "backend",
+							},
 						},
 					},
 				},
@@ -118,4 +121,117 @@ var _ = Describe("Cached Resource Manager", func() {
 		Expect(fetch().Items[0].GetSpec()).To(Equal(&res.Spec))
 		Expect(countingManager.listQueries).To(Equal(2))
 	})
+
+	Context("with invalidating manager", func() {
+
+		var manager core_manager.ResourceManager
+
+		BeforeEach(func() {
+			manager = core_manager.NewInvalidatingManager(core_manager.NewResourceManager(store), cachedManager)
+
+			// and
+			err := store.Create(context.Background(), &core_mesh.MeshResource{}, core_store.CreateByKey("default", "default"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		fetch := func() *core_mesh.DataplaneResource {
+			fetched := &core_mesh.DataplaneResource{}
+			err := cachedManager.Get(context.Background(), fetched, core_store.GetByKey("dp-1", "default"))
+			Expect(err).ToNot(HaveOccurred())
+			return fetched
+		}
+
+		fetchList := func() core_mesh.DataplaneResourceList {
+			fetched := core_mesh.DataplaneResourceList{}
+			err := cachedManager.List(context.Background(), &fetched, core_store.ListByMesh("default"))
+			Expect(err).ToNot(HaveOccurred())
+			return fetched
+		}
+
+		It("should invalidate cache on Update()", func() {
+			// given cached resources
+			fetched := fetch()
+			Expect(fetchList().Items).To(HaveLen(1))
+			Expect(countingManager.getQueries).To(Equal(1))
+			Expect(countingManager.listQueries).To(Equal(1))
+
+			// when
+			fetched.Spec.Networking.Address = "192.168.0.1"
+			err := manager.Update(context.Background(), fetched)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when fetched before the cache expires
+			updated := fetch()
+			list := fetchList()
+
+			// then the writer reads its own write
+			Expect(updated.Spec.Networking.Address).To(Equal("192.168.0.1"))
+			Expect(list.Items).To(HaveLen(1))
+			Expect(list.Items[0].GetSpec()).To(Equal(&updated.Spec))
+			Expect(countingManager.getQueries).To(Equal(2))
+			Expect(countingManager.listQueries).To(Equal(2))
+
+			// and subsequent reads are served from the cache
+			fetch()
+			fetchList()
+			Expect(countingManager.getQueries).To(Equal(2))
+			Expect(countingManager.listQueries).To(Equal(2))
+		})
+
+		It("should invalidate cache on Create() and Delete()", func() {
+			// given cached resources
+			Expect(fetchList().Items).To(HaveLen(1))
+
+			// when
+			err := manager.Create(context.Background(), &core_mesh.DataplaneResource{Spec: res.Spec}, core_store.CreateByKey("dp-2", "default"))
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fetchList().Items).To(HaveLen(2))
+
+			// when
+			err = manager.Delete(context.Background(), &core_mesh.DataplaneResource{}, core_store.DeleteByKey("dp-2", "default"))
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fetchList().Items).To(HaveLen(1))
+			Expect(countingManager.listQueries).To(Equal(3))
+		})
+
+		It("should not invalidate cache of other types", func() {
+			// given cached resources
+			fetch()
+
+			// when
+			route := &core_mesh.TrafficRouteResource{
+				Spec: mesh_proto.TrafficRoute{
+					Sources:      []*mesh_proto.Selector{{Match: mesh_proto.MatchService("*")}},
+					Destinations: []*mesh_proto.Selector{{Match: mesh_proto.MatchService("*")}},
+					Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+						Weight:      100,
+						Destination: mesh_proto.MatchService("backend"),
+					}},
+				},
+			}
+			err := manager.Create(context.Background(), route, core_store.CreateByKey("route-1", "default"))
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			fetch()
+			// then
+			Expect(countingManager.getQueries).To(Equal(1))
+		})
+
+		It("should invalidate whole cache on write of a Mesh", func() {
+			// given cached resources
+			fetch()
+
+			// when
+			cachedManager.Invalidate(core_mesh.MeshType)
+			fetch()
+
+			// then
+			Expect(countingManager.getQueries).To(Equal(2))
+		})
+	})
 })
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/Kong/kuma/pkg/core/validators"
+)
+
+// defaulter is implemented by resources that have default values, e.g. Mesh.
+type defaulter interface {
+	Default()
+}
+
+// ValidateAll validates every resource of a batch, e.g. a manifest of a Mesh together with its policies,
+// and aggregates violations of all resources, so that every problem is reported at once.
+//
+// Violations are prefixed by the type and the name of a resource, e.g. `TrafficRoute/route-1.sources`.
+// Resources that have default values are defaulted in place before they are validated.
+func ValidateAll(resources []Resource) validators.ValidationError {
+	verr := validators.ValidationError{}
+	for _, resource := range resources {
+		if d, ok := resource.(defaulter); ok {
+			d.Default()
+		}
+		prefix := fmt.Sprintf("%s/%s", resource.GetType(), MetaToResourceKey(resource.GetMeta()).Name)
+		err := resource.Validate()
+		switch {
+		case err == nil:
+		case validators.IsValidationError(err):
+			verr.AddError(prefix, *err.(*validators.ValidationError))
+		default:
+			verr.AddViolation(prefix, err.Error())
+		}
+	}
+	return verr
+}
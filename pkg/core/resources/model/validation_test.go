@@ -0,0 +1,71 @@
+package model_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	"github.com/Kong/kuma/pkg/core/resources/model"
+	test_model "github.com/Kong/kuma/pkg/test/resources/model"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+var _ = Describe("ValidateAll()", func() {
+
+	newResource := func(resource model.Resource, mesh string, name string, spec string) model.Resource {
+		Expect(util_proto.FromYAML([]byte(spec), resource.GetSpec())).To(Succeed())
+		resource.SetMeta(&test_model.ResourceMeta{Mesh: mesh, Name: name})
+		return resource
+	}
+
+	It("should report violations of all resources", func() {
+		// given
+		resources := []model.Resource{
+			newResource(&mesh.MeshResource{}, "demo", "demo", `
+            mtls:
+              enabledBackend: ca-1
+`),
+			newResource(&mesh.TrafficPermissionResource{}, "demo", "allow-all", ``),
+			newResource(&mesh.TrafficPermissionResource{}, "demo", "allow-web", `
+            sources:
+            - match:
+                service: '*'
+            destinations:
+            - match:
+                service: web
+`),
+		}
+
+		// when
+		verr := model.ValidateAll(resources)
+
+		// then
+		actual, err := yaml.Marshal(verr)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(`
+        violations:
+        - field: Mesh/demo.mtls.enabledBackend
+          message: has to be set to one of the backends in the mesh
+        - field: TrafficPermission/allow-all.sources
+          message: must have at least one element
+        - field: TrafficPermission/allow-all.destinations
+          message: must have at least one element
+`))
+		// and
+		Expect(resources[0].(*mesh.MeshResource).Spec.GetNetworking().GetOutbound().GetPassthrough().GetValue()).To(BeTrue())
+	})
+
+	It("should not report violations of valid resources", func() {
+		// given
+		resources := []model.Resource{
+			newResource(&mesh.MeshResource{}, "demo", "demo", ``),
+		}
+
+		// when
+		verr := model.ValidateAll(resources)
+
+		// then
+		Expect(verr.OrNil()).ToNot(HaveOccurred())
+	})
+})
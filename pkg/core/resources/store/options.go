@@ -145,6 +145,7 @@ func (g *GetOptions) HashCode() string {
 
 type ListOptions struct {
 	Mesh       string
+	NamePrefix string
 	PageSize   int
 	PageOffset string
 }
@@ -165,6 +166,14 @@ func ListByMesh(mesh string) ListOptionsFunc {
 	}
 }
 
+// ListByNamePrefix narrows down a list to resources with names that start with a given prefix.
+// Notice that the filter is applied by SecretManager, resource stores ignore it.
+func ListByNamePrefix(prefix string) ListOptionsFunc {
+	return func(opts *ListOptions) {
+		opts.NamePrefix = prefix
+	}
+}
+
 func ListByPage(size int, offset string) ListOptionsFunc {
 	return func(opts *ListOptions) {
 		opts.PageSize = size
@@ -173,5 +182,5 @@ func ListByPage(size int, offset string) ListOptionsFunc {
 }
 
 func (l *ListOptions) HashCode() string {
-	return l.Mesh
+	return fmt.Sprintf("%s:%s", l.Mesh, l.NamePrefix)
 }
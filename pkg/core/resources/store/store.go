@@ -24,11 +24,28 @@ type ClosableResourceStore interface {
 	io.Closer
 }
 
+// TransactionalResourceStore is a ResourceStore that can apply multiple writes atomically.
+type TransactionalResourceStore interface {
+	ResourceStore
+	// RunInTx executes fn against a ResourceStore whose writes are applied atomically.
+	// If fn returns an error, none of its writes are applied.
+	RunInTx(fn func(ResourceStore) error) error
+}
+
+// RunInTx executes fn in a transaction if a given ResourceStore supports transactions.
+// Otherwise, fn is executed directly against the ResourceStore.
+func RunInTx(s ResourceStore, fn func(ResourceStore) error) error {
+	if tx, ok := s.(TransactionalResourceStore); ok {
+		return tx.RunInTx(fn)
+	}
+	return fn(s)
+}
+
 func NewStrictResourceStore(c ResourceStore) ClosableResourceStore {
 	return &strictResourceStore{delegate: c}
 }
 
-var _ ResourceStore = &strictResourceStore{}
+var _ TransactionalResourceStore = &strictResourceStore{}
 
 // strictResourceStore encapsulates a contract between ResourceStore and its users.
 type strictResourceStore struct {
@@ -104,6 +121,12 @@ func (s *strictResourceStore) List(ctx context.Context, rs model.ResourceList, f
 	return s.delegate.List(ctx, rs, fs...)
 }
 
+func (s *strictResourceStore) RunInTx(fn func(ResourceStore) error) error {
+	return RunInTx(s.delegate, func(tx ResourceStore) error {
+		return fn(&strictResourceStore{delegate: tx})
+	})
+}
+
 func (s *strictResourceStore) Close() error {
 	closable, ok := s.delegate.(io.Closer)
 	if ok {
@@ -8,10 +8,12 @@ import (
 	kuma_cp "github.com/Kong/kuma/pkg/config/app/kuma-cp"
 	"github.com/Kong/kuma/pkg/core"
 	core_ca "github.com/Kong/kuma/pkg/core/ca"
+	"github.com/Kong/kuma/pkg/core/ca/revocation"
 	"github.com/Kong/kuma/pkg/core/datasource"
 	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
 	core_store "github.com/Kong/kuma/pkg/core/resources/store"
 	"github.com/Kong/kuma/pkg/core/runtime/component"
+	secret_cipher "github.com/Kong/kuma/pkg/core/secrets/cipher"
 	secret_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
 	core_xds "github.com/Kong/kuma/pkg/core/xds"
 )
@@ -23,6 +25,7 @@ type BuilderContext interface {
 	XdsContext() core_xds.XdsContext
 	Config() kuma_cp.Config
 	SecretManager() secret_manager.SecretManager
+	SecretCipher() secret_cipher.Cipher
 	DataSourceLoader() datasource.Loader
 	Extensions() context.Context
 }
@@ -37,7 +40,9 @@ type Builder struct {
 	rm  core_manager.ResourceManager
 	rom core_manager.ReadOnlyResourceManager
 	sm  secret_manager.SecretManager
+	sc  secret_cipher.Cipher
 	cam core_ca.Managers
+	rvm revocation.Manager
 	xds core_xds.XdsContext
 	dsl datasource.Loader
 	ext context.Context
@@ -76,13 +81,23 @@ func (b *Builder) WithSecretManager(sm secret_manager.SecretManager) *Builder {
 	return b
 }
 
+func (b *Builder) WithSecretCipher(sc secret_cipher.Cipher) *Builder {
+	b.sc = sc
+	return b
+}
+
 func (b *Builder) WithCaManagers(cam core_ca.Managers) *Builder {
 	b.cam = cam
 	return b
 }
 
 func (b *Builder) WithCaManager(name string, cam core_ca.Manager) *Builder {
-	b.cam[name] = cam
+	b.cam.Register(name, cam)
+	return b
+}
+
+func (b *Builder) WithRevocationManager(rvm revocation.Manager) *Builder {
+	b.rvm = rvm
 	return b
 }
 
@@ -117,6 +132,9 @@ func (b *Builder) Build() (Runtime, error) {
 	if b.sm == nil {
 		return nil, errors.Errorf("SecretManager has not been configured")
 	}
+	if b.rvm == nil {
+		return nil, errors.Errorf("RevocationManager has not been configured")
+	}
 	if b.xds == nil {
 		return nil, errors.Errorf("xDS Context has not been configured")
 	}
@@ -136,6 +154,7 @@ func (b *Builder) Build() (Runtime, error) {
 			rom: b.rom,
 			sm:  b.sm,
 			cam: b.cam,
+			rvm: b.rvm,
 			xds: b.xds,
 			ext: b.ext,
 		},
@@ -152,12 +171,18 @@ func (b *Builder) ResourceStore() core_store.ResourceStore {
 func (b *Builder) SecretManager() secret_manager.SecretManager {
 	return b.sm
 }
+func (b *Builder) SecretCipher() secret_cipher.Cipher {
+	return b.sc
+}
 func (b *Builder) ReadOnlyResourceManager() core_manager.ReadOnlyResourceManager {
 	return b.rom
 }
 func (b *Builder) CaManagers() core_ca.Managers {
 	return b.cam
 }
+func (b *Builder) RevocationManager() revocation.Manager {
+	return b.rvm
+}
 func (b *Builder) XdsContext() core_xds.XdsContext {
 	return b.xds
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/Kong/kuma/pkg/core/ca"
+	"github.com/Kong/kuma/pkg/core/ca/revocation"
 
 	kuma_cp "github.com/Kong/kuma/pkg/config/app/kuma-cp"
 	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
@@ -30,6 +31,7 @@ type RuntimeContext interface {
 	ReadOnlyResourceManager() core_manager.ReadOnlyResourceManager
 	SecretManager() secret_manager.SecretManager
 	CaManagers() ca.Managers
+	RevocationManager() revocation.Manager
 	Extensions() context.Context
 }
 
@@ -59,6 +61,7 @@ type runtimeContext struct {
 	rom core_manager.ReadOnlyResourceManager
 	sm  secret_manager.SecretManager
 	cam ca.Managers
+	rvm revocation.Manager
 	xds core_xds.XdsContext
 	ext context.Context
 }
@@ -66,6 +69,9 @@ type runtimeContext struct {
 func (rc *runtimeContext) CaManagers() ca.Managers {
 	return rc.cam
 }
+func (rc *runtimeContext) RevocationManager() revocation.Manager {
+	return rc.rvm
+}
 func (rc *runtimeContext) Config() kuma_cp.Config {
 	return rc.cfg
 }
@@ -0,0 +1,51 @@
+package cipher
+
+import (
+	"crypto/aes"
+	go_cipher "crypto/cipher"
+	"crypto/rand"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// NewAESGCM returns a Cipher that encrypts data with AES-GCM under a given key of 16, 24 or 32 bytes.
+// Every ciphertext is prefixed with a random nonce, and data that was not encrypted under the same key
+// and with the same additional data is rejected by Decrypt.
+func NewAESGCM(key []byte) (Cipher, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create AES cipher")
+	}
+	aead, err := go_cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create GCM cipher")
+	}
+	return &aesGCM{aead: aead}, nil
+}
+
+var _ Cipher = &aesGCM{}
+
+type aesGCM struct {
+	aead go_cipher.AEAD
+}
+
+func (c *aesGCM) Encrypt(data []byte, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, errors.Wrap(err, "could not generate nonce")
+	}
+	return c.aead.Seal(nonce, nonce, data, additionalData), nil
+}
+
+func (c *aesGCM) Decrypt(data []byte, additionalData []byte) ([]byte, error) {
+	if len(data) < c.aead.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+	nonce, ciphertext := data[:c.aead.NonceSize()], data[c.aead.NonceSize():]
+	plaintext, err := c.aead.Open(nil, nonce, ciphertext, additionalData)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt")
+	}
+	return plaintext, nil
+}
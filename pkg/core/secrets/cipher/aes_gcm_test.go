@@ -0,0 +1,101 @@
+package cipher_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Kong/kuma/pkg/core/secrets/cipher"
+)
+
+var _ = Describe("AES-GCM", func() {
+
+	var aesGCM cipher.Cipher
+
+	BeforeEach(func() {
+		var err error
+		aesGCM, err = cipher.NewAESGCM([]byte("0123456789abcdef0123456789abcdef"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should decrypt encrypted data", func() {
+		// when
+		ciphertext, err := aesGCM.Encrypt([]byte("secret"), []byte("default.secret-1"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ciphertext).ToNot(ContainSubstring("secret"))
+
+		// when
+		plaintext, err := aesGCM.Decrypt(ciphertext, []byte("default.secret-1"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(plaintext)).To(Equal("secret"))
+	})
+
+	It("should encrypt the same data differently every time", func() {
+		// when
+		first, err := aesGCM.Encrypt([]byte("secret"), nil)
+		Expect(err).ToNot(HaveOccurred())
+		second, err := aesGCM.Encrypt([]byte("secret"), nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(first).ToNot(Equal(second))
+	})
+
+	It("should reject data encrypted under a different key", func() {
+		// given
+		other, err := cipher.NewAESGCM([]byte("fedcba9876543210fedcba9876543210"))
+		Expect(err).ToNot(HaveOccurred())
+		ciphertext, err := other.Encrypt([]byte("secret"), []byte("default.secret-1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = aesGCM.Decrypt(ciphertext, []byte("default.secret-1"))
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("could not decrypt")))
+	})
+
+	It("should reject data encrypted with different additional data", func() {
+		// given
+		ciphertext, err := aesGCM.Encrypt([]byte("secret"), []byte("default.secret-1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = aesGCM.Decrypt(ciphertext, []byte("default.secret-2"))
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("could not decrypt")))
+	})
+
+	It("should reject tampered data", func() {
+		// given
+		ciphertext, err := aesGCM.Encrypt([]byte("secret"), nil)
+		Expect(err).ToNot(HaveOccurred())
+		ciphertext[len(ciphertext)-1] ^= 0xff
+
+		// when
+		_, err = aesGCM.Decrypt(ciphertext, nil)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("could not decrypt")))
+	})
+
+	It("should reject a ciphertext shorter than a nonce", func() {
+		// when
+		_, err := aesGCM.Decrypt([]byte("short"), nil)
+
+		// then
+		Expect(err).To(MatchError("ciphertext is too short"))
+	})
+
+	It("should reject a key of invalid length", func() {
+		// when
+		_, err := cipher.NewAESGCM([]byte("0123456789"))
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("could not create AES cipher")))
+	})
+})
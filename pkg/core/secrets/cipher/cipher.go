@@ -5,10 +5,14 @@ type Cipher interface {
 	Decryptor
 }
 
+// Encryptor encrypts data. Additional data is authenticated, but neither encrypted nor included in the result,
+// so that data can be decrypted only with the same additional data, e.g. the key of a resource the data belongs to.
+// Ciphers that do not authenticate data ignore it.
 type Encryptor interface {
-	Encrypt([]byte) ([]byte, error)
+	Encrypt(data []byte, additionalData []byte) ([]byte, error)
 }
 
+// Decryptor decrypts data encrypted with given additional data, see Encryptor.
 type Decryptor interface {
-	Decrypt([]byte) ([]byte, error)
+	Decrypt(data []byte, additionalData []byte) ([]byte, error)
 }
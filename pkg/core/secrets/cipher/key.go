@@ -0,0 +1,50 @@
+package cipher
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"os/exec"
+
+	"github.com/pkg/errors"
+)
+
+// KeyFromFile loads a base64-encoded key from a file.
+func KeyFromFile(path string) ([]byte, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read key from file %q", path)
+	}
+	return decodeKey(data)
+}
+
+// KeyFromEnv loads a base64-encoded key from an environment variable.
+func KeyFromEnv(name string) ([]byte, error) {
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return nil, errors.Errorf("environment variable %q with key is not set", name)
+	}
+	return decodeKey([]byte(value))
+}
+
+// KeyFromCommand loads a base64-encoded key printed by a shell command,
+// e.g. one that decrypts the key with a KMS, so that the key is never stored in plain text.
+func KeyFromCommand(command string) ([]byte, error) {
+	stderr := &bytes.Buffer{}
+	cmd := exec.Command("sh", "-c", command)
+	cmd.Stderr = stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not load key with command: %s", stderr.String())
+	}
+	return decodeKey(output)
+}
+
+func decodeKey(data []byte) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(data)))
+	if err != nil {
+		return nil, errors.Wrap(err, "key is not a valid base64 string")
+	}
+	return key, nil
+}
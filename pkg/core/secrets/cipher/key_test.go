@@ -0,0 +1,114 @@
+package cipher_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Kong/kuma/pkg/core/secrets/cipher"
+)
+
+var _ = Describe("Key", func() {
+
+	// key is a base64-encoded key of 32 bytes
+	const key = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+	// shortKey is a base64-encoded key of 10 bytes, which is not a valid AES key
+	const shortKey = "MDEyMzQ1Njc4OQ=="
+	const envName = "KUMA_TEST_SECRETS_KEY"
+
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "cipher")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+		Expect(os.Unsetenv(envName)).To(Succeed())
+	})
+
+	fromFile := func(value string) ([]byte, error) {
+		path := filepath.Join(dir, "key")
+		Expect(ioutil.WriteFile(path, []byte(value+"\n"), 0600)).To(Succeed())
+		return cipher.KeyFromFile(path)
+	}
+	fromEnv := func(value string) ([]byte, error) {
+		Expect(os.Setenv(envName, value)).To(Succeed())
+		return cipher.KeyFromEnv(envName)
+	}
+	fromCommand := func(value string) ([]byte, error) {
+		return cipher.KeyFromCommand("echo " + value)
+	}
+
+	sources := map[string]func(string) ([]byte, error){
+		"file":    fromFile,
+		"env":     fromEnv,
+		"command": fromCommand,
+	}
+
+	for name, load := range sources {
+		name, load := name, load
+
+		Context(name, func() {
+			It("should load a base64-encoded key", func() {
+				// when
+				loaded, err := load(key)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(loaded)).To(Equal("0123456789abcdef0123456789abcdef"))
+				_, err = cipher.NewAESGCM(loaded)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should reject a key that is not base64-encoded", func() {
+				// when
+				_, err := load("not-base64!")
+
+				// then
+				Expect(err).To(MatchError(ContainSubstring("key is not a valid base64 string")))
+			})
+
+			It("should load a key of invalid length that is rejected by AES-GCM", func() {
+				// given
+				loaded, err := load(shortKey)
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				_, err = cipher.NewAESGCM(loaded)
+
+				// then
+				Expect(err).To(MatchError(ContainSubstring("invalid key size 10")))
+			})
+		})
+	}
+
+	It("should fail for a file that does not exist", func() {
+		// when
+		_, err := cipher.KeyFromFile(filepath.Join(dir, "missing"))
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("could not read key from file")))
+	})
+
+	It("should fail for an environment variable that is not set", func() {
+		// when
+		_, err := cipher.KeyFromEnv(envName)
+
+		// then
+		Expect(err).To(MatchError(`environment variable "KUMA_TEST_SECRETS_KEY" with key is not set`))
+	})
+
+	It("should fail for a command that fails", func() {
+		// when
+		_, err := cipher.KeyFromCommand("echo 'access denied' >&2; exit 1")
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("could not load key with command: access denied")))
+	})
+})
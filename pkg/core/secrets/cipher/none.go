@@ -6,12 +6,18 @@ func None() Cipher {
 
 var _ Cipher = &none{}
 
+// IsNone returns true if a cipher leaves data unencrypted, e.g. None() or TODO().
+func IsNone(c Cipher) bool {
+	_, ok := c.(*none)
+	return ok
+}
+
 type none struct{}
 
-func (_ none) Encrypt(data []byte) ([]byte, error) {
+func (_ none) Encrypt(data []byte, _ []byte) ([]byte, error) {
 	return data, nil
 }
 
-func (_ none) Decrypt(data []byte) ([]byte, error) {
+func (_ none) Decrypt(data []byte, _ []byte) ([]byte, error) {
 	return data, nil
 }
@@ -0,0 +1,54 @@
+package cipher
+
+import (
+	"github.com/pkg/errors"
+)
+
+// RotatingCipher is a Cipher that can still decrypt data encrypted with previous keys,
+// so that data can be re-encrypted with the current key gradually.
+type RotatingCipher interface {
+	Cipher
+	// Stale returns true if data has not been encrypted with the current key and has to be re-encrypted
+	Stale(data []byte, additionalData []byte) bool
+}
+
+// NewRotating returns a Cipher that encrypts data with the primary cipher and decrypts data encrypted
+// with either the primary cipher or any of previous ones, which are tried in a given order.
+//
+// The primary cipher and all previous ones but the last one have to reject data they did not encrypt, e.g. AES-GCM.
+// The last one might be None() to read data that has been stored unencrypted.
+func NewRotating(primary Cipher, previous ...Cipher) RotatingCipher {
+	return &rotating{
+		primary:  primary,
+		previous: previous,
+	}
+}
+
+var _ RotatingCipher = &rotating{}
+
+type rotating struct {
+	primary  Cipher
+	previous []Cipher
+}
+
+func (r *rotating) Encrypt(data []byte, additionalData []byte) ([]byte, error) {
+	return r.primary.Encrypt(data, additionalData)
+}
+
+func (r *rotating) Decrypt(data []byte, additionalData []byte) ([]byte, error) {
+	value, err := r.primary.Decrypt(data, additionalData)
+	if err == nil {
+		return value, nil
+	}
+	for _, previous := range r.previous {
+		if value, err := previous.Decrypt(data, additionalData); err == nil {
+			return value, nil
+		}
+	}
+	return nil, errors.Wrap(err, "could not decrypt with any of keys")
+}
+
+func (r *rotating) Stale(data []byte, additionalData []byte) bool {
+	_, err := r.primary.Decrypt(data, additionalData)
+	return err != nil
+}
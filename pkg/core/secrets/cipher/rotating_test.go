@@ -0,0 +1,91 @@
+package cipher_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Kong/kuma/pkg/core/secrets/cipher"
+)
+
+var _ = Describe("Rotating", func() {
+
+	var newCipher, oldCipher, otherCipher cipher.Cipher
+
+	BeforeEach(func() {
+		var err error
+		newCipher, err = cipher.NewAESGCM([]byte("0123456789abcdef0123456789abcdef"))
+		Expect(err).ToNot(HaveOccurred())
+		oldCipher, err = cipher.NewAESGCM([]byte("fedcba9876543210fedcba9876543210"))
+		Expect(err).ToNot(HaveOccurred())
+		otherCipher, err = cipher.NewAESGCM([]byte("abcdef0123456789abcdef0123456789"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should encrypt data with the primary cipher", func() {
+		// given
+		rotating := cipher.NewRotating(newCipher, oldCipher)
+
+		// when
+		ciphertext, err := rotating.Encrypt([]byte("secret"), []byte("ad"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		plaintext, err := newCipher.Decrypt(ciphertext, []byte("ad"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(plaintext)).To(Equal("secret"))
+		// and
+		Expect(rotating.Stale(ciphertext, []byte("ad"))).To(BeFalse())
+	})
+
+	It("should fall back to previous ciphers", func() {
+		// given
+		rotating := cipher.NewRotating(newCipher, oldCipher, cipher.None())
+		ciphertext, err := oldCipher.Encrypt([]byte("secret"), []byte("ad"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		plaintext, err := rotating.Decrypt(ciphertext, []byte("ad"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(plaintext)).To(Equal("secret"))
+		// and
+		Expect(rotating.Stale(ciphertext, []byte("ad"))).To(BeTrue())
+
+		// when data has been stored unencrypted
+		plaintext, err = rotating.Decrypt([]byte("plain"), []byte("ad"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(plaintext)).To(Equal("plain"))
+		Expect(rotating.Stale([]byte("plain"), []byte("ad"))).To(BeTrue())
+	})
+
+	It("should reject data that none of ciphers can decrypt", func() {
+		// given
+		rotating := cipher.NewRotating(newCipher, oldCipher)
+		ciphertext, err := otherCipher.Encrypt([]byte("secret"), []byte("ad"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = rotating.Decrypt(ciphertext, []byte("ad"))
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("could not decrypt with any of keys")))
+		// and
+		Expect(rotating.Stale(ciphertext, []byte("ad"))).To(BeTrue())
+	})
+
+	It("should reject data decrypted with different additional data", func() {
+		// given
+		rotating := cipher.NewRotating(newCipher, oldCipher)
+		ciphertext, err := oldCipher.Encrypt([]byte("secret"), []byte("ad"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = rotating.Decrypt(ciphertext, []byte("other"))
+
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+})
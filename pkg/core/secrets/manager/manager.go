@@ -2,8 +2,15 @@ package manager
 
 import (
 	"context"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/pkg/errors"
+
+	system_proto "github.com/Kong/kuma/api/system/v1alpha1"
+	"github.com/Kong/kuma/pkg/core"
 	secret_model "github.com/Kong/kuma/pkg/core/resources/apis/system"
 	"github.com/Kong/kuma/pkg/core/resources/model"
 	core_store "github.com/Kong/kuma/pkg/core/resources/store"
@@ -11,66 +18,179 @@ import (
 	secret_store "github.com/Kong/kuma/pkg/core/secrets/store"
 )
 
+var log = core.Log.WithName("secret-manager")
+
 type SecretManager interface {
 	Create(context.Context, *secret_model.SecretResource, ...core_store.CreateOptionsFunc) error
 	Update(context.Context, *secret_model.SecretResource, ...core_store.UpdateOptionsFunc) error
 	Delete(context.Context, *secret_model.SecretResource, ...core_store.DeleteOptionsFunc) error
 	DeleteAll(context.Context, ...core_store.DeleteAllOptionsFunc) error
 	Get(context.Context, *secret_model.SecretResource, ...core_store.GetOptionsFunc) error
+	// List lists secrets, e.g. all secrets of a Mesh with core_store.ListByMesh
+	// or secrets with a common name prefix with core_store.ListByNamePrefix.
 	List(context.Context, *secret_model.SecretResourceList, ...core_store.ListOptionsFunc) error
 }
 
+// TransactionalSecretManager is a SecretManager that can apply multiple writes atomically.
+type TransactionalSecretManager interface {
+	SecretManager
+	// RunInTx executes fn against a SecretManager whose writes are applied atomically.
+	// If fn returns an error, none of its writes are applied.
+	RunInTx(fn func(SecretManager) error) error
+}
+
+// RunInTx executes fn in a transaction if a given SecretManager supports transactions.
+// Otherwise, fn is executed directly against the SecretManager.
+func RunInTx(m SecretManager, fn func(SecretManager) error) error {
+	if tx, ok := m.(TransactionalSecretManager); ok {
+		return tx.RunInTx(fn)
+	}
+	return fn(m)
+}
+
+// RekeyableSecretManager is a SecretManager whose storage cipher can be rotated online.
+type RekeyableSecretManager interface {
+	SecretManager
+	// Rekey re-encrypts every stored secret with newCipher, which is used for all secrets afterwards.
+	//
+	// Secrets remain readable and writable while they are being rekeyed. Secrets written meanwhile
+	// are encrypted with newCipher right away. If Rekey fails, e.g. because ctx is done,
+	// it can be resumed by calling it again with the same cipher, which skips secrets that are
+	// already encrypted with it.
+	//
+	// To tell secrets that are already rekeyed apart, newCipher has to reject data it did not encrypt,
+	// e.g. AES-GCM, which makes it possible to turn encryption on, i.e. to rekey from secret_cipher.None().
+	Rekey(ctx context.Context, newCipher secret_cipher.Cipher) error
+	// RekeyProgress returns the progress of the last Rekey.
+	RekeyProgress() RekeyProgress
+}
+
+// RekeyProgress describes the progress of re-encrypting secrets with a new cipher.
+type RekeyProgress struct {
+	// InProgress is true from the start of Rekey until all secrets are rekeyed.
+	// It remains true if Rekey fails, until Rekey is resumed and finished.
+	InProgress bool
+	// Total is the number of secrets to rekey.
+	Total int
+	// Rekeyed is the number of secrets that are already encrypted with the new cipher.
+	Rekeyed int
+}
+
 func NewSecretManager(secretStore secret_store.SecretStore, cipher secret_cipher.Cipher) SecretManager {
 	return &secretManager{
 		secretStore: secretStore,
-		cipher:      cipher,
+		keys: &keys{
+			cipher: cipher,
+		},
 	}
 }
 
-var _ SecretManager = &secretManager{}
+var _ TransactionalSecretManager = &secretManager{}
+var _ RekeyableSecretManager = &secretManager{}
 
 type secretManager struct {
 	secretStore secret_store.SecretStore
-	cipher      secret_cipher.Cipher
+	keys        *keys
+}
+
+// keys are ciphers of a secretManager, which are shared with its transactions.
+type keys struct {
+	// cipher encrypts secrets unless they are being rekeyed
+	cipher secret_cipher.Cipher
+	// next is the cipher secrets are being rekeyed to
+	next     secret_cipher.Cipher
+	progress RekeyProgress
+	mu       sync.RWMutex
+}
+
+// encryptor returns the cipher new writes are encrypted with.
+func (k *keys) encryptor() secret_cipher.Cipher {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	if k.next != nil {
+		return k.next
+	}
+	return k.cipher
+}
+
+// decrypt decrypts data with the new cipher if secrets are being rekeyed and data is already rekeyed,
+// or with the current one otherwise.
+func (k *keys) decrypt(data []byte, additionalData []byte) ([]byte, error) {
+	k.mu.RLock()
+	current, next := k.cipher, k.next
+	k.mu.RUnlock()
+	if next != nil {
+		if value, err := next.Decrypt(data, additionalData); err == nil {
+			return value, nil
+		}
+	}
+	return current.Decrypt(data, additionalData)
+}
+
+// stale returns true if data has been encrypted with a previous key of a rotating cipher.
+// Data is not re-encrypted while secrets are being rekeyed, since Rekey takes care of it.
+func (k *keys) stale(data []byte, additionalData []byte) bool {
+	k.mu.RLock()
+	current, next := k.cipher, k.next
+	k.mu.RUnlock()
+	rotating, ok := current.(secret_cipher.RotatingCipher)
+	return next == nil && ok && rotating.Stale(data, additionalData)
+}
+
+// AdditionalData returns data the value of a secret is bound to when it is encrypted, i.e. the Mesh and the name
+// of the secret, so that a value copied from one secret to another cannot be decrypted.
+func AdditionalData(key model.ResourceKey) []byte {
+	// neither Mesh nor name can contain a null character, so different keys cannot have the same additional data
+	return []byte(key.Mesh + "\x00" + key.Name)
 }
 
 func (s *secretManager) Get(ctx context.Context, secret *secret_model.SecretResource, fs ...core_store.GetOptionsFunc) error {
 	if err := s.secretStore.Get(ctx, secret, fs...); err != nil {
 		return err
 	}
-	return s.decrypt(secret)
+	return s.decryptAndRefresh(ctx, secret)
 }
 
 func (s *secretManager) List(ctx context.Context, secrets *secret_model.SecretResourceList, fs ...core_store.ListOptionsFunc) error {
 	if err := s.secretStore.List(ctx, secrets, fs...); err != nil {
 		return err
 	}
+	opts := core_store.NewListOptions(fs...)
+	var items []*secret_model.SecretResource
 	for _, secret := range secrets.Items {
-		if err := s.decrypt(secret); err != nil {
+		if !strings.HasPrefix(secret.GetMeta().GetName(), opts.NamePrefix) {
+			continue
+		}
+		if err := s.decryptAndRefresh(ctx, secret); err != nil {
 			return err
 		}
+		items = append(items, secret)
 	}
+	secrets.Items = items
 	return nil
 }
 
 func (s *secretManager) Create(ctx context.Context, secret *secret_model.SecretResource, fs ...core_store.CreateOptionsFunc) error {
-	if err := s.encrypt(secret); err != nil {
+	opts := core_store.NewCreateOptions(fs...)
+	key := model.ResourceKey{Mesh: opts.Mesh, Name: opts.Name}
+	if err := s.encrypt(secret, key); err != nil {
 		return err
 	}
 	if err := s.secretStore.Create(ctx, secret, append(fs, core_store.CreatedAt(time.Now()))...); err != nil {
 		return err
 	}
-	return s.decrypt(secret)
+	return s.decrypt(secret, key)
 }
 
 func (s *secretManager) Update(ctx context.Context, secret *secret_model.SecretResource, fs ...core_store.UpdateOptionsFunc) error {
-	if err := s.encrypt(secret); err != nil {
+	key := model.MetaToResourceKey(secret.Meta)
+	if err := s.encrypt(secret, key); err != nil {
 		return err
 	}
 	if err := s.secretStore.Update(ctx, secret, append(fs, core_store.ModifiedAt(time.Now()))...); err != nil {
 		return err
 	}
-	return s.decrypt(secret)
+	return s.decrypt(secret, key)
 }
 
 func (s *secretManager) Delete(ctx context.Context, secret *secret_model.SecretResource, fs ...core_store.DeleteOptionsFunc) error {
@@ -91,9 +211,87 @@ func (s *secretManager) DeleteAll(ctx context.Context, fs ...core_store.DeleteAl
 	return nil
 }
 
-func (s *secretManager) encrypt(secret *secret_model.SecretResource) error {
+func (s *secretManager) RunInTx(fn func(SecretManager) error) error {
+	return secret_store.RunInTx(s.secretStore, func(tx secret_store.SecretStore) error {
+		return fn(&secretManager{secretStore: tx, keys: s.keys})
+	})
+}
+
+func (s *secretManager) Rekey(ctx context.Context, newCipher secret_cipher.Cipher) error {
+	s.keys.mu.Lock()
+	if s.keys.next != nil && s.keys.next != newCipher {
+		s.keys.mu.Unlock()
+		return errors.New("secrets are already being rekeyed to another cipher")
+	}
+	s.keys.next = newCipher
+	s.keys.progress = RekeyProgress{InProgress: true}
+	current := s.keys.cipher
+	s.keys.mu.Unlock()
+
+	list := &secret_model.SecretResourceList{}
+	if err := s.secretStore.List(ctx, list); err != nil {
+		return errors.Wrap(err, "could not list secrets")
+	}
+	s.updateProgress(func(progress *RekeyProgress) {
+		progress.Total = len(list.Items)
+	})
+	for _, secret := range list.Items {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := s.rekey(ctx, secret, current, newCipher); err != nil {
+			return errors.Wrapf(err, "could not rekey secret %q in Mesh %q", secret.GetMeta().GetName(), secret.GetMeta().GetMesh())
+		}
+		s.updateProgress(func(progress *RekeyProgress) {
+			progress.Rekeyed++
+		})
+	}
+
+	s.keys.mu.Lock()
+	defer s.keys.mu.Unlock()
+	s.keys.cipher = newCipher
+	s.keys.next = nil
+	s.keys.progress.InProgress = false
+	return nil
+}
+
+// rekey re-encrypts a stored secret with newCipher unless it is already encrypted with it.
+func (s *secretManager) rekey(ctx context.Context, secret *secret_model.SecretResource, current secret_cipher.Cipher, newCipher secret_cipher.Cipher) error {
+	data := secret.Spec.GetData().GetValue()
+	if len(data) == 0 {
+		return nil
+	}
+	additionalData := AdditionalData(model.MetaToResourceKey(secret.Meta))
+	// the secret was either rekeyed by a previous attempt or written after the start of rekeying
+	if _, err := newCipher.Decrypt(data, additionalData); err == nil {
+		return nil
+	}
+	value, err := current.Decrypt(data, additionalData)
+	if err != nil {
+		return err
+	}
+	if secret.Spec.Data.Value, err = newCipher.Encrypt(value, additionalData); err != nil {
+		return err
+	}
+	// optimistic locking of the store rejects the write if the secret was updated meanwhile
+	return s.secretStore.Update(ctx, secret)
+}
+
+func (s *secretManager) RekeyProgress() RekeyProgress {
+	s.keys.mu.RLock()
+	defer s.keys.mu.RUnlock()
+	return s.keys.progress
+}
+
+func (s *secretManager) updateProgress(fn func(*RekeyProgress)) {
+	s.keys.mu.Lock()
+	defer s.keys.mu.Unlock()
+	fn(&s.keys.progress)
+}
+
+func (s *secretManager) encrypt(secret *secret_model.SecretResource, key model.ResourceKey) error {
 	if len(secret.Spec.GetData().GetValue()) > 0 {
-		value, err := s.cipher.Encrypt(secret.Spec.Data.Value)
+		value, err := s.keys.encryptor().Encrypt(secret.Spec.Data.Value, AdditionalData(key))
 		if err != nil {
 			return err
 		}
@@ -102,9 +300,43 @@ func (s *secretManager) encrypt(secret *secret_model.SecretResource) error {
 	return nil
 }
 
-func (s *secretManager) decrypt(secret *secret_model.SecretResource) error {
+// decryptAndRefresh decrypts a stored secret and re-encrypts it with the current key
+// if it has been encrypted with a previous one, so that keys are rotated lazily as secrets are read.
+func (s *secretManager) decryptAndRefresh(ctx context.Context, secret *secret_model.SecretResource) error {
+	key := model.MetaToResourceKey(secret.Meta)
+	stale := len(secret.Spec.GetData().GetValue()) > 0 && s.keys.stale(secret.Spec.Data.Value, AdditionalData(key))
+	if err := s.decrypt(secret, key); err != nil {
+		return err
+	}
+	if !stale {
+		return nil
+	}
+	refreshed := &secret_model.SecretResource{
+		Meta: secret.Meta,
+		Spec: system_proto.Secret{
+			Data: &wrappers.BytesValue{Value: secret.Spec.Data.Value},
+		},
+	}
+	if err := s.encrypt(refreshed, key); err != nil {
+		return err
+	}
+	// failing to re-encrypt is not fatal, e.g. the secret might have been updated meanwhile and is encrypted with the current key already
+	if err := s.secretStore.Update(ctx, refreshed, core_store.ModifiedAt(time.Now())); err != nil {
+		log.V(1).Info("could not re-encrypt secret with the current key", "name", secret.GetMeta().GetName(), "mesh", secret.GetMeta().GetMesh(), "err", err)
+		return nil
+	}
+	// not every store updates the version of a given resource, so the latest one has to be read back
+	latest := &secret_model.SecretResource{}
+	if err := s.secretStore.Get(ctx, latest, core_store.GetBy(key)); err != nil {
+		return err
+	}
+	secret.Meta = latest.Meta
+	return nil
+}
+
+func (s *secretManager) decrypt(secret *secret_model.SecretResource, key model.ResourceKey) error {
 	if len(secret.Spec.GetData().GetValue()) > 0 {
-		value, err := s.cipher.Decrypt(secret.Spec.Data.Value)
+		value, err := s.keys.decrypt(secret.Spec.Data.Value, AdditionalData(key))
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,344 @@
+package manager_test
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	system_proto "github.com/Kong/kuma/api/system/v1alpha1"
+	"github.com/Kong/kuma/pkg/core/resources/apis/system"
+	"github.com/Kong/kuma/pkg/core/resources/model"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+	secret_cipher "github.com/Kong/kuma/pkg/core/secrets/cipher"
+	secret_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
+	secret_store "github.com/Kong/kuma/pkg/core/secrets/store"
+	"github.com/Kong/kuma/pkg/plugins/resources/memory"
+)
+
+// reverseCipher is a trivial Cipher that makes sure secrets are decrypted on the way out.
+type reverseCipher struct{}
+
+func (reverseCipher) Encrypt(data []byte, _ []byte) ([]byte, error) {
+	return reverse(data), nil
+}
+
+func (reverseCipher) Decrypt(data []byte, _ []byte) ([]byte, error) {
+	return reverse(data), nil
+}
+
+func reverse(data []byte) []byte {
+	out := make([]byte, len(data))
+	for i := range data {
+		out[len(data)-1-i] = data[i]
+	}
+	return out
+}
+
+var _ = Describe("SecretManager", func() {
+
+	var secretManager secret_manager.SecretManager
+	var resourceStore core_store.ResourceStore
+
+	BeforeEach(func() {
+		resourceStore = memory.NewStore()
+		secretManager = secret_manager.NewSecretManager(secret_store.NewSecretStore(resourceStore), reverseCipher{})
+
+		secrets := []struct {
+			name  string
+			mesh  string
+			value string
+		}{
+			{name: "default.ca-builtin-cert-ca-1", mesh: "default", value: "cert"},
+			{name: "default.ca-builtin-key-ca-1", mesh: "default", value: "key"},
+			{name: "default.sds-token", mesh: "default", value: "token"},
+			{name: "demo.ca-builtin-cert-ca-1", mesh: "demo", value: "cert"},
+		}
+		for _, secret := range secrets {
+			err := secretManager.Create(context.Background(), &system.SecretResource{
+				Spec: system_proto.Secret{
+					Data: &wrappers.BytesValue{Value: []byte(secret.value)},
+				},
+			}, core_store.CreateByKey(secret.name, secret.mesh))
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	names := func(list *system.SecretResourceList) []string {
+		var names []string
+		for _, item := range list.Items {
+			names = append(names, item.GetMeta().GetName())
+		}
+		return names
+	}
+
+	var oldCipher, newCipher secret_cipher.Cipher
+
+	newAESGCM := func(key string) secret_cipher.Cipher {
+		cipher, err := secret_cipher.NewAESGCM([]byte(key))
+		Expect(err).ToNot(HaveOccurred())
+		return cipher
+	}
+
+	storeSecrets := func(cipher secret_cipher.Cipher) {
+		resourceStore = memory.NewStore()
+		secretManager = secret_manager.NewSecretManager(secret_store.NewSecretStore(resourceStore), cipher)
+		for _, name := range []string{"secret-1", "secret-2", "secret-3"} {
+			err := secretManager.Create(context.Background(), &system.SecretResource{
+				Spec: system_proto.Secret{
+					Data: &wrappers.BytesValue{Value: []byte("value of " + name)},
+				},
+			}, core_store.CreateByKey(name, "default"))
+			Expect(err).ToNot(HaveOccurred())
+		}
+	}
+
+	expectDecrypted := func(manager secret_manager.SecretManager) {
+		for _, name := range []string{"secret-1", "secret-2", "secret-3"} {
+			secret := &system.SecretResource{}
+			err := manager.Get(context.Background(), secret, core_store.GetByKey(name, "default"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(secret.Spec.GetData().GetValue())).To(Equal("value of " + name))
+		}
+	}
+
+	storedValue := func(name string) []byte {
+		stored := &system.SecretResource{}
+		err := resourceStore.Get(context.Background(), stored, core_store.GetByKey(name, "default"))
+		Expect(err).ToNot(HaveOccurred())
+		return stored.Spec.GetData().GetValue()
+	}
+
+	additionalData := func(name string) []byte {
+		return secret_manager.AdditionalData(model.ResourceKey{Mesh: "default", Name: name})
+	}
+
+	BeforeEach(func() {
+		oldCipher = newAESGCM("0123456789abcdef0123456789abcdef")
+		newCipher = newAESGCM("fedcba9876543210fedcba9876543210")
+	})
+
+	Describe("List()", func() {
+		It("should list all secrets of a Mesh", func() {
+			// given
+			list := &system.SecretResourceList{}
+
+			// when
+			err := secretManager.List(context.Background(), list, core_store.ListByMesh("default"))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names(list)).To(ConsistOf("default.ca-builtin-cert-ca-1", "default.ca-builtin-key-ca-1", "default.sds-token"))
+		})
+
+		It("should list secrets of a Mesh with a given name prefix", func() {
+			// given
+			list := &system.SecretResourceList{}
+
+			// when
+			err := secretManager.List(context.Background(), list, core_store.ListByMesh("default"), core_store.ListByNamePrefix("default.ca-builtin-"))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names(list)).To(ConsistOf("default.ca-builtin-cert-ca-1", "default.ca-builtin-key-ca-1"))
+		})
+
+		It("should decrypt listed secrets", func() {
+			// given
+			list := &system.SecretResourceList{}
+
+			// when
+			err := secretManager.List(context.Background(), list, core_store.ListByMesh("default"), core_store.ListByNamePrefix("default.sds-"))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Items).To(HaveLen(1))
+			Expect(string(list.Items[0].Spec.GetData().GetValue())).To(Equal("token"))
+
+			// and secret is encrypted in the store
+			stored := &system.SecretResource{}
+			err = resourceStore.Get(context.Background(), stored, core_store.GetByKey("default.sds-token", "default"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(stored.Spec.GetData().GetValue())).To(Equal("nekot"))
+		})
+
+		It("should return an empty list when no secret matches a prefix", func() {
+			// given
+			list := &system.SecretResourceList{}
+
+			// when
+			err := secretManager.List(context.Background(), list, core_store.ListByMesh("demo"), core_store.ListByNamePrefix("default."))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Items).To(BeEmpty())
+		})
+	})
+
+	Describe("Get() with AES-GCM", func() {
+
+		It("should not decrypt a value copied from another secret", func() {
+			// given
+			storeSecrets(oldCipher)
+			// and the value of one secret is copied to another one
+			stored := &system.SecretResource{}
+			err := resourceStore.Get(context.Background(), stored, core_store.GetByKey("secret-1", "default"))
+			Expect(err).ToNot(HaveOccurred())
+			stored.Spec.Data.Value = storedValue("secret-2")
+			Expect(resourceStore.Update(context.Background(), stored)).To(Succeed())
+
+			// when
+			err = secretManager.Get(context.Background(), &system.SecretResource{}, core_store.GetByKey("secret-1", "default"))
+
+			// then
+			Expect(err).To(HaveOccurred())
+
+			// when
+			secret := &system.SecretResource{}
+			err = secretManager.Get(context.Background(), secret, core_store.GetByKey("secret-2", "default"))
+
+			// then the value can still be decrypted as the secret it was encrypted for
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(secret.Spec.GetData().GetValue())).To(Equal("value of secret-2"))
+		})
+	})
+
+	Describe("Rekey()", func() {
+		It("should re-encrypt all secrets with a new key", func() {
+			// given
+			storeSecrets(oldCipher)
+
+			// when
+			err := secretManager.(secret_manager.RekeyableSecretManager).Rekey(context.Background(), newCipher)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secretManager.(secret_manager.RekeyableSecretManager).RekeyProgress()).To(Equal(secret_manager.RekeyProgress{
+				Total:   3,
+				Rekeyed: 3,
+			}))
+			// and all secrets are decrypted
+			expectDecrypted(secretManager)
+			expectDecrypted(secret_manager.NewSecretManager(secret_store.NewSecretStore(resourceStore), newCipher))
+
+			// and the old key no longer decrypts stored secrets
+			_, err = oldCipher.Decrypt(storedValue("secret-1"), additionalData("secret-1"))
+			Expect(err).To(HaveOccurred())
+			err = secret_manager.NewSecretManager(secret_store.NewSecretStore(resourceStore), oldCipher).
+				Get(context.Background(), &system.SecretResource{}, core_store.GetByKey("secret-1", "default"))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should turn encryption on", func() {
+			// given
+			storeSecrets(secret_cipher.None())
+			Expect(string(storedValue("secret-1"))).To(Equal("value of secret-1"))
+
+			// when
+			err := secretManager.(secret_manager.RekeyableSecretManager).Rekey(context.Background(), newCipher)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			expectDecrypted(secretManager)
+			// and secrets are no longer stored as plain text
+			Expect(string(storedValue("secret-1"))).ToNot(ContainSubstring("value of secret-1"))
+		})
+
+		It("should resume interrupted rekeying", func() {
+			// given
+			storeSecrets(oldCipher)
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			// when
+			err := secretManager.(secret_manager.RekeyableSecretManager).Rekey(ctx, newCipher)
+
+			// then
+			Expect(err).To(MatchError(context.Canceled))
+			Expect(secretManager.(secret_manager.RekeyableSecretManager).RekeyProgress()).To(Equal(secret_manager.RekeyProgress{
+				InProgress: true,
+				Total:      3,
+			}))
+			// and secrets remain readable and writable meanwhile
+			expectDecrypted(secretManager)
+			err = secretManager.Create(context.Background(), &system.SecretResource{
+				Spec: system_proto.Secret{
+					Data: &wrappers.BytesValue{Value: []byte("value of secret-4")},
+				},
+			}, core_store.CreateByKey("secret-4", "default"))
+			Expect(err).ToNot(HaveOccurred())
+			// and new secrets are encrypted with the new key right away
+			_, err = newCipher.Decrypt(storedValue("secret-4"), additionalData("secret-4"))
+			Expect(err).ToNot(HaveOccurred())
+
+			// and rekeying to another cipher is rejected
+			err = secretManager.(secret_manager.RekeyableSecretManager).Rekey(context.Background(), newAESGCM("another-key-of-32-bytes-length!!"))
+			Expect(err).To(MatchError("secrets are already being rekeyed to another cipher"))
+
+			// when
+			err = secretManager.(secret_manager.RekeyableSecretManager).Rekey(context.Background(), newCipher)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secretManager.(secret_manager.RekeyableSecretManager).RekeyProgress()).To(Equal(secret_manager.RekeyProgress{
+				Total:   4,
+				Rekeyed: 4,
+			}))
+			expectDecrypted(secret_manager.NewSecretManager(secret_store.NewSecretStore(resourceStore), newCipher))
+		})
+	})
+
+	Describe("Get() with a rotating cipher", func() {
+
+		It("should re-encrypt secrets encrypted with a previous key once they are read", func() {
+			// given
+			storeSecrets(oldCipher)
+			secretManager = secret_manager.NewSecretManager(secret_store.NewSecretStore(resourceStore), secret_cipher.NewRotating(newCipher, oldCipher))
+
+			// when
+			secret := &system.SecretResource{}
+			err := secretManager.Get(context.Background(), secret, core_store.GetByKey("secret-1", "default"))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(secret.Spec.GetData().GetValue())).To(Equal("value of secret-1"))
+			// and the secret is re-encrypted with the current key
+			_, err = newCipher.Decrypt(storedValue("secret-1"), additionalData("secret-1"))
+			Expect(err).ToNot(HaveOccurred())
+			// and the returned secret can be updated right away
+			Expect(secretManager.Update(context.Background(), secret)).To(Succeed())
+			// and secrets that have not been read are left as they are
+			_, err = oldCipher.Decrypt(storedValue("secret-2"), additionalData("secret-2"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should re-encrypt listed secrets encrypted with a previous key", func() {
+			// given
+			storeSecrets(oldCipher)
+			secretManager = secret_manager.NewSecretManager(secret_store.NewSecretStore(resourceStore), secret_cipher.NewRotating(newCipher, oldCipher))
+
+			// when
+			list := &system.SecretResourceList{}
+			err := secretManager.List(context.Background(), list, core_store.ListByMesh("default"))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Items).To(HaveLen(3))
+			expectDecrypted(secret_manager.NewSecretManager(secret_store.NewSecretStore(resourceStore), newCipher))
+		})
+
+		It("should encrypt secrets stored unencrypted once they are read", func() {
+			// given
+			storeSecrets(secret_cipher.None())
+			secretManager = secret_manager.NewSecretManager(secret_store.NewSecretStore(resourceStore), secret_cipher.NewRotating(newCipher, secret_cipher.None()))
+
+			// when
+			expectDecrypted(secretManager)
+
+			// then
+			Expect(string(storedValue("secret-1"))).ToNot(ContainSubstring("value of secret-1"))
+			expectDecrypted(secret_manager.NewSecretManager(secret_store.NewSecretStore(resourceStore), newCipher))
+		})
+	})
+})
@@ -13,7 +13,7 @@ func NewSecretStore(resourceStore core_store.ResourceStore) SecretStore {
 	}
 }
 
-var _ SecretStore = &secretStore{}
+var _ TransactionalSecretStore = &secretStore{}
 
 type secretStore struct {
 	resourceStore core_store.ResourceStore
@@ -38,3 +38,9 @@ func (r *secretStore) Delete(ctx context.Context, secret *secret_model.SecretRes
 func (r *secretStore) Update(ctx context.Context, secret *secret_model.SecretResource, fs ...core_store.UpdateOptionsFunc) error {
 	return r.resourceStore.Update(ctx, secret, fs...)
 }
+
+func (r *secretStore) RunInTx(fn func(SecretStore) error) error {
+	return core_store.RunInTx(r.resourceStore, func(tx core_store.ResourceStore) error {
+		return fn(&secretStore{resourceStore: tx})
+	})
+}
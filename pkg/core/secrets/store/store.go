@@ -14,3 +14,20 @@ type SecretStore interface {
 	Get(context.Context, *secret_model.SecretResource, ...core_store.GetOptionsFunc) error
 	List(context.Context, *secret_model.SecretResourceList, ...core_store.ListOptionsFunc) error
 }
+
+// TransactionalSecretStore is a SecretStore that can apply multiple writes atomically.
+type TransactionalSecretStore interface {
+	SecretStore
+	// RunInTx executes fn against a SecretStore whose writes are applied atomically.
+	// If fn returns an error, none of its writes are applied.
+	RunInTx(fn func(SecretStore) error) error
+}
+
+// RunInTx executes fn in a transaction if a given SecretStore supports transactions.
+// Otherwise, fn is executed directly against the SecretStore.
+func RunInTx(s SecretStore, fn func(SecretStore) error) error {
+	if tx, ok := s.(TransactionalSecretStore); ok {
+		return tx.RunInTx(fn)
+	}
+	return fn(s)
+}
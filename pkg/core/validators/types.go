@@ -5,13 +5,14 @@ import (
 	"strings"
 )
 
+// ValidationError has the same shape in JSON and YAML, i.e. `violations: [{field, message}]`.
 type ValidationError struct {
-	Violations []Violation `json:"violations"`
+	Violations []Violation `json:"violations" yaml:"violations"`
 }
 
 type Violation struct {
-	Field   string `json:"field"`
-	Message string `json:"message"`
+	Field   string `json:"field" yaml:"field"`
+	Message string `json:"message" yaml:"message"`
 }
 
 func (v *ValidationError) Error() string {
@@ -1,8 +1,12 @@
 package validators_test
 
 import (
+	"encoding/json"
 	"fmt"
 
+	ghodss_yaml "github.com/ghodss/yaml"
+	yaml_v2 "gopkg.in/yaml.v2"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -173,6 +177,70 @@ var _ = Describe("Validation Error", func() {
 	})
 })
 
+var _ = Describe("Validation Error marshaling", func() {
+
+	var verr *validators.ValidationError
+
+	BeforeEach(func() {
+		verr = &validators.ValidationError{}
+		verr.AddViolation("cert", "must have a valid signature")
+		verr.AddViolation("key", "must match the certificate")
+	})
+
+	expected := map[string]interface{}{
+		"violations": []interface{}{
+			map[string]interface{}{"field": "cert", "message": "must have a valid signature"},
+			map[string]interface{}{"field": "key", "message": "must match the certificate"},
+		},
+	}
+
+	It("should marshal to JSON", func() {
+		// when
+		actual, err := json.Marshal(verr)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(actual).To(MatchJSON(`
+		{
+		  "violations": [
+		    {"field": "cert", "message": "must have a valid signature"},
+		    {"field": "key", "message": "must match the certificate"}
+		  ]
+		}`))
+
+		// when
+		structure := map[string]interface{}{}
+		// then
+		Expect(json.Unmarshal(actual, &structure)).To(Succeed())
+		Expect(structure).To(Equal(expected))
+	})
+
+	DescribeTable("should marshal to YAML with the same fields as JSON",
+		func(marshal func(interface{}) ([]byte, error)) {
+			// when
+			actual, err := marshal(verr)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(`
+            violations:
+            - field: cert
+              message: must have a valid signature
+            - field: key
+              message: must match the certificate
+`))
+
+			// when
+			structure := map[string]interface{}{}
+			// then
+			Expect(ghodss_yaml.Unmarshal(actual, &structure)).To(Succeed())
+			Expect(structure).To(Equal(expected))
+		},
+		Entry("github.com/ghodss/yaml", ghodss_yaml.Marshal),
+		Entry("gopkg.in/yaml.v2", yaml_v2.Marshal),
+	)
+})
+
 var _ = Describe("PathBuilder", func() {
 	It("should produce empty path by default", func() {
 		Expect(validators.PathBuilder{}.String()).To(Equal(""))
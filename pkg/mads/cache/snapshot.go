@@ -77,3 +77,27 @@ func (s *Snapshot) WithVersion(typ string, version string) util_xds.Snapshot {
 	}
 	return s
 }
+
+// WithResources creates a new snapshot with different resources and version for a given resource type.
+func (s *Snapshot) WithResources(typ string, version string, resources map[string]envoy_cache.Resource) util_xds.Snapshot {
+	if s == nil {
+		return nil
+	}
+	switch typ {
+	case mads.MonitoringAssignmentType:
+		return &Snapshot{
+			MonitoringAssignments: envoy_cache.Resources{Version: version, Items: resources},
+		}
+	}
+	return s
+}
+
+// Clone creates a copy of the snapshot that does not share resource maps with the original.
+func (s *Snapshot) Clone() util_xds.Snapshot {
+	if s == nil {
+		return nil
+	}
+	return &Snapshot{
+		MonitoringAssignments: util_xds.CloneResources(s.MonitoringAssignments),
+	}
+}
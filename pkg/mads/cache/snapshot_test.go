@@ -182,4 +182,73 @@ var _ = Describe("Snapshot", func() {
 			Expect(actual).To(BeIdenticalTo(snapshot))
 		})
 	})
+
+	Describe("WithResources()", func() {
+		It("should handle `nil`", func() {
+			// given
+			var snapshot *Snapshot
+			// when
+			actual := snapshot.WithResources(expectedType, "v1", nil)
+			// then
+			Expect(actual).To(BeNil())
+		})
+
+		It("should return a new snapshot with given resources", func() {
+			// given
+			snapshot := NewSnapshot("v1", map[string]envoy_cache.Resource{
+				"backend": &observability_proto.MonitoringAssignment{
+					Name: "/meshes/default/dataplanes/backend",
+				},
+			})
+			assignments := map[string]envoy_cache.Resource{
+				"frontend": &observability_proto.MonitoringAssignment{
+					Name: "/meshes/default/dataplanes/frontend",
+				},
+			}
+			// when
+			actual := snapshot.WithResources(expectedType, "v2", assignments)
+			// then
+			Expect(actual).To(Equal(NewSnapshot("v2", assignments)))
+			// and
+			Expect(snapshot.GetVersion(expectedType)).To(Equal("v1"))
+		})
+
+		It("should return the same snapshot if resource type is not supported", func() {
+			// given
+			snapshot := NewSnapshot("v1", nil)
+			// when
+			actual := snapshot.WithResources("unsupported type", "v2", nil)
+			// then
+			Expect(actual).To(BeIdenticalTo(snapshot))
+		})
+	})
+
+	Describe("Clone()", func() {
+		It("should handle `nil`", func() {
+			// given
+			var snapshot *Snapshot
+			// when
+			actual := snapshot.Clone()
+			// then
+			Expect(actual).To(BeNil())
+		})
+
+		It("should not share resources with the original snapshot", func() {
+			// given
+			assignments := map[string]envoy_cache.Resource{
+				"backend": &observability_proto.MonitoringAssignment{
+					Name: "/meshes/default/dataplanes/backend",
+				},
+			}
+			snapshot := NewSnapshot("v1", assignments)
+			// when
+			actual := snapshot.Clone()
+			// and
+			delete(assignments, "backend")
+			// then
+			Expect(actual).ToNot(BeIdenticalTo(snapshot))
+			Expect(actual.GetVersion(expectedType)).To(Equal("v1"))
+			Expect(actual.GetResources(expectedType)).To(HaveKey("backend"))
+		})
+	})
 })
@@ -11,6 +11,9 @@ import (
 // Reconciler re-computes configuration for a given node.
 type Reconciler interface {
 	Reconcile(context.Context, *envoy_core.Node) error
+	// Warmup computes configuration for given nodes before they connect
+	// and reports errors indexed by node IDs.
+	Warmup(ctx context.Context, nodes []*envoy_core.Node, progress func(util_xds.WarmupProgress)) map[string]error
 }
 
 // Generates a snapshot of xDS resources for a given node.
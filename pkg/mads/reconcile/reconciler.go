@@ -27,15 +27,38 @@ type reconciler struct {
 }
 
 func (r *reconciler) Reconcile(ctx context.Context, node *envoy_core.Node) error {
-	new, err := r.generator.GenerateSnapshot(ctx, node)
+	id := r.hasher.ID(node)
+	new, err := r.build(ctx, id, node)
 	if err != nil {
 		return err
 	}
+	return r.cache.SetSnapshot(id, new)
+}
+
+func (r *reconciler) Warmup(ctx context.Context, nodes []*envoy_core.Node, progress func(util_xds.WarmupProgress)) map[string]error {
+	var ids []string
+	nodesByID := make(map[string]*envoy_core.Node)
+	for _, node := range nodes {
+		id := r.hasher.ID(node)
+		if _, exists := nodesByID[id]; exists {
+			continue
+		}
+		ids = append(ids, id)
+		nodesByID[id] = node
+	}
+	return util_xds.Warmup(ctx, r.cache, ids, func(ctx context.Context, id string) (util_xds.Snapshot, error) {
+		return r.build(ctx, id, nodesByID[id])
+	}, progress)
+}
+
+func (r *reconciler) build(ctx context.Context, id string, node *envoy_core.Node) (util_xds.Snapshot, error) {
+	new, err := r.generator.GenerateSnapshot(ctx, node)
+	if err != nil {
+		return nil, err
+	}
 	if err := new.Consistent(); err != nil {
-		return err
+		return nil, err
 	}
-	id := r.hasher.ID(node)
 	old, _ := r.cache.GetSnapshot(id)
-	new = r.versioner.Version(new, old)
-	return r.cache.SetSnapshot(id, new)
+	return r.versioner.Version(new, old), nil
 }
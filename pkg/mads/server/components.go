@@ -49,6 +49,19 @@ func NewSyncTracker(rt core_runtime.Runtime, reconciler mads_reconcile.Reconcile
 	})
 }
 
+// Warmup populates the cache with configuration before any client connects,
+// so that the first request is served right away.
+func Warmup(ctx context.Context, reconciler mads_reconcile.Reconciler) {
+	// all clients share the same configuration, see hasher
+	nodes := []*envoy_core.Node{{}}
+	errs := reconciler.Warmup(ctx, nodes, func(progress util_xds.WarmupProgress) {
+		madsServerLog.V(1).Info("warming up", "node", progress.Node, "done", progress.Done, "total", progress.Total)
+	})
+	for node, err := range errs {
+		madsServerLog.Error(err, "could not warm up cache, configuration will be computed on the first request", "node", node)
+	}
+}
+
 func NewXdsContext(log logr.Logger) (envoy_cache.NodeHash, util_xds.SnapshotCache) {
 	hasher := hasher{}
 	logger := util_xds.NewLogger(log)
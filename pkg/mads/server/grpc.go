@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"net"
 
@@ -22,6 +23,8 @@ var (
 type grpcServer struct {
 	server Server
 	config mads_config.MonitoringAssignmentServerConfig
+	// warmup is called before the server starts accepting connections.
+	warmup func(ctx context.Context)
 }
 
 var (
@@ -29,6 +32,19 @@ var (
 )
 
 func (s *grpcServer) Start(stop <-chan struct{}) error {
+	if s.warmup != nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			select {
+			case <-stop:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+		s.warmup(ctx)
+		cancel()
+	}
+
 	var grpcOptions []grpc.ServerOption
 	grpcOptions = append(grpcOptions, grpc.MaxConcurrentStreams(grpcMaxConcurrentStreams))
 	grpcServer := grpc.NewServer(grpcOptions...)
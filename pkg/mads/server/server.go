@@ -1,6 +1,8 @@
 package server
 
 import (
+	"context"
+
 	"github.com/Kong/kuma/pkg/core"
 
 	core_runtime "github.com/Kong/kuma/pkg/core/runtime"
@@ -20,9 +22,16 @@ func SetupServer(rt core_runtime.Runtime) error {
 	callbacks := util_xds.CallbacksChain{
 		util_xds.LoggingCallbacks{Log: madsServerLog},
 		syncTracker,
+		cache.ResponseCallbacks(),
 	}
 	srv := NewServer(cache, callbacks, madsServerLog)
 	return rt.Add(
-		&grpcServer{srv, *rt.Config().MonitoringAssignmentServer},
+		&grpcServer{
+			server: srv,
+			config: *rt.Config().MonitoringAssignmentServer,
+			warmup: func(ctx context.Context) {
+				Warmup(ctx, reconciler)
+			},
+		},
 	)
 }
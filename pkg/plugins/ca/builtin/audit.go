@@ -0,0 +1,61 @@
+package builtin
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+
+	"github.com/pkg/errors"
+
+	"github.com/Kong/kuma/pkg/core"
+	core_ca "github.com/Kong/kuma/pkg/core/ca"
+)
+
+var auditLog = core.Log.WithName("ca-builtin").WithName("audit")
+
+// RootCaAuditEvent describes generation of a new Root CA.
+type RootCaAuditEvent struct {
+	Mesh    string
+	Backend string
+	// Subject is a subject of the generated Root CA certificate.
+	Subject string
+	// Fingerprint is a hex encoded SHA-256 fingerprint of the generated Root CA certificate.
+	Fingerprint string
+}
+
+// AuditSink is notified every time builtin CA generates a new Root CA.
+// It is not notified when Root CA already exists.
+type AuditSink interface {
+	RootCaGenerated(ctx context.Context, event RootCaAuditEvent)
+}
+
+type AuditSinkFunc func(ctx context.Context, event RootCaAuditEvent)
+
+func (f AuditSinkFunc) RootCaGenerated(ctx context.Context, event RootCaAuditEvent) {
+	f(ctx, event)
+}
+
+// LoggingAuditSink is a default AuditSink that logs audit events.
+var LoggingAuditSink AuditSink = AuditSinkFunc(func(_ context.Context, event RootCaAuditEvent) {
+	auditLog.Info("generated a new Root CA", "mesh", event.Mesh, "backend", event.Backend, "subject", event.Subject, "fingerprint", event.Fingerprint)
+})
+
+func newRootCaAuditEvent(mesh string, backendName string, keyPair *core_ca.KeyPair) (RootCaAuditEvent, error) {
+	block, _ := pem.Decode(keyPair.CertPEM)
+	if block == nil {
+		return RootCaAuditEvent{}, errors.New("failed to decode PEM of a Root CA certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return RootCaAuditEvent{}, errors.Wrap(err, "failed to parse a Root CA certificate")
+	}
+	fingerprint := sha256.Sum256(cert.Raw)
+	return RootCaAuditEvent{
+		Mesh:        mesh,
+		Backend:     backendName,
+		Subject:     cert.Subject.String(),
+		Fingerprint: hex.EncodeToString(fingerprint[:]),
+	}, nil
+}
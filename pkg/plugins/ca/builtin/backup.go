@@ -0,0 +1,175 @@
+package builtin
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/Kong/kuma/api/system/v1alpha1"
+	core_ca "github.com/Kong/kuma/pkg/core/ca"
+	core_system "github.com/Kong/kuma/pkg/core/resources/apis/system"
+	core_model "github.com/Kong/kuma/pkg/core/resources/model"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+	"github.com/Kong/kuma/pkg/core/secrets/cipher"
+	secret_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
+)
+
+// BackupManager exports and imports the full state of a builtin CA backend,
+// so that the CA can be restored on a fresh Control Plane after a disaster.
+type BackupManager interface {
+	// ExportBackend returns Root CA cert, key and metadata of a backend as a blob encrypted with the configured cipher.
+	// It fails if no cipher that encrypts data has been configured, so that the Root CA key is never exported in plain text.
+	ExportBackend(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend) ([]byte, error)
+	// ImportBackend restores a backend from a blob returned by ExportBackend.
+	// It fails if the backend already exists, unless force is set, in which case the existing CA is replaced.
+	ImportBackend(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend, blob []byte, force bool) error
+}
+
+var _ BackupManager = &builtinCaManager{}
+
+const backupVersion = 1
+
+// backendBackup is a serialized state of a builtin CA backend.
+type backendBackup struct {
+	Version int    `json:"version"`
+	Mesh    string `json:"mesh"`
+	Backend string `json:"backend"`
+	CertPEM []byte `json:"cert"`
+	KeyPEM  []byte `json:"key"`
+}
+
+// backupEnvelope carries a serialized backendBackup together with its checksum,
+// which detects a corrupted blob regardless of the cipher.
+type backupEnvelope struct {
+	Checksum string `json:"checksum"`
+	Data     []byte `json:"data"`
+}
+
+func (b *builtinCaManager) ExportBackend(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend) ([]byte, error) {
+	if cipher.IsNone(b.cipher) {
+		return nil, errors.Errorf("CA of backend %q in Mesh %q cannot be exported without encryption, configure encryption of secrets first", backend.Name, mesh)
+	}
+	ca, err := b.getCa(ctx, mesh, backend.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load CA key pair for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	data, err := json.Marshal(backendBackup{
+		Version: backupVersion,
+		Mesh:    mesh,
+		Backend: backend.Name,
+		CertPEM: ca.CertPEM,
+		KeyPEM:  ca.KeyPEM,
+	})
+	if err != nil {
+		return nil, err
+	}
+	envelope, err := json.Marshal(backupEnvelope{
+		Checksum: checksum(data),
+		Data:     data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	blob, err := b.cipher.Encrypt(envelope, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to encrypt CA of backend %q in Mesh %q", backend.Name, mesh)
+	}
+	return blob, nil
+}
+
+func (b *builtinCaManager) ImportBackend(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend, blob []byte, force bool) error {
+	backup, err := b.openBackup(blob)
+	if err != nil {
+		return errors.Wrapf(err, "invalid backup of backend %q in Mesh %q", backend.Name, mesh)
+	}
+	if backup.Mesh != mesh || backup.Backend != backend.Name {
+		return errors.Errorf("backup of backend %q in Mesh %q cannot be imported as backend %q in Mesh %q", backup.Backend, backup.Mesh, backend.Name, mesh)
+	}
+	ca := core_ca.KeyPair{
+		CertPEM: backup.CertPEM,
+		KeyPEM:  backup.KeyPEM,
+	}
+	if err := b.validateFIPSCa(mesh, backend.Name, ca); err != nil {
+		return err
+	}
+
+	// the check and the replacement must not interleave with creation or rotation of the CA
+	lock := b.lockFor(mesh, backend.Name)
+	lock.Lock()
+	defer lock.Unlock()
+
+	_, err = b.getCa(ctx, mesh, backend.Name)
+	switch {
+	case err == nil && !force:
+		return errors.Errorf("CA of backend %q in Mesh %q already exists", backend.Name, mesh)
+	case err != nil && !core_store.IsResourceNotFound(err):
+		return err
+	}
+
+	err = secret_manager.RunInTx(b.secretManager, func(secretManager secret_manager.SecretManager) error {
+		if err := upsertSecret(ctx, secretManager, certSecretResKey(mesh, backend.Name), ca.CertPEM); err != nil {
+			return err
+		}
+		if err := upsertSecret(ctx, secretManager, keySecretResKey(mesh, backend.Name), ca.KeyPEM); err != nil {
+			return err
+		}
+		// Root CAs of a rotation of the replaced CA are not related to the imported one
+		return deleteSecrets(ctx, secretManager, nextCertSecretResKey(mesh, backend.Name), nextKeySecretResKey(mesh, backend.Name), previousCertSecretResKey(mesh, backend.Name), previousKeySecretResKey(mesh, backend.Name))
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to import CA of backend %q in Mesh %q", backend.Name, mesh)
+	}
+	return nil
+}
+
+// openBackup decrypts a blob and verifies that it carries an intact and consistent backup.
+func (b *builtinCaManager) openBackup(blob []byte) (*backendBackup, error) {
+	decrypted, err := b.cipher.Decrypt(blob, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt")
+	}
+	envelope := backupEnvelope{}
+	if err := json.Unmarshal(decrypted, &envelope); err != nil {
+		return nil, errors.Wrap(err, "failed to decode")
+	}
+	if checksum(envelope.Data) != envelope.Checksum {
+		return nil, errors.New("checksum mismatch")
+	}
+	backup := &backendBackup{}
+	if err := json.Unmarshal(envelope.Data, backup); err != nil {
+		return nil, errors.Wrap(err, "failed to decode")
+	}
+	if backup.Version != backupVersion {
+		return nil, errors.Errorf("unsupported version %d", backup.Version)
+	}
+	if _, err := tls.X509KeyPair(backup.CertPEM, backup.KeyPEM); err != nil {
+		return nil, errors.Wrap(err, "CA cert does not match CA key")
+	}
+	return backup, nil
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func upsertSecret(ctx context.Context, secretManager secret_manager.SecretManager, key core_model.ResourceKey, value []byte) error {
+	secret := &core_system.SecretResource{}
+	err := secretManager.Get(ctx, secret, core_store.GetBy(key))
+	if err != nil && !core_store.IsResourceNotFound(err) {
+		return err
+	}
+	data := &wrappers.BytesValue{Value: value}
+	if err == nil {
+		secret.Spec.Data = data
+		return secretManager.Update(ctx, secret)
+	}
+	secret.Spec = system_proto.Secret{Data: data}
+	return secretManager.Create(ctx, secret, core_store.CreateBy(key))
+}
@@ -0,0 +1,190 @@
+package builtin_test
+
+import (
+	"context"
+	"encoding/base64"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	core_ca "github.com/Kong/kuma/pkg/core/ca"
+	core_system "github.com/Kong/kuma/pkg/core/resources/apis/system"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+	"github.com/Kong/kuma/pkg/core/secrets/cipher"
+	secret_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
+	"github.com/Kong/kuma/pkg/core/secrets/store"
+	"github.com/Kong/kuma/pkg/plugins/ca/builtin"
+	"github.com/Kong/kuma/pkg/plugins/resources/memory"
+)
+
+// xorCipher is a trivial cipher that makes sure that a backup is not exported in plain text.
+type xorCipher struct{}
+
+var _ cipher.Cipher = xorCipher{}
+
+func (xorCipher) Encrypt(data []byte, _ []byte) ([]byte, error) {
+	return xor(data), nil
+}
+
+func (xorCipher) Decrypt(data []byte, _ []byte) ([]byte, error) {
+	return xor(data), nil
+}
+
+func xor(data []byte) []byte {
+	result := make([]byte, len(data))
+	for i := range data {
+		result[i] = data[i] ^ 0x5a
+	}
+	return result
+}
+
+var _ = Describe("Builtin CA Backup", func() {
+
+	mesh := "default"
+	backend := mesh_proto.CertificateAuthorityBackend{
+		Name: "builtin-1",
+		Type: "builtin",
+	}
+
+	var secretManager secret_manager.SecretManager
+	var caManager core_ca.Manager
+
+	freshControlPlane := func() {
+		secretManager = secret_manager.NewSecretManager(store.NewSecretStore(memory.NewStore()), cipher.None())
+		caManager = builtin.NewBuiltinCaManager(secretManager, builtin.WithCipher(xorCipher{}))
+	}
+
+	rootCert := func() core_ca.Cert {
+		certs, err := caManager.GetRootCert(context.Background(), mesh, backend)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(certs).To(HaveLen(1))
+		return certs[0]
+	}
+
+	var exportedRootCert core_ca.Cert
+	var blob []byte
+
+	BeforeEach(func() {
+		freshControlPlane()
+		Expect(caManager.Ensure(context.Background(), mesh, backend)).To(Succeed())
+		exportedRootCert = rootCert()
+
+		var err error
+		blob, err = caManager.(builtin.BackupManager).ExportBackend(context.Background(), mesh, backend)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should restore a CA on a fresh Control Plane", func() {
+		// given the backup is encrypted
+		Expect(string(blob)).ToNot(ContainSubstring("PRIVATE KEY"))
+		// and the store is cleared
+		freshControlPlane()
+
+		// when
+		err := caManager.(builtin.BackupManager).ImportBackend(context.Background(), mesh, backend, blob, false)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rootCert()).To(Equal(exportedRootCert))
+		// and the restored CA issues dataplane certs
+		_, err = caManager.GenerateDataplaneCert(context.Background(), mesh, backend, "web", 0)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should not replace an existing CA unless forced", func() {
+		// given a CA recreated after the export
+		Expect(secretManager.DeleteAll(context.Background(), core_store.DeleteAllByMesh(mesh))).To(Succeed())
+		Expect(caManager.Ensure(context.Background(), mesh, backend)).To(Succeed())
+		Expect(rootCert()).ToNot(Equal(exportedRootCert))
+
+		// when
+		err := caManager.(builtin.BackupManager).ImportBackend(context.Background(), mesh, backend, blob, false)
+
+		// then
+		Expect(err).To(MatchError(`CA of backend "builtin-1" in Mesh "default" already exists`))
+		Expect(rootCert()).ToNot(Equal(exportedRootCert))
+
+		// when
+		err = caManager.(builtin.BackupManager).ImportBackend(context.Background(), mesh, backend, blob, true)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rootCert()).To(Equal(exportedRootCert))
+		// and the key is replaced as well
+		_, err = caManager.GenerateDataplaneCert(context.Background(), mesh, backend, "web", 0)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should reject a corrupted backup", func() {
+		// given
+		freshControlPlane()
+		corrupted := xor(blob)
+		corrupted[len(corrupted)/2] ^= 0x01
+
+		// when
+		err := caManager.(builtin.BackupManager).ImportBackend(context.Background(), mesh, backend, xor(corrupted), false)
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(HavePrefix(`invalid backup of backend "builtin-1" in Mesh "default": `))
+		// and CA is not restored
+		_, err = caManager.GetRootCert(context.Background(), mesh, backend)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject a backup of another backend", func() {
+		// given
+		freshControlPlane()
+		other := mesh_proto.CertificateAuthorityBackend{
+			Name: "builtin-2",
+			Type: "builtin",
+		}
+
+		// when
+		err := caManager.(builtin.BackupManager).ImportBackend(context.Background(), mesh, other, blob, false)
+
+		// then
+		Expect(err).To(MatchError(`backup of backend "builtin-1" in Mesh "default" cannot be imported as backend "builtin-2" in Mesh "default"`))
+	})
+
+	It("should not export the Root CA key when encrypted with AES-GCM", func() {
+		// given
+		aesGCM, err := cipher.NewAESGCM([]byte("0123456789abcdef0123456789abcdef"))
+		Expect(err).ToNot(HaveOccurred())
+		caManager = builtin.NewBuiltinCaManager(secretManager, builtin.WithCipher(aesGCM))
+		// and
+		key := &core_system.SecretResource{}
+		Expect(secretManager.Get(context.Background(), key, core_store.GetByKey("default.ca-builtin-key-builtin-1", mesh))).To(Succeed())
+		keyPEM := key.Spec.GetData().GetValue()
+
+		// when
+		blob, err := caManager.(builtin.BackupManager).ExportBackend(context.Background(), mesh, backend)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(blob).ToNot(ContainSubstring(string(keyPEM)))
+		Expect(blob).ToNot(ContainSubstring(base64.StdEncoding.EncodeToString(keyPEM)))
+		Expect(blob).ToNot(ContainSubstring(`"key"`))
+
+		// when
+		freshControlPlane()
+		caManager = builtin.NewBuiltinCaManager(secretManager, builtin.WithCipher(aesGCM))
+		err = caManager.(builtin.BackupManager).ImportBackend(context.Background(), mesh, backend, blob, false)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rootCert()).To(Equal(exportedRootCert))
+	})
+
+	It("should not export a CA without encryption", func() {
+		// given
+		caManager = builtin.NewBuiltinCaManager(secretManager, builtin.WithCipher(cipher.None()))
+
+		// when
+		_, err := caManager.(builtin.BackupManager).ExportBackend(context.Background(), mesh, backend)
+
+		// then
+		Expect(err).To(MatchError(`CA of backend "builtin-1" in Mesh "default" cannot be exported without encryption, configure encryption of secrets first`))
+	})
+})
@@ -3,7 +3,6 @@ package builtin
 import (
 	"crypto"
 	"crypto/rand"
-	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"math/big"
@@ -23,19 +22,19 @@ const (
 	DefaultCACertValidityPeriod = 10 * 365 * 24 * time.Hour
 )
 
-func newRootCa(mesh string) (*core_ca.KeyPair, error) {
-	key, err := rsa.GenerateKey(rand.Reader, DefaultRsaBits)
+func newRootCa(mesh string, spec keySpec, now time.Time) (*core_ca.KeyPair, error) {
+	key, err := generateKey(spec)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to generate a private key")
+		return nil, errors.Wrapf(err, "failed to generate a private key %s", spec)
 	}
-	cert, err := newCACert(key, mesh)
+	cert, err := newCACert(key, mesh, now)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to generate X509 certificate")
 	}
 	return util_tls.ToKeyPair(key, cert)
 }
 
-func newCACert(signer crypto.Signer, trustDomain string) ([]byte, error) {
+func newCACert(signer crypto.Signer, trustDomain string, now time.Time) ([]byte, error) {
 	spiffeID := &url.URL{
 		Scheme: "spiffe",
 		Host:   trustDomain,
@@ -45,11 +44,16 @@ func newCACert(signer crypto.Signer, trustDomain string) ([]byte, error) {
 		OrganizationalUnit: []string{"Mesh"},
 		CommonName:         trustDomain,
 	}
-	now := time.Now()
 	notBefore := now.Add(-DefaultAllowedClockSkew)
 	notAfter := now.Add(DefaultCACertValidityPeriod)
 
-	template, err := caTemplate(spiffeID.String(), trustDomain, subject, signer.Public(), notBefore, notAfter, big.NewInt(0))
+	// Root CAs of the same mesh share a subject, so a rotated one is told apart by a random serial number
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := caTemplate(spiffeID.String(), trustDomain, subject, signer.Public(), notBefore, notAfter, serialNumber)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,149 @@
+package builtin
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/Kong/kuma/pkg/core/validators"
+	"github.com/Kong/kuma/pkg/plugins/ca/builtin/config"
+)
+
+const (
+	KeyTypeRSA   = "RSA"
+	KeyTypeECDSA = "ECDSA"
+
+	DefaultEcdsaCurve = "P-256"
+
+	minRsaBits     = 1024
+	minFIPSRsaBits = 2048
+)
+
+var ecdsaCurves = map[string]elliptic.Curve{
+	"P-224": elliptic.P224(),
+	"P-256": elliptic.P256(),
+	"P-384": elliptic.P384(),
+	"P-521": elliptic.P521(),
+}
+
+// fipsApprovedCurves are ECDSA curves that can be used in FIPS mode.
+var fipsApprovedCurves = map[string]bool{
+	"P-256": true,
+	"P-384": true,
+}
+
+// keySpec defines a key of the CA.
+type keySpec struct {
+	keyType string
+	rsaBits int
+	curve   string
+}
+
+func (s keySpec) String() string {
+	if s.keyType == KeyTypeECDSA {
+		return fmt.Sprintf("%s %s", s.keyType, s.curve)
+	}
+	return fmt.Sprintf("%s %d", s.keyType, s.rsaBits)
+}
+
+// newKeySpec applies defaults to the configuration of the CA key.
+func newKeySpec(cfg *config.BuiltinCertificateAuthorityConfig) keySpec {
+	spec := keySpec{
+		keyType: cfg.GetKeyType(),
+		rsaBits: int(cfg.GetRsaBits()),
+		curve:   cfg.GetEcdsaCurve(),
+	}
+	if spec.keyType == "" {
+		spec.keyType = KeyTypeRSA
+	}
+	switch spec.keyType {
+	case KeyTypeRSA:
+		if spec.rsaBits == 0 {
+			spec.rsaBits = DefaultRsaBits
+		}
+	case KeyTypeECDSA:
+		if spec.curve == "" {
+			spec.curve = DefaultEcdsaCurve
+		}
+	}
+	return spec
+}
+
+func validateConfig(cfg *config.BuiltinCertificateAuthorityConfig, fipsMode bool) (verr validators.ValidationError) {
+	spec := newKeySpec(cfg)
+	switch spec.keyType {
+	case KeyTypeRSA:
+		if cfg.GetEcdsaCurve() != "" {
+			verr.AddViolation("ecdsaCurve", "cannot be set for a key of type RSA")
+		}
+		if fipsMode && spec.rsaBits < minFIPSRsaBits {
+			verr.AddViolation("rsaBits", fmt.Sprintf("has to be at least %d in FIPS mode", minFIPSRsaBits))
+		} else if spec.rsaBits < minRsaBits {
+			verr.AddViolation("rsaBits", fmt.Sprintf("has to be at least %d", minRsaBits))
+		}
+	case KeyTypeECDSA:
+		if cfg.GetRsaBits() != 0 {
+			verr.AddViolation("rsaBits", "cannot be set for a key of type ECDSA")
+		}
+		if _, ok := ecdsaCurves[spec.curve]; !ok {
+			verr.AddViolation("ecdsaCurve", fmt.Sprintf("unsupported curve %q. Allowed values: %s", spec.curve, curveNames(func(string) bool { return true })))
+		} else if fipsMode && !fipsApprovedCurves[spec.curve] {
+			verr.AddViolation("ecdsaCurve", fmt.Sprintf("curve %q is not approved in FIPS mode. Allowed values: %s", spec.curve, curveNames(func(curve string) bool { return fipsApprovedCurves[curve] })))
+		}
+	default:
+		verr.AddViolation("keyType", fmt.Sprintf("unsupported key type %q. Allowed values: %s, %s", spec.keyType, KeyTypeECDSA, KeyTypeRSA))
+	}
+	verr.Add(validateRotation(cfg))
+	return
+}
+
+func curveNames(matches func(string) bool) string {
+	var names []string
+	for name := range ecdsaCurves {
+		if matches(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+func generateKey(spec keySpec) (crypto.Signer, error) {
+	switch spec.keyType {
+	case KeyTypeRSA:
+		return rsa.GenerateKey(rand.Reader, spec.rsaBits)
+	case KeyTypeECDSA:
+		curve, ok := ecdsaCurves[spec.curve]
+		if !ok {
+			return nil, errors.Errorf("unsupported curve %q", spec.curve)
+		}
+		return ecdsa.GenerateKey(curve, rand.Reader)
+	default:
+		return nil, errors.Errorf("unsupported key type %q", spec.keyType)
+	}
+}
+
+// validateFIPSKey verifies that a key of the CA certificate is approved in FIPS mode.
+func validateFIPSKey(cert *x509.Certificate) error {
+	switch key := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		if bits := key.N.BitLen(); bits < minFIPSRsaBits {
+			return errors.Errorf("key %s %d is not approved in FIPS mode", KeyTypeRSA, bits)
+		}
+	case *ecdsa.PublicKey:
+		if curve := key.Curve.Params().Name; !fipsApprovedCurves[curve] {
+			return errors.Errorf("key %s %s is not approved in FIPS mode", KeyTypeECDSA, curve)
+		}
+	default:
+		return errors.Errorf("key of type %s is not approved in FIPS mode", cert.PublicKeyAlgorithm)
+	}
+	return nil
+}
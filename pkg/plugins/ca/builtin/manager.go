@@ -2,7 +2,11 @@ package builtin
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"sync"
+	"time"
 
 	core_model "github.com/Kong/kuma/pkg/core/resources/model"
 
@@ -15,64 +19,184 @@ import (
 	ca_issuer "github.com/Kong/kuma/pkg/core/ca/issuer"
 	core_system "github.com/Kong/kuma/pkg/core/resources/apis/system"
 	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+	"github.com/Kong/kuma/pkg/core/secrets/cipher"
 	secret_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
+	"github.com/Kong/kuma/pkg/core/validators"
+	"github.com/Kong/kuma/pkg/plugins/ca/builtin/config"
+	"github.com/Kong/kuma/pkg/util/proto"
 )
 
 type builtinCaManager struct {
 	secretManager secret_manager.SecretManager
+	auditSink     AuditSink
+	fipsMode      bool
+	cipher        cipher.Cipher
+	now           func() time.Time
+
+	// locks serialize creation of CAs indexed by keys of their cert secrets
+	locks   map[core_model.ResourceKey]*sync.Mutex
+	locksMu sync.Mutex
+}
+
+type OptionFunc func(*builtinCaManager)
+
+// WithAuditSink overrides the default LoggingAuditSink.
+func WithAuditSink(sink AuditSink) OptionFunc {
+	return func(m *builtinCaManager) {
+		m.auditSink = sink
+	}
+}
+
+// WithFIPSMode restricts keys of CAs to the ones approved by FIPS 140-2,
+// i.e. RSA of at least 2048 bits and ECDSA on P-256 or P-384 curves.
+// A backend that requests any other key is rejected, and so is an existing CA
+// that has been created with such a key.
+func WithFIPSMode(enabled bool) OptionFunc {
+	return func(m *builtinCaManager) {
+		m.fipsMode = enabled
+	}
+}
+
+// WithCipher sets the cipher used to encrypt backups of CAs, see BackupManager.
+// Backups cannot be exported unless the cipher actually encrypts data.
+func WithCipher(c cipher.Cipher) OptionFunc {
+	return func(m *builtinCaManager) {
+		m.cipher = c
+	}
+}
+
+// WithClock overrides the clock used to generate Root CAs and to decide when they are rotated.
+func WithClock(now func() time.Time) OptionFunc {
+	return func(m *builtinCaManager) {
+		m.now = now
+	}
 }
 
-func NewBuiltinCaManager(secretManager secret_manager.SecretManager) core_ca.Manager {
-	return &builtinCaManager{
+func NewBuiltinCaManager(secretManager secret_manager.SecretManager, fs ...OptionFunc) core_ca.Manager {
+	m := &builtinCaManager{
 		secretManager: secretManager,
+		auditSink:     LoggingAuditSink,
+		cipher:        cipher.None(),
+		now:           time.Now,
+		locks:         map[core_model.ResourceKey]*sync.Mutex{},
 	}
+	for _, f := range fs {
+		f(m)
+	}
+	return m
 }
 
 var _ core_ca.Manager = &builtinCaManager{}
 
 func (b *builtinCaManager) Ensure(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend) error {
-	_, err := b.getCa(ctx, mesh, backend.Name)
+	cfg := &config.BuiltinCertificateAuthorityConfig{}
+	if err := proto.ToTyped(backend.Config, cfg); err != nil {
+		return errors.Wrap(err, "could not convert backend config to BuiltinCertificateAuthorityConfig")
+	}
+	verr := validateConfig(cfg, b.fipsMode)
+	if err := verr.OrNil(); err != nil {
+		return errors.Wrapf(err, "invalid configuration of backend %q in Mesh %q", backend.Name, mesh)
+	}
+
+	// concurrent calls for the same CA, e.g. of controllers bootstrapping a mesh, converge on a single Root CA
+	lock := b.lockFor(mesh, backend.Name)
+	lock.Lock()
+	defer lock.Unlock()
+
+	ca, err := b.getCa(ctx, mesh, backend.Name)
 	if core_store.IsResourceNotFound(err) {
-		if err := b.create(ctx, mesh, backend.Name); err != nil {
+		if err := b.create(ctx, mesh, backend.Name, newKeySpec(cfg)); err != nil {
 			return errors.Wrapf(err, "failed to create CA for mesh %q and backend %q", mesh, backend.Name)
 		}
-	} else {
+		return nil
+	}
+	if err != nil {
 		return err
 	}
-	return nil
+	return b.validateFIPSCa(mesh, backend.Name, ca)
+}
+
+// lockFor returns a lock that serializes creation, rotation and import of the CA of a given backend in a given mesh.
+func (b *builtinCaManager) lockFor(mesh string, backendName string) *sync.Mutex {
+	b.locksMu.Lock()
+	defer b.locksMu.Unlock()
+	key := certSecretResKey(mesh, backendName)
+	lock, ok := b.locks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		b.locks[key] = lock
+	}
+	return lock
 }
 
 func (b *builtinCaManager) ValidateBackend(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend) error {
-	return nil // builtin CA has no config
+	cfg := &config.BuiltinCertificateAuthorityConfig{}
+	if err := proto.ToTyped(backend.Config, cfg); err != nil {
+		verr := validators.ValidationError{}
+		verr.AddViolation("", "could not convert backend config: "+err.Error())
+		return verr.OrNil()
+	}
+	verr := validateConfig(cfg, b.fipsMode)
+	return verr.OrNil()
+}
+
+// validateFIPSCa verifies in FIPS mode that an existing CA has been created with an approved key.
+func (b *builtinCaManager) validateFIPSCa(mesh string, backendName string, ca core_ca.KeyPair) error {
+	if !b.fipsMode {
+		return nil
+	}
+	block, _ := pem.Decode(ca.CertPEM)
+	if block == nil {
+		return errors.Errorf("failed to decode CA cert of backend %q in Mesh %q", backendName, mesh)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse CA cert of backend %q in Mesh %q", backendName, mesh)
+	}
+	if err := validateFIPSKey(cert); err != nil {
+		return errors.Wrapf(err, "CA of backend %q in Mesh %q cannot be used", backendName, mesh)
+	}
+	return nil
 }
 
-func (b *builtinCaManager) create(ctx context.Context, mesh string, backendName string) error {
-	keyPair, err := newRootCa(mesh)
+func (b *builtinCaManager) create(ctx context.Context, mesh string, backendName string, spec keySpec) error {
+	keyPair, err := newRootCa(mesh, spec, b.now())
 	if err != nil {
 		return errors.Wrapf(err, "failed to generate a Root CA cert for Mesh %q", mesh)
 	}
 
-	certSecret := &core_system.SecretResource{
-		Spec: system_proto.Secret{
-			Data: &wrappers.BytesValue{
-				Value: keyPair.CertPEM,
+	// cert and key are created atomically if the underlying store supports transactions,
+	// so that a failure in between does not leave a CA without a key
+	err = secret_manager.RunInTx(b.secretManager, func(secretManager secret_manager.SecretManager) error {
+		certSecret := &core_system.SecretResource{
+			Spec: system_proto.Secret{
+				Data: &wrappers.BytesValue{
+					Value: keyPair.CertPEM,
+				},
 			},
-		},
-	}
-	if err := b.secretManager.Create(ctx, certSecret, core_store.CreateBy(certSecretResKey(mesh, backendName))); err != nil {
-		return err
-	}
+		}
+		if err := secretManager.Create(ctx, certSecret, core_store.CreateBy(certSecretResKey(mesh, backendName))); err != nil {
+			return err
+		}
 
-	keySecret := &core_system.SecretResource{
-		Spec: system_proto.Secret{
-			Data: &wrappers.BytesValue{
-				Value: keyPair.KeyPEM,
+		keySecret := &core_system.SecretResource{
+			Spec: system_proto.Secret{
+				Data: &wrappers.BytesValue{
+					Value: keyPair.KeyPEM,
+				},
 			},
-		},
+		}
+		return secretManager.Create(ctx, keySecret, core_store.CreateBy(keySecretResKey(mesh, backendName)))
+	})
+	if err != nil {
+		return err
 	}
-	if err := b.secretManager.Create(ctx, keySecret, core_store.CreateBy(keySecretResKey(mesh, backendName))); err != nil {
+
+	event, err := newRootCaAuditEvent(mesh, backendName, keyPair)
+	if err != nil {
 		return err
 	}
+	b.auditSink.RootCaGenerated(ctx, event)
 	return nil
 }
 
@@ -91,20 +215,52 @@ func keySecretResKey(mesh string, backendName string) core_model.ResourceKey {
 }
 
 func (b *builtinCaManager) GetRootCert(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend) ([]core_ca.Cert, error) {
-	ca, err := b.getCa(ctx, mesh, backend.Name)
+	cfg := &config.BuiltinCertificateAuthorityConfig{}
+	if err := proto.ToTyped(backend.Config, cfg); err != nil {
+		return nil, errors.Wrap(err, "could not convert backend config to BuiltinCertificateAuthorityConfig")
+	}
+	cas, err := b.getRootCas(ctx, mesh, backend.Name, cfg)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to load CA key pair for Mesh %q and backend %q", mesh, backend.Name)
 	}
-	return []core_ca.Cert{ca.CertPEM}, nil
+	return cas.trusted(), nil
 }
 
-func (b *builtinCaManager) GenerateDataplaneCert(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend, service string) (core_ca.KeyPair, error) {
-	ca, err := b.getCa(ctx, mesh, backend.Name)
+func (b *builtinCaManager) GetTrustBundle(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend) ([]byte, error) {
+	certs, err := b.GetRootCert(ctx, mesh, backend)
+	if err != nil {
+		return nil, err
+	}
+	return core_ca.NewTrustBundle(certs)
+}
+
+func (b *builtinCaManager) GenerateDataplaneCert(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend, service string, validity time.Duration) (core_ca.KeyPair, error) {
+	if err := core_ca.ValidateServiceName(service); err != nil {
+		return core_ca.KeyPair{}, errors.Wrapf(err, "could not generate a Workload Identity cert in Mesh %q", mesh)
+	}
+	cfg := &config.BuiltinCertificateAuthorityConfig{}
+	if err := proto.ToTyped(backend.Config, cfg); err != nil {
+		return core_ca.KeyPair{}, errors.Wrap(err, "could not convert backend config to BuiltinCertificateAuthorityConfig")
+	}
+	cas, err := b.getRootCas(ctx, mesh, backend.Name, cfg)
 	if err != nil {
 		return core_ca.KeyPair{}, errors.Wrapf(err, "failed to load CA key pair for Mesh %q and backend %q", mesh, backend.Name)
 	}
+	ca := cas.current
+	// Dataplane keys are always RSA 2048, which is approved in FIPS mode
+	if err := b.validateFIPSCa(mesh, backend.Name, ca); err != nil {
+		return core_ca.KeyPair{}, err
+	}
 
-	keyPair, err := ca_issuer.NewWorkloadCert(ca, mesh, service)
+	validity, err = capValidity(cfg, validity)
+	if err != nil {
+		return core_ca.KeyPair{}, errors.Wrapf(err, "invalid rotation period of backend %q in Mesh %q", backend.Name, mesh)
+	}
+	var opts []ca_issuer.WorkloadCertOptionFunc
+	if validity != 0 {
+		opts = append(opts, ca_issuer.WithValidityPeriod(validity))
+	}
+	keyPair, err := ca_issuer.NewWorkloadCert(ca, mesh, service, opts...)
 	if err != nil {
 		return core_ca.KeyPair{}, errors.Wrapf(err, "failed to generate a Workload Identity cert for workload %q in Mesh %q using backend %q", service, mesh, backend)
 	}
@@ -127,3 +283,30 @@ func (b *builtinCaManager) getCa(ctx context.Context, mesh string, backendName s
 		KeyPEM:  keySecret.Spec.Data.Value,
 	}, nil
 }
+
+func (b *builtinCaManager) GetCRL(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend, revoked []core_ca.RevokedCert) ([]byte, error) {
+	cfg := &config.BuiltinCertificateAuthorityConfig{}
+	if err := proto.ToTyped(backend.Config, cfg); err != nil {
+		return nil, errors.Wrap(err, "could not convert backend config to BuiltinCertificateAuthorityConfig")
+	}
+	cas, err := b.getRootCas(ctx, mesh, backend.Name, cfg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load CA key pair for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	signers := []core_ca.KeyPair{cas.current}
+	if cas.next != nil {
+		signers = append(signers, *cas.next)
+	}
+	if cas.previous != nil && cas.previousKey != nil {
+		signers = append(signers, core_ca.KeyPair{CertPEM: cas.previous, KeyPEM: cas.previousKey})
+	}
+	var crls []byte
+	for _, signer := range signers {
+		crl, err := ca_issuer.NewCRL(signer, revoked, b.now())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to generate a CRL for Mesh %q and backend %q", mesh, backend.Name)
+		}
+		crls = append(crls, crl...)
+	}
+	return crls, nil
+}
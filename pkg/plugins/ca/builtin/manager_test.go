@@ -2,20 +2,36 @@ package builtin_test
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"encoding/pem"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
 
 	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	core_ca "github.com/Kong/kuma/pkg/core/ca"
+	ca_issuer "github.com/Kong/kuma/pkg/core/ca/issuer"
 	"github.com/Kong/kuma/pkg/core/resources/apis/system"
 	core_store "github.com/Kong/kuma/pkg/core/resources/store"
 	"github.com/Kong/kuma/pkg/core/secrets/cipher"
 	secret_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
 	"github.com/Kong/kuma/pkg/core/secrets/store"
 	"github.com/Kong/kuma/pkg/plugins/ca/builtin"
+	"github.com/Kong/kuma/pkg/plugins/ca/builtin/config"
 	"github.com/Kong/kuma/pkg/plugins/resources/memory"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
@@ -61,6 +77,125 @@ var _ = Describe("Builtin CA Manager", func() {
 			// then no error happens
 			Expect(err).ToNot(HaveOccurred())
 		})
+
+		It("should not leave a CA cert without a key", func() {
+			// given
+			mesh := "default"
+			backend := mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+			}
+			// and a conflicting key secret
+			err := secretManager.Create(context.Background(), &system.SecretResource{}, core_store.CreateByKey("default.ca-builtin-key-builtin-1", "default"))
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = caManager.Ensure(context.Background(), mesh, backend)
+
+			// then
+			Expect(err).To(HaveOccurred())
+
+			// and cert is not stored
+			err = secretManager.Get(context.Background(), &system.SecretResource{}, core_store.GetByKey("default.ca-builtin-cert-builtin-1", "default"))
+			Expect(core_store.IsResourceNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Context("Ensure called concurrently", func() {
+		It("should create a single CA", func() {
+			// given
+			var events []builtin.RootCaAuditEvent
+			var eventsMu sync.Mutex
+			sink := builtin.AuditSinkFunc(func(_ context.Context, event builtin.RootCaAuditEvent) {
+				eventsMu.Lock()
+				defer eventsMu.Unlock()
+				events = append(events, event)
+			})
+			caManager = builtin.NewBuiltinCaManager(secretManager, builtin.WithAuditSink(sink))
+			mesh := "default"
+			backend := mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+			}
+
+			// when
+			const calls = 10
+			errs := make(chan error, calls)
+			var wg sync.WaitGroup
+			for i := 0; i < calls; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					errs <- caManager.Ensure(context.Background(), mesh, backend)
+				}()
+			}
+			wg.Wait()
+			close(errs)
+
+			// then
+			for err := range errs {
+				Expect(err).ToNot(HaveOccurred())
+			}
+			Expect(events).To(HaveLen(1))
+
+			// and exactly one Root CA cert is stored
+			secrets := system.SecretResourceList{}
+			err := secretManager.List(context.Background(), &secrets, core_store.ListByMesh(mesh))
+			Expect(err).ToNot(HaveOccurred())
+			var certSecrets []*system.SecretResource
+			for _, secret := range secrets.Items {
+				if secret.GetMeta().GetName() == "default.ca-builtin-cert-builtin-1" {
+					certSecrets = append(certSecrets, secret)
+				}
+			}
+			Expect(certSecrets).To(HaveLen(1))
+
+			// and it is the one reported to the audit sink
+			block, _ := pem.Decode(certSecrets[0].Spec.GetData().GetValue())
+			fingerprint := sha256.Sum256(block.Bytes)
+			Expect(events[0].Fingerprint).To(Equal(hex.EncodeToString(fingerprint[:])))
+		})
+	})
+
+	Context("Audit", func() {
+		It("should notify audit sink only when Root CA is generated", func() {
+			// given
+			var events []builtin.RootCaAuditEvent
+			sink := builtin.AuditSinkFunc(func(_ context.Context, event builtin.RootCaAuditEvent) {
+				events = append(events, event)
+			})
+			caManager = builtin.NewBuiltinCaManager(secretManager, builtin.WithAuditSink(sink))
+			mesh := "default"
+			backend := mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+			}
+
+			// when
+			err := caManager.Ensure(context.Background(), mesh, backend)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].Mesh).To(Equal("default"))
+			Expect(events[0].Backend).To(Equal("builtin-1"))
+			Expect(events[0].Subject).To(Equal("CN=default,OU=Mesh,O=Kuma"))
+
+			// and fingerprint matches generated Root CA
+			certs, err := caManager.GetRootCert(context.Background(), mesh, backend)
+			Expect(err).ToNot(HaveOccurred())
+			block, _ := pem.Decode(certs[0])
+			fingerprint := sha256.Sum256(block.Bytes)
+			Expect(events[0].Fingerprint).To(Equal(hex.EncodeToString(fingerprint[:])))
+
+			// when called Ensure after CA is already created
+			err = caManager.Ensure(context.Background(), mesh, backend)
+
+			// then no new audit event is emitted
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(HaveLen(1))
+		})
 	})
 
 	Context("GetRootCert", func() {
@@ -99,6 +234,66 @@ var _ = Describe("Builtin CA Manager", func() {
 		})
 	})
 
+	Context("GetTrustBundle", func() {
+		It("should export root cert as a SPIFFE trust bundle", func() {
+			// given
+			mesh := "default"
+			backend := mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+			}
+			err := caManager.Ensure(context.Background(), mesh, backend)
+			Expect(err).ToNot(HaveOccurred())
+			certs, err := caManager.GetRootCert(context.Background(), mesh, backend)
+			Expect(err).ToNot(HaveOccurred())
+			block, _ := pem.Decode(certs[0])
+			rootCert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			bundle, err := caManager.GetTrustBundle(context.Background(), mesh, backend)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			jwks := core_ca.TrustBundle{}
+			err = json.Unmarshal(bundle, &jwks)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(jwks.Keys).To(HaveLen(1))
+			Expect(jwks.Keys[0].Use).To(Equal("x509-svid"))
+			Expect(jwks.Keys[0].Kty).To(Equal("RSA"))
+			// and x5c carries DER of the root cert
+			Expect(jwks.Keys[0].X5c).To(Equal([]string{base64.StdEncoding.EncodeToString(block.Bytes)}))
+			// and JWK parameters match the public key of the root cert
+			n, err := base64.RawURLEncoding.DecodeString(jwks.Keys[0].N)
+			Expect(err).ToNot(HaveOccurred())
+			e, err := base64.RawURLEncoding.DecodeString(jwks.Keys[0].E)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(&rsa.PublicKey{
+				N: new(big.Int).SetBytes(n),
+				E: int(new(big.Int).SetBytes(e).Int64()),
+			}).To(Equal(rootCert.PublicKey))
+		})
+
+		It("should throw an error on CA that was not created", func() {
+			// given
+			mesh := "default"
+			backend := mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+			}
+
+			// when
+			_, err := caManager.GetTrustBundle(context.Background(), mesh, backend)
+
+			// then
+			Expect(err).To(MatchError(`failed to load CA key pair for Mesh "default" and backend "builtin-1": Resource not found: type="Secret" name="default.ca-builtin-cert-builtin-1" mesh="default"`))
+		})
+	})
+
 	Context("GenerateDataplaneCert", func() {
 		It("should generate dataplane certs", func() {
 			//given
@@ -111,7 +306,7 @@ var _ = Describe("Builtin CA Manager", func() {
 			Expect(err).ToNot(HaveOccurred())
 
 			// when
-			pair, err := caManager.GenerateDataplaneCert(context.Background(), mesh, backend, "web")
+			pair, err := caManager.GenerateDataplaneCert(context.Background(), mesh, backend, "web", 0)
 
 			// then
 			Expect(err).ToNot(HaveOccurred())
@@ -126,6 +321,66 @@ var _ = Describe("Builtin CA Manager", func() {
 			Expect(cert.URIs[0].String()).To(Equal("spiffe://default/web"))
 		})
 
+		It("should generate dataplane certs valid for a given period", func() {
+			// given
+			mesh := "default"
+			backend := mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+			}
+			err := caManager.Ensure(context.Background(), mesh, backend)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			pair, err := caManager.GenerateDataplaneCert(context.Background(), mesh, backend, "web", 24*time.Hour)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			block, _ := pem.Decode(pair.CertPEM)
+			cert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cert.NotAfter.Sub(cert.NotBefore)).To(Equal(24*time.Hour + ca_issuer.DefaultAllowedClockSkew))
+		})
+
+		It("should percent-encode the service name in spiffe URI", func() {
+			// given
+			mesh := "default"
+			backend := mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+			}
+			err := caManager.Ensure(context.Background(), mesh, backend)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			pair, err := caManager.GenerateDataplaneCert(context.Background(), mesh, backend, "café", 0)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			block, _ := pem.Decode(pair.CertPEM)
+			cert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cert.URIs).To(HaveLen(1))
+			Expect(cert.URIs[0].String()).To(Equal("spiffe://default/caf%C3%A9"))
+		})
+
+		It("should throw an error on a service name that cannot be a part of spiffe URI", func() {
+			// given
+			mesh := "default"
+			backend := mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+			}
+			err := caManager.Ensure(context.Background(), mesh, backend)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			_, err = caManager.GenerateDataplaneCert(context.Background(), mesh, backend, "web app", 0)
+
+			// then
+			Expect(err).To(MatchError(`could not generate a Workload Identity cert in Mesh "default": service name "web app" must not contain whitespace or control characters`))
+		})
+
 		It("should throw an error on generate dataplane certs on non-existing CA", func() {
 			// given
 			mesh := "default"
@@ -135,10 +390,379 @@ var _ = Describe("Builtin CA Manager", func() {
 			}
 
 			// when
-			_, err := caManager.GenerateDataplaneCert(context.Background(), mesh, backend, "web")
+			_, err := caManager.GenerateDataplaneCert(context.Background(), mesh, backend, "web", 0)
 
 			// then
 			Expect(err).To(MatchError(`failed to load CA key pair for Mesh "default" and backend "builtin-non-existent": Resource not found: type="Secret" name="default.ca-builtin-cert-builtin-non-existent" mesh="default"`))
 		})
 	})
+
+	Context("Rotation", func() {
+		var now time.Time
+		var events []builtin.RootCaAuditEvent
+
+		rotationPeriod := 100 * 24 * time.Hour
+		mesh := "default"
+		var backend mesh_proto.CertificateAuthorityBackend
+
+		BeforeEach(func() {
+			now = time.Now()
+			events = nil
+			sink := builtin.AuditSinkFunc(func(_ context.Context, event builtin.RootCaAuditEvent) {
+				events = append(events, event)
+			})
+			caManager = builtin.NewBuiltinCaManager(secretManager, builtin.WithAuditSink(sink), builtin.WithClock(func() time.Time {
+				return now
+			}))
+			str, err := util_proto.ToStruct(&config.BuiltinCertificateAuthorityConfig{
+				RotationPeriod: ptypes.DurationProto(rotationPeriod),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			backend = mesh_proto.CertificateAuthorityBackend{
+				Name:   "builtin-1",
+				Type:   "builtin",
+				Config: &str,
+			}
+		})
+
+		parseCert := func(data []byte) *x509.Certificate {
+			block, _ := pem.Decode(data)
+			Expect(block).ToNot(BeNil())
+			cert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			return cert
+		}
+
+		rootCerts := func() []core_ca.Cert {
+			certs, err := caManager.GetRootCert(context.Background(), mesh, backend)
+			Expect(err).ToNot(HaveOccurred())
+			return certs
+		}
+
+		signerOf := func(roots ...core_ca.Cert) core_ca.Cert {
+			pair, err := caManager.GenerateDataplaneCert(context.Background(), mesh, backend, "web", 0)
+			Expect(err).ToNot(HaveOccurred())
+			cert := parseCert(pair.CertPEM)
+			for _, root := range roots {
+				if cert.CheckSignatureFrom(parseCert(root)) == nil {
+					return root
+				}
+			}
+			return nil
+		}
+
+		It("should not issue Dataplane certificates that outlive the rotation period", func() {
+			// given
+			Expect(caManager.Ensure(context.Background(), mesh, backend)).To(Succeed())
+
+			// when
+			pair, err := caManager.GenerateDataplaneCert(context.Background(), mesh, backend, "web", 365*24*time.Hour)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			cert := parseCert(pair.CertPEM)
+			Expect(cert.NotAfter).To(BeTemporally("<=", time.Now().Add(rotationPeriod)))
+		})
+
+		It("should rotate Root CA without dropping trust in the replaced one", func() {
+			// given
+			Expect(caManager.Ensure(context.Background(), mesh, backend)).To(Succeed())
+			roots := rootCerts()
+			Expect(roots).To(HaveLen(1))
+			first := roots[0]
+
+			// when rotation period has not elapsed yet
+			now = now.Add(rotationPeriod - time.Minute)
+
+			// then Root CA is not rotated
+			Expect(rootCerts()).To(Equal([]core_ca.Cert{first}))
+
+			// when rotation period elapses
+			now = now.Add(time.Minute)
+
+			// then the next Root CA is trusted
+			roots = rootCerts()
+			Expect(roots).To(HaveLen(2))
+			Expect(roots[0]).To(Equal(first))
+			second := roots[1]
+			Expect(parseCert(second).SerialNumber).ToNot(Equal(parseCert(first).SerialNumber))
+			// but Dataplane certificates are still signed by the current one
+			Expect(signerOf(first, second)).To(Equal(first))
+			// and generation of the next Root CA is audited
+			Expect(events).To(HaveLen(2))
+
+			// when the next Root CA has been propagated
+			now = now.Add(builtin.RootCaPropagationPeriod)
+
+			// then it replaces the current one, which is still trusted
+			Expect(rootCerts()).To(Equal([]core_ca.Cert{second, first}))
+			// and Dataplane certificates are signed by the new Root CA
+			Expect(signerOf(first, second)).To(Equal(second))
+
+			// when the next rotation completes
+			now = now.Add(rotationPeriod)
+			roots = rootCerts()
+			Expect(roots).To(HaveLen(3))
+			Expect(roots[0]).To(Equal(second))
+			Expect(roots[2]).To(Equal(first))
+			third := roots[1]
+			now = now.Add(builtin.RootCaPropagationPeriod)
+
+			// then the first Root CA is not trusted anymore
+			Expect(rootCerts()).To(Equal([]core_ca.Cert{third, second}))
+			Expect(signerOf(second, third)).To(Equal(third))
+			Expect(events).To(HaveLen(3))
+		})
+
+		It("should complete a started rotation even if rotation is disabled", func() {
+			// given
+			Expect(caManager.Ensure(context.Background(), mesh, backend)).To(Succeed())
+			now = now.Add(rotationPeriod)
+			roots := rootCerts()
+			Expect(roots).To(HaveLen(2))
+
+			// when
+			backend.Config = nil
+			now = now.Add(builtin.RootCaPropagationPeriod)
+
+			// then
+			Expect(rootCerts()).To(Equal([]core_ca.Cert{roots[1], roots[0]}))
+
+			// when
+			now = now.Add(rotationPeriod)
+
+			// then
+			Expect(rootCerts()).To(Equal([]core_ca.Cert{roots[1], roots[0]}))
+		})
+
+		It("should sign CRLs by all Root CAs that might have signed Dataplane certificates", func() {
+			// given
+			Expect(caManager.Ensure(context.Background(), mesh, backend)).To(Succeed())
+			now = now.Add(rotationPeriod)
+			Expect(rootCerts()).To(HaveLen(2))
+			now = now.Add(builtin.RootCaPropagationPeriod)
+			Expect(rootCerts()).To(HaveLen(2))
+			now = now.Add(rotationPeriod)
+			roots := rootCerts()
+			Expect(roots).To(HaveLen(3))
+			revoked := []core_ca.RevokedCert{
+				{
+					SerialNumber: big.NewInt(42),
+					NotAfter:     now.Add(time.Hour),
+					RevokedAt:    now,
+				},
+			}
+
+			// when
+			data, err := caManager.GetCRL(context.Background(), mesh, backend, revoked)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			var signers []core_ca.Cert
+			for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+				Expect(block.Type).To(Equal("X509 CRL"))
+				crl, err := x509.ParseCRL(block.Bytes)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(crl.TBSCertList.RevokedCertificates).To(HaveLen(1))
+				Expect(crl.TBSCertList.RevokedCertificates[0].SerialNumber).To(Equal(big.NewInt(42)))
+				for _, root := range roots {
+					if parseCert(root).CheckCRLSignature(crl) == nil {
+						signers = append(signers, root)
+					}
+				}
+			}
+			Expect(signers).To(ConsistOf(roots[0], roots[1], roots[2]))
+		})
+
+		It("should not rotate Root CA without rotation period", func() {
+			// given
+			backend.Config = nil
+			Expect(caManager.Ensure(context.Background(), mesh, backend)).To(Succeed())
+			roots := rootCerts()
+
+			// when
+			now = now.Add(5 * 365 * 24 * time.Hour)
+
+			// then
+			Expect(rootCerts()).To(Equal(roots))
+		})
+	})
+
+	Context("FIPS mode", func() {
+
+		backendWith := func(cfg *config.BuiltinCertificateAuthorityConfig) mesh_proto.CertificateAuthorityBackend {
+			str, err := util_proto.ToStruct(cfg)
+			Expect(err).ToNot(HaveOccurred())
+			return mesh_proto.CertificateAuthorityBackend{
+				Name:   "builtin-1",
+				Type:   "builtin",
+				Config: &str,
+			}
+		}
+
+		caKeyOf := func(mesh string) interface{} {
+			secretRes := system.SecretResource{}
+			err := secretManager.Get(context.Background(), &secretRes, core_store.GetByKey(mesh+".ca-builtin-cert-builtin-1", mesh))
+			Expect(err).ToNot(HaveOccurred())
+			block, _ := pem.Decode(secretRes.Spec.GetData().GetValue())
+			cert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			return cert.PublicKey
+		}
+
+		type testCase struct {
+			config      *config.BuiltinCertificateAuthorityConfig
+			fipsMode    bool
+			expectedErr string
+		}
+
+		DescribeTable("should validate backend config",
+			func(given testCase) {
+				// given
+				caManager := builtin.NewBuiltinCaManager(secretManager, builtin.WithFIPSMode(given.fipsMode))
+
+				// when
+				err := caManager.ValidateBackend(context.Background(), "default", backendWith(given.config))
+
+				// then
+				if given.expectedErr == "" {
+					Expect(err).ToNot(HaveOccurred())
+				} else {
+					Expect(err).To(MatchError(given.expectedErr))
+				}
+			},
+			Entry("default key in FIPS mode", testCase{
+				config:   &config.BuiltinCertificateAuthorityConfig{},
+				fipsMode: true,
+			}),
+			Entry("approved curve in FIPS mode", testCase{
+				config:   &config.BuiltinCertificateAuthorityConfig{KeyType: "ECDSA", EcdsaCurve: "P-384"},
+				fipsMode: true,
+			}),
+			Entry("disallowed curve in FIPS mode", testCase{
+				config:      &config.BuiltinCertificateAuthorityConfig{KeyType: "ECDSA", EcdsaCurve: "P-224"},
+				fipsMode:    true,
+				expectedErr: `ecdsaCurve: curve "P-224" is not approved in FIPS mode. Allowed values: P-256, P-384`,
+			}),
+			Entry("disallowed curve with FIPS mode off", testCase{
+				config:   &config.BuiltinCertificateAuthorityConfig{KeyType: "ECDSA", EcdsaCurve: "P-224"},
+				fipsMode: false,
+			}),
+			Entry("short RSA key in FIPS mode", testCase{
+				config:      &config.BuiltinCertificateAuthorityConfig{RsaBits: 1024},
+				fipsMode:    true,
+				expectedErr: `rsaBits: has to be at least 2048 in FIPS mode`,
+			}),
+			Entry("too short RSA key", testCase{
+				config:      &config.BuiltinCertificateAuthorityConfig{RsaBits: 512},
+				expectedErr: `rsaBits: has to be at least 1024`,
+			}),
+			Entry("unknown curve", testCase{
+				config:      &config.BuiltinCertificateAuthorityConfig{KeyType: "ECDSA", EcdsaCurve: "secp256k1"},
+				expectedErr: `ecdsaCurve: unsupported curve "secp256k1". Allowed values: P-224, P-256, P-384, P-521`,
+			}),
+			Entry("unknown key type", testCase{
+				config:      &config.BuiltinCertificateAuthorityConfig{KeyType: "DSA"},
+				expectedErr: `keyType: unsupported key type "DSA". Allowed values: ECDSA, RSA`,
+			}),
+			Entry("options of another key type", testCase{
+				config:      &config.BuiltinCertificateAuthorityConfig{KeyType: "ECDSA", RsaBits: 4096},
+				expectedErr: `rsaBits: cannot be set for a key of type ECDSA`,
+			}),
+			Entry("rotation period", testCase{
+				config: &config.BuiltinCertificateAuthorityConfig{RotationPeriod: ptypes.DurationProto(365 * 24 * time.Hour)},
+			}),
+			Entry("rotation period shorter than validity of Dataplane certificates", testCase{
+				config:      &config.BuiltinCertificateAuthorityConfig{RotationPeriod: ptypes.DurationProto(24 * time.Hour)},
+				expectedErr: `rotationPeriod: has to be at least the validity period of Dataplane certificates (2160h0m0s)`,
+			}),
+			Entry("rotation period longer than validity of Root CA", testCase{
+				config:      &config.BuiltinCertificateAuthorityConfig{RotationPeriod: ptypes.DurationProto(20 * 365 * 24 * time.Hour)},
+				expectedErr: `rotationPeriod: has to be shorter than the validity period of Root CA (87600h0m0s)`,
+			}),
+		)
+
+		It("should reject a disallowed curve in FIPS mode", func() {
+			// given
+			caManager := builtin.NewBuiltinCaManager(secretManager, builtin.WithFIPSMode(true))
+			backend := backendWith(&config.BuiltinCertificateAuthorityConfig{KeyType: "ECDSA", EcdsaCurve: "P-224"})
+
+			// when
+			err := caManager.Ensure(context.Background(), "default", backend)
+
+			// then
+			Expect(err).To(MatchError(`invalid configuration of backend "builtin-1" in Mesh "default": ecdsaCurve: curve "P-224" is not approved in FIPS mode. Allowed values: P-256, P-384`))
+
+			// and CA is not created
+			_, err = caManager.GetRootCert(context.Background(), "default", backend)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should allow a disallowed curve when FIPS mode is off", func() {
+			// given
+			backend := backendWith(&config.BuiltinCertificateAuthorityConfig{KeyType: "ECDSA", EcdsaCurve: "P-224"})
+
+			// when
+			err := caManager.Ensure(context.Background(), "default", backend)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			key, ok := caKeyOf("default").(*ecdsa.PublicKey)
+			Expect(ok).To(BeTrue())
+			Expect(key.Curve).To(Equal(elliptic.P224()))
+
+			// when
+			_, err = caManager.GenerateDataplaneCert(context.Background(), "default", backend, "web", 0)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should create a CA with an approved curve in FIPS mode", func() {
+			// given
+			caManager := builtin.NewBuiltinCaManager(secretManager, builtin.WithFIPSMode(true))
+			backend := backendWith(&config.BuiltinCertificateAuthorityConfig{KeyType: "ECDSA", EcdsaCurve: "P-384"})
+
+			// when
+			err := caManager.Ensure(context.Background(), "default", backend)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			key, ok := caKeyOf("default").(*ecdsa.PublicKey)
+			Expect(ok).To(BeTrue())
+			Expect(key.Curve).To(Equal(elliptic.P384()))
+
+			// when
+			pair, err := caManager.GenerateDataplaneCert(context.Background(), "default", backend, "web", 0)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			block, _ := pem.Decode(pair.CertPEM)
+			cert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cert.PublicKeyAlgorithm).To(Equal(x509.RSA))
+		})
+
+		It("should refuse to use an existing CA with a disallowed curve in FIPS mode", func() {
+			// given CA created with FIPS mode off
+			backend := backendWith(&config.BuiltinCertificateAuthorityConfig{KeyType: "ECDSA", EcdsaCurve: "P-224"})
+			Expect(caManager.Ensure(context.Background(), "default", backend)).To(Succeed())
+			// and FIPS mode turned on later
+			fipsCaManager := builtin.NewBuiltinCaManager(secretManager, builtin.WithFIPSMode(true))
+
+			// when
+			_, err := fipsCaManager.GenerateDataplaneCert(context.Background(), "default", backendWith(&config.BuiltinCertificateAuthorityConfig{}), "web", 0)
+
+			// then
+			Expect(err).To(MatchError(`CA of backend "builtin-1" in Mesh "default" cannot be used: key ECDSA P-224 is not approved in FIPS mode`))
+
+			// when
+			err = fipsCaManager.Ensure(context.Background(), "default", backendWith(&config.BuiltinCertificateAuthorityConfig{}))
+
+			// then
+			Expect(err).To(MatchError(`CA of backend "builtin-1" in Mesh "default" cannot be used: key ECDSA P-224 is not approved in FIPS mode`))
+		})
+	})
 })
@@ -0,0 +1,291 @@
+package builtin
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	core_ca "github.com/Kong/kuma/pkg/core/ca"
+	ca_issuer "github.com/Kong/kuma/pkg/core/ca/issuer"
+	core_system "github.com/Kong/kuma/pkg/core/resources/apis/system"
+	core_model "github.com/Kong/kuma/pkg/core/resources/model"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+	secret_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
+	"github.com/Kong/kuma/pkg/core/validators"
+	"github.com/Kong/kuma/pkg/plugins/ca/builtin/config"
+)
+
+// RootCaPropagationPeriod is how long a new Root CA is only trusted before it starts to sign Dataplane certificates,
+// so that all dataplanes have received it by the time they are presented certificates signed by it.
+const RootCaPropagationPeriod = 1 * time.Hour
+
+// rootCas are Root CAs of a backend.
+//
+// Rotation of a Root CA goes through the following stages:
+//  1. once the current Root CA is older than the rotation period, the next one is generated.
+//     Both are trusted, but only the current one signs Dataplane certificates;
+//  2. once the next Root CA is older than RootCaPropagationPeriod, it replaces the current one,
+//     which becomes the previous one. Both are still trusted, since Dataplane certificates signed
+//     by the previous one might still be in use;
+//  3. the previous Root CA is dropped once it is replaced by the Root CA it has been rotated to.
+//
+// The key of the previous Root CA is kept only to sign CRLs of Dataplane certificates it has signed.
+// It is missing if the rotation has been completed before keys of previous Root CAs were kept.
+type rootCas struct {
+	current     core_ca.KeyPair
+	next        *core_ca.KeyPair
+	previous    core_ca.Cert
+	previousKey []byte
+}
+
+// trusted returns certs of all Root CAs that dataplanes have to trust.
+func (r rootCas) trusted() []core_ca.Cert {
+	certs := []core_ca.Cert{r.current.CertPEM}
+	if r.next != nil {
+		certs = append(certs, r.next.CertPEM)
+	}
+	if r.previous != nil {
+		certs = append(certs, r.previous)
+	}
+	return certs
+}
+
+func nextCertSecretResKey(mesh string, backendName string) core_model.ResourceKey {
+	return core_model.ResourceKey{
+		Mesh: mesh,
+		Name: fmt.Sprintf("%s.ca-builtin-next-cert-%s", mesh, backendName),
+	}
+}
+
+func nextKeySecretResKey(mesh string, backendName string) core_model.ResourceKey {
+	return core_model.ResourceKey{
+		Mesh: mesh,
+		Name: fmt.Sprintf("%s.ca-builtin-next-key-%s", mesh, backendName),
+	}
+}
+
+func previousCertSecretResKey(mesh string, backendName string) core_model.ResourceKey {
+	return core_model.ResourceKey{
+		Mesh: mesh,
+		Name: fmt.Sprintf("%s.ca-builtin-previous-cert-%s", mesh, backendName),
+	}
+}
+
+func previousKeySecretResKey(mesh string, backendName string) core_model.ResourceKey {
+	return core_model.ResourceKey{
+		Mesh: mesh,
+		Name: fmt.Sprintf("%s.ca-builtin-previous-key-%s", mesh, backendName),
+	}
+}
+
+func validateRotation(cfg *config.BuiltinCertificateAuthorityConfig) (verr validators.ValidationError) {
+	if cfg.GetRotationPeriod() == nil {
+		return
+	}
+	period, err := ptypes.Duration(cfg.GetRotationPeriod())
+	switch {
+	case err != nil:
+		verr.AddViolation("rotationPeriod", err.Error())
+	case period < ca_issuer.DefaultWorkloadCertValidityPeriod:
+		verr.AddViolation("rotationPeriod", fmt.Sprintf("has to be at least the validity period of Dataplane certificates (%s)", ca_issuer.DefaultWorkloadCertValidityPeriod))
+	case period >= DefaultCACertValidityPeriod:
+		verr.AddViolation("rotationPeriod", fmt.Sprintf("has to be shorter than the validity period of Root CA (%s)", DefaultCACertValidityPeriod))
+	}
+	return
+}
+
+// capValidity caps validity of Dataplane certificates at the rotation period, since a replaced Root CA
+// is trusted only for one more rotation period and Dataplane certificates signed by it must not outlive it.
+// Validity of Dataplane certificates is set by the Mesh, so it is capped here rather than validated with the backend.
+func capValidity(cfg *config.BuiltinCertificateAuthorityConfig, validity time.Duration) (time.Duration, error) {
+	if cfg.GetRotationPeriod() == nil {
+		return validity, nil
+	}
+	period, err := ptypes.Duration(cfg.GetRotationPeriod())
+	if err != nil {
+		return 0, err
+	}
+	if validity == 0 {
+		validity = ca_issuer.DefaultWorkloadCertValidityPeriod
+	}
+	if validity > period {
+		return period, nil
+	}
+	return validity, nil
+}
+
+// getRootCas loads Root CAs of a backend and advances their rotation if it is due.
+func (b *builtinCaManager) getRootCas(ctx context.Context, mesh string, backendName string, cfg *config.BuiltinCertificateAuthorityConfig) (rootCas, error) {
+	cas, err := b.loadRootCas(ctx, mesh, backendName)
+	if err != nil {
+		return rootCas{}, err
+	}
+	due, err := b.rotationDue(cas, cfg)
+	if err != nil || !due {
+		return cas, err
+	}
+
+	lock := b.lockFor(mesh, backendName)
+	lock.Lock()
+	defer lock.Unlock()
+
+	// Root CAs might have been rotated while waiting for the lock
+	if cas, err = b.loadRootCas(ctx, mesh, backendName); err != nil {
+		return rootCas{}, err
+	}
+	if due, err = b.rotationDue(cas, cfg); err != nil || !due {
+		return cas, err
+	}
+	if cas.next == nil {
+		return b.startRotation(ctx, mesh, backendName, newKeySpec(cfg), cas)
+	}
+	return b.completeRotation(ctx, mesh, backendName, cas)
+}
+
+func (b *builtinCaManager) loadRootCas(ctx context.Context, mesh string, backendName string) (rootCas, error) {
+	current, err := b.getCa(ctx, mesh, backendName)
+	if err != nil {
+		return rootCas{}, err
+	}
+	cas := rootCas{current: current}
+
+	nextCert, err := b.getSecret(ctx, nextCertSecretResKey(mesh, backendName))
+	if err != nil {
+		return rootCas{}, err
+	}
+	nextKey, err := b.getSecret(ctx, nextKeySecretResKey(mesh, backendName))
+	if err != nil {
+		return rootCas{}, err
+	}
+	if nextCert != nil && nextKey != nil {
+		cas.next = &core_ca.KeyPair{
+			CertPEM: nextCert,
+			KeyPEM:  nextKey,
+		}
+	}
+
+	if cas.previous, err = b.getSecret(ctx, previousCertSecretResKey(mesh, backendName)); err != nil {
+		return rootCas{}, err
+	}
+	if cas.previousKey, err = b.getSecret(ctx, previousKeySecretResKey(mesh, backendName)); err != nil {
+		return rootCas{}, err
+	}
+	return cas, nil
+}
+
+// getSecret returns data of a secret or nil if the secret does not exist.
+func (b *builtinCaManager) getSecret(ctx context.Context, key core_model.ResourceKey) ([]byte, error) {
+	secret := &core_system.SecretResource{}
+	err := b.secretManager.Get(ctx, secret, core_store.GetBy(key))
+	if core_store.IsResourceNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return secret.Spec.GetData().GetValue(), nil
+}
+
+// rotationDue returns true if Root CAs have to move to the next stage of rotation.
+// A rotation that has already started is completed even if rotation is disabled in the meantime.
+func (b *builtinCaManager) rotationDue(cas rootCas, cfg *config.BuiltinCertificateAuthorityConfig) (bool, error) {
+	if cas.next != nil {
+		notBefore, err := notBeforeOf(cas.next.CertPEM)
+		if err != nil {
+			return false, err
+		}
+		return !b.now().Before(notBefore.Add(DefaultAllowedClockSkew + RootCaPropagationPeriod)), nil
+	}
+	if cfg.GetRotationPeriod() == nil {
+		return false, nil
+	}
+	period, err := ptypes.Duration(cfg.GetRotationPeriod())
+	if err != nil {
+		return false, err
+	}
+	notBefore, err := notBeforeOf(cas.current.CertPEM)
+	if err != nil {
+		return false, err
+	}
+	return !b.now().Before(notBefore.Add(DefaultAllowedClockSkew + period)), nil
+}
+
+func notBeforeOf(certPEM []byte) (time.Time, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return time.Time{}, errors.New("failed to decode PEM of a Root CA certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "failed to parse a Root CA certificate")
+	}
+	return cert.NotBefore, nil
+}
+
+// startRotation generates the next Root CA, which is only trusted until it replaces the current one.
+func (b *builtinCaManager) startRotation(ctx context.Context, mesh string, backendName string, spec keySpec, cas rootCas) (rootCas, error) {
+	next, err := newRootCa(mesh, spec, b.now())
+	if err != nil {
+		return rootCas{}, errors.Wrapf(err, "failed to generate a Root CA cert for Mesh %q", mesh)
+	}
+	err = secret_manager.RunInTx(b.secretManager, func(secretManager secret_manager.SecretManager) error {
+		if err := upsertSecret(ctx, secretManager, nextCertSecretResKey(mesh, backendName), next.CertPEM); err != nil {
+			return err
+		}
+		return upsertSecret(ctx, secretManager, nextKeySecretResKey(mesh, backendName), next.KeyPEM)
+	})
+	if err != nil {
+		return rootCas{}, errors.Wrapf(err, "failed to start rotation of CA of backend %q in Mesh %q", backendName, mesh)
+	}
+
+	event, err := newRootCaAuditEvent(mesh, backendName, next)
+	if err != nil {
+		return rootCas{}, err
+	}
+	b.auditSink.RootCaGenerated(ctx, event)
+	cas.next = next
+	return cas, nil
+}
+
+// completeRotation replaces the current Root CA with the next one and keeps the replaced one trusted.
+func (b *builtinCaManager) completeRotation(ctx context.Context, mesh string, backendName string, cas rootCas) (rootCas, error) {
+	err := secret_manager.RunInTx(b.secretManager, func(secretManager secret_manager.SecretManager) error {
+		if err := upsertSecret(ctx, secretManager, previousCertSecretResKey(mesh, backendName), cas.current.CertPEM); err != nil {
+			return err
+		}
+		if err := upsertSecret(ctx, secretManager, previousKeySecretResKey(mesh, backendName), cas.current.KeyPEM); err != nil {
+			return err
+		}
+		if err := upsertSecret(ctx, secretManager, certSecretResKey(mesh, backendName), cas.next.CertPEM); err != nil {
+			return err
+		}
+		if err := upsertSecret(ctx, secretManager, keySecretResKey(mesh, backendName), cas.next.KeyPEM); err != nil {
+			return err
+		}
+		return deleteSecrets(ctx, secretManager, nextCertSecretResKey(mesh, backendName), nextKeySecretResKey(mesh, backendName))
+	})
+	if err != nil {
+		return rootCas{}, errors.Wrapf(err, "failed to complete rotation of CA of backend %q in Mesh %q", backendName, mesh)
+	}
+	return rootCas{
+		current:     *cas.next,
+		previous:    cas.current.CertPEM,
+		previousKey: cas.current.KeyPEM,
+	}, nil
+}
+
+// deleteSecrets deletes given secrets, ignoring the ones that do not exist.
+func deleteSecrets(ctx context.Context, secretManager secret_manager.SecretManager, keys ...core_model.ResourceKey) error {
+	for _, key := range keys {
+		err := secretManager.Delete(ctx, &core_system.SecretResource{}, core_store.DeleteBy(key))
+		if err != nil && !core_store.IsResourceNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -15,22 +15,63 @@ func ValidateCaCert(signingPair util_tls.KeyPair) error {
 	return err.OrNil()
 }
 
-func validateCaCert(signingPair util_tls.KeyPair) (verr validators.ValidationError) {
-	tlsKeyPair, err := tls.X509KeyPair(signingPair.CertPEM, signingPair.KeyPEM)
-	if err != nil {
-		verr.AddViolation("cert", fmt.Sprintf("not a valid TLS key pair: %s", err))
+func validateCaCert(signingPair util_tls.KeyPair) validators.ValidationError {
+	return validateSigningCert(signingPair, true)
+}
+
+// validateSigningCert validates a CA that signs Dataplane certificates.
+// Unless it is also the trust anchor, the CA might be an intermediate one.
+func validateSigningCert(signingPair util_tls.KeyPair, selfSigned bool) (verr validators.ValidationError) {
+	certs, verr := parseSigningPair(signingPair)
+	if verr.HasViolations() {
 		return
 	}
-	if len(tlsKeyPair.Certificate) != 1 {
+	if len(certs) != 1 {
 		verr.AddViolation("cert", "certificate must be a root CA (certificate chains are not allowed)") // Envoy constraint
 		return
 	}
-	cert, err := x509.ParseCertificate(tlsKeyPair.Certificate[0])
+	return validateSigningCa(certs[0], selfSigned)
+}
+
+// validateSigningChain validates a CA that signs Dataplane certificates followed by the chain of CAs up to the root one,
+// e.g. an intermediate CA and the root CA that signed it. A single CA has to be a root one.
+func validateSigningChain(signingPair util_tls.KeyPair) (verr validators.ValidationError) {
+	certs, verr := parseSigningPair(signingPair)
+	if verr.HasViolations() {
+		return
+	}
+	verr.Add(validateSigningCa(certs[0], len(certs) == 1))
+	for i := 1; i < len(certs); i++ {
+		if err := certs[i-1].CheckSignatureFrom(certs[i]); err != nil {
+			verr.AddViolation("cert", fmt.Sprintf("certificate %d of the chain must be signed by the next one: %s", i, err))
+		}
+	}
+	if root := certs[len(certs)-1]; len(certs) > 1 && (root.Issuer.String() != root.Subject.String() || root.CheckSignatureFrom(root) != nil) {
+		verr.AddViolation("cert", "the last certificate of the chain must be a self-signed root CA")
+	}
+	return
+}
+
+// parseSigningPair parses certificates of a key pair, where the first one has to match the key.
+func parseSigningPair(signingPair util_tls.KeyPair) (certs []*x509.Certificate, verr validators.ValidationError) {
+	tlsKeyPair, err := tls.X509KeyPair(signingPair.CertPEM, signingPair.KeyPEM)
 	if err != nil {
-		verr.AddViolation("cert", fmt.Sprintf("not a valid x509 certificate: %s", err))
+		verr.AddViolation("cert", fmt.Sprintf("not a valid TLS key pair: %s", err))
 		return
 	}
-	if cert.Issuer.String() != cert.Subject.String() {
+	for _, der := range tlsKeyPair.Certificate {
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			verr.AddViolation("cert", fmt.Sprintf("not a valid x509 certificate: %s", err))
+			return nil, verr
+		}
+		certs = append(certs, cert)
+	}
+	return
+}
+
+func validateSigningCa(cert *x509.Certificate, selfSigned bool) (verr validators.ValidationError) {
+	if selfSigned && cert.Issuer.String() != cert.Subject.String() {
 		verr.AddViolation("cert", "certificate must be self-signed (intermediate CAs are not allowed)") // Envoy constraint
 	}
 	if !cert.IsCA {
@@ -48,6 +89,23 @@ func validateCaCert(signingPair util_tls.KeyPair) (verr validators.ValidationErr
 	if cert.KeyUsage&x509.KeyUsageKeyEncipherment != 0 {
 		verr.AddViolation("cert", "key usage extension 'keyEncipherment' must NOT be set (see X509-SVID: Appendix A. X.509 Field Reference)")
 	}
+	if !allowsExtKeyUsage(cert, x509.ExtKeyUsageServerAuth) || !allowsExtKeyUsage(cert, x509.ExtKeyUsageClientAuth) {
+		verr.AddViolation("cert", "extended key usage extension must either be unset or allow both 'serverAuth' and 'clientAuth' (otherwise mutual TLS between dataplanes fails)")
+	}
 
 	return
 }
+
+// allowsExtKeyUsage returns true if certificates signed by a given CA can be used for a given purpose.
+// CA without extended key usage extension doesn't constrain the purpose of certificates it signs.
+func allowsExtKeyUsage(cert *x509.Certificate, usage x509.ExtKeyUsage) bool {
+	if len(cert.ExtKeyUsage) == 0 && len(cert.UnknownExtKeyUsage) == 0 {
+		return true
+	}
+	for _, allowed := range cert.ExtKeyUsage {
+		if allowed == usage || allowed == x509.ExtKeyUsageAny {
+			return true
+		}
+	}
+	return false
+}
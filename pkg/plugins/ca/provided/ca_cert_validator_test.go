@@ -341,6 +341,29 @@ bgeEDefxTxoTMgJ1urwl0KX6R9dbv9YWZWJXk2DQj6UwkMEyXpc+kw==
                 violations:
                 - field: cert
                   message: "key usage extension 'keyCertSign' must be set (see X509-SVID: 4.3. Key Usage)"
+`,
+				input: *keyPair,
+			}
+		}),
+		Entry("certificate with extended key usage extension that doesn't allow `clientAuth`", func() testCase {
+			// when
+			keyPair, err := NewSelfSignedCert(func() *x509.Certificate {
+				return &x509.Certificate{
+					SerialNumber:          big.NewInt(0),
+					BasicConstraintsValid: true,
+					IsCA:                  true,
+					KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+					ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+				}
+			})
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			return testCase{
+				expectedErr: `
+                violations:
+                - field: cert
+                  message: "extended key usage extension must either be unset or allow both 'serverAuth' and 'clientAuth' (otherwise mutual TLS between dataplanes fails)"
 `,
 				input: *keyPair,
 			}
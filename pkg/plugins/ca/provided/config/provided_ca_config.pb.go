@@ -24,13 +24,38 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 // ProvidedCertificateAuthorityConfig defines configuration for Provided CA
 // plugin
 type ProvidedCertificateAuthorityConfig struct {
-	// Data source for the certificate of CA
+	// Data source for the certificate of CA. It might be followed by the chain
+	// of CAs up to the root one, e.g. an intermediate CA and the root CA that
+	// signed it, in which case Dataplane certificates are signed by the first
+	// one and the whole chain is trusted.
 	Cert *v1alpha1.DataSource `protobuf:"bytes,1,opt,name=cert,proto3" json:"cert,omitempty"`
 	// Data source for the key of CA
-	Key                  *v1alpha1.DataSource `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Key *v1alpha1.DataSource `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Data source that contains both the certificate and the key of CA in PEM
+	// format, e.g. a single file emitted by a tool. It is an alternative to cert
+	// and key, which must not be set together with it.
+	KeyPair *v1alpha1.DataSource `protobuf:"bytes,8,opt,name=key_pair,json=keyPair,proto3" json:"key_pair,omitempty"`
+	// Signature algorithm used to sign Dataplane certificates, e.g. SHA384-RSA
+	// or ECDSA-SHA384. Has to be supported by the type of the CA key.
+	// If not set, the algorithm is chosen based on the type of the CA key.
+	SignatureAlgorithm string `protobuf:"bytes,3,opt,name=signature_algorithm,json=signatureAlgorithm,proto3" json:"signature_algorithm,omitempty"`
+	// Naming policy of Dataplane certificates. If not set, Dataplane
+	// certificates carry only a SPIFFE URI.
+	LeafTemplate *ProvidedCertificateAuthorityConfig_LeafTemplate `protobuf:"bytes,4,opt,name=leaf_template,json=leafTemplate,proto3" json:"leaf_template,omitempty"`
+	// Issuing CA that signs Dataplane certificates. It is an alternative to
+	// cert and key that allows the issuing CA to differ from trust anchors.
+	Issuer *ProvidedCertificateAuthorityConfig_Issuer `protobuf:"bytes,5,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// Data sources for the certificates of trust anchors, i.e. root CAs
+	// distributed to peers to validate Dataplane certificates. If not set, the
+	// certificate of the issuing CA is the only trust anchor.
+	TrustAnchors []*v1alpha1.DataSource `protobuf:"bytes,6,rep,name=trust_anchors,json=trustAnchors,proto3" json:"trust_anchors,omitempty"`
+	// If true, the system root pool of the Control Plane host is used besides
+	// trust anchors to verify the chain of the issuing CA, e.g. when an
+	// intermediate CA is signed by a publicly trusted root.
+	IncludeSystemRoots   bool     `protobuf:"varint,7,opt,name=include_system_roots,json=includeSystemRoots,proto3" json:"include_system_roots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ProvidedCertificateAuthorityConfig) Reset()         { *m = ProvidedCertificateAuthorityConfig{} }
@@ -72,8 +97,177 @@ func (m *ProvidedCertificateAuthorityConfig) GetKey() *v1alpha1.DataSource {
 	return nil
 }
 
+func (m *ProvidedCertificateAuthorityConfig) GetKeyPair() *v1alpha1.DataSource {
+	if m != nil {
+		return m.KeyPair
+	}
+	return nil
+}
+
+func (m *ProvidedCertificateAuthorityConfig) GetSignatureAlgorithm() string {
+	if m != nil {
+		return m.SignatureAlgorithm
+	}
+	return ""
+}
+
+func (m *ProvidedCertificateAuthorityConfig) GetLeafTemplate() *ProvidedCertificateAuthorityConfig_LeafTemplate {
+	if m != nil {
+		return m.LeafTemplate
+	}
+	return nil
+}
+
+func (m *ProvidedCertificateAuthorityConfig) GetIssuer() *ProvidedCertificateAuthorityConfig_Issuer {
+	if m != nil {
+		return m.Issuer
+	}
+	return nil
+}
+
+func (m *ProvidedCertificateAuthorityConfig) GetTrustAnchors() []*v1alpha1.DataSource {
+	if m != nil {
+		return m.TrustAnchors
+	}
+	return nil
+}
+
+func (m *ProvidedCertificateAuthorityConfig) GetIncludeSystemRoots() bool {
+	if m != nil {
+		return m.IncludeSystemRoots
+	}
+	return false
+}
+
+// LeafTemplate defines naming policy of Dataplane certificates
+type ProvidedCertificateAuthorityConfig_LeafTemplate struct {
+	// Format of the Common Name of Dataplane certificates, where `{{service}}`
+	// and `{{mesh}}` are replaced with the names of a service and a Mesh,
+	// e.g. `{{service}}.{{mesh}}.example.com`.
+	CommonName string `protobuf:"bytes,1,opt,name=common_name,json=commonName,proto3" json:"common_name,omitempty"`
+	// Types of SANs Dataplane certificates may carry besides the mandatory
+	// SPIFFE URI, e.g. DNS. If DNS is allowed, the Common Name is added as a
+	// DNS SAN.
+	AllowedSanTypes []string `protobuf:"bytes,2,rep,name=allowed_san_types,json=allowedSanTypes,proto3" json:"allowed_san_types,omitempty"`
+	// Formats of additional DNS SANs of Dataplane certificates, where
+	// `{{service}}` and `{{mesh}}` are replaced the same way as in the Common
+	// Name, e.g. `{{service}}.{{mesh}}.svc.cluster.local`. Requires DNS to be
+	// among allowed SAN types.
+	DnsNames             []string `protobuf:"bytes,3,rep,name=dns_names,json=dnsNames,proto3" json:"dns_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProvidedCertificateAuthorityConfig_LeafTemplate) Reset() {
+	*m = ProvidedCertificateAuthorityConfig_LeafTemplate{}
+}
+func (m *ProvidedCertificateAuthorityConfig_LeafTemplate) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ProvidedCertificateAuthorityConfig_LeafTemplate) ProtoMessage() {}
+func (*ProvidedCertificateAuthorityConfig_LeafTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde4b37f63959dba, []int{0, 0}
+}
+
+func (m *ProvidedCertificateAuthorityConfig_LeafTemplate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProvidedCertificateAuthorityConfig_LeafTemplate.Unmarshal(m, b)
+}
+func (m *ProvidedCertificateAuthorityConfig_LeafTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProvidedCertificateAuthorityConfig_LeafTemplate.Marshal(b, m, deterministic)
+}
+func (m *ProvidedCertificateAuthorityConfig_LeafTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProvidedCertificateAuthorityConfig_LeafTemplate.Merge(m, src)
+}
+func (m *ProvidedCertificateAuthorityConfig_LeafTemplate) XXX_Size() int {
+	return xxx_messageInfo_ProvidedCertificateAuthorityConfig_LeafTemplate.Size(m)
+}
+func (m *ProvidedCertificateAuthorityConfig_LeafTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProvidedCertificateAuthorityConfig_LeafTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProvidedCertificateAuthorityConfig_LeafTemplate proto.InternalMessageInfo
+
+func (m *ProvidedCertificateAuthorityConfig_LeafTemplate) GetCommonName() string {
+	if m != nil {
+		return m.CommonName
+	}
+	return ""
+}
+
+func (m *ProvidedCertificateAuthorityConfig_LeafTemplate) GetAllowedSanTypes() []string {
+	if m != nil {
+		return m.AllowedSanTypes
+	}
+	return nil
+}
+
+func (m *ProvidedCertificateAuthorityConfig_LeafTemplate) GetDnsNames() []string {
+	if m != nil {
+		return m.DnsNames
+	}
+	return nil
+}
+
+// Issuer defines a CA that signs Dataplane certificates
+type ProvidedCertificateAuthorityConfig_Issuer struct {
+	// Data source for the certificate of the issuing CA. It might be an
+	// intermediate CA signed by one of the trust anchors.
+	Cert *v1alpha1.DataSource `protobuf:"bytes,1,opt,name=cert,proto3" json:"cert,omitempty"`
+	// Data source for the key of the issuing CA
+	Key                  *v1alpha1.DataSource `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ProvidedCertificateAuthorityConfig_Issuer) Reset() {
+	*m = ProvidedCertificateAuthorityConfig_Issuer{}
+}
+func (m *ProvidedCertificateAuthorityConfig_Issuer) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ProvidedCertificateAuthorityConfig_Issuer) ProtoMessage() {}
+func (*ProvidedCertificateAuthorityConfig_Issuer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde4b37f63959dba, []int{0, 1}
+}
+
+func (m *ProvidedCertificateAuthorityConfig_Issuer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProvidedCertificateAuthorityConfig_Issuer.Unmarshal(m, b)
+}
+func (m *ProvidedCertificateAuthorityConfig_Issuer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProvidedCertificateAuthorityConfig_Issuer.Marshal(b, m, deterministic)
+}
+func (m *ProvidedCertificateAuthorityConfig_Issuer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProvidedCertificateAuthorityConfig_Issuer.Merge(m, src)
+}
+func (m *ProvidedCertificateAuthorityConfig_Issuer) XXX_Size() int {
+	return xxx_messageInfo_ProvidedCertificateAuthorityConfig_Issuer.Size(m)
+}
+func (m *ProvidedCertificateAuthorityConfig_Issuer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProvidedCertificateAuthorityConfig_Issuer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProvidedCertificateAuthorityConfig_Issuer proto.InternalMessageInfo
+
+func (m *ProvidedCertificateAuthorityConfig_Issuer) GetCert() *v1alpha1.DataSource {
+	if m != nil {
+		return m.Cert
+	}
+	return nil
+}
+
+func (m *ProvidedCertificateAuthorityConfig_Issuer) GetKey() *v1alpha1.DataSource {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ProvidedCertificateAuthorityConfig)(nil), "kuma.plugins.ca.ProvidedCertificateAuthorityConfig")
+	proto.RegisterType((*ProvidedCertificateAuthorityConfig_LeafTemplate)(nil), "kuma.plugins.ca.ProvidedCertificateAuthorityConfig.LeafTemplate")
+	proto.RegisterType((*ProvidedCertificateAuthorityConfig_Issuer)(nil), "kuma.plugins.ca.ProvidedCertificateAuthorityConfig.Issuer")
 }
 
 func init() {
@@ -81,18 +275,33 @@ func init() {
 }
 
 var fileDescriptor_cde4b37f63959dba = []byte{
-	// 203 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x2f, 0xc8, 0x4e, 0xd7,
-	0x2f, 0xc8, 0x29, 0x4d, 0xcf, 0xcc, 0x2b, 0xd6, 0x4f, 0x4e, 0xd4, 0x2f, 0x28, 0xca, 0x2f, 0xcb,
-	0x4c, 0x49, 0x4d, 0xd1, 0x4f, 0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0x87, 0xf3, 0xe3, 0x93, 0x13, 0xe3,
-	0x21, 0x42, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xfc, 0xd9, 0xa5, 0xb9, 0x89, 0x7a, 0x50,
-	0x9d, 0x7a, 0xc9, 0x89, 0x52, 0x0a, 0xc5, 0x95, 0xc5, 0x25, 0xa9, 0xb9, 0xfa, 0x65, 0x86, 0x89,
-	0x39, 0x05, 0x19, 0x89, 0x86, 0xfa, 0x29, 0x89, 0x25, 0x89, 0xc5, 0xf9, 0xa5, 0x45, 0xc9, 0xa9,
-	0x10, 0x2d, 0x4a, 0x7d, 0x8c, 0x5c, 0x4a, 0x01, 0x50, 0xf3, 0x9c, 0x53, 0x8b, 0x4a, 0x32, 0xd3,
-	0x32, 0x93, 0x13, 0x4b, 0x52, 0x1d, 0x4b, 0x4b, 0x32, 0xf2, 0x8b, 0x32, 0x4b, 0x2a, 0x9d, 0xc1,
-	0xe6, 0x0b, 0x99, 0x70, 0xb1, 0x24, 0xa7, 0x16, 0x95, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b,
-	0x29, 0xe8, 0x81, 0x2d, 0x82, 0x18, 0xae, 0x07, 0x33, 0x5c, 0xcf, 0x25, 0xb1, 0x24, 0x31, 0x18,
-	0x6c, 0x78, 0x10, 0x58, 0xb5, 0x90, 0x11, 0x17, 0x73, 0x76, 0x6a, 0xa5, 0x04, 0x13, 0x91, 0x9a,
-	0x40, 0x8a, 0x9d, 0x38, 0xa2, 0xd8, 0x20, 0x7e, 0x4a, 0x62, 0x03, 0xbb, 0xd0, 0x18, 0x10, 0x00,
-	0x00, 0xff, 0xff, 0x07, 0xbe, 0x7b, 0xb5, 0x0f, 0x01, 0x00, 0x00,
+	// 440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0x41, 0x6f, 0xd4, 0x30,
+	0x10, 0x85, 0xb5, 0x4d, 0x49, 0xb3, 0xee, 0x56, 0x15, 0x86, 0x83, 0xb5, 0x1c, 0x88, 0x7a, 0x5a,
+	0x71, 0x48, 0x68, 0x41, 0x42, 0x82, 0x0b, 0x4b, 0xe1, 0x80, 0x84, 0x50, 0x95, 0xed, 0x89, 0x8b,
+	0x35, 0x38, 0xb3, 0x59, 0x2b, 0x8e, 0x1d, 0xd9, 0x4e, 0x21, 0x7f, 0x86, 0xdf, 0x8a, 0xe2, 0xa4,
+	0xd5, 0x8a, 0x0b, 0x2b, 0x0e, 0x1c, 0x3d, 0x6f, 0xde, 0x67, 0xcd, 0xd3, 0x0c, 0x79, 0xd3, 0xd6,
+	0x55, 0xde, 0xaa, 0xae, 0x92, 0xda, 0xe5, 0x02, 0xf2, 0xd6, 0x9a, 0x3b, 0x59, 0x62, 0x99, 0x0b,
+	0xa3, 0xb7, 0xb2, 0x7a, 0x78, 0x73, 0x01, 0x7c, 0x2c, 0x65, 0xad, 0x35, 0xde, 0xd0, 0xf3, 0xba,
+	0x6b, 0x20, 0x9b, 0x9c, 0x99, 0x80, 0x65, 0xea, 0x7a, 0xe7, 0xb1, 0xc9, 0xef, 0x2e, 0x41, 0xb5,
+	0x3b, 0xb8, 0xcc, 0x4b, 0xf0, 0xe0, 0x4c, 0x67, 0x05, 0x8e, 0x96, 0x8b, 0x5f, 0x31, 0xb9, 0xb8,
+	0x99, 0x78, 0xd7, 0x68, 0xbd, 0xdc, 0x4a, 0x01, 0x1e, 0xd7, 0x9d, 0xdf, 0x19, 0x2b, 0x7d, 0x7f,
+	0x1d, 0xf8, 0xf4, 0x35, 0x39, 0x16, 0x68, 0x3d, 0x9b, 0xa5, 0xb3, 0xd5, 0xe9, 0x55, 0x9a, 0x85,
+	0x8f, 0x46, 0x78, 0x76, 0x0f, 0xcf, 0x3e, 0x82, 0x87, 0x4d, 0x80, 0x17, 0xa1, 0x9b, 0x5e, 0x91,
+	0xa8, 0xc6, 0x9e, 0x1d, 0x1d, 0x68, 0x1a, 0x9a, 0xe9, 0x3b, 0x92, 0xd4, 0xd8, 0xf3, 0x16, 0xa4,
+	0x65, 0xc9, 0x81, 0xc6, 0x93, 0x1a, 0xfb, 0x1b, 0x90, 0x96, 0xe6, 0xe4, 0x89, 0x93, 0x95, 0x06,
+	0xdf, 0x59, 0xe4, 0xa0, 0xaa, 0x61, 0x84, 0x5d, 0xc3, 0xa2, 0x74, 0xb6, 0x9a, 0x17, 0xf4, 0x41,
+	0x5a, 0xdf, 0x2b, 0x14, 0xc9, 0x99, 0x42, 0xd8, 0x72, 0x8f, 0x4d, 0xab, 0xc0, 0x23, 0x3b, 0x0e,
+	0x5f, 0xbe, 0xcf, 0xfe, 0x48, 0x32, 0xfb, 0x7b, 0x46, 0xd9, 0x17, 0x84, 0xed, 0xed, 0xc4, 0x29,
+	0x16, 0x6a, 0xef, 0x45, 0x0b, 0x12, 0x4b, 0xe7, 0x3a, 0xb4, 0xec, 0x51, 0xe0, 0xbf, 0xfd, 0x17,
+	0xfe, 0xe7, 0x40, 0x28, 0x26, 0x12, 0xfd, 0x44, 0xce, 0xbc, 0xed, 0x9c, 0xe7, 0xa0, 0xc5, 0xce,
+	0x58, 0xc7, 0xe2, 0x34, 0x3a, 0x28, 0xad, 0x45, 0xb0, 0xad, 0x47, 0x17, 0x7d, 0x49, 0x9e, 0x4a,
+	0x2d, 0x54, 0x57, 0x22, 0x1f, 0x3d, 0xdc, 0x1a, 0xe3, 0x1d, 0x3b, 0x49, 0x67, 0xab, 0xa4, 0xa0,
+	0x93, 0xb6, 0x09, 0x52, 0x31, 0x28, 0xcb, 0x9f, 0x64, 0xb1, 0x3f, 0x2a, 0x7d, 0x4e, 0x4e, 0x85,
+	0x69, 0x1a, 0xa3, 0xb9, 0x86, 0x06, 0xc3, 0x8a, 0xcc, 0x0b, 0x32, 0x96, 0xbe, 0x42, 0x83, 0xf4,
+	0x05, 0x79, 0x0c, 0x4a, 0x99, 0x1f, 0x58, 0x72, 0x07, 0x9a, 0xfb, 0xbe, 0x45, 0xc7, 0x8e, 0xd2,
+	0x68, 0x35, 0x2f, 0xce, 0x27, 0x61, 0x03, 0xfa, 0x76, 0x28, 0xd3, 0x67, 0x64, 0x5e, 0x6a, 0x17,
+	0x48, 0x8e, 0x45, 0xa1, 0x27, 0x29, 0xb5, 0x1b, 0x38, 0x6e, 0x69, 0x49, 0x3c, 0x86, 0xf0, 0xff,
+	0xf6, 0xf1, 0x43, 0xf2, 0x2d, 0x1e, 0x6f, 0xec, 0x7b, 0x1c, 0x2e, 0xe6, 0xd5, 0xef, 0x01, 0x00,
+	0x5f, 0x00, 0xc9, 0x2e, 0x9f, 0x03, 0x00, 0x00,
 }
@@ -64,6 +64,55 @@ func (m *ProvidedCertificateAuthorityConfig) Validate() error {
 		}
 	}
 
+	if v, ok := interface{}(m.GetKeyPair()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProvidedCertificateAuthorityConfigValidationError{
+				field:  "KeyPair",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SignatureAlgorithm
+
+	if v, ok := interface{}(m.GetLeafTemplate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProvidedCertificateAuthorityConfigValidationError{
+				field:  "LeafTemplate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetIssuer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProvidedCertificateAuthorityConfigValidationError{
+				field:  "Issuer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTrustAnchors() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProvidedCertificateAuthorityConfigValidationError{
+					field:  fmt.Sprintf("TrustAnchors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for IncludeSystemRoots
+
 	return nil
 }
 
@@ -123,3 +172,167 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ProvidedCertificateAuthorityConfigValidationError{}
+
+// Validate checks the field values on
+// ProvidedCertificateAuthorityConfig_LeafTemplate with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ProvidedCertificateAuthorityConfig_LeafTemplate) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for CommonName
+
+	return nil
+}
+
+// ProvidedCertificateAuthorityConfig_LeafTemplateValidationError is the
+// validation error returned by
+// ProvidedCertificateAuthorityConfig_LeafTemplate.Validate if the designated
+// constraints aren't met.
+type ProvidedCertificateAuthorityConfig_LeafTemplateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProvidedCertificateAuthorityConfig_LeafTemplateValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e ProvidedCertificateAuthorityConfig_LeafTemplateValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e ProvidedCertificateAuthorityConfig_LeafTemplateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProvidedCertificateAuthorityConfig_LeafTemplateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProvidedCertificateAuthorityConfig_LeafTemplateValidationError) ErrorName() string {
+	return "ProvidedCertificateAuthorityConfig_LeafTemplateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProvidedCertificateAuthorityConfig_LeafTemplateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProvidedCertificateAuthorityConfig_LeafTemplate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProvidedCertificateAuthorityConfig_LeafTemplateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProvidedCertificateAuthorityConfig_LeafTemplateValidationError{}
+
+// Validate checks the field values on
+// ProvidedCertificateAuthorityConfig_Issuer with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *ProvidedCertificateAuthorityConfig_Issuer) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetCert()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProvidedCertificateAuthorityConfig_IssuerValidationError{
+				field:  "Cert",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProvidedCertificateAuthorityConfig_IssuerValidationError{
+				field:  "Key",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ProvidedCertificateAuthorityConfig_IssuerValidationError is the validation
+// error returned by ProvidedCertificateAuthorityConfig_Issuer.Validate if the
+// designated constraints aren't met.
+type ProvidedCertificateAuthorityConfig_IssuerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProvidedCertificateAuthorityConfig_IssuerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProvidedCertificateAuthorityConfig_IssuerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProvidedCertificateAuthorityConfig_IssuerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProvidedCertificateAuthorityConfig_IssuerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProvidedCertificateAuthorityConfig_IssuerValidationError) ErrorName() string {
+	return "ProvidedCertificateAuthorityConfig_IssuerValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProvidedCertificateAuthorityConfig_IssuerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProvidedCertificateAuthorityConfig_Issuer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProvidedCertificateAuthorityConfig_IssuerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProvidedCertificateAuthorityConfig_IssuerValidationError{}
@@ -0,0 +1,125 @@
+package provided
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/Kong/kuma/pkg/core/validators"
+	"github.com/Kong/kuma/pkg/plugins/ca/provided/config"
+)
+
+const (
+	servicePlaceholder = "{{service}}"
+	meshPlaceholder    = "{{mesh}}"
+
+	uriSanType = "URI"
+	dnsSanType = "DNS"
+
+	// maxCommonNameLength is the upper bound of the Common Name defined by RFC 5280
+	maxCommonNameLength = 64
+
+	// maxDNSNameLength is the upper bound of a DNS name defined by RFC 1035
+	maxDNSNameLength = 253
+)
+
+// supportedSanTypes are types of SANs Dataplane certificates may carry.
+// SPIFFE URI SAN is mandatory, so URI is always allowed.
+var supportedSanTypes = map[string]bool{
+	uriSanType: true,
+	dnsSanType: true,
+}
+
+var dnsNameRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+
+func validateLeafTemplate(template *config.ProvidedCertificateAuthorityConfig_LeafTemplate) (verr validators.ValidationError) {
+	if template == nil {
+		return
+	}
+	if template.GetCommonName() == "" {
+		verr.AddViolation("commonName", "has to be defined")
+	} else if !strings.Contains(template.GetCommonName(), servicePlaceholder) {
+		verr.AddViolation("commonName", fmt.Sprintf("has to contain %s placeholder", servicePlaceholder))
+	}
+	for i, sanType := range template.GetAllowedSanTypes() {
+		if !supportedSanTypes[sanType] {
+			verr.AddViolationAt(validators.RootedAt("allowedSanTypes").Index(i), fmt.Sprintf("unsupported SAN type %q. Allowed values: %s", sanType, strings.Join(sanTypes(), ", ")))
+		}
+	}
+	if len(template.GetDnsNames()) > 0 && !allowsSanType(template, dnsSanType) {
+		verr.AddViolation("dnsNames", fmt.Sprintf("requires %s to be among allowed SAN types", dnsSanType))
+	}
+	for i, dnsName := range template.GetDnsNames() {
+		if !isValidDNSNamePattern(dnsName) {
+			verr.AddViolationAt(validators.RootedAt("dnsNames").Index(i), fmt.Sprintf("%q is not a valid DNS name", dnsName))
+		}
+	}
+	return
+}
+
+func allowsSanType(template *config.ProvidedCertificateAuthorityConfig_LeafTemplate, sanType string) bool {
+	return contains(template.GetAllowedSanTypes(), sanType)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// isValidDNSNamePattern checks whether a pattern renders to a valid DNS name given valid names of a service and a Mesh.
+func isValidDNSNamePattern(pattern string) bool {
+	sample := strings.NewReplacer(servicePlaceholder, "service", meshPlaceholder, "mesh").Replace(pattern)
+	return len(sample) <= maxDNSNameLength && dnsNameRegexp.MatchString(sample)
+}
+
+func sanTypes() []string {
+	var types []string
+	for sanType := range supportedSanTypes {
+		types = append(types, sanType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// leafName is the name of a Dataplane certificate rendered from a LeafTemplate.
+type leafName struct {
+	commonName string
+	dnsNames   []string
+}
+
+// renderLeafTemplate renders the name of a Dataplane certificate for a given service
+// and rejects services that would violate the template.
+func renderLeafTemplate(template *config.ProvidedCertificateAuthorityConfig_LeafTemplate, mesh string, service string) (leafName, error) {
+	replacer := strings.NewReplacer(servicePlaceholder, service, meshPlaceholder, mesh)
+	commonName := replacer.Replace(template.GetCommonName())
+	if len(commonName) > maxCommonNameLength {
+		return leafName{}, errors.Errorf("Common Name %q of a certificate for service %q is longer than %d characters", commonName, service, maxCommonNameLength)
+	}
+	name := leafName{
+		commonName: commonName,
+	}
+	if !allowsSanType(template, dnsSanType) {
+		return name, nil
+	}
+	if !dnsNameRegexp.MatchString(commonName) {
+		return leafName{}, errors.Errorf("Common Name %q of a certificate for service %q is not a valid DNS name", commonName, service)
+	}
+	name.dnsNames = []string{commonName}
+	for _, pattern := range template.GetDnsNames() {
+		dnsName := replacer.Replace(pattern)
+		if len(dnsName) > maxDNSNameLength || !dnsNameRegexp.MatchString(dnsName) {
+			return leafName{}, errors.Errorf("DNS name %q of a certificate for service %q is not a valid DNS name", dnsName, service)
+		}
+		if !contains(name.dnsNames, dnsName) {
+			name.dnsNames = append(name.dnsNames, dnsName)
+		}
+	}
+	return name, nil
+}
@@ -2,10 +2,16 @@ package provided
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
 	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/Kong/kuma/api/system/v1alpha1"
 	"github.com/Kong/kuma/pkg/core/ca"
 	ca_issuer "github.com/Kong/kuma/pkg/core/ca/issuer"
 	"github.com/Kong/kuma/pkg/core/datasource"
@@ -16,14 +22,29 @@ import (
 
 type providedCaManager struct {
 	dataSourceLoader datasource.Loader
+	systemCertPool   func() (*x509.CertPool, error)
 }
 
 var _ ca.Manager = &providedCaManager{}
 
-func NewProvidedCaManager(dataSourceLoader datasource.Loader) ca.Manager {
-	return &providedCaManager{
+type OptionFunc func(*providedCaManager)
+
+// WithSystemCertPool overrides x509.SystemCertPool used by backends that include system roots.
+func WithSystemCertPool(systemCertPool func() (*x509.CertPool, error)) OptionFunc {
+	return func(m *providedCaManager) {
+		m.systemCertPool = systemCertPool
+	}
+}
+
+func NewProvidedCaManager(dataSourceLoader datasource.Loader, fs ...OptionFunc) ca.Manager {
+	m := &providedCaManager{
 		dataSourceLoader: dataSourceLoader,
+		systemCertPool:   x509.SystemCertPool,
+	}
+	for _, f := range fs {
+		f(m)
 	}
+	return m
 }
 
 func (p *providedCaManager) ValidateBackend(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend) error {
@@ -35,27 +56,322 @@ func (p *providedCaManager) ValidateBackend(ctx context.Context, mesh string, ba
 		return verr.OrNil()
 	}
 
-	if cfg.GetCert() == nil {
-		verr.AddViolation("cert", "has to be defined")
-	} else {
-		verr.AddError("cert", datasource.Validate(cfg.GetCert()))
+	issuerPath := ""
+	if cfg.GetIssuer() != nil {
+		issuerPath = "issuer"
+		if cfg.GetCert() != nil {
+			verr.AddViolation("cert", "cannot be defined together with issuer")
+		}
+		if cfg.GetKey() != nil {
+			verr.AddViolation("key", "cannot be defined together with issuer")
+		}
+		if cfg.GetKeyPair() != nil {
+			verr.AddViolation("keyPair", "cannot be defined together with issuer")
+		}
 	}
-	if cfg.GetKey() == nil {
-		verr.AddViolation("key", "has to be defined")
+	certPath, keyPath := fieldPath(issuerPath, "cert"), fieldPath(issuerPath, "key")
+	if usesKeyPair(cfg) {
+		certPath, keyPath = "keyPair", "keyPair"
+		if cfg.GetCert() != nil {
+			verr.AddViolation("cert", "cannot be defined together with keyPair")
+		}
+		if cfg.GetKey() != nil {
+			verr.AddViolation("key", "cannot be defined together with keyPair")
+		}
+		verr.AddError("keyPair", datasource.Validate(cfg.GetKeyPair()))
 	} else {
-		verr.AddError("key", datasource.Validate(cfg.GetKey()))
+		certSource, keySource := issuerOf(cfg)
+		if certSource == nil {
+			verr.AddViolation(certPath, "has to be defined")
+		} else {
+			verr.AddError(certPath, datasource.Validate(certSource))
+		}
+		if keySource == nil {
+			verr.AddViolation(keyPath, "has to be defined")
+		} else {
+			verr.AddError(keyPath, datasource.Validate(keySource))
+		}
+	}
+	for i, anchor := range cfg.GetTrustAnchors() {
+		if anchor == nil {
+			verr.AddViolation(trustAnchorPath(i), "has to be defined")
+		} else {
+			verr.AddError(trustAnchorPath(i), datasource.Validate(anchor))
+		}
 	}
+	signatureAlgorithm := x509.UnknownSignatureAlgorithm
+	if cfg.GetSignatureAlgorithm() != "" {
+		algorithm, err := parseSignatureAlgorithm(cfg.GetSignatureAlgorithm())
+		if err != nil {
+			verr.AddViolation("signatureAlgorithm", err.Error())
+		}
+		signatureAlgorithm = algorithm
+	}
+	verr.AddError("leafTemplate", validateLeafTemplate(cfg.GetLeafTemplate()))
 
 	if !verr.HasViolations() {
-		pair, err := p.getCa(ctx, mesh, backend)
+		cert, key, certErr, keyErr := p.loadIssuer(ctx, mesh, cfg)
+		if certErr != nil {
+			verr.AddViolation(certPath, certErr.Error())
+		}
+		if keyErr != nil {
+			verr.AddViolation(keyPath, keyErr.Error())
+		}
+		anchors := x509.NewCertPool()
+		if cfg.GetIncludeSystemRoots() {
+			systemRoots, err := p.systemCertPool()
+			if err != nil {
+				verr.AddViolation("includeSystemRoots", fmt.Sprintf("could not load system root pool: %s", err))
+			} else {
+				anchors = systemRoots
+			}
+		}
+		for i, anchor := range cfg.GetTrustAnchors() {
+			_, anchorCert, err := p.loadCert(ctx, mesh, anchor)
+			if err != nil {
+				verr.AddViolation(trustAnchorPath(i), err.Error())
+				continue
+			}
+			if !anchorCert.IsCA {
+				verr.AddViolation(trustAnchorPath(i), "basic constraint 'CA' must be set to 'true'")
+			}
+			anchors.AddCert(anchorCert)
+		}
+		if certErr == nil && keyErr == nil {
+			verr.Add(validateSwappedPEM(certPath, keyPath, cert, key))
+		}
+		if certErr == nil && keyErr == nil && !verr.HasViolations() {
+			pair := ca.KeyPair{
+				CertPEM: cert,
+				KeyPEM:  key,
+			}
+			if len(cfg.GetTrustAnchors()) == 0 {
+				// the issuing CA might be an intermediate one followed by the chain up to the root CA
+				verr.AddError(issuerPath, validateSigningChain(pair))
+			} else {
+				// an issuing CA distinct from trust anchors might be an intermediate one
+				verr.AddError(issuerPath, validateSigningCert(pair, false))
+			}
+			if signatureAlgorithm != x509.UnknownSignatureAlgorithm {
+				verr.AddError(issuerPath, validateSignatureAlgorithm(pair, signatureAlgorithm))
+			}
+			if len(cfg.GetTrustAnchors()) > 0 && !verr.HasViolations() {
+				if err := verifyIssuer(pair, anchors); err != nil {
+					verr.AddViolation(certPath, err.Error())
+				}
+			}
+		}
+	}
+	return verr.OrNil()
+}
+
+// issuerOf returns data sources of the CA that signs Dataplane certificates.
+func issuerOf(cfg *config.ProvidedCertificateAuthorityConfig) (cert *system_proto.DataSource, key *system_proto.DataSource) {
+	if cfg.GetIssuer() != nil {
+		return cfg.GetIssuer().GetCert(), cfg.GetIssuer().GetKey()
+	}
+	return cfg.GetCert(), cfg.GetKey()
+}
+
+// usesKeyPair returns true if the cert and the key of the CA come from a single data source.
+func usesKeyPair(cfg *config.ProvidedCertificateAuthorityConfig) bool {
+	return cfg.GetIssuer() == nil && cfg.GetKeyPair() != nil
+}
+
+// loadIssuer loads the cert and the key of the CA that signs Dataplane certificates.
+// An error of a single key pair data source is returned as an error of the cert.
+func (p *providedCaManager) loadIssuer(ctx context.Context, mesh string, cfg *config.ProvidedCertificateAuthorityConfig) (cert []byte, key []byte, certErr error, keyErr error) {
+	if usesKeyPair(cfg) {
+		cert, key, certErr = p.loadKeyPair(ctx, mesh, cfg.GetKeyPair())
+		return
+	}
+	certSource, keySource := issuerOf(cfg)
+	cert, certErr = p.dataSourceLoader.Load(ctx, mesh, certSource)
+	key, keyErr = p.dataSourceLoader.Load(ctx, mesh, keySource)
+	return
+}
+
+// loadKeyPair loads PEM data that contains both a certificate and a private key,
+// and splits it, so that the private key is never distributed as a part of the certificate.
+func (p *providedCaManager) loadKeyPair(ctx context.Context, mesh string, source *system_proto.DataSource) (cert []byte, key []byte, err error) {
+	data, err := p.dataSourceLoader.Load(ctx, mesh, source)
+	if err != nil {
+		return nil, nil, err
+	}
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		switch {
+		case block.Type == "CERTIFICATE":
+			cert = append(cert, pem.EncodeToMemory(block)...)
+		case strings.HasSuffix(block.Type, "PRIVATE KEY"):
+			if key != nil {
+				return nil, nil, errors.New("contains more than one private key")
+			}
+			key = pem.EncodeToMemory(block)
+		}
+	}
+	if cert == nil {
+		return nil, nil, errors.New("does not contain a certificate")
+	}
+	if key == nil {
+		return nil, nil, errors.New("does not contain a private key")
+	}
+	return cert, key, nil
+}
+
+func fieldPath(parent string, field string) string {
+	if parent == "" {
+		return field
+	}
+	return parent + "." + field
+}
+
+func trustAnchorPath(index int) string {
+	return fmt.Sprintf("trustAnchors[%d]", index)
+}
+
+// validateSwappedPEM detects a private key pasted into the cert data source or the other way round,
+// which otherwise surfaces as a confusing error about the key pair.
+func validateSwappedPEM(certPath string, keyPath string, cert []byte, key []byte) (verr validators.ValidationError) {
+	certTypes, keyTypes := pemTypesOf(cert), pemTypesOf(key)
+	if certTypes.privateKey && !certTypes.certificate {
+		verr.AddViolation(certPath, "cert contains a private key, expected a certificate")
+	}
+	if keyTypes.certificate && !keyTypes.privateKey {
+		verr.AddViolation(keyPath, "key contains a certificate, expected a private key")
+	}
+	return
+}
+
+type pemTypes struct {
+	certificate bool
+	privateKey  bool
+}
+
+// pemTypesOf returns which kinds of PEM blocks given data contains.
+func pemTypesOf(data []byte) (types pemTypes) {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return
+		}
+		switch {
+		case block.Type == "CERTIFICATE":
+			types.certificate = true
+		case strings.HasSuffix(block.Type, "PRIVATE KEY"): // PKCS #8, PKCS #1 or SEC 1 private key
+			types.privateKey = true
+		}
+	}
+}
+
+// verifyIssuer checks that the issuing CA is signed by one of trust anchors,
+// otherwise peers would reject Dataplane certificates signed by it.
+// The pool of anchors might include system roots, see IncludeSystemRoots.
+func verifyIssuer(issuer ca.KeyPair, anchors *x509.CertPool) error {
+	block, _ := pem.Decode(issuer.CertPEM)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return err
+	}
+	opts := x509.VerifyOptions{
+		Roots:     anchors,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}
+	if _, err := cert.Verify(opts); err != nil {
+		return errors.Wrap(err, "certificate must be signed by one of trust anchors")
+	}
+	return nil
+}
+
+// loadCert loads a PEM certificate from a given data source.
+func (p *providedCaManager) loadCert(ctx context.Context, mesh string, source *system_proto.DataSource) (ca.Cert, *x509.Certificate, error) {
+	data, err := p.dataSourceLoader.Load(ctx, mesh, source)
+	if err != nil {
+		return nil, nil, err
+	}
+	return parseCert(data)
+}
+
+// parseCert parses a PEM certificate.
+func parseCert(data []byte) (ca.Cert, *x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, nil, errors.New("not a valid PEM certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "not a valid PEM certificate")
+	}
+	return data, cert, nil
+}
+
+// splitChain splits PEM data of a chain into certificates, e.g. an issuing CA and the chain of CAs up to the root one.
+func splitChain(data []byte) ([]ca.Cert, error) {
+	var certs []ca.Cert
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return certs, nil
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, _, err := parseCert(pem.EncodeToMemory(block))
 		if err != nil {
-			verr.AddViolation("cert", err.Error())
-			verr.AddViolation("key", err.Error())
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+}
+
+// getRootCerts loads only certs of trust anchors, so that root certs can be distributed
+// even if the key of the issuing CA is stored separately and cannot be loaded.
+func (p *providedCaManager) getRootCerts(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend) ([]ca.Cert, error) {
+	cfg := &config.ProvidedCertificateAuthorityConfig{}
+	if err := proto.ToTyped(backend.Config, cfg); err != nil {
+		return nil, errors.Wrap(err, "could not convert backend config to ProvidedCertificateAuthorityConfig")
+	}
+	if len(cfg.GetTrustAnchors()) == 0 {
+		// the issuing CA and the chain up to the root CA it might be followed by are trust anchors
+		var data []byte
+		if usesKeyPair(cfg) {
+			cert, _, err := p.loadKeyPair(ctx, mesh, cfg.GetKeyPair())
+			if err != nil {
+				return nil, err
+			}
+			data = cert
 		} else {
-			verr.AddError("", validateCaCert(pair))
+			source, _ := issuerOf(cfg)
+			cert, err := p.dataSourceLoader.Load(ctx, mesh, source)
+			if err != nil {
+				return nil, err
+			}
+			data = cert
+		}
+		if isChain(data) {
+			return splitChain(data)
+		}
+		root, _, err := parseCert(data)
+		if err != nil {
+			return nil, err
 		}
+		return []ca.Cert{root}, nil
 	}
-	return verr.OrNil()
+	var roots []ca.Cert
+	for i, anchor := range cfg.GetTrustAnchors() {
+		root, _, err := p.loadCert(ctx, mesh, anchor)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not load trust anchor %d", i)
+		}
+		roots = append(roots, root)
+	}
+	return roots, nil
 }
 
 func (p *providedCaManager) getCa(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend) (ca.KeyPair, error) {
@@ -63,13 +379,15 @@ func (p *providedCaManager) getCa(ctx context.Context, mesh string, backend mesh
 	if err := proto.ToTyped(backend.Config, cfg); err != nil {
 		return ca.KeyPair{}, errors.Wrap(err, "could not convert backend config to ProvidedCertificateAuthorityConfig")
 	}
-	key, err := p.dataSourceLoader.Load(ctx, mesh, cfg.Key)
-	if err != nil {
-		return ca.KeyPair{}, err
+	cert, key, certErr, keyErr := p.loadIssuer(ctx, mesh, cfg)
+	if certErr != nil && usesKeyPair(cfg) {
+		return ca.KeyPair{}, errors.Wrap(certErr, "could not load key pair")
 	}
-	cert, err := p.dataSourceLoader.Load(ctx, mesh, cfg.Cert)
-	if err != nil {
-		return ca.KeyPair{}, err
+	if certErr != nil {
+		return ca.KeyPair{}, errors.Wrap(certErr, "could not load cert")
+	}
+	if keyErr != nil {
+		return ca.KeyPair{}, errors.Wrap(keyErr, "could not load key")
 	}
 	pair := ca.KeyPair{
 		CertPEM: cert,
@@ -83,22 +401,121 @@ func (p *providedCaManager) Ensure(ctx context.Context, mesh string, backend mes
 }
 
 func (p *providedCaManager) GetRootCert(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend) ([]ca.Cert, error) {
-	meshCa, err := p.getCa(ctx, mesh, backend)
+	certs, err := p.getRootCerts(ctx, mesh, backend)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to load CA key pair for Mesh %q and backend %q", mesh, backend.Name)
+		return nil, errors.Wrapf(err, "failed to load CA cert for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	return certs, nil
+}
+
+func (p *providedCaManager) GetTrustBundle(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend) ([]byte, error) {
+	certs, err := p.GetRootCert(ctx, mesh, backend)
+	if err != nil {
+		return nil, err
 	}
-	return []ca.Cert{meshCa.CertPEM}, nil
+	return ca.NewTrustBundle(certs)
 }
 
-func (p *providedCaManager) GenerateDataplaneCert(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend, service string) (ca.KeyPair, error) {
+func (p *providedCaManager) GenerateDataplaneCert(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend, service string, validity time.Duration) (ca.KeyPair, error) {
+	if err := ca.ValidateServiceName(service); err != nil {
+		return ca.KeyPair{}, errors.Wrapf(err, "could not generate a Workload Identity cert in Mesh %q", mesh)
+	}
 	meshCa, err := p.getCa(ctx, mesh, backend)
 	if err != nil {
 		return ca.KeyPair{}, errors.Wrapf(err, "failed to load CA key pair for Mesh %q and backend %q", mesh, backend.Name)
 	}
 
-	keyPair, err := ca_issuer.NewWorkloadCert(meshCa, mesh, service)
+	cfg := &config.ProvidedCertificateAuthorityConfig{}
+	if err := proto.ToTyped(backend.Config, cfg); err != nil {
+		return ca.KeyPair{}, errors.Wrap(err, "could not convert backend config to ProvidedCertificateAuthorityConfig")
+	}
+	var opts []ca_issuer.WorkloadCertOptionFunc
+	if validity != 0 {
+		opts = append(opts, ca_issuer.WithValidityPeriod(validity))
+	}
+	if cfg.GetSignatureAlgorithm() != "" {
+		signatureAlgorithm, err := parseSignatureAlgorithm(cfg.GetSignatureAlgorithm())
+		if err != nil {
+			return ca.KeyPair{}, errors.Wrapf(err, "invalid configuration of backend %q in Mesh %q", backend.Name, mesh)
+		}
+		opts = append(opts, ca_issuer.WithSignatureAlgorithm(signatureAlgorithm))
+	}
+	if cfg.GetLeafTemplate() != nil {
+		name, err := renderLeafTemplate(cfg.GetLeafTemplate(), mesh, service)
+		if err != nil {
+			return ca.KeyPair{}, errors.Wrapf(err, "service %q violates naming policy of backend %q in Mesh %q", service, backend.Name, mesh)
+		}
+		opts = append(opts, ca_issuer.WithCommonName(name.commonName), ca_issuer.WithDNSNames(name.dnsNames...))
+	}
+
+	keyPair, err := ca_issuer.NewWorkloadCert(meshCa, mesh, service, opts...)
 	if err != nil {
 		return ca.KeyPair{}, errors.Wrapf(err, "failed to generate a Workload Identity cert for workload %q in Mesh %q using backend %q", service, mesh, backend.Name)
 	}
+	if len(cfg.GetTrustAnchors()) > 0 || isChain(meshCa.CertPEM) {
+		// peers validate Dataplane certificates against trust anchors,
+		// so the chain has to include the issuing CA that might be an intermediate one
+		keyPair.CertPEM = appendCert(keyPair.CertPEM, meshCa.CertPEM)
+	}
 	return *keyPair, nil // todo pointer?
 }
+
+func (p *providedCaManager) GetCRL(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend, revoked []ca.RevokedCert) ([]byte, error) {
+	meshCa, err := p.getCa(ctx, mesh, backend)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load CA key pair for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	roots, err := p.getRootCerts(ctx, mesh, backend)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load CA cert for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	if !isOnlyRoot(meshCa.CertPEM, roots) {
+		// only the key of the issuing CA is available, so parents of an intermediate CA
+		// and other trust anchors cannot sign their CRLs
+		return nil, nil
+	}
+	crl, err := ca_issuer.NewCRL(meshCa, revoked, time.Now())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to generate a CRL for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	return crl, nil
+}
+
+// isOnlyRoot returns true if the issuing CA is a self-signed CA that is the only trust anchor.
+func isOnlyRoot(issuer []byte, roots []ca.Cert) bool {
+	if len(roots) != 1 || isChain(issuer) {
+		return false
+	}
+	_, issuerCert, err := parseCert(issuer)
+	if err != nil {
+		return false
+	}
+	_, rootCert, err := parseCert(roots[0])
+	if err != nil {
+		return false
+	}
+	return issuerCert.Equal(rootCert) && issuerCert.CheckSignatureFrom(issuerCert) == nil
+}
+
+// isChain returns true if PEM data contains more than one certificate.
+func isChain(data []byte) bool {
+	certs := 0
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return certs > 1
+		}
+		if block.Type == "CERTIFICATE" {
+			certs++
+		}
+	}
+}
+
+func appendCert(chain []byte, cert []byte) []byte {
+	result := append([]byte{}, chain...)
+	if len(result) > 0 && result[len(result)-1] != '\n' {
+		result = append(result, '\n')
+	}
+	return append(result, cert...)
+}
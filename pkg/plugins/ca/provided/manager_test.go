@@ -1,11 +1,16 @@
 package provided_test
 
 import (
+	"bytes"
 	"context"
 	"crypto/x509"
 	"encoding/pem"
+	"errors"
+	"fmt"
 	"io/ioutil"
+	"math/big"
 	"path/filepath"
+	"time"
 
 	"github.com/ghodss/yaml"
 	structpb "github.com/golang/protobuf/ptypes/struct"
@@ -70,9 +75,9 @@ var _ = Describe("Provided CA", func() {
 				expected: `
             violations:
             - field: cert
-              message: 'data source has to be chosen. Available sources: secret, file, inline'
+              message: 'data source has to be chosen. Available sources: secret, file, inline, projected'
             - field: key
-              message: 'data source has to be chosen. Available sources: secret, file, inline'`,
+              message: 'data source has to be chosen. Available sources: secret, file, inline, projected'`,
 			}),
 			Entry("config with empty secret", testCase{
 				configYAML: `
@@ -97,6 +102,17 @@ var _ = Describe("Provided CA", func() {
             violations:
             - field: cert
               message: 'could not load data: open /tmp/non-existing-file: no such file or directory'
+            - field: key
+              message: 'could not load data: open /tmp/non-existing-file: no such file or directory'`,
+			}),
+			Entry("config with a key that cannot be loaded", testCase{
+				configYAML: `
+            cert:
+              file: testdata/ca.pem
+            key:
+              file: /tmp/non-existing-file`,
+				expected: `
+            violations:
             - field: key
               message: 'could not load data: open /tmp/non-existing-file: no such file or directory'`,
 			}),
@@ -111,11 +127,245 @@ var _ = Describe("Provided CA", func() {
             - field: cert
               message: 'not a valid TLS key pair: tls: failed to find any PEM data in certificate input'`,
 			}),
+			Entry("config with a key in place of cert", testCase{
+				configYAML: `
+            cert:
+              file: testdata/ca.key
+            key:
+              file: testdata/ca.key`,
+				expected: `
+            violations:
+            - field: cert
+              message: cert contains a private key, expected a certificate`,
+			}),
+			Entry("config with swapped cert and key", testCase{
+				configYAML: `
+            cert:
+              file: testdata/ca.key
+            key:
+              file: testdata/ca.pem`,
+				expected: `
+            violations:
+            - field: cert
+              message: cert contains a private key, expected a certificate
+            - field: key
+              message: key contains a certificate, expected a private key`,
+			}),
+			Entry("config with swapped cert and key of issuer", testCase{
+				configYAML: `
+            issuer:
+              cert:
+                file: testdata/intermediate.key
+              key:
+                file: testdata/intermediate.pem
+            trustAnchors:
+            - file: testdata/ca.pem`,
+				expected: `
+            violations:
+            - field: issuer.cert
+              message: cert contains a private key, expected a certificate
+            - field: issuer.key
+              message: key contains a certificate, expected a private key`,
+			}),
+			Entry("config with unsupported signature algorithm", testCase{
+				configYAML: `
+            cert:
+              file: testdata/ca.pem
+            key:
+              file: testdata/ca.key
+            signatureAlgorithm: MD5-RSA`,
+				expected: `
+            violations:
+            - field: signatureAlgorithm
+              message: 'unsupported signature algorithm "MD5-RSA". Allowed values: ECDSA-SHA256, ECDSA-SHA384, ECDSA-SHA512, SHA256-RSA, SHA256-RSAPSS, SHA384-RSA, SHA384-RSAPSS, SHA512-RSA, SHA512-RSAPSS'`,
+			}),
+			Entry("config with signature algorithm not supported by the type of CA key", testCase{
+				configYAML: `
+            cert:
+              file: testdata/ca.pem
+            key:
+              file: testdata/ca.key
+            signatureAlgorithm: ECDSA-SHA384`,
+				expected: `
+            violations:
+            - field: signatureAlgorithm
+              message: 'signature algorithm "ECDSA-SHA384" requires a CA key of type ECDSA, got RSA'`,
+			}),
+			Entry("config with invalid leaf template", testCase{
+				configYAML: `
+            cert:
+              file: testdata/ca.pem
+            key:
+              file: testdata/ca.key
+            leafTemplate:
+              commonName: '{{mesh}}.example.com'
+              allowedSanTypes:
+              - DNS
+              - IP`,
+				expected: `
+            violations:
+            - field: leafTemplate.commonName
+              message: 'has to contain {{service}} placeholder'
+            - field: leafTemplate.allowedSanTypes[1]
+              message: 'unsupported SAN type "IP". Allowed values: DNS, URI'`,
+			}),
+			Entry("config with invalid DNS names of leaf template", testCase{
+				configYAML: `
+            cert:
+              file: testdata/ca.pem
+            key:
+              file: testdata/ca.key
+            leafTemplate:
+              commonName: '{{service}}.example.com'
+              dnsNames:
+              - '{{service}}.{{mesh}}.svc.cluster.local'
+              - '*.{{mesh}}.example.com'
+              - '{{service}}..example.com'`,
+				expected: `
+            violations:
+            - field: leafTemplate.dnsNames
+              message: 'requires DNS to be among allowed SAN types'
+            - field: leafTemplate.dnsNames[1]
+              message: '"*.{{mesh}}.example.com" is not a valid DNS name'
+            - field: leafTemplate.dnsNames[2]
+              message: '"{{service}}..example.com" is not a valid DNS name'`,
+			}),
+			Entry("config with both issuer and cert", testCase{
+				configYAML: `
+            cert:
+              file: testdata/ca.pem
+            key:
+              file: testdata/ca.key
+            issuer:
+              cert:
+                file: testdata/intermediate.pem`,
+				expected: `
+            violations:
+            - field: cert
+              message: cannot be defined together with issuer
+            - field: key
+              message: cannot be defined together with issuer
+            - field: issuer.key
+              message: has to be defined`,
+			}),
+			Entry("config with both keyPair and cert", testCase{
+				configYAML: `
+            cert:
+              file: testdata/ca.pem
+            keyPair:
+              file: testdata/ca-keypair.pem`,
+				expected: `
+            violations:
+            - field: cert
+              message: cannot be defined together with keyPair`,
+			}),
+			Entry("config with both keyPair and issuer", testCase{
+				configYAML: `
+            keyPair:
+              file: testdata/ca-keypair.pem
+            issuer:
+              cert:
+                file: testdata/ca.pem
+              key:
+                file: testdata/ca.key`,
+				expected: `
+            violations:
+            - field: keyPair
+              message: cannot be defined together with issuer`,
+			}),
+			Entry("config with a keyPair without a private key", testCase{
+				configYAML: `
+            keyPair:
+              file: testdata/ca.pem`,
+				expected: `
+            violations:
+            - field: keyPair
+              message: does not contain a private key`,
+			}),
+			Entry("config with an intermediate issuer and no trust anchors", testCase{
+				configYAML: `
+            issuer:
+              cert:
+                file: testdata/intermediate.pem
+              key:
+                file: testdata/intermediate.key`,
+				expected: `
+            violations:
+            - field: issuer.cert
+              message: certificate must be self-signed (intermediate CAs are not allowed)`,
+			}),
+			Entry("config with an intermediate cert followed by a chain of another root", testCase{
+				configYAML: `
+            cert:
+              file: testdata/intermediate-wrong-chain.pem
+            key:
+              file: testdata/intermediate.key`,
+				expected: `
+            violations:
+            - field: cert
+              message: 'certificate 1 of the chain must be signed by the next one: crypto/rsa: verification error'`,
+			}),
+			Entry("config with an issuer not signed by trust anchors", testCase{
+				configYAML: `
+            issuer:
+              cert:
+                file: testdata/ca.pem
+              key:
+                file: testdata/ca.key
+            trustAnchors:
+            - file: testdata/intermediate.pem`,
+				expected: `
+            violations:
+            - field: issuer.cert
+              message: 'certificate must be signed by one of trust anchors: x509: certificate signed by unknown authority'`,
+			}),
+			Entry("config with a trust anchor that cannot be loaded", testCase{
+				configYAML: `
+            issuer:
+              cert:
+                file: testdata/intermediate.pem
+              key:
+                file: testdata/intermediate.key
+            trustAnchors:
+            - file: testdata/ca.pem
+            - file: testdata/ca.key
+            - {}`,
+				expected: `
+            violations:
+            - field: trustAnchors[2]
+              message: 'data source has to be chosen. Available sources: secret, file, inline, projected'`,
+			}),
 		)
+
+		It("should accept signature algorithm supported by the type of CA key", func() {
+			// given
+			str := structpb.Struct{}
+			err := proto.FromYAML([]byte(`
+            cert:
+              file: testdata/ca.pem
+            key:
+              file: testdata/ca.key
+            signatureAlgorithm: SHA384-RSA`), &str)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			verr := caManager.ValidateBackend(context.Background(), "default", mesh_proto.CertificateAuthorityBackend{
+				Name:   "provided-1",
+				Type:   "provided",
+				Config: &str,
+			})
+
+			// then
+			Expect(verr).ToNot(HaveOccurred())
+		})
 	})
 
 	var backendWithTestCerts mesh_proto.CertificateAuthorityBackend
 	var backendWithInvalidCerts mesh_proto.CertificateAuthorityBackend
+	var backendWithoutKey mesh_proto.CertificateAuthorityBackend
+	var backendWithIssuer mesh_proto.CertificateAuthorityBackend
+	var backendWithKeyPair mesh_proto.CertificateAuthorityBackend
+	var backendWithChain mesh_proto.CertificateAuthorityBackend
 
 	BeforeEach(func() {
 		cfg := provided_config.ProvidedCertificateAuthorityConfig{
@@ -159,6 +409,183 @@ var _ = Describe("Provided CA", func() {
 			Type:   "provided",
 			Config: &invalidStr,
 		}
+
+		withoutKeyCfg := provided_config.ProvidedCertificateAuthorityConfig{
+			Cert: &system_proto.DataSource{
+				Type: &system_proto.DataSource_File{
+					File: filepath.Join("testdata", "ca.pem"),
+				},
+			},
+			Key: &system_proto.DataSource{
+				Type: &system_proto.DataSource_File{
+					File: filepath.Join("testdata", "offline.key"),
+				},
+			},
+		}
+		withoutKeyStr, err := proto.ToStruct(&withoutKeyCfg)
+		Expect(err).ToNot(HaveOccurred())
+
+		backendWithoutKey = mesh_proto.CertificateAuthorityBackend{
+			Name:   "provided-3",
+			Type:   "provided",
+			Config: &withoutKeyStr,
+		}
+
+		withIssuerCfg := provided_config.ProvidedCertificateAuthorityConfig{
+			Issuer: &provided_config.ProvidedCertificateAuthorityConfig_Issuer{
+				Cert: &system_proto.DataSource{
+					Type: &system_proto.DataSource_File{
+						File: filepath.Join("testdata", "intermediate.pem"),
+					},
+				},
+				Key: &system_proto.DataSource{
+					Type: &system_proto.DataSource_File{
+						File: filepath.Join("testdata", "intermediate.key"),
+					},
+				},
+			},
+			TrustAnchors: []*system_proto.DataSource{
+				{
+					Type: &system_proto.DataSource_File{
+						File: filepath.Join("testdata", "ca.pem"),
+					},
+				},
+			},
+		}
+		withIssuerStr, err := proto.ToStruct(&withIssuerCfg)
+		Expect(err).ToNot(HaveOccurred())
+
+		backendWithIssuer = mesh_proto.CertificateAuthorityBackend{
+			Name:   "provided-4",
+			Type:   "provided",
+			Config: &withIssuerStr,
+		}
+
+		withKeyPairCfg := provided_config.ProvidedCertificateAuthorityConfig{
+			KeyPair: &system_proto.DataSource{
+				Type: &system_proto.DataSource_File{
+					File: filepath.Join("testdata", "ca-keypair.pem"),
+				},
+			},
+		}
+		withKeyPairStr, err := proto.ToStruct(&withKeyPairCfg)
+		Expect(err).ToNot(HaveOccurred())
+
+		backendWithKeyPair = mesh_proto.CertificateAuthorityBackend{
+			Name:   "provided-5",
+			Type:   "provided",
+			Config: &withKeyPairStr,
+		}
+
+		withChainCfg := provided_config.ProvidedCertificateAuthorityConfig{
+			Cert: &system_proto.DataSource{
+				Type: &system_proto.DataSource_File{
+					File: filepath.Join("testdata", "intermediate-chain.pem"),
+				},
+			},
+			Key: &system_proto.DataSource{
+				Type: &system_proto.DataSource_File{
+					File: filepath.Join("testdata", "intermediate.key"),
+				},
+			},
+		}
+		withChainStr, err := proto.ToStruct(&withChainCfg)
+		Expect(err).ToNot(HaveOccurred())
+
+		backendWithChain = mesh_proto.CertificateAuthorityBackend{
+			Name:   "provided-6",
+			Type:   "provided",
+			Config: &withChainStr,
+		}
+	})
+
+	It("should accept a separate issuer and trust anchors", func() {
+		// when
+		err := caManager.ValidateBackend(context.Background(), "default", backendWithIssuer)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should accept a combined cert and key", func() {
+		// when
+		err := caManager.ValidateBackend(context.Background(), "default", backendWithKeyPair)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should accept an intermediate cert followed by the chain up to the root", func() {
+		// when
+		err := caManager.ValidateBackend(context.Background(), "default", backendWithChain)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Context("with system roots", func() {
+		var systemRoots *x509.CertPool
+
+		BeforeEach(func() {
+			// the root that signs the intermediate issuer is trusted by the system only
+			data, err := ioutil.ReadFile(filepath.Join("testdata", "ca.pem"))
+			Expect(err).ToNot(HaveOccurred())
+			systemRoots = x509.NewCertPool()
+			Expect(systemRoots.AppendCertsFromPEM(data)).To(BeTrue())
+
+			caManager = provided.NewProvidedCaManager(datasource.NewDataSourceLoader(nil), provided.WithSystemCertPool(func() (*x509.CertPool, error) {
+				return systemRoots, nil
+			}))
+		})
+
+		backendIncludingSystemRoots := func(include bool) mesh_proto.CertificateAuthorityBackend {
+			str := structpb.Struct{}
+			err := proto.FromYAML([]byte(fmt.Sprintf(`
+            issuer:
+              cert:
+                file: testdata/intermediate.pem
+              key:
+                file: testdata/intermediate.key
+            trustAnchors:
+            - file: testdata/other-ca.pem
+            includeSystemRoots: %v`, include)), &str)
+			Expect(err).ToNot(HaveOccurred())
+			return mesh_proto.CertificateAuthorityBackend{
+				Name:   "provided-5",
+				Type:   "provided",
+				Config: &str,
+			}
+		}
+
+		It("should verify an intermediate issuer against system roots", func() {
+			// when
+			err := caManager.ValidateBackend(context.Background(), "default", backendIncludingSystemRoots(true))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should not verify an intermediate issuer against system roots unless included", func() {
+			// when
+			err := caManager.ValidateBackend(context.Background(), "default", backendIncludingSystemRoots(false))
+
+			// then
+			Expect(err).To(MatchError("issuer.cert: certificate must be signed by one of trust anchors: x509: certificate signed by unknown authority"))
+		})
+
+		It("should report a system root pool that cannot be loaded", func() {
+			// given
+			caManager = provided.NewProvidedCaManager(datasource.NewDataSourceLoader(nil), provided.WithSystemCertPool(func() (*x509.CertPool, error) {
+				return nil, errors.New("no roots")
+			}))
+
+			// when
+			err := caManager.ValidateBackend(context.Background(), "default", backendIncludingSystemRoots(true))
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("includeSystemRoots: could not load system root pool: no roots"))
+		})
 	})
 
 	Context("GetRootCert", func() {
@@ -176,19 +603,120 @@ var _ = Describe("Provided CA", func() {
 			Expect(rootCerts[0]).To(Equal(expectedCert))
 		})
 
+		It("should load root certs when the key cannot be loaded", func() {
+			// given
+			expectedCert, err := ioutil.ReadFile(filepath.Join("testdata", "ca.pem"))
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			rootCerts, err := caManager.GetRootCert(context.Background(), "default", backendWithoutKey)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rootCerts).To(HaveLen(1))
+			Expect(rootCerts[0]).To(Equal(expectedCert))
+		})
+
+		It("should return only trust anchors when issuer is separate", func() {
+			// given
+			expectedCert, err := ioutil.ReadFile(filepath.Join("testdata", "ca.pem"))
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			rootCerts, err := caManager.GetRootCert(context.Background(), "default", backendWithIssuer)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rootCerts).To(HaveLen(1))
+			Expect(rootCerts[0]).To(Equal(expectedCert))
+		})
+
+		It("should return only the cert of a combined cert and key", func() {
+			// given
+			expectedCert, err := ioutil.ReadFile(filepath.Join("testdata", "ca.pem"))
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			rootCerts, err := caManager.GetRootCert(context.Background(), "default", backendWithKeyPair)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rootCerts).To(HaveLen(1))
+			Expect(rootCerts[0]).To(Equal(expectedCert))
+			Expect(string(rootCerts[0])).ToNot(ContainSubstring("PRIVATE KEY"))
+		})
+
+		It("should return the whole chain of an intermediate cert", func() {
+			// given
+			intermediateCert, err := ioutil.ReadFile(filepath.Join("testdata", "intermediate.pem"))
+			Expect(err).ToNot(HaveOccurred())
+			rootCert, err := ioutil.ReadFile(filepath.Join("testdata", "ca.pem"))
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			rootCerts, err := caManager.GetRootCert(context.Background(), "default", backendWithChain)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rootCerts).To(Equal([]core_ca.Cert{intermediateCert, rootCert}))
+		})
+
+		It("should throw an error on a cert that is not a PEM certificate", func() {
+			// given
+			cfg := provided_config.ProvidedCertificateAuthorityConfig{
+				Cert: &system_proto.DataSource{
+					Type: &system_proto.DataSource_File{
+						File: filepath.Join("testdata", "ca.key"),
+					},
+				},
+			}
+			str, err := proto.ToStruct(&cfg)
+			Expect(err).ToNot(HaveOccurred())
+			backendWithTestCerts.Config = &str
+
+			// when
+			_, err = caManager.GetRootCert(context.Background(), "default", backendWithTestCerts)
+
+			// then
+			Expect(err).To(MatchError(`failed to load CA cert for Mesh "default" and backend "provided-1": not a valid PEM certificate`))
+		})
+
 		It("should throw an error on invalid certs", func() {
 			// when
 			_, err := caManager.GetRootCert(context.Background(), "default", backendWithInvalidCerts)
 
 			// then
-			Expect(err).To(MatchError(`failed to load CA key pair for Mesh "default" and backend "provided-2": could not load data: open testdata/invalid.key: no such file or directory`))
+			Expect(err).To(MatchError(`failed to load CA cert for Mesh "default" and backend "provided-2": could not load data: open testdata/invalid.pem: no such file or directory`))
+		})
+	})
+
+	Context("GetTrustBundle", func() {
+		It("should export root certs as a SPIFFE trust bundle", func() {
+			// given
+			expected, err := ioutil.ReadFile(filepath.Join("testdata", "trust-bundle.golden.json"))
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			bundle, err := caManager.GetTrustBundle(context.Background(), "default", backendWithTestCerts)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bundle).To(MatchJSON(expected))
+		})
+
+		It("should throw an error on invalid certs", func() {
+			// when
+			_, err := caManager.GetTrustBundle(context.Background(), "default", backendWithInvalidCerts)
+
+			// then
+			Expect(err).To(MatchError(`failed to load CA cert for Mesh "default" and backend "provided-2": could not load data: open testdata/invalid.pem: no such file or directory`))
 		})
 	})
 
 	Context("GenerateDataplaneCert", func() {
 		It("should generate dataplane cert", func() {
 			// when
-			pair, err := caManager.GenerateDataplaneCert(context.Background(), "default", backendWithTestCerts, "web")
+			pair, err := caManager.GenerateDataplaneCert(context.Background(), "default", backendWithTestCerts, "web", 0)
 
 			// then
 			Expect(err).ToNot(HaveOccurred())
@@ -201,14 +729,307 @@ var _ = Describe("Provided CA", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(cert.URIs).To(HaveLen(1))
 			Expect(cert.URIs[0].String()).To(Equal("spiffe://default/web"))
+			// and should use the default signature algorithm for RSA keys
+			Expect(cert.SignatureAlgorithm).To(Equal(x509.SHA256WithRSA))
+			// and should be usable on both sides of mutual TLS
+			Expect(cert.ExtKeyUsage).To(ConsistOf(x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth))
+			Expect(cert.KeyUsage & x509.KeyUsageDigitalSignature).ToNot(BeZero())
+
+			// and should be verifiable against the provided root for both purposes
+			roots, err := caManager.GetRootCert(context.Background(), "default", backendWithTestCerts)
+			Expect(err).ToNot(HaveOccurred())
+			pool := x509.NewCertPool()
+			Expect(pool.AppendCertsFromPEM(roots[0])).To(BeTrue())
+			for _, usage := range []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth} {
+				_, err = cert.Verify(x509.VerifyOptions{Roots: pool, KeyUsages: []x509.ExtKeyUsage{usage}})
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		It("should generate dataplane cert with a combined cert and key", func() {
+			// when
+			pair, err := caManager.GenerateDataplaneCert(context.Background(), "default", backendWithKeyPair, "web", 0)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			block, _ := pem.Decode(pair.CertPEM)
+			cert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cert.URIs).To(HaveLen(1))
+			Expect(cert.URIs[0].String()).To(Equal("spiffe://default/web"))
+
+			// and should be verifiable against the cert of the key pair
+			roots, err := caManager.GetRootCert(context.Background(), "default", backendWithKeyPair)
+			Expect(err).ToNot(HaveOccurred())
+			pool := x509.NewCertPool()
+			Expect(pool.AppendCertsFromPEM(roots[0])).To(BeTrue())
+			_, err = cert.Verify(x509.VerifyOptions{Roots: pool})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should percent-encode the service name in spiffe URI", func() {
+			// when
+			pair, err := caManager.GenerateDataplaneCert(context.Background(), "default", backendWithTestCerts, "café", 0)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			block, _ := pem.Decode(pair.CertPEM)
+			cert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cert.URIs).To(HaveLen(1))
+			Expect(cert.URIs[0].String()).To(Equal("spiffe://default/caf%C3%A9"))
+		})
+
+		It("should throw an error on a service name that cannot be a part of spiffe URI", func() {
+			// when
+			_, err := caManager.GenerateDataplaneCert(context.Background(), "default", backendWithTestCerts, "web app", 0)
+
+			// then
+			Expect(err).To(MatchError(`could not generate a Workload Identity cert in Mesh "default": service name "web app" must not contain whitespace or control characters`))
+		})
+
+		It("should sign dataplane cert with configured signature algorithm", func() {
+			// given
+			cfg := provided_config.ProvidedCertificateAuthorityConfig{}
+			Expect(proto.ToTyped(backendWithTestCerts.Config, &cfg)).To(Succeed())
+			cfg.SignatureAlgorithm = "SHA384-RSA"
+			str, err := proto.ToStruct(&cfg)
+			Expect(err).ToNot(HaveOccurred())
+			backendWithTestCerts.Config = &str
+
+			// when
+			pair, err := caManager.GenerateDataplaneCert(context.Background(), "default", backendWithTestCerts, "web", 0)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			block, _ := pem.Decode(pair.CertPEM)
+			cert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cert.SignatureAlgorithm).To(Equal(x509.SHA384WithRSA))
+		})
+
+		Context("with leaf template", func() {
+			BeforeEach(func() {
+				cfg := provided_config.ProvidedCertificateAuthorityConfig{}
+				Expect(proto.ToTyped(backendWithTestCerts.Config, &cfg)).To(Succeed())
+				cfg.LeafTemplate = &provided_config.ProvidedCertificateAuthorityConfig_LeafTemplate{
+					CommonName:      "{{service}}.{{mesh}}.example.com",
+					AllowedSanTypes: []string{"DNS"},
+				}
+				str, err := proto.ToStruct(&cfg)
+				Expect(err).ToNot(HaveOccurred())
+				backendWithTestCerts.Config = &str
+			})
+
+			It("should generate dataplane cert with templated Common Name", func() {
+				// when
+				pair, err := caManager.GenerateDataplaneCert(context.Background(), "default", backendWithTestCerts, "web", 0)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				block, _ := pem.Decode(pair.CertPEM)
+				cert, err := x509.ParseCertificate(block.Bytes)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cert.Subject.CommonName).To(Equal("web.default.example.com"))
+				Expect(cert.DNSNames).To(Equal([]string{"web.default.example.com"}))
+				// and SPIFFE URI is still there
+				Expect(cert.URIs).To(HaveLen(1))
+				Expect(cert.URIs[0].String()).To(Equal("spiffe://default/web"))
+			})
+
+			It("should generate dataplane cert with additional DNS names", func() {
+				// given
+				cfg := provided_config.ProvidedCertificateAuthorityConfig{}
+				Expect(proto.ToTyped(backendWithTestCerts.Config, &cfg)).To(Succeed())
+				cfg.LeafTemplate.DnsNames = []string{
+					"{{service}}.{{mesh}}.svc.cluster.local",
+					"{{service}}.{{mesh}}.example.com",
+					"{{service}}.legacy.example.com",
+				}
+				str, err := proto.ToStruct(&cfg)
+				Expect(err).ToNot(HaveOccurred())
+				backendWithTestCerts.Config = &str
+
+				// when
+				pair, err := caManager.GenerateDataplaneCert(context.Background(), "default", backendWithTestCerts, "web", 0)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				block, _ := pem.Decode(pair.CertPEM)
+				cert, err := x509.ParseCertificate(block.Bytes)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cert.DNSNames).To(Equal([]string{
+					"web.default.example.com",
+					"web.default.svc.cluster.local",
+					"web.legacy.example.com",
+				}))
+				// and SPIFFE URI is still there
+				Expect(cert.URIs).To(HaveLen(1))
+				Expect(cert.URIs[0].String()).To(Equal("spiffe://default/web"))
+			})
+
+			It("should reject a service that violates the template", func() {
+				// when
+				_, err := caManager.GenerateDataplaneCert(context.Background(), "default", backendWithTestCerts, "web.demo.svc:80", 0)
+
+				// then
+				Expect(err).To(MatchError(`service "web.demo.svc:80" violates naming policy of backend "provided-1" in Mesh "default": Common Name "web.demo.svc:80.default.example.com" of a certificate for service "web.demo.svc:80" is not a valid DNS name`))
+			})
+		})
+
+		It("should sign dataplane cert with the issuer and append it to the chain", func() {
+			// given
+			issuerPEM, err := ioutil.ReadFile(filepath.Join("testdata", "intermediate.pem"))
+			Expect(err).ToNot(HaveOccurred())
+			issuerBlock, _ := pem.Decode(issuerPEM)
+			issuer, err := x509.ParseCertificate(issuerBlock.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			pair, err := caManager.GenerateDataplaneCert(context.Background(), "default", backendWithIssuer, "web", 0)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			leafBlock, rest := pem.Decode(pair.CertPEM)
+			leaf, err := x509.ParseCertificate(leafBlock.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(leaf.CheckSignatureFrom(issuer)).To(Succeed())
+
+			// and the issuer cert should follow the leaf
+			chainBlock, rest := pem.Decode(rest)
+			Expect(chainBlock).ToNot(BeNil())
+			Expect(chainBlock.Bytes).To(Equal(issuerBlock.Bytes))
+			Expect(bytes.TrimSpace(rest)).To(BeEmpty())
+
+			// and peers should verify the chain against trust anchors only
+			roots, err := caManager.GetRootCert(context.Background(), "default", backendWithIssuer)
+			Expect(err).ToNot(HaveOccurred())
+			pool := x509.NewCertPool()
+			for _, root := range roots {
+				Expect(pool.AppendCertsFromPEM(root)).To(BeTrue())
+			}
+			intermediates := x509.NewCertPool()
+			intermediates.AddCert(issuer)
+			for _, usage := range []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth} {
+				_, err = leaf.Verify(x509.VerifyOptions{Roots: pool, Intermediates: intermediates, KeyUsages: []x509.ExtKeyUsage{usage}})
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		It("should sign dataplane cert with the intermediate cert of a chain and append the chain", func() {
+			// given
+			chainPEM, err := ioutil.ReadFile(filepath.Join("testdata", "intermediate-chain.pem"))
+			Expect(err).ToNot(HaveOccurred())
+			issuerBlock, _ := pem.Decode(chainPEM)
+			issuer, err := x509.ParseCertificate(issuerBlock.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			pair, err := caManager.GenerateDataplaneCert(context.Background(), "default", backendWithChain, "web", 0)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			leafBlock, rest := pem.Decode(pair.CertPEM)
+			leaf, err := x509.ParseCertificate(leafBlock.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(leaf.CheckSignatureFrom(issuer)).To(Succeed())
+
+			// and the chain should follow the leaf
+			Expect(rest).To(Equal(chainPEM))
+
+			// and peers should verify the leaf against the root cert of the chain
+			roots, err := caManager.GetRootCert(context.Background(), "default", backendWithChain)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(roots).To(HaveLen(2))
+			pool := x509.NewCertPool()
+			Expect(pool.AppendCertsFromPEM(roots[1])).To(BeTrue())
+			intermediates := x509.NewCertPool()
+			intermediates.AddCert(issuer)
+			_, err = leaf.Verify(x509.VerifyOptions{Roots: pool, Intermediates: intermediates})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should throw an error when the key cannot be loaded", func() {
+			// when
+			_, err := caManager.GenerateDataplaneCert(context.Background(), "default", backendWithoutKey, "web", 0)
+
+			// then
+			Expect(err).To(MatchError(`failed to load CA key pair for Mesh "default" and backend "provided-3": could not load key: could not load data: open testdata/offline.key: no such file or directory`))
 		})
 
 		It("should throw an error on invalid certs", func() {
 			// when
-			_, err := caManager.GenerateDataplaneCert(context.Background(), "default", backendWithInvalidCerts, "web")
+			_, err := caManager.GenerateDataplaneCert(context.Background(), "default", backendWithInvalidCerts, "web", 0)
+
+			// then
+			Expect(err).To(MatchError(`failed to load CA key pair for Mesh "default" and backend "provided-2": could not load cert: could not load data: open testdata/invalid.pem: no such file or directory`))
+		})
+	})
+
+	Context("GetCRL", func() {
+		It("should sign CRL with the provided CA", func() {
+			// given
+			revoked := []core_ca.RevokedCert{
+				{
+					SerialNumber: big.NewInt(42),
+					NotAfter:     time.Now().Add(time.Hour),
+					RevokedAt:    time.Now(),
+				},
+			}
+
+			// when
+			data, err := caManager.GetCRL(context.Background(), "default", backendWithTestCerts, revoked)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			block, rest := pem.Decode(data)
+			Expect(block.Type).To(Equal("X509 CRL"))
+			Expect(rest).To(BeEmpty())
+			crl, err := x509.ParseCRL(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(crl.TBSCertList.RevokedCertificates).To(HaveLen(1))
+			Expect(crl.TBSCertList.RevokedCertificates[0].SerialNumber).To(Equal(big.NewInt(42)))
+			// and
+			roots, err := caManager.GetRootCert(context.Background(), "default", backendWithTestCerts)
+			Expect(err).ToNot(HaveOccurred())
+			block, _ = pem.Decode(roots[0])
+			root, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(root.CheckCRLSignature(crl)).To(Succeed())
+		})
+
+		DescribeTable("should not sign CRL with an intermediate CA",
+			func(backend func() mesh_proto.CertificateAuthorityBackend) {
+				// given
+				revoked := []core_ca.RevokedCert{
+					{
+						SerialNumber: big.NewInt(42),
+						NotAfter:     time.Now().Add(time.Hour),
+						RevokedAt:    time.Now(),
+					},
+				}
+
+				// when
+				data, err := caManager.GetCRL(context.Background(), "default", backend(), revoked)
+
+				// then Envoy would require CRLs of parents of the intermediate CA as well
+				Expect(err).ToNot(HaveOccurred())
+				Expect(data).To(BeNil())
+			},
+			Entry("intermediate cert followed by the chain up to the root", func() mesh_proto.CertificateAuthorityBackend {
+				return backendWithChain
+			}),
+			Entry("intermediate issuer with trust anchors", func() mesh_proto.CertificateAuthorityBackend {
+				return backendWithIssuer
+			}),
+		)
+
+		It("should throw an error when the key cannot be loaded", func() {
+			// when
+			_, err := caManager.GetCRL(context.Background(), "default", backendWithoutKey, nil)
 
 			// then
-			Expect(err).To(MatchError(`failed to load CA key pair for Mesh "default" and backend "provided-2": could not load data: open testdata/invalid.key: no such file or directory`))
+			Expect(err).To(MatchError(`failed to load CA key pair for Mesh "default" and backend "provided-3": could not load key: could not load data: open testdata/offline.key: no such file or directory`))
 		})
 	})
 })
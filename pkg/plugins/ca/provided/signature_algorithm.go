@@ -0,0 +1,56 @@
+package provided
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/Kong/kuma/pkg/core/validators"
+
+	util_tls "github.com/Kong/kuma/pkg/tls"
+)
+
+// supportedSignatureAlgorithms maps signature algorithms that can be used to sign Dataplane certificates
+// to the type of CA key they require.
+var supportedSignatureAlgorithms = map[x509.SignatureAlgorithm]x509.PublicKeyAlgorithm{
+	x509.SHA256WithRSA:    x509.RSA,
+	x509.SHA384WithRSA:    x509.RSA,
+	x509.SHA512WithRSA:    x509.RSA,
+	x509.SHA256WithRSAPSS: x509.RSA,
+	x509.SHA384WithRSAPSS: x509.RSA,
+	x509.SHA512WithRSAPSS: x509.RSA,
+	x509.ECDSAWithSHA256:  x509.ECDSA,
+	x509.ECDSAWithSHA384:  x509.ECDSA,
+	x509.ECDSAWithSHA512:  x509.ECDSA,
+}
+
+func parseSignatureAlgorithm(name string) (x509.SignatureAlgorithm, error) {
+	var names []string
+	for algorithm := range supportedSignatureAlgorithms {
+		if algorithm.String() == name {
+			return algorithm, nil
+		}
+		names = append(names, algorithm.String())
+	}
+	sort.Strings(names)
+	return x509.UnknownSignatureAlgorithm, errors.Errorf("unsupported signature algorithm %q. Allowed values: %s", name, strings.Join(names, ", "))
+}
+
+func validateSignatureAlgorithm(signingPair util_tls.KeyPair, algorithm x509.SignatureAlgorithm) (verr validators.ValidationError) {
+	tlsKeyPair, err := tls.X509KeyPair(signingPair.CertPEM, signingPair.KeyPEM)
+	if err != nil {
+		return // reported by validateCaCert()
+	}
+	cert, err := x509.ParseCertificate(tlsKeyPair.Certificate[0])
+	if err != nil {
+		return // reported by validateCaCert()
+	}
+	if required := supportedSignatureAlgorithms[algorithm]; cert.PublicKeyAlgorithm != required {
+		verr.AddViolation("signatureAlgorithm", fmt.Sprintf("signature algorithm %q requires a CA key of type %s, got %s", algorithm, required, cert.PublicKeyAlgorithm))
+	}
+	return
+}
@@ -0,0 +1,293 @@
+package vault
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	util_http "github.com/Kong/kuma/pkg/util/http"
+)
+
+const (
+	timeout = 10 * time.Second
+
+	tokenHeader = "X-Vault-Token"
+)
+
+// vaultClient is a minimal client of the HTTP API of Vault that covers the PKI secrets engine
+// and the auth methods supported by the plugin.
+type vaultClient struct {
+	client util_http.Client
+	token  string
+	// denied is set once Vault rejects a request of the client, e.g. because its token has been revoked
+	denied int32
+}
+
+// newVaultClient creates a client of the Vault server at a given address.
+// If caCert is not empty, the certificate of the server is verified against it instead of system roots.
+func newVaultClient(address string, caCert []byte) (*vaultClient, error) {
+	baseURL, err := url.Parse(address)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse Vault URL")
+	}
+	httpClient := &http.Client{
+		Timeout: timeout,
+	}
+	if len(caCert) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, errors.New("CA certificate of the Vault server is not a valid PEM certificate")
+		}
+		httpClient.Transport = &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: pool},
+		}
+	}
+	return &vaultClient{
+		client: util_http.ClientWithBaseURL(httpClient, baseURL),
+	}, nil
+}
+
+// lease describes how long the token of a client is valid for.
+type lease struct {
+	// ttl is the remaining time to live of the token. Zero means that the token never expires, e.g. a root token.
+	ttl time.Duration
+	// renewable is true if the token can be renewed before it expires.
+	renewable bool
+}
+
+// loginWithToken uses a given token for subsequent requests and checks that Vault accepts it.
+func (c *vaultClient) loginWithToken(ctx context.Context, token string) (lease, error) {
+	c.token = strings.TrimSpace(token)
+	response := struct {
+		Data struct {
+			TTL       int64 `json:"ttl"`
+			Renewable bool  `json:"renewable"`
+		} `json:"data"`
+	}{}
+	if err := c.do(ctx, http.MethodGet, "/v1/auth/token/lookup-self", nil, &response); err != nil {
+		return lease{}, err
+	}
+	return lease{ttl: time.Duration(response.Data.TTL) * time.Second, renewable: response.Data.Renewable}, nil
+}
+
+// authResponse is a response of Vault that issues or renews a token.
+type authResponse struct {
+	Auth struct {
+		ClientToken   string `json:"client_token"`
+		LeaseDuration int64  `json:"lease_duration"`
+		Renewable     bool   `json:"renewable"`
+	} `json:"auth"`
+}
+
+func (r *authResponse) lease() lease {
+	return lease{ttl: time.Duration(r.Auth.LeaseDuration) * time.Second, renewable: r.Auth.Renewable}
+}
+
+// loginWithAppRole exchanges credentials of an AppRole for a token used for subsequent requests.
+func (c *vaultClient) loginWithAppRole(ctx context.Context, mountPath string, roleID string, secretID string) (lease, error) {
+	request := map[string]string{
+		"role_id":   roleID,
+		"secret_id": strings.TrimSpace(secretID),
+	}
+	response := authResponse{}
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/v1/auth/%s/login", mountPath), request, &response); err != nil {
+		return lease{}, err
+	}
+	if response.Auth.ClientToken == "" {
+		return lease{}, errors.New("Vault did not return a client token")
+	}
+	c.token = response.Auth.ClientToken
+	return response.lease(), nil
+}
+
+// renewToken extends the lease of the token of the client.
+func (c *vaultClient) renewToken(ctx context.Context) (lease, error) {
+	response := authResponse{}
+	if err := c.do(ctx, http.MethodPost, "/v1/auth/token/renew-self", map[string]string{}, &response); err != nil {
+		return lease{}, err
+	}
+	return response.lease(), nil
+}
+
+// isDenied returns true if Vault has rejected a request of the client, so it has to log in again.
+func (c *vaultClient) isDenied() bool {
+	return atomic.LoadInt32(&c.denied) == 1
+}
+
+// caCert returns the PEM certificate of the CA of a PKI secrets engine.
+func (c *vaultClient) caCert(ctx context.Context, pkiPath string) ([]byte, error) {
+	return c.send(ctx, http.MethodGet, fmt.Sprintf("/v1/%s/ca/pem", pkiPath), nil)
+}
+
+// caChain returns PEM certificates of the chain of the CA of a PKI secrets engine, which is empty for a root CA.
+// Depending on the version of Vault, the chain may or may not include the CA itself.
+func (c *vaultClient) caChain(ctx context.Context, pkiPath string) ([]byte, error) {
+	response := struct {
+		Data struct {
+			Certificate string `json:"certificate"`
+		} `json:"data"`
+	}{}
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/v1/%s/cert/ca_chain", pkiPath), nil, &response); err != nil {
+		return nil, err
+	}
+	return []byte(response.Data.Certificate), nil
+}
+
+// sign signs a PEM certificate request with a given role of a PKI secrets engine and returns the PEM certificate.
+// Zero ttl means the TTL of the role.
+func (c *vaultClient) sign(ctx context.Context, pkiPath string, role string, csr []byte, uriSANs string, ttl time.Duration) ([]byte, error) {
+	request := map[string]string{
+		"csr":      string(csr),
+		"uri_sans": uriSANs,
+		"format":   "pem",
+	}
+	if ttl != 0 {
+		request["ttl"] = fmt.Sprintf("%ds", int64(ttl/time.Second))
+	}
+	response := struct {
+		Data struct {
+			Certificate string `json:"certificate"`
+		} `json:"data"`
+	}{}
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/v1/%s/sign/%s", pkiPath, role), request, &response); err != nil {
+		return nil, err
+	}
+	if response.Data.Certificate == "" {
+		return nil, errors.New("Vault did not return a certificate")
+	}
+	return []byte(response.Data.Certificate), nil
+}
+
+// revoke revokes a certificate of a given serial number issued by a PKI secrets engine,
+// so that it is listed in the CRL of the engine.
+func (c *vaultClient) revoke(ctx context.Context, pkiPath string, serialNumber *big.Int) error {
+	request := map[string]string{
+		"serial_number": formatSerialNumber(serialNumber),
+	}
+	return c.do(ctx, http.MethodPost, fmt.Sprintf("/v1/%s/revoke", pkiPath), request, nil)
+}
+
+// crl returns the PEM CRL of the CA of a PKI secrets engine.
+func (c *vaultClient) crl(ctx context.Context, pkiPath string) ([]byte, error) {
+	return c.send(ctx, http.MethodGet, fmt.Sprintf("/v1/%s/crl/pem", pkiPath), nil)
+}
+
+// formatSerialNumber formats a serial number the way Vault does, i.e. as colon-separated hex bytes.
+func formatSerialNumber(serialNumber *big.Int) string {
+	data := serialNumber.Bytes()
+	if len(data) == 0 {
+		data = []byte{0}
+	}
+	hexBytes := make([]string, len(data))
+	for i, b := range data {
+		hexBytes[i] = fmt.Sprintf("%02x", b)
+	}
+	return strings.Join(hexBytes, ":")
+}
+
+func (c *vaultClient) newRequest(ctx context.Context, method string, path string, request interface{}) (*http.Request, error) {
+	var body []byte
+	if request != nil {
+		var err error
+		if body, err = json.Marshal(request); err != nil {
+			return nil, errors.Wrap(err, "could not marshal the request to json")
+		}
+	}
+	req, err := http.NewRequest(method, path, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not construct the request")
+	}
+	if request != nil {
+		req.Header.Set("content-type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set(tokenHeader, c.token)
+	}
+	return req.WithContext(ctx), nil
+}
+
+// do executes a JSON request and unmarshals the JSON response into a given value, unless it is nil.
+func (c *vaultClient) do(ctx context.Context, method string, path string, request interface{}, response interface{}) error {
+	body, err := c.send(ctx, method, path, request)
+	if err != nil {
+		return err
+	}
+	if response == nil {
+		return nil
+	}
+	if err := json.Unmarshal(body, response); err != nil {
+		return errors.Wrap(err, "could not unmarshal the response")
+	}
+	return nil
+}
+
+// send executes a request and returns the body of a successful response.
+func (c *vaultClient) send(ctx context.Context, method string, path string, request interface{}) ([]byte, error) {
+	req, err := c.newRequest(ctx, method, path, request)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not execute the request")
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read a body of the response")
+	}
+	if resp.StatusCode != http.StatusOK {
+		if resp.StatusCode == http.StatusForbidden {
+			atomic.StoreInt32(&c.denied, 1)
+		}
+		return nil, responseError(resp.StatusCode, body)
+	}
+	return body, nil
+}
+
+// vaultError is an error of a failed request including errors reported by Vault, if any.
+type vaultError struct {
+	statusCode int
+	errors     []string
+}
+
+func (e *vaultError) Error() string {
+	if len(e.errors) == 0 {
+		return fmt.Sprintf("unexpected status code %d. Expected 200", e.statusCode)
+	}
+	return fmt.Sprintf("unexpected status code %d. Expected 200: %s", e.statusCode, strings.Join(e.errors, ", "))
+}
+
+func responseError(statusCode int, body []byte) error {
+	response := struct {
+		Errors []string `json:"errors"`
+	}{}
+	_ = json.Unmarshal(body, &response)
+	return &vaultError{statusCode: statusCode, errors: response.Errors}
+}
+
+// isCertNotFound returns true if Vault has rejected a request, because a PKI secrets engine has not issued a given certificate.
+func isCertNotFound(err error) bool {
+	vaultErr, ok := errors.Cause(err).(*vaultError)
+	if !ok || vaultErr.statusCode != http.StatusBadRequest {
+		return false
+	}
+	for _, msg := range vaultErr.errors {
+		if strings.Contains(msg, "not found") {
+			return true
+		}
+	}
+	return false
+}
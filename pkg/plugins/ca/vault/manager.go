@@ -0,0 +1,508 @@
+package vault
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core"
+	"github.com/Kong/kuma/pkg/core/ca"
+	"github.com/Kong/kuma/pkg/core/datasource"
+	"github.com/Kong/kuma/pkg/core/validators"
+	"github.com/Kong/kuma/pkg/plugins/ca/vault/config"
+	util_tls "github.com/Kong/kuma/pkg/tls"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+var log = core.Log.WithName("ca-vault")
+
+const (
+	defaultPkiPath          = "pki"
+	defaultAppRoleMountPath = "approle"
+	rsaBits                 = 2048
+)
+
+// vaultCaManager signs Dataplane certificates with the PKI secrets engine of Vault,
+// so that the key of the CA never leaves Vault. Private keys of Dataplane certificates are generated
+// by the Control Plane and only certificate requests are sent to Vault.
+//
+// Authenticated clients are reused per Mesh and backend until their tokens are close to expiry,
+// so that the Control Plane does not log in to Vault on every request.
+type vaultCaManager struct {
+	dataSourceLoader datasource.Loader
+	now              func() time.Time
+
+	// mu guards connections, it is never held across requests to Vault
+	mu          sync.Mutex
+	connections map[connectionKey]*connection
+
+	// locks serialize connecting to Vault per Mesh and backend, so that a slow Vault server of one backend
+	// does not block clients of others
+	locks   map[connectionKey]*sync.Mutex
+	locksMu sync.Mutex
+}
+
+var _ ca.Manager = &vaultCaManager{}
+
+type OptionFunc func(*vaultCaManager)
+
+// WithClock overrides the clock used to tell whether tokens are close to expiry.
+func WithClock(now func() time.Time) OptionFunc {
+	return func(v *vaultCaManager) {
+		v.now = now
+	}
+}
+
+func NewVaultCaManager(dataSourceLoader datasource.Loader, fs ...OptionFunc) ca.Manager {
+	v := &vaultCaManager{
+		dataSourceLoader: dataSourceLoader,
+		now:              time.Now,
+		connections:      map[connectionKey]*connection{},
+		locks:            map[connectionKey]*sync.Mutex{},
+	}
+	for _, f := range fs {
+		f(v)
+	}
+	return v
+}
+
+type connectionKey struct {
+	mesh    string
+	backend string
+}
+
+// connection is a client of the Vault server of a backend authenticated with a token that has a lease.
+type connection struct {
+	// config of the backend the client has been created for
+	config *structpb.Struct
+	// token loaded from the data source when the token auth method is used
+	token  string
+	client *vaultClient
+	// renewAt is the time after which the token is close to expiry. Zero means that the token never expires.
+	renewAt   time.Time
+	renewable bool
+}
+
+func (c *connection) setLease(lease lease, now time.Time) {
+	c.renewable = lease.renewable
+	if lease.ttl == 0 {
+		c.renewAt = time.Time{}
+		return
+	}
+	// the token is renewed once less than a third of its lease is left
+	c.renewAt = now.Add(lease.ttl * 2 / 3)
+}
+
+func (v *vaultCaManager) ValidateBackend(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend) error {
+	verr := validators.ValidationError{}
+
+	cfg := &config.VaultCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Config, cfg); err != nil {
+		verr.AddViolation("", "could not convert backend config: "+err.Error())
+		return verr.OrNil()
+	}
+
+	if cfg.GetAddress() == "" {
+		verr.AddViolation("address", "has to be defined")
+	} else if address, err := url.Parse(cfg.GetAddress()); err != nil || (address.Scheme != "http" && address.Scheme != "https") || address.Host == "" {
+		verr.AddViolation("address", "has to be a valid URL with http or https scheme")
+	}
+	if cfg.GetRole() == "" {
+		verr.AddViolation("role", "has to be defined")
+	}
+	if cfg.GetCaCert() != nil {
+		verr.AddError("caCert", datasource.Validate(cfg.GetCaCert()))
+	}
+	auth := cfg.GetAuth()
+	switch {
+	case auth.GetToken() == nil && auth.GetAppRole() == nil:
+		verr.AddViolation("auth", "either token or appRole has to be defined")
+	case auth.GetToken() != nil && auth.GetAppRole() != nil:
+		verr.AddViolation("auth.appRole", "cannot be defined together with token")
+	case auth.GetToken() != nil:
+		verr.AddError("auth.token", datasource.Validate(auth.GetToken()))
+	default:
+		if auth.GetAppRole().GetRoleId() == "" {
+			verr.AddViolation("auth.appRole.roleId", "has to be defined")
+		}
+		if auth.GetAppRole().GetSecretId() == nil {
+			verr.AddViolation("auth.appRole.secretId", "has to be defined")
+		} else {
+			verr.AddError("auth.appRole.secretId", datasource.Validate(auth.GetAppRole().GetSecretId()))
+		}
+	}
+
+	if !verr.HasViolations() {
+		client, err := v.newClient(ctx, mesh, cfg)
+		if err != nil {
+			verr.AddViolation("caCert", err.Error())
+			return verr.OrNil()
+		}
+		if _, err := v.login(ctx, mesh, cfg, client); err != nil {
+			verr.AddViolation("auth", err.Error())
+			return verr.OrNil()
+		}
+		if _, err := v.getRootCerts(ctx, cfg, client); err != nil {
+			verr.AddViolation("pkiPath", err.Error())
+		}
+	}
+	return verr.OrNil()
+}
+
+// newClient creates a client of the Vault server of a backend that is not authenticated yet.
+func (v *vaultCaManager) newClient(ctx context.Context, mesh string, cfg *config.VaultCertificateAuthorityConfig) (*vaultClient, error) {
+	var caCert []byte
+	if cfg.GetCaCert() != nil {
+		data, err := v.dataSourceLoader.Load(ctx, mesh, cfg.GetCaCert())
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load CA certificate of the Vault server")
+		}
+		caCert = data
+	}
+	return newVaultClient(cfg.GetAddress(), caCert)
+}
+
+// loadToken loads the token of a backend that uses the token auth method.
+func (v *vaultCaManager) loadToken(ctx context.Context, mesh string, cfg *config.VaultCertificateAuthorityConfig) (string, error) {
+	token, err := v.dataSourceLoader.Load(ctx, mesh, cfg.GetAuth().GetToken())
+	if err != nil {
+		return "", errors.Wrap(err, "could not load token")
+	}
+	return string(token), nil
+}
+
+// login authenticates a client with the auth method of a backend and returns the lease of the token.
+// Credentials are loaded on every login, so that rotated credentials are picked up.
+func (v *vaultCaManager) login(ctx context.Context, mesh string, cfg *config.VaultCertificateAuthorityConfig, client *vaultClient) (lease, error) {
+	auth := cfg.GetAuth()
+	if auth.GetToken() != nil {
+		token, err := v.loadToken(ctx, mesh, cfg)
+		if err != nil {
+			return lease{}, err
+		}
+		lease, err := client.loginWithToken(ctx, token)
+		if err != nil {
+			return lease, errors.Wrap(err, "could not authenticate to Vault with token")
+		}
+		return lease, nil
+	}
+	appRole := auth.GetAppRole()
+	secretID, err := v.dataSourceLoader.Load(ctx, mesh, appRole.GetSecretId())
+	if err != nil {
+		return lease{}, errors.Wrap(err, "could not load secret ID")
+	}
+	mountPath := appRole.GetMountPath()
+	if mountPath == "" {
+		mountPath = defaultAppRoleMountPath
+	}
+	lease, err := client.loginWithAppRole(ctx, mountPath, appRole.GetRoleId(), string(secretID))
+	if err != nil {
+		return lease, errors.Wrap(err, "could not authenticate to Vault with AppRole")
+	}
+	return lease, nil
+}
+
+// connect returns an authenticated client of the Vault server of a backend.
+// The client of a previous call is reused unless the config of the backend or its token has changed,
+// Vault has denied a request of the client or its token is close to expiry and cannot be renewed.
+func (v *vaultCaManager) connect(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend) (*config.VaultCertificateAuthorityConfig, *vaultClient, error) {
+	cfg := &config.VaultCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Config, cfg); err != nil {
+		return nil, nil, errors.Wrap(err, "could not convert backend config to VaultCertificateAuthorityConfig")
+	}
+
+	key := connectionKey{mesh: mesh, backend: backend.Name}
+	lock := v.lockFor(key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	if conn, ok := v.connectionOf(key); ok && v.reuse(ctx, mesh, backend, cfg, conn) {
+		return cfg, conn.client, nil
+	}
+	v.setConnection(key, nil)
+
+	client, err := v.newClient(ctx, mesh, cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	lease, err := v.login(ctx, mesh, cfg, client)
+	if err != nil {
+		return nil, nil, err
+	}
+	conn := &connection{
+		config: proto.Clone(backend.Config).(*structpb.Struct),
+		client: client,
+	}
+	if cfg.GetAuth().GetToken() != nil {
+		conn.token = client.token
+	}
+	conn.setLease(lease, v.now())
+	v.setConnection(key, conn)
+	return cfg, client, nil
+}
+
+// lockFor returns a lock that serializes connecting to Vault for a given Mesh and backend.
+func (v *vaultCaManager) lockFor(key connectionKey) *sync.Mutex {
+	v.locksMu.Lock()
+	defer v.locksMu.Unlock()
+	lock, ok := v.locks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		v.locks[key] = lock
+	}
+	return lock
+}
+
+func (v *vaultCaManager) connectionOf(key connectionKey) (*connection, bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	conn, ok := v.connections[key]
+	return conn, ok
+}
+
+// setConnection replaces the connection of a given key, nil removes it.
+func (v *vaultCaManager) setConnection(key connectionKey, conn *connection) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if conn == nil {
+		delete(v.connections, key)
+		return
+	}
+	v.connections[key] = conn
+}
+
+// reuse returns true if a connection can still be used, renewing its token if it is close to expiry.
+func (v *vaultCaManager) reuse(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend, cfg *config.VaultCertificateAuthorityConfig, conn *connection) bool {
+	if !proto.Equal(conn.config, backend.Config) || conn.client.isDenied() {
+		return false
+	}
+	if cfg.GetAuth().GetToken() != nil {
+		token, err := v.loadToken(ctx, mesh, cfg)
+		if err != nil || strings.TrimSpace(token) != conn.token {
+			return false
+		}
+	}
+	now := v.now()
+	if conn.renewAt.IsZero() || now.Before(conn.renewAt) {
+		return true
+	}
+	if !conn.renewable {
+		return false
+	}
+	lease, err := conn.client.renewToken(ctx)
+	if err != nil {
+		log.Info("could not renew Vault token, logging in again", "mesh", mesh, "backend", backend.Name, "err", err.Error())
+		return false
+	}
+	conn.setLease(lease, now)
+	return true
+}
+
+func pkiPathOf(cfg *config.VaultCertificateAuthorityConfig) string {
+	if cfg.GetPkiPath() == "" {
+		return defaultPkiPath
+	}
+	return cfg.GetPkiPath()
+}
+
+// getRootCerts fetches the certificate of the CA of the PKI secrets engine followed by the chain up to the root CA
+// it might be signed by, which are trust anchors of Dataplane certificates.
+func (v *vaultCaManager) getRootCerts(ctx context.Context, cfg *config.VaultCertificateAuthorityConfig, client *vaultClient) ([]ca.Cert, error) {
+	cert, err := client.caCert(ctx, pkiPathOf(cfg))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch CA certificate from Vault")
+	}
+	block, _ := pem.Decode(cert)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("CA certificate fetched from Vault is not a valid PEM certificate")
+	}
+	chain, err := client.caChain(ctx, pkiPathOf(cfg))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch CA chain from Vault")
+	}
+	certs := []ca.Cert{cert}
+	for {
+		var parent *pem.Block
+		parent, chain = pem.Decode(chain)
+		if parent == nil {
+			return certs, nil
+		}
+		if parent.Type != "CERTIFICATE" || bytes.Equal(parent.Bytes, block.Bytes) {
+			continue
+		}
+		if _, err := x509.ParseCertificate(parent.Bytes); err != nil {
+			return nil, errors.Wrap(err, "CA chain fetched from Vault is not a valid PEM chain")
+		}
+		certs = append(certs, pem.EncodeToMemory(parent))
+	}
+}
+
+func (v *vaultCaManager) Ensure(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend) error {
+	return nil // CA is created by user in Vault and pointed in the configuration which is validated first
+}
+
+func (v *vaultCaManager) GetRootCert(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend) ([]ca.Cert, error) {
+	cfg, client, err := v.connect(ctx, mesh, backend)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to Vault for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	certs, err := v.getRootCerts(ctx, cfg, client)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load CA cert for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	return certs, nil
+}
+
+func (v *vaultCaManager) GetTrustBundle(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend) ([]byte, error) {
+	certs, err := v.GetRootCert(ctx, mesh, backend)
+	if err != nil {
+		return nil, err
+	}
+	return ca.NewTrustBundle(certs)
+}
+
+func (v *vaultCaManager) GenerateDataplaneCert(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend, service string, validity time.Duration) (ca.KeyPair, error) {
+	if err := ca.ValidateServiceName(service); err != nil {
+		return ca.KeyPair{}, errors.Wrapf(err, "could not generate a Workload Identity cert in Mesh %q", mesh)
+	}
+	cfg, client, err := v.connect(ctx, mesh, backend)
+	if err != nil {
+		return ca.KeyPair{}, errors.Wrapf(err, "failed to connect to Vault for Mesh %q and backend %q", mesh, backend.Name)
+	}
+
+	spiffeID := ca.ServiceSpiffeID(mesh, service)
+	key, csr, err := newCertificateRequest(spiffeID)
+	if err != nil {
+		return ca.KeyPair{}, errors.Wrapf(err, "failed to generate a certificate request for workload %q in Mesh %q", service, mesh)
+	}
+	cert, err := client.sign(ctx, pkiPathOf(cfg), cfg.GetRole(), csr, spiffeID, validity)
+	if err != nil {
+		return ca.KeyPair{}, errors.Wrapf(err, "failed to sign a Workload Identity cert for workload %q in Mesh %q using backend %q", service, mesh, backend.Name)
+	}
+	block, _ := pem.Decode(cert)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return ca.KeyPair{}, errors.Errorf("certificate signed by Vault for workload %q in Mesh %q is not a valid PEM certificate", service, mesh)
+	}
+	keyPair, err := util_tls.ToKeyPair(key, block.Bytes)
+	if err != nil {
+		return ca.KeyPair{}, err
+	}
+	return *keyPair, nil
+}
+
+// GetCRL returns the CRL of the PKI secrets engine after revoking in Vault the certs it does not list yet,
+// since CRLs can only be signed by Vault, which holds the key of the CA.
+// Only certs issued by the backend are revoked. Vault does not know certs issued before the CA of the engine
+// has been replaced, they are skipped, since they are not trusted anymore anyway.
+func (v *vaultCaManager) GetCRL(ctx context.Context, mesh string, backend mesh_proto.CertificateAuthorityBackend, revoked []ca.RevokedCert) ([]byte, error) {
+	cfg, client, err := v.connect(ctx, mesh, backend)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to Vault for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	roots, err := v.getRootCerts(ctx, cfg, client)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load CA cert for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	// the PKI secrets engine of an intermediate CA signs only its own CRL, not CRLs of its parents
+	distributed := len(roots) == 1 && isSelfSigned(roots[0])
+	crl, listed, err := v.getCRL(ctx, cfg, client)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load CRL for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	missing := false
+	for _, cert := range revoked {
+		if cert.Backend != backend.Name || listed[cert.SerialNumber.String()] {
+			continue
+		}
+		err := client.revoke(ctx, pkiPathOf(cfg), cert.SerialNumber)
+		if isCertNotFound(err) {
+			log.Info("skipping revocation of a cert that Vault has not issued", "mesh", mesh, "backend", backend.Name, "serialNumber", cert.SerialNumber.String())
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to revoke cert %s in Vault for Mesh %q and backend %q", cert.SerialNumber, mesh, backend.Name)
+		}
+		missing = true
+	}
+	if !distributed {
+		// certs are revoked in Vault anyway, so that they are rejected by anything else that checks its CRL
+		if missing {
+			log.Info("certs have been revoked in Vault, but no CRL is distributed to dataplanes, since Vault is configured with an intermediate CA", "mesh", mesh, "backend", backend.Name)
+		}
+		return nil, nil
+	}
+	if !missing {
+		return crl, nil
+	}
+	crl, _, err = v.getCRL(ctx, cfg, client)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load CRL for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	return crl, nil
+}
+
+// isSelfSigned returns true if a PEM certificate is signed by itself, i.e. it is a root CA.
+func isSelfSigned(certPEM []byte) bool {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return false
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return false
+	}
+	return cert.CheckSignatureFrom(cert) == nil
+}
+
+// getCRL fetches the CRL of the PKI secrets engine together with serial numbers of certs it lists.
+func (v *vaultCaManager) getCRL(ctx context.Context, cfg *config.VaultCertificateAuthorityConfig, client *vaultClient) ([]byte, map[string]bool, error) {
+	data, err := client.crl(ctx, pkiPathOf(cfg))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not fetch CRL from Vault")
+	}
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "X509 CRL" {
+		return nil, nil, errors.New("CRL fetched from Vault is not a valid PEM CRL")
+	}
+	crl, err := x509.ParseCRL(block.Bytes)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "CRL fetched from Vault is not a valid PEM CRL")
+	}
+	listed := map[string]bool{}
+	for _, cert := range crl.TBSCertList.RevokedCertificates {
+		listed[cert.SerialNumber.String()] = true
+	}
+	return data, listed, nil
+}
+
+// newCertificateRequest generates a private key and a PEM certificate request for it that carries a SPIFFE URI SAN.
+func newCertificateRequest(spiffeID string) (*rsa.PrivateKey, []byte, error) {
+	uri, err := url.Parse(spiffeID)
+	if err != nil {
+		return nil, nil, err
+	}
+	key, err := rsa.GenerateKey(rand.Reader, rsaBits)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to generate a private key")
+	}
+	template := &x509.CertificateRequest{
+		URIs: []*url.URL{uri},
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}), nil
+}
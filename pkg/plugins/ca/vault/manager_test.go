@@ -0,0 +1,742 @@
+package vault_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	core_ca "github.com/Kong/kuma/pkg/core/ca"
+	"github.com/Kong/kuma/pkg/core/datasource"
+	"github.com/Kong/kuma/pkg/plugins/ca/vault"
+	"github.com/Kong/kuma/pkg/util/proto"
+)
+
+// fakeVault serves the subset of the HTTP API of Vault used by the plugin.
+// It accepts the `s.root` token, which never expires, and the `kuma` AppRole with the `secret` secret ID,
+// which issues tokens with a renewable lease of `leaseDuration` seconds,
+// and signs certificate requests with the `dataplanes` role of the PKI secrets engine mounted at `pki`.
+// Only certs of serial numbers in `issued` can be revoked.
+type fakeVault struct {
+	caCert        []byte
+	caChain       []byte
+	caKey         interface{}
+	ca            *x509.Certificate
+	tokens        map[string]bool
+	leaseDuration int
+	logins        int
+	renewals      int
+	issued        map[string]bool
+	revoked       []string
+}
+
+func newFakeVault() *fakeVault {
+	f := &fakeVault{
+		tokens:        map[string]bool{"s.root": true},
+		leaseDuration: 3600,
+		issued:        map[string]bool{},
+	}
+	f.loadCa("ca")
+	return f
+}
+
+// loadCa replaces the CA of the PKI secrets engine with a given one from testdata
+// followed by a chain of given parent CAs.
+func (f *fakeVault) loadCa(name string, parents ...string) {
+	caCert, err := ioutil.ReadFile(filepath.Join("testdata", name+".pem"))
+	Expect(err).ToNot(HaveOccurred())
+	caKey, err := ioutil.ReadFile(filepath.Join("testdata", name+".key"))
+	Expect(err).ToNot(HaveOccurred())
+	pair, err := tls.X509KeyPair(caCert, caKey)
+	Expect(err).ToNot(HaveOccurred())
+	ca, err := x509.ParseCertificate(pair.Certificate[0])
+	Expect(err).ToNot(HaveOccurred())
+	f.caCert = caCert
+	f.caKey = pair.PrivateKey
+	f.ca = ca
+	// like recent versions of Vault, the chain includes the CA itself
+	f.caChain = caCert
+	for _, parent := range parents {
+		parentCert, err := ioutil.ReadFile(filepath.Join("testdata", parent+".pem"))
+		Expect(err).ToNot(HaveOccurred())
+		f.caChain = append(f.caChain, parentCert...)
+	}
+}
+
+func (f *fakeVault) ServeHTTP(writer http.ResponseWriter, req *http.Request) {
+	respond := func(status int, response interface{}) {
+		writer.WriteHeader(status)
+		Expect(json.NewEncoder(writer).Encode(response)).To(Succeed())
+	}
+	denied := func() {
+		respond(http.StatusForbidden, map[string]interface{}{"errors": []string{"permission denied"}})
+	}
+	request := map[string]string{}
+	if req.Method == http.MethodPost {
+		Expect(json.NewDecoder(req.Body).Decode(&request)).To(Succeed())
+	}
+
+	switch req.URL.Path {
+	case "/v1/auth/approle/login":
+		if request["role_id"] != "kuma" || request["secret_id"] != "secret" {
+			denied()
+			return
+		}
+		f.tokens["s.approle"] = true
+		f.logins++
+		respond(http.StatusOK, map[string]interface{}{"auth": map[string]interface{}{
+			"client_token":   "s.approle",
+			"lease_duration": f.leaseDuration,
+			"renewable":      true,
+		}})
+	case "/v1/auth/token/lookup-self":
+		if !f.tokens[req.Header.Get("X-Vault-Token")] {
+			denied()
+			return
+		}
+		f.logins++
+		respond(http.StatusOK, map[string]interface{}{"data": map[string]interface{}{"ttl": 0, "renewable": false}})
+	case "/v1/auth/token/renew-self":
+		if req.Header.Get("X-Vault-Token") != "s.approle" || !f.tokens["s.approle"] {
+			denied()
+			return
+		}
+		f.renewals++
+		respond(http.StatusOK, map[string]interface{}{"auth": map[string]interface{}{
+			"client_token":   "s.approle",
+			"lease_duration": f.leaseDuration,
+			"renewable":      true,
+		}})
+	case "/v1/pki/ca/pem":
+		_, _ = writer.Write(f.caCert)
+	case "/v1/pki/cert/ca_chain":
+		respond(http.StatusOK, map[string]interface{}{"data": map[string]string{"certificate": string(f.caChain)}})
+	case "/v1/pki/sign/dataplanes":
+		if !f.tokens[req.Header.Get("X-Vault-Token")] {
+			denied()
+			return
+		}
+		block, _ := pem.Decode([]byte(request["csr"]))
+		Expect(block).ToNot(BeNil())
+		csr, err := x509.ParseCertificateRequest(block.Bytes)
+		Expect(err).ToNot(HaveOccurred())
+		uri, err := url.Parse(request["uri_sans"])
+		Expect(err).ToNot(HaveOccurred())
+		ttl := time.Hour // TTL of the role
+		if request["ttl"] != "" {
+			ttl, err = time.ParseDuration(request["ttl"])
+			Expect(err).ToNot(HaveOccurred())
+		}
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			NotBefore:    time.Now().Add(-time.Minute),
+			NotAfter:     time.Now().Add(ttl),
+			KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+			URIs:         []*url.URL{uri},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, f.ca, csr.PublicKey, f.caKey)
+		Expect(err).ToNot(HaveOccurred())
+		cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+		respond(http.StatusOK, map[string]interface{}{"data": map[string]string{"certificate": string(cert)}})
+	case "/v1/pki/revoke":
+		if !f.tokens[req.Header.Get("X-Vault-Token")] {
+			denied()
+			return
+		}
+		if !f.issued[request["serial_number"]] {
+			respond(http.StatusBadRequest, map[string]interface{}{"errors": []string{fmt.Sprintf("certificate with serial %s not found.", request["serial_number"])}})
+			return
+		}
+		f.revoked = append(f.revoked, request["serial_number"])
+		respond(http.StatusOK, map[string]interface{}{"data": map[string]string{}})
+	case "/v1/pki/crl/pem":
+		var revoked []pkix.RevokedCertificate
+		for _, serial := range f.revoked {
+			serialNumber, ok := new(big.Int).SetString(strings.Replace(serial, ":", "", -1), 16)
+			Expect(ok).To(BeTrue())
+			revoked = append(revoked, pkix.RevokedCertificate{SerialNumber: serialNumber, RevocationTime: time.Now()})
+		}
+		der, err := f.ca.CreateCRL(rand.Reader, f.caKey, revoked, time.Now(), time.Now().Add(time.Hour))
+		Expect(err).ToNot(HaveOccurred())
+		_, _ = writer.Write(pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der}))
+	default:
+		respond(http.StatusNotFound, map[string]interface{}{"errors": []string{}})
+	}
+}
+
+var _ = Describe("Vault CA", func() {
+	var caManager core_ca.Manager
+	var vaultServer *fakeVault
+	var server *httptest.Server
+	var now time.Time
+
+	BeforeEach(func() {
+		now = time.Now()
+		caManager = vault.NewVaultCaManager(datasource.NewDataSourceLoader(nil), vault.WithClock(func() time.Time { return now }))
+		vaultServer = newFakeVault()
+		server = httptest.NewServer(vaultServer)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	backendOf := func(configYAML string) mesh_proto.CertificateAuthorityBackend {
+		str := structpb.Struct{}
+		err := proto.FromYAML([]byte(configYAML), &str)
+		Expect(err).ToNot(HaveOccurred())
+		return mesh_proto.CertificateAuthorityBackend{
+			Name:   "vault-1",
+			Type:   "vault",
+			Config: &str,
+		}
+	}
+
+	tokenBackend := func() mesh_proto.CertificateAuthorityBackend {
+		return backendOf(fmt.Sprintf(`
+        address: %s
+        role: dataplanes
+        auth:
+          token:
+            inline: cy5yb290 # s.root`, server.URL))
+	}
+
+	appRoleBackend := func() mesh_proto.CertificateAuthorityBackend {
+		return backendOf(fmt.Sprintf(`
+        address: %s
+        role: dataplanes
+        auth:
+          appRole:
+            roleId: kuma
+            secretId:
+              inline: c2VjcmV0 # secret`, server.URL))
+	}
+
+	Context("ValidateBackend", func() {
+		type testCase struct {
+			configYAML string
+			expected   string
+		}
+
+		DescribeTable("should Validate invalid config",
+			func(given testCase) {
+				// when
+				verr := caManager.ValidateBackend(context.Background(), "default", backendOf(given.configYAML))
+
+				// then
+				actual, err := yaml.Marshal(verr)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty config", testCase{
+				configYAML: ``,
+				expected: `
+            violations:
+            - field: address
+              message: has to be defined
+            - field: role
+              message: has to be defined
+            - field: auth
+              message: either token or appRole has to be defined`,
+			}),
+			Entry("config with invalid address", testCase{
+				configYAML: `
+            address: vault.example.com:8200
+            role: dataplanes
+            auth:
+              token:
+                inline: cy5yb290`,
+				expected: `
+            violations:
+            - field: address
+              message: has to be a valid URL with http or https scheme`,
+			}),
+			Entry("config with both auth methods", testCase{
+				configYAML: `
+            address: https://vault.example.com:8200
+            role: dataplanes
+            auth:
+              token:
+                inline: cy5yb290
+              appRole:
+                roleId: kuma`,
+				expected: `
+            violations:
+            - field: auth.appRole
+              message: cannot be defined together with token`,
+			}),
+			Entry("config with incomplete AppRole", testCase{
+				configYAML: `
+            address: https://vault.example.com:8200
+            role: dataplanes
+            auth:
+              appRole: {}`,
+				expected: `
+            violations:
+            - field: auth.appRole.roleId
+              message: has to be defined
+            - field: auth.appRole.secretId
+              message: has to be defined`,
+			}),
+			Entry("config without data sources", testCase{
+				configYAML: `
+            address: https://vault.example.com:8200
+            role: dataplanes
+            caCert: {}
+            auth:
+              token: {}`,
+				expected: `
+            violations:
+            - field: caCert
+              message: 'data source has to be chosen. Available sources: secret, file, inline, projected'
+            - field: auth.token
+              message: 'data source has to be chosen. Available sources: secret, file, inline, projected'`,
+			}),
+		)
+
+		It("should validate config against Vault", func() {
+			// when
+			verr := caManager.ValidateBackend(context.Background(), "default", tokenBackend())
+
+			// then
+			Expect(verr).ToNot(HaveOccurred())
+
+			// when
+			verr = caManager.ValidateBackend(context.Background(), "default", appRoleBackend())
+
+			// then
+			Expect(verr).ToNot(HaveOccurred())
+		})
+
+		It("should reject a token that Vault does not accept", func() {
+			// given
+			backend := backendOf(fmt.Sprintf(`
+            address: %s
+            role: dataplanes
+            auth:
+              token:
+                inline: d3Jvbmc= # wrong`, server.URL))
+
+			// when
+			verr := caManager.ValidateBackend(context.Background(), "default", backend)
+
+			// then
+			actual, err := yaml.Marshal(verr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(`
+            violations:
+            - field: auth
+              message: 'could not authenticate to Vault with token: unexpected status code 403. Expected 200: permission denied'`))
+		})
+
+		It("should reject a PKI path that does not exist", func() {
+			// given
+			backend := backendOf(fmt.Sprintf(`
+            address: %s
+            pkiPath: pki-other
+            role: dataplanes
+            auth:
+              token:
+                inline: cy5yb290`, server.URL))
+
+			// when
+			verr := caManager.ValidateBackend(context.Background(), "default", backend)
+
+			// then
+			actual, err := yaml.Marshal(verr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(`
+            violations:
+            - field: pkiPath
+              message: 'could not fetch CA certificate from Vault: unexpected status code 404. Expected 200'`))
+		})
+	})
+
+	Context("GetRootCert", func() {
+		It("should return the CA certificate of the PKI secrets engine", func() {
+			// when
+			roots, err := caManager.GetRootCert(context.Background(), "default", tokenBackend())
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			expected, err := ioutil.ReadFile(filepath.Join("testdata", "ca.pem"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(roots).To(Equal([]core_ca.Cert{expected}))
+		})
+
+		It("should return the chain of an intermediate CA", func() {
+			// given
+			vaultServer.loadCa("intermediate", "ca")
+
+			// when
+			roots, err := caManager.GetRootCert(context.Background(), "default", tokenBackend())
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			intermediate, err := ioutil.ReadFile(filepath.Join("testdata", "intermediate.pem"))
+			Expect(err).ToNot(HaveOccurred())
+			root, err := ioutil.ReadFile(filepath.Join("testdata", "ca.pem"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(roots).To(Equal([]core_ca.Cert{intermediate, root}))
+		})
+	})
+
+	Context("authentication", func() {
+		It("should reuse a token and renew it once it is close to expiry", func() {
+			// when
+			_, err := caManager.GetRootCert(context.Background(), "default", appRoleBackend())
+			Expect(err).ToNot(HaveOccurred())
+			_, err = caManager.GenerateDataplaneCert(context.Background(), "default", appRoleBackend(), "web", 0)
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(vaultServer.logins).To(Equal(1))
+			Expect(vaultServer.renewals).To(Equal(0))
+
+			// when less than a third of the lease is left
+			now = now.Add(41 * time.Minute)
+			_, err = caManager.GetRootCert(context.Background(), "default", appRoleBackend())
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vaultServer.logins).To(Equal(1))
+			Expect(vaultServer.renewals).To(Equal(1))
+
+			// when
+			now = now.Add(39 * time.Minute)
+			_, err = caManager.GetRootCert(context.Background(), "default", appRoleBackend())
+
+			// then the renewed lease is not close to expiry yet
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vaultServer.renewals).To(Equal(1))
+		})
+
+		It("should log in again once a token cannot be renewed", func() {
+			// given
+			_, err := caManager.GetRootCert(context.Background(), "default", appRoleBackend())
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			delete(vaultServer.tokens, "s.approle")
+
+			// when
+			now = now.Add(time.Hour)
+			_, err = caManager.GetRootCert(context.Background(), "default", appRoleBackend())
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vaultServer.logins).To(Equal(2))
+		})
+
+		It("should log in again once Vault denies a token", func() {
+			// given
+			_, err := caManager.GenerateDataplaneCert(context.Background(), "default", appRoleBackend(), "web", 0)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			delete(vaultServer.tokens, "s.approle")
+
+			// when
+			_, err = caManager.GenerateDataplaneCert(context.Background(), "default", appRoleBackend(), "web", 0)
+
+			// then
+			Expect(err).To(HaveOccurred())
+
+			// when
+			_, err = caManager.GenerateDataplaneCert(context.Background(), "default", appRoleBackend(), "web", 0)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vaultServer.logins).To(Equal(2))
+		})
+
+		It("should never renew a token that does not expire", func() {
+			// when
+			_, err := caManager.GetRootCert(context.Background(), "default", tokenBackend())
+			Expect(err).ToNot(HaveOccurred())
+			now = now.Add(24 * time.Hour)
+			_, err = caManager.GetRootCert(context.Background(), "default", tokenBackend())
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vaultServer.logins).To(Equal(1))
+			Expect(vaultServer.renewals).To(Equal(0))
+		})
+
+		It("should log in again once the config of a backend changes", func() {
+			// given
+			_, err := caManager.GetRootCert(context.Background(), "default", tokenBackend())
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			_, err = caManager.GetRootCert(context.Background(), "default", backendOf(fmt.Sprintf(`
+            address: %s
+            role: other
+            auth:
+              token:
+                inline: cy5yb290`, server.URL)))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vaultServer.logins).To(Equal(2))
+		})
+
+		It("should not block other backends while connecting to a slow Vault", func() {
+			// given Vault that does not respond to logins until released
+			entered := make(chan struct{})
+			release := make(chan struct{})
+			slowServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+				entered <- struct{}{}
+				<-release
+				writer.WriteHeader(http.StatusForbidden)
+			}))
+			defer slowServer.Close()
+			defer close(release)
+			slowBackend := backendOf(fmt.Sprintf(`
+            address: %s
+            role: dataplanes
+            auth:
+              token:
+                inline: cy5yb290`, slowServer.URL))
+			go func() {
+				defer GinkgoRecover()
+				_, _ = caManager.GetRootCert(context.Background(), "slow", slowBackend)
+			}()
+			Eventually(entered).Should(Receive())
+
+			// when
+			done := make(chan error)
+			go func() {
+				_, err := caManager.GetRootCert(context.Background(), "default", tokenBackend())
+				done <- err
+			}()
+
+			// then
+			var err error
+			Eventually(done).Should(Receive(&err))
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	Context("GenerateDataplaneCert", func() {
+		DescribeTable("should sign a Workload Identity cert with Vault",
+			func(backend func() mesh_proto.CertificateAuthorityBackend) {
+				// when
+				pair, err := caManager.GenerateDataplaneCert(context.Background(), "default", backend(), "web", 0)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				_, err = tls.X509KeyPair(pair.CertPEM, pair.KeyPEM)
+				Expect(err).ToNot(HaveOccurred())
+
+				// and
+				block, _ := pem.Decode(pair.CertPEM)
+				cert, err := x509.ParseCertificate(block.Bytes)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cert.URIs).To(HaveLen(1))
+				Expect(cert.URIs[0].String()).To(Equal("spiffe://default/web"))
+
+				// and
+				roots, err := caManager.GetRootCert(context.Background(), "default", backend())
+				Expect(err).ToNot(HaveOccurred())
+				pool := x509.NewCertPool()
+				Expect(pool.AppendCertsFromPEM(roots[0])).To(BeTrue())
+				_, err = cert.Verify(x509.VerifyOptions{
+					Roots:     pool,
+					KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+				})
+				Expect(err).ToNot(HaveOccurred())
+			},
+			Entry("with token", tokenBackend),
+			Entry("with AppRole", appRoleBackend),
+		)
+
+		It("should request a Workload Identity cert valid for a given period", func() {
+			// when
+			pair, err := caManager.GenerateDataplaneCert(context.Background(), "default", tokenBackend(), "web", 24*time.Hour)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			block, _ := pem.Decode(pair.CertPEM)
+			cert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cert.NotAfter).To(BeTemporally("~", time.Now().Add(24*time.Hour), time.Minute))
+		})
+
+		It("should fail when Vault rejects the credentials", func() {
+			// given
+			backend := backendOf(fmt.Sprintf(`
+            address: %s
+            role: dataplanes
+            auth:
+              appRole:
+                roleId: kuma
+                secretId:
+                  inline: d3Jvbmc= # wrong`, server.URL))
+
+			// when
+			_, err := caManager.GenerateDataplaneCert(context.Background(), "default", backend, "web", 0)
+
+			// then
+			Expect(err).To(MatchError(`failed to connect to Vault for Mesh "default" and backend "vault-1": could not authenticate to Vault with AppRole: unexpected status code 403. Expected 200: permission denied`))
+		})
+
+		It("should fail for a role that does not exist", func() {
+			// given
+			backend := backendOf(fmt.Sprintf(`
+            address: %s
+            role: other
+            auth:
+              token:
+                inline: cy5yb290`, server.URL))
+
+			// when
+			_, err := caManager.GenerateDataplaneCert(context.Background(), "default", backend, "web", 0)
+
+			// then
+			Expect(err).To(MatchError(`failed to sign a Workload Identity cert for workload "web" in Mesh "default" using backend "vault-1": unexpected status code 404. Expected 200`))
+		})
+	})
+
+	Context("GetCRL", func() {
+		It("should revoke certs in Vault and return its CRL", func() {
+			// given
+			vaultServer.issued["12:34"] = true
+			revoked := []core_ca.RevokedCert{
+				{
+					SerialNumber: big.NewInt(0x1234),
+					NotAfter:     time.Now().Add(time.Hour),
+					RevokedAt:    time.Now(),
+					Backend:      "vault-1",
+				},
+			}
+
+			// when
+			data, err := caManager.GetCRL(context.Background(), "default", tokenBackend(), revoked)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vaultServer.revoked).To(Equal([]string{"12:34"}))
+			// and
+			block, _ := pem.Decode(data)
+			Expect(block.Type).To(Equal("X509 CRL"))
+			crl, err := x509.ParseCRL(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(crl.TBSCertList.RevokedCertificates).To(HaveLen(1))
+			Expect(crl.TBSCertList.RevokedCertificates[0].SerialNumber).To(Equal(big.NewInt(0x1234)))
+
+			// when
+			_, err = caManager.GetCRL(context.Background(), "default", tokenBackend(), revoked)
+
+			// then certs already listed in the CRL are not revoked again
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vaultServer.revoked).To(HaveLen(1))
+		})
+
+		It("should revoke only certs issued by the backend", func() {
+			// given
+			vaultServer.issued["12:34"] = true
+			vaultServer.issued["56:78"] = true
+			revoked := []core_ca.RevokedCert{
+				{
+					SerialNumber: big.NewInt(0x1234),
+					NotAfter:     time.Now().Add(time.Hour),
+					RevokedAt:    time.Now(),
+					Backend:      "vault-1",
+				},
+				{
+					SerialNumber: big.NewInt(0x5678),
+					NotAfter:     time.Now().Add(time.Hour),
+					RevokedAt:    time.Now(),
+					Backend:      "builtin-1",
+				},
+			}
+
+			// when
+			_, err := caManager.GetCRL(context.Background(), "default", tokenBackend(), revoked)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vaultServer.revoked).To(Equal([]string{"12:34"}))
+		})
+
+		It("should skip certs that Vault has not issued", func() {
+			// given
+			vaultServer.issued["12:34"] = true
+			revoked := []core_ca.RevokedCert{
+				{
+					SerialNumber: big.NewInt(0x1111),
+					NotAfter:     time.Now().Add(time.Hour),
+					RevokedAt:    time.Now(),
+					Backend:      "vault-1",
+				},
+				{
+					SerialNumber: big.NewInt(0x1234),
+					NotAfter:     time.Now().Add(time.Hour),
+					RevokedAt:    time.Now(),
+					Backend:      "vault-1",
+				},
+			}
+
+			// when
+			data, err := caManager.GetCRL(context.Background(), "default", tokenBackend(), revoked)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vaultServer.revoked).To(Equal([]string{"12:34"}))
+			// and
+			block, _ := pem.Decode(data)
+			crl, err := x509.ParseCRL(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(crl.TBSCertList.RevokedCertificates).To(HaveLen(1))
+		})
+
+		It("should revoke certs in Vault but not return a CRL of an intermediate CA", func() {
+			// given
+			vaultServer.loadCa("intermediate", "ca")
+			vaultServer.issued["12:34"] = true
+			revoked := []core_ca.RevokedCert{
+				{
+					SerialNumber: big.NewInt(0x1234),
+					NotAfter:     time.Now().Add(time.Hour),
+					RevokedAt:    time.Now(),
+					Backend:      "vault-1",
+				},
+			}
+
+			// when
+			data, err := caManager.GetCRL(context.Background(), "default", tokenBackend(), revoked)
+
+			// then Envoy would require CRLs of parents of the intermediate CA as well
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(BeNil())
+			// and certs are revoked in Vault anyway
+			Expect(vaultServer.revoked).To(Equal([]string{"12:34"}))
+
+			// when
+			_, err = caManager.GetCRL(context.Background(), "default", tokenBackend(), revoked)
+
+			// then certs already listed in the CRL are not revoked again
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vaultServer.revoked).To(HaveLen(1))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package vault_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCaVault(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CA Vault Suite")
+}
@@ -183,6 +183,9 @@ func InboundTagsFor(pod *kube_core.Pod, svc *kube_core.Service, svcPort *kube_co
 	if tags == nil {
 		tags = make(map[string]string)
 	}
+	// labels set by Kuma Sidecar Injector are not meant to be used as tags
+	delete(tags, injector_metadata.KumaMeshLabel)
+	delete(tags, injector_metadata.KumaSidecarInjectedLabel)
 	tags[mesh_proto.ServiceTag] = ServiceTagFor(svc, svcPort)
 	// notice that in case of a gateway it might be confusing to see a protocol tag
 	// since gateway proxies multiple services each with its own protocol
@@ -515,6 +515,19 @@ var _ = Describe("InboundTagsFor(..)", func() {
 				"protocol": "tcp", // we want Kuma's default behaviour to be explicit to a user
 			},
 		}),
+		Entry("Pod with labels set by Kuma Sidecar Injector", testCase{
+			isGateway: false,
+			podLabels: map[string]string{
+				"app":                      "example",
+				"kuma.io/mesh":             "default",
+				"kuma.io/sidecar-injected": "true",
+			},
+			expected: map[string]string{
+				"app":      "example",
+				"service":  "example.demo.svc:80",
+				"protocol": "tcp", // we want Kuma's default behaviour to be explicit to a user
+			},
+		}),
 		Entry("Pod with `service` label", testCase{
 			isGateway: false,
 			podLabels: map[string]string{
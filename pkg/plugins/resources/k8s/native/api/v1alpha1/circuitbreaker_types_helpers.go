@@ -0,0 +1,60 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/plugins/resources/k8s/native/pkg/model"
+	"github.com/Kong/kuma/pkg/plugins/resources/k8s/native/pkg/registry"
+)
+
+func (o *CircuitBreaker) GetObjectMeta() *metav1.ObjectMeta {
+	return &o.ObjectMeta
+}
+
+func (o *CircuitBreaker) SetObjectMeta(m *metav1.ObjectMeta) {
+	o.ObjectMeta = *m
+}
+
+func (o *CircuitBreaker) GetMesh() string {
+	return o.Mesh
+}
+
+func (o *CircuitBreaker) SetMesh(mesh string) {
+	o.Mesh = mesh
+}
+
+func (o *CircuitBreaker) GetSpec() map[string]interface{} {
+	return o.Spec
+}
+
+func (o *CircuitBreaker) SetSpec(spec map[string]interface{}) {
+	o.Spec = spec
+}
+
+func (o *CircuitBreaker) Scope() model.Scope {
+	return model.ScopeNamespace
+}
+
+func (l *CircuitBreakerList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&proto.CircuitBreaker{}, &CircuitBreaker{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "CircuitBreaker",
+		},
+	})
+	registry.RegisterListType(&proto.CircuitBreaker{}, &CircuitBreakerList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "CircuitBreakerList",
+		},
+	})
+}
@@ -84,6 +84,16 @@ var _ = Describe("global TypeRegistry", func() {
 				expectedType: &FaultInjection{},
 				expectedKind: "FaultInjection",
 			}),
+			Entry("MeshGateway", testCase{
+				inputType:    &mesh_proto.MeshGateway{},
+				expectedType: &MeshGateway{},
+				expectedKind: "MeshGateway",
+			}),
+			Entry("CircuitBreaker", testCase{
+				inputType:    &mesh_proto.CircuitBreaker{},
+				expectedType: &CircuitBreaker{},
+				expectedKind: "CircuitBreaker",
+			}),
 		)
 	})
 
@@ -151,6 +161,16 @@ var _ = Describe("global TypeRegistry", func() {
 				expectedType: &TrafficTraceList{},
 				expectedKind: "TrafficTraceList",
 			}),
+			Entry("MeshGatewayList", testCase{
+				inputType:    &mesh_proto.MeshGateway{},
+				expectedType: &MeshGatewayList{},
+				expectedKind: "MeshGatewayList",
+			}),
+			Entry("CircuitBreakerList", testCase{
+				inputType:    &mesh_proto.CircuitBreaker{},
+				expectedType: &CircuitBreakerList{},
+				expectedKind: "CircuitBreakerList",
+			}),
 		)
 	})
 })
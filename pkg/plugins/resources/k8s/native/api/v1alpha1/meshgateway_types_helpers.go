@@ -0,0 +1,60 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/plugins/resources/k8s/native/pkg/model"
+	"github.com/Kong/kuma/pkg/plugins/resources/k8s/native/pkg/registry"
+)
+
+func (mg *MeshGateway) GetObjectMeta() *metav1.ObjectMeta {
+	return &mg.ObjectMeta
+}
+
+func (mg *MeshGateway) SetObjectMeta(m *metav1.ObjectMeta) {
+	mg.ObjectMeta = *m
+}
+
+func (mg *MeshGateway) GetMesh() string {
+	return mg.Mesh
+}
+
+func (mg *MeshGateway) SetMesh(mesh string) {
+	mg.Mesh = mesh
+}
+
+func (mg *MeshGateway) GetSpec() map[string]interface{} {
+	return mg.Spec
+}
+
+func (mg *MeshGateway) SetSpec(spec map[string]interface{}) {
+	mg.Spec = spec
+}
+
+func (mg *MeshGateway) Scope() model.Scope {
+	return model.ScopeNamespace
+}
+
+func (l *MeshGatewayList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&proto.MeshGateway{}, &MeshGateway{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "MeshGateway",
+		},
+	})
+	registry.RegisterListType(&proto.MeshGateway{}, &MeshGatewayList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "MeshGatewayList",
+		},
+	})
+}
@@ -68,7 +68,7 @@ func (v memoryVersion) String() string {
 	return strconv.FormatUint(uint64(v), 10)
 }
 
-var _ store.ResourceStore = &memoryStore{}
+var _ store.TransactionalResourceStore = &memoryStore{}
 
 type memoryStore struct {
 	records memoryStoreRecords
@@ -79,10 +79,72 @@ func NewStore() store.ResourceStore {
 	return &memoryStore{}
 }
 
-func (c *memoryStore) Create(_ context.Context, r model.Resource, fs ...store.CreateOptionsFunc) error {
+func (c *memoryStore) Create(ctx context.Context, r model.Resource, fs ...store.CreateOptionsFunc) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
+	return c.create(ctx, r, fs...)
+}
+func (c *memoryStore) Update(ctx context.Context, r model.Resource, fs ...store.UpdateOptionsFunc) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.update(ctx, r, fs...)
+}
+func (c *memoryStore) Delete(ctx context.Context, r model.Resource, fs ...store.DeleteOptionsFunc) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.delete(ctx, r, fs...)
+}
+func (c *memoryStore) Get(ctx context.Context, r model.Resource, fs ...store.GetOptionsFunc) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.get(ctx, r, fs...)
+}
+func (c *memoryStore) List(ctx context.Context, rs model.ResourceList, fs ...store.ListOptionsFunc) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list(ctx, rs, fs...)
+}
+
+// RunInTx holds the lock for the whole transaction, so that other clients
+// never observe writes of a transaction that is not finished yet.
+func (c *memoryStore) RunInTx(fn func(store.ResourceStore) error) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// records are never modified in place, so a shallow copy is enough to roll back
+	backup := append(memoryStoreRecords{}, c.records...)
+	if err := fn(&memoryTx{store: c}); err != nil {
+		c.records = backup
+		return err
+	}
+	return nil
+}
 
+var _ store.ResourceStore = &memoryTx{}
+
+// memoryTx is a view of memoryStore for the duration of a transaction.
+// The lock is already held by memoryStore.RunInTx().
+type memoryTx struct {
+	store *memoryStore
+}
+
+func (t *memoryTx) Create(ctx context.Context, r model.Resource, fs ...store.CreateOptionsFunc) error {
+	return t.store.create(ctx, r, fs...)
+}
+func (t *memoryTx) Update(ctx context.Context, r model.Resource, fs ...store.UpdateOptionsFunc) error {
+	return t.store.update(ctx, r, fs...)
+}
+func (t *memoryTx) Delete(ctx context.Context, r model.Resource, fs ...store.DeleteOptionsFunc) error {
+	return t.store.delete(ctx, r, fs...)
+}
+func (t *memoryTx) Get(ctx context.Context, r model.Resource, fs ...store.GetOptionsFunc) error {
+	return t.store.get(ctx, r, fs...)
+}
+func (t *memoryTx) List(ctx context.Context, rs model.ResourceList, fs ...store.ListOptionsFunc) error {
+	return t.store.list(ctx, rs, fs...)
+}
+
+func (c *memoryStore) create(_ context.Context, r model.Resource, fs ...store.CreateOptionsFunc) error {
 	opts := store.NewCreateOptions(fs...)
 	if r.GetType() == mesh.MeshType {
 		opts.Mesh = opts.Name
@@ -116,10 +178,7 @@ func (c *memoryStore) Create(_ context.Context, r model.Resource, fs ...store.Cr
 	c.records = append(c.records, record)
 	return nil
 }
-func (c *memoryStore) Update(_ context.Context, r model.Resource, fs ...store.UpdateOptionsFunc) error {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
+func (c *memoryStore) update(_ context.Context, r model.Resource, fs ...store.UpdateOptionsFunc) error {
 	opts := store.NewUpdateOptions(fs...)
 
 	meta, ok := (r.GetMeta()).(memoryMeta)
@@ -148,10 +207,7 @@ func (c *memoryStore) Update(_ context.Context, r model.Resource, fs ...store.Up
 	c.records[idx] = record
 	return nil
 }
-func (c *memoryStore) Delete(_ context.Context, r model.Resource, fs ...store.DeleteOptionsFunc) error {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
+func (c *memoryStore) delete(_ context.Context, r model.Resource, fs ...store.DeleteOptionsFunc) error {
 	opts := store.NewDeleteOptions(fs...)
 
 	_, ok := (r.GetMeta()).(memoryMeta)
@@ -168,10 +224,7 @@ func (c *memoryStore) Delete(_ context.Context, r model.Resource, fs ...store.De
 	return nil
 }
 
-func (c *memoryStore) Get(_ context.Context, r model.Resource, fs ...store.GetOptionsFunc) error {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
+func (c *memoryStore) get(_ context.Context, r model.Resource, fs ...store.GetOptionsFunc) error {
 	opts := store.NewGetOptions(fs...)
 	if r.GetType() == mesh.MeshType {
 		opts.Mesh = opts.Name
@@ -186,10 +239,7 @@ func (c *memoryStore) Get(_ context.Context, r model.Resource, fs ...store.GetOp
 	}
 	return c.unmarshalRecord(record, r)
 }
-func (c *memoryStore) List(_ context.Context, rs model.ResourceList, fs ...store.ListOptionsFunc) error {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
+func (c *memoryStore) list(_ context.Context, rs model.ResourceList, fs ...store.ListOptionsFunc) error {
 	opts := store.NewListOptions(fs...)
 
 	records := c.findRecords(string(rs.GetItemType()), opts.Mesh)
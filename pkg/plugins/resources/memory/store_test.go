@@ -1,12 +1,116 @@
 package memory_test
 
 import (
+	"context"
+	"errors"
+
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
 
+	"github.com/Kong/kuma/pkg/core/resources/store"
 	"github.com/Kong/kuma/pkg/plugins/resources/memory"
+	sample_proto "github.com/Kong/kuma/pkg/test/apis/sample/v1alpha1"
+	sample_model "github.com/Kong/kuma/pkg/test/resources/apis/sample"
 	test_store "github.com/Kong/kuma/pkg/test/store"
 )
 
 var _ = Describe("MemoryStore", func() {
 	test_store.ExecuteStoreTests(memory.NewStore)
+
+	Describe("RunInTx()", func() {
+		const mesh = "default-mesh"
+
+		var s store.ResourceStore
+
+		BeforeEach(func() {
+			s = memory.NewStore()
+		})
+
+		newResource := func(path string) *sample_model.TrafficRouteResource {
+			return &sample_model.TrafficRouteResource{
+				Spec: sample_proto.TrafficRoute{
+					Path: path,
+				},
+			}
+		}
+
+		It("should apply all writes of a successful transaction", func() {
+			// when
+			err := store.RunInTx(s, func(tx store.ResourceStore) error {
+				if err := tx.Create(context.Background(), newResource("/first"), store.CreateByKey("first", mesh)); err != nil {
+					return err
+				}
+				return tx.Create(context.Background(), newResource("/second"), store.CreateByKey("second", mesh))
+			})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			list := &sample_model.TrafficRouteResourceList{}
+			err = s.List(context.Background(), list, store.ListByMesh(mesh))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Items).To(HaveLen(2))
+		})
+
+		It("should not leave partial writes when a transaction fails mid-way", func() {
+			// given
+			existing := newResource("/existing")
+			err := s.Create(context.Background(), existing, store.CreateByKey("existing", mesh))
+			Expect(err).ToNot(HaveOccurred())
+			doomed := newResource("/doomed")
+			err = s.Create(context.Background(), doomed, store.CreateByKey("doomed", mesh))
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = store.RunInTx(s, func(tx store.ResourceStore) error {
+				if err := tx.Create(context.Background(), newResource("/new"), store.CreateByKey("new", mesh)); err != nil {
+					return err
+				}
+				existing.Spec.Path = "/updated"
+				if err := tx.Update(context.Background(), existing); err != nil {
+					return err
+				}
+				if err := tx.Delete(context.Background(), doomed, store.DeleteByKey("doomed", mesh)); err != nil {
+					return err
+				}
+				return errors.New("something went wrong")
+			})
+
+			// then
+			Expect(err).To(MatchError("something went wrong"))
+
+			// when
+			err = s.Get(context.Background(), &sample_model.TrafficRouteResource{}, store.GetByKey("new", mesh))
+			// then
+			Expect(store.IsResourceNotFound(err)).To(BeTrue())
+
+			// when
+			actual := &sample_model.TrafficRouteResource{}
+			err = s.Get(context.Background(), actual, store.GetByKey("existing", mesh))
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual.Spec.Path).To(Equal("/existing"))
+
+			// when
+			err = s.Get(context.Background(), &sample_model.TrafficRouteResource{}, store.GetByKey("doomed", mesh))
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should see its own writes", func() {
+			// when
+			err := store.RunInTx(s, func(tx store.ResourceStore) error {
+				if err := tx.Create(context.Background(), newResource("/first"), store.CreateByKey("first", mesh)); err != nil {
+					return err
+				}
+				return tx.Get(context.Background(), &sample_model.TrafficRouteResource{}, store.GetByKey("first", mesh))
+			})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
 })
@@ -0,0 +1,145 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	kube_core "k8s.io/api/core/v1"
+	kube_apierrs "k8s.io/apimachinery/pkg/api/errors"
+	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kube_runtime "k8s.io/apimachinery/pkg/runtime"
+	kube_types "k8s.io/apimachinery/pkg/types"
+	kube_ctrl "sigs.k8s.io/controller-runtime"
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+	kube_controllerutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/Kong/kuma/app/kuma-injector/pkg/injector/metadata"
+	core_ca "github.com/Kong/kuma/pkg/core/ca"
+	core_plugins "github.com/Kong/kuma/pkg/core/plugins"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	k8s_resources "github.com/Kong/kuma/pkg/plugins/resources/k8s"
+	mesh_k8s "github.com/Kong/kuma/pkg/plugins/resources/k8s/native/api/v1alpha1"
+)
+
+// CaRootCertKey is a key of the ConfigMap under which root certificates of a Mesh are stored
+const CaRootCertKey = "ca.crt"
+
+// CaRootCertConfigMapName returns name of the ConfigMap that mirrors root certificates of a Mesh
+func CaRootCertConfigMapName(mesh string) string {
+	return fmt.Sprintf("%s.ca-root-cert", mesh) // we add mesh as a prefix to have uniqueness of ConfigMap names in the system namespace
+}
+
+// CaRootCertReconciler mirrors root certificates of a builtin CA of a Mesh into a ConfigMap in the system namespace,
+// so that other systems, e.g. cert-manager or external gateways, can trust workloads of the Mesh.
+type CaRootCertReconciler struct {
+	kube_client.Client
+	Log logr.Logger
+
+	Scheme          *kube_runtime.Scheme
+	Converter       k8s_resources.Converter
+	CaManagers      core_ca.Managers
+	SystemNamespace string
+	// RefreshInterval is an interval at which root certificates are checked for changes, e.g. caused by rotation of the CA
+	RefreshInterval time.Duration
+}
+
+func (r *CaRootCertReconciler) Reconcile(req kube_ctrl.Request) (kube_ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("mesh", req.Name)
+
+	// Fetch the Mesh instance
+	mesh := &mesh_k8s.Mesh{}
+	if err := r.Get(ctx, req.NamespacedName, mesh); err != nil {
+		if kube_apierrs.IsNotFound(err) {
+			// the ConfigMap is owned by the Mesh, so it is garbage collected together with the Mesh
+			return kube_ctrl.Result{}, nil
+		}
+		log.Error(err, "unable to fetch Mesh")
+		return kube_ctrl.Result{}, err
+	}
+
+	meshResource := &mesh_core.MeshResource{}
+	if err := r.Converter.ToCoreResource(mesh, meshResource); err != nil {
+		log.Error(err, "unable to convert Mesh k8s object into core model")
+		return kube_ctrl.Result{}, err
+	}
+
+	backend := meshResource.GetEnabledCertificateAuthorityBackend()
+	if backend == nil || backend.Type != string(core_plugins.CaBuiltin) {
+		err := r.deleteConfigMap(ctx, req.Name)
+		if err != nil {
+			log.Error(err, "unable to delete ConfigMap with root certificates")
+		}
+		return kube_ctrl.Result{}, err
+	}
+
+	caManager, err := r.CaManagers.Get(backend.Type)
+	if err != nil {
+		return kube_ctrl.Result{}, err
+	}
+	rootCerts, err := caManager.GetRootCert(ctx, req.Name, *backend)
+	if err != nil {
+		log.Error(err, "unable to retrieve root certificates")
+		return kube_ctrl.Result{}, err
+	}
+
+	configMap := &kube_core.ConfigMap{
+		ObjectMeta: kube_meta.ObjectMeta{
+			Namespace: r.SystemNamespace,
+			Name:      CaRootCertConfigMapName(req.Name),
+		},
+	}
+	result, err := kube_controllerutil.CreateOrUpdate(ctx, r.Client, configMap, func() error {
+		if configMap.Labels == nil {
+			configMap.Labels = map[string]string{}
+		}
+		configMap.Labels[metadata.KumaMeshLabel] = req.Name
+		configMap.Data = map[string]string{
+			CaRootCertKey: string(bytes.Join(rootCerts, nil)),
+		}
+		return kube_controllerutil.SetControllerReference(mesh, configMap, r.Scheme)
+	})
+	if err != nil {
+		log.Error(err, "unable to create or update ConfigMap with root certificates")
+		return kube_ctrl.Result{}, err
+	}
+	if result != kube_controllerutil.OperationResultNone {
+		log.Info("root certificates have been synced", "configMap", configMap.Name, "operation", result)
+	}
+
+	// root certificates change over time without any change to the Mesh, e.g. when the CA is rotated.
+	// Resync also reverts changes made to the ConfigMap by anyone else.
+	return kube_ctrl.Result{RequeueAfter: r.RefreshInterval}, nil
+}
+
+func (r *CaRootCertReconciler) deleteConfigMap(ctx context.Context, mesh string) error {
+	configMap := &kube_core.ConfigMap{}
+	name := kube_types.NamespacedName{Namespace: r.SystemNamespace, Name: CaRootCertConfigMapName(mesh)}
+	if err := r.Get(ctx, name, configMap); err != nil {
+		if kube_apierrs.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if err := r.Delete(ctx, configMap); err != nil && !kube_apierrs.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (r *CaRootCertReconciler) SetupWithManager(mgr kube_ctrl.Manager) error {
+	if err := kube_core.AddToScheme(mgr.GetScheme()); err != nil {
+		return errors.Wrapf(err, "could not add %q to scheme", kube_core.SchemeGroupVersion)
+	}
+	if err := mesh_k8s.AddToScheme(mgr.GetScheme()); err != nil {
+		return errors.Wrapf(err, "could not add %q to scheme", mesh_k8s.GroupVersion)
+	}
+	return kube_ctrl.NewControllerManagedBy(mgr).
+		Named("ca-root-cert").
+		For(&mesh_k8s.Mesh{}).
+		Complete(r)
+}
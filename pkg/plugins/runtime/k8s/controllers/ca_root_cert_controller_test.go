@@ -0,0 +1,140 @@
+package controllers_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	kube_core "k8s.io/api/core/v1"
+	kube_apierrs "k8s.io/apimachinery/pkg/api/errors"
+	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kube_types "k8s.io/apimachinery/pkg/types"
+	kube_ctrl "sigs.k8s.io/controller-runtime"
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+	kube_client_fake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core"
+	core_ca "github.com/Kong/kuma/pkg/core/ca"
+	k8s_resources "github.com/Kong/kuma/pkg/plugins/resources/k8s"
+	mesh_k8s "github.com/Kong/kuma/pkg/plugins/resources/k8s/native/api/v1alpha1"
+	"github.com/Kong/kuma/pkg/plugins/runtime/k8s/controllers"
+)
+
+type staticRootCertCaManager struct {
+	core_ca.Manager
+	rootCerts []core_ca.Cert
+}
+
+func (m *staticRootCertCaManager) GetRootCert(context.Context, string, mesh_proto.CertificateAuthorityBackend) ([]core_ca.Cert, error) {
+	return m.rootCerts, nil
+}
+
+var _ = Describe("CaRootCertReconciler", func() {
+
+	var kubeClient kube_client.Client
+	var caManager *staticRootCertCaManager
+	var reconciler *controllers.CaRootCertReconciler
+
+	meshWithBackend := func(backendType string) *mesh_k8s.Mesh {
+		return &mesh_k8s.Mesh{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name: "demo",
+				UID:  "d2d8e2a0-6a3b-4b55-9a5e-0c7c2cbd5b2e",
+			},
+			Spec: map[string]interface{}{
+				"mtls": map[string]interface{}{
+					"enabledBackend": "ca-1",
+					"backends": []interface{}{
+						map[string]interface{}{
+							"name": "ca-1",
+							"type": backendType,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	request := kube_ctrl.Request{
+		NamespacedName: kube_types.NamespacedName{Name: "demo"},
+	}
+
+	configMap := func() (*kube_core.ConfigMap, error) {
+		configMap := &kube_core.ConfigMap{}
+		err := kubeClient.Get(context.Background(), kube_types.NamespacedName{Namespace: "kuma-system", Name: "demo.ca-root-cert"}, configMap)
+		return configMap, err
+	}
+
+	BeforeEach(func() {
+		kubeClient = kube_client_fake.NewFakeClientWithScheme(k8sClientScheme, meshWithBackend("builtin"))
+		caManager = &staticRootCertCaManager{
+			rootCerts: []core_ca.Cert{[]byte("root-1\n")},
+		}
+		reconciler = &controllers.CaRootCertReconciler{
+			Client:          kubeClient,
+			Log:             core.Log.WithName("test"),
+			Scheme:          k8sClientScheme,
+			Converter:       k8s_resources.DefaultConverter(),
+			CaManagers:      core_ca.Managers{"builtin": caManager, "provided": caManager},
+			SystemNamespace: "kuma-system",
+			RefreshInterval: time.Minute,
+		}
+	})
+
+	It("should mirror root certificates of a builtin CA into a ConfigMap", func() {
+		// when
+		result, err := reconciler.Reconcile(request)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(kube_ctrl.Result{RequeueAfter: time.Minute}))
+
+		// when
+		actual, err := configMap()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual.Data).To(Equal(map[string]string{"ca.crt": "root-1\n"}))
+		Expect(actual.Labels).To(HaveKeyWithValue("kuma.io/mesh", "demo"))
+		Expect(actual.OwnerReferences).To(HaveLen(1))
+		Expect(actual.OwnerReferences[0].Kind).To(Equal("Mesh"))
+		Expect(actual.OwnerReferences[0].Name).To(Equal("demo"))
+	})
+
+	It("should keep the ConfigMap in sync when the CA is rotated", func() {
+		// given
+		_, err := reconciler.Reconcile(request)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		caManager.rootCerts = []core_ca.Cert{[]byte("root-1\n"), []byte("root-2\n")}
+		_, err = reconciler.Reconcile(request)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		actual, err := configMap()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual.Data).To(Equal(map[string]string{"ca.crt": "root-1\nroot-2\n"}))
+	})
+
+	It("should delete the ConfigMap when a builtin CA is no longer enabled", func() {
+		// given
+		_, err := reconciler.Reconcile(request)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		mesh := &mesh_k8s.Mesh{}
+		Expect(kubeClient.Get(context.Background(), request.NamespacedName, mesh)).To(Succeed())
+		mesh.Spec = meshWithBackend("provided").Spec
+		Expect(kubeClient.Update(context.Background(), mesh)).To(Succeed())
+		result, err := reconciler.Reconcile(request)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeZero())
+		_, err = configMap()
+		Expect(kube_apierrs.IsNotFound(err)).To(BeTrue())
+	})
+})
@@ -58,6 +58,9 @@ func addControllers(mgr kube_ctrl.Manager, rt core_runtime.Runtime) error {
 	if err := addNamespaceReconciler(mgr, rt); err != nil {
 		return err
 	}
+	if err := addCaRootCertReconciler(mgr, rt); err != nil {
+		return err
+	}
 	return addMeshReconciler(mgr, rt)
 }
 
@@ -87,6 +90,23 @@ func addMeshReconciler(mgr kube_ctrl.Manager, rt core_runtime.Runtime) error {
 	return reconciler.SetupWithManager(mgr)
 }
 
+func addCaRootCertReconciler(mgr kube_ctrl.Manager, rt core_runtime.Runtime) error {
+	cfg := rt.Config().Runtime.Kubernetes.CaRootCertSync
+	if !cfg.Enabled {
+		return nil
+	}
+	reconciler := &k8s_controllers.CaRootCertReconciler{
+		Client:          mgr.GetClient(),
+		Log:             core.Log.WithName("controllers").WithName("CaRootCert"),
+		Scheme:          mgr.GetScheme(),
+		Converter:       k8s_resources.DefaultConverter(),
+		CaManagers:      rt.CaManagers(),
+		SystemNamespace: rt.Config().Store.Kubernetes.SystemNamespace,
+		RefreshInterval: cfg.RefreshInterval,
+	}
+	return reconciler.SetupWithManager(mgr)
+}
+
 func addDefaulters(mgr kube_ctrl.Manager) error {
 	if err := mesh_k8s.AddToScheme(mgr.GetScheme()); err != nil {
 		return errors.Wrapf(err, "could not add %q to scheme", mesh_k8s.GroupVersion)
@@ -168,16 +168,16 @@ var _ = Describe("ServiceValidator", func() {
               details:
                 causes:
                 - field: spec.metadata.annotations["8081.service.kuma.io/protocol"]
-                  message: 'value "" is not valid. Allowed values: http, tcp'
+                  message: 'value "" is not valid. Allowed values: grpc, http, tcp'
                   reason: FieldValueInvalid
                 - field: spec.metadata.annotations["8082.service.kuma.io/protocol"]
-                  message: 'value "not-yet-supported-protocol" is not valid. Allowed values: http,
-                    tcp'
+                  message: 'value "not-yet-supported-protocol" is not valid. Allowed values: grpc,
+                    http, tcp'
                   reason: FieldValueInvalid
                 kind: Service
               message: 'spec.metadata.annotations["8081.service.kuma.io/protocol"]: value "" is
-                not valid. Allowed values: http, tcp; spec.metadata.annotations["8082.service.kuma.io/protocol"]:
-                value "not-yet-supported-protocol" is not valid. Allowed values: http, tcp'
+                not valid. Allowed values: grpc, http, tcp; spec.metadata.annotations["8082.service.kuma.io/protocol"]:
+                value "not-yet-supported-protocol" is not valid. Allowed values: grpc, http, tcp'
               metadata: {}
               reason: Invalid
               status: Failure
@@ -20,7 +20,8 @@ func GetDataplaneIdentity(dataplane *core_mesh.DataplaneResource) (sds_auth.Iden
 	}
 	service := services[0]
 	return sds_auth.Identity{
-		Mesh:    dataplane.Meta.GetMesh(),
-		Service: service,
+		Mesh:      dataplane.Meta.GetMesh(),
+		Service:   service,
+		Dataplane: dataplane.Meta.GetName(),
 	}, nil
 }
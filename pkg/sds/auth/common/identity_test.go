@@ -43,8 +43,9 @@ var _ = Describe("GetDataplaneIdentity()", func() {
 
 		// and
 		Expect(id).To(Equal(sds_auth.Identity{
-			Mesh:    "demo",
-			Service: "backend",
+			Mesh:      "demo",
+			Service:   "backend",
+			Dataplane: "dp-1",
 		}))
 	})
 
@@ -74,8 +75,9 @@ var _ = Describe("GetDataplaneIdentity()", func() {
 
 		// and
 		Expect(id).To(Equal(sds_auth.Identity{
-			Mesh:    "demo",
-			Service: "edge",
+			Mesh:      "demo",
+			Service:   "edge",
+			Dataplane: "dp-1",
 		}))
 	})
 
@@ -11,6 +11,8 @@ type Credential string
 type Identity struct {
 	Mesh    string
 	Service string
+	// Dataplane is the name of the Dataplane that requests a Secret
+	Dataplane string
 }
 
 type Authenticator interface {
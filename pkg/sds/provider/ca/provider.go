@@ -1,11 +1,19 @@
 package ca
 
 import (
+	"bytes"
 	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	core_ca "github.com/Kong/kuma/pkg/core/ca"
+	ca_issuer "github.com/Kong/kuma/pkg/core/ca/issuer"
+	"github.com/Kong/kuma/pkg/core/ca/revocation"
 	core_mesh "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
 	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
 	core_store "github.com/Kong/kuma/pkg/core/resources/store"
@@ -13,16 +21,42 @@ import (
 	sds_provider "github.com/Kong/kuma/pkg/sds/provider"
 )
 
-func New(resourceManager core_manager.ResourceManager, caManagers core_ca.Managers) sds_provider.SecretProvider {
+// RevocationCheckInterval is how often root certs and CRLs of a Mesh are checked for changes,
+// so that revoked Dataplane certificates are rejected by dataplanes shortly after they are revoked.
+// They are fetched once per Mesh, all dataplanes of a Mesh get them from the cache.
+const RevocationCheckInterval = 1 * time.Minute
+
+func New(resourceManager core_manager.ResourceManager, caManagers core_ca.Managers, revocationManager revocation.Manager) sds_provider.SecretProvider {
 	return &meshCaProvider{
-		resourceManager: resourceManager,
-		caManagers:      caManagers,
+		resourceManager:   resourceManager,
+		caManagers:        caManagers,
+		revocationManager: revocationManager,
+		cache:             map[string]*meshCaCacheEntry{},
 	}
 }
 
 type meshCaProvider struct {
-	resourceManager core_manager.ResourceManager
-	caManagers      core_ca.Managers
+	resourceManager   core_manager.ResourceManager
+	caManagers        core_ca.Managers
+	revocationManager revocation.Manager
+
+	// cache holds root certs and CRLs indexed by Mesh, so that all dataplanes of a Mesh get the same ones
+	// and CAs are not asked for them on every check
+	cache   map[string]*meshCaCacheEntry
+	cacheMu sync.Mutex
+}
+
+type meshCaCacheEntry struct {
+	// meshVersion and backend tell which version of a Mesh and which CA backend root certs have been fetched for
+	meshVersion string
+	backend     string
+	certs       []core_ca.Cert
+	fetchedAt   time.Time
+
+	// serialNumbers tell which revoked certificates the CRLs have been generated for
+	serialNumbers  string
+	crls           []byte
+	crlGeneratedAt time.Time
 }
 
 func (s *meshCaProvider) RequiresIdentity() bool {
@@ -37,22 +71,79 @@ func (s *meshCaProvider) Get(ctx context.Context, resource string, requestor sds
 		return nil, errors.Wrapf(err, "failed to find a Mesh %q", meshName)
 	}
 
-	backend := meshRes.GetEnabledCertificateAuthorityBackend()
-	if backend == nil {
-		return nil, errors.New("CA backend is nil")
+	caManager, backend, err := s.caManagers.GetEnabled(meshName, &meshRes.Spec)
+	if err != nil {
+		return nil, err
 	}
 
-	caManager, exist := s.caManagers[backend.Type]
-	if !exist {
-		return nil, errors.Errorf("CA manager of type %s not exist", backend.Type)
+	entry, err := s.getMeshCa(ctx, meshRes, caManager, *backend)
+	if err != nil {
+		return nil, err
 	}
 
-	certs, err := caManager.GetRootCert(ctx, meshName, *backend)
+	return &MeshCaSecret{
+		PemCerts: entry.certs,
+		PemCrls:  entry.crls,
+		Renewal:  entry.fetchedAt.Add(RevocationCheckInterval),
+	}, nil
+}
+
+// getMeshCa returns cached root certs and CRLs of a Mesh unless the Mesh has changed or they are due to be checked for changes.
+// CRLs are re-generated only if root certs or revoked certificates have changed since they have been generated,
+// or they are halfway through their validity.
+func (s *meshCaProvider) getMeshCa(ctx context.Context, meshRes *core_mesh.MeshResource, caManager core_ca.Manager, backend mesh_proto.CertificateAuthorityBackend) (*meshCaCacheEntry, error) {
+	meshName := meshRes.GetMeta().GetName()
+	now := time.Now()
+
+	s.cacheMu.Lock()
+	defer s.cacheMu.Unlock()
+	cached, ok := s.cache[meshName]
+	if ok && (cached.meshVersion != meshRes.GetMeta().GetVersion() || cached.backend != backend.Name) {
+		cached, ok = nil, false
+	}
+	if ok && now.Before(cached.fetchedAt.Add(RevocationCheckInterval)) {
+		return cached, nil
+	}
+
+	certs, err := caManager.GetRootCert(ctx, meshName, backend)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get root certs")
 	}
+	revoked, err := s.revocationManager.RevokedCerts(ctx, meshName)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get revoked certs")
+	}
+	entry := &meshCaCacheEntry{
+		meshVersion:   meshRes.GetMeta().GetVersion(),
+		backend:       backend.Name,
+		certs:         certs,
+		fetchedAt:     now,
+		serialNumbers: serialNumbersOf(revoked),
+	}
+	// CRLs are only configured once there are revoked certs, since every CA of a chain has to have one.
+	// Backends that cannot sign CRLs of every CA return none, in which case revoked dataplanes
+	// are only not issued new certificates
+	if len(revoked) > 0 {
+		if ok && bytes.Equal(bytes.Join(cached.certs, nil), bytes.Join(certs, nil)) && cached.serialNumbers == entry.serialNumbers &&
+			now.Before(cached.crlGeneratedAt.Add(ca_issuer.DefaultCRLValidityPeriod/2)) {
+			entry.crls, entry.crlGeneratedAt = cached.crls, cached.crlGeneratedAt
+		} else {
+			crls, err := caManager.GetCRL(ctx, meshName, backend, revoked)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not get CRLs")
+			}
+			entry.crls, entry.crlGeneratedAt = crls, now
+		}
+	}
+	s.cache[meshName] = entry
+	return entry, nil
+}
 
-	return &MeshCaSecret{
-		PemCerts: certs,
-	}, nil
+func serialNumbersOf(revoked []core_ca.RevokedCert) string {
+	serialNumbers := make([]string, 0, len(revoked))
+	for _, cert := range revoked {
+		serialNumbers = append(serialNumbers, cert.SerialNumber.String())
+	}
+	sort.Strings(serialNumbers)
+	return strings.Join(serialNumbers, ",")
 }
@@ -2,6 +2,7 @@ package ca
 
 import (
 	"bytes"
+	"time"
 
 	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
@@ -11,21 +12,37 @@ import (
 
 type MeshCaSecret struct {
 	PemCerts [][]byte
+	// PemCrls are CRLs of revoked Dataplane certificates, if any
+	PemCrls []byte
+	Renewal time.Time
 }
 
-var _ sds_provider.Secret = &MeshCaSecret{}
+var _ sds_provider.ExpiringSecret = &MeshCaSecret{}
+
+// RenewAt returns the time at which root certs and CRLs are checked for changes.
+func (s *MeshCaSecret) RenewAt() time.Time {
+	return s.Renewal
+}
 
 func (s *MeshCaSecret) ToResource(name string) *envoy_auth.Secret {
+	validationContext := &envoy_auth.CertificateValidationContext{
+		TrustedCa: &envoy_core.DataSource{
+			Specifier: &envoy_core.DataSource_InlineBytes{
+				InlineBytes: bytes.Join(s.PemCerts, []byte("\n")),
+			},
+		},
+	}
+	if len(s.PemCrls) > 0 {
+		validationContext.Crl = &envoy_core.DataSource{
+			Specifier: &envoy_core.DataSource_InlineBytes{
+				InlineBytes: s.PemCrls,
+			},
+		}
+	}
 	return &envoy_auth.Secret{
 		Name: name,
 		Type: &envoy_auth.Secret_ValidationContext{
-			ValidationContext: &envoy_auth.CertificateValidationContext{
-				TrustedCa: &envoy_core.DataSource{
-					Specifier: &envoy_core.DataSource_InlineBytes{
-						InlineBytes: bytes.Join(s.PemCerts, []byte("\n")),
-					},
-				},
-			},
+			ValidationContext: validationContext,
 		},
 	}
 }
@@ -2,10 +2,15 @@ package identity
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/pkg/errors"
 
 	core_ca "github.com/Kong/kuma/pkg/core/ca"
+	"github.com/Kong/kuma/pkg/core/ca/revocation"
 	core_mesh "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
 	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
 	core_store "github.com/Kong/kuma/pkg/core/resources/store"
@@ -13,16 +18,18 @@ import (
 	sds_provider "github.com/Kong/kuma/pkg/sds/provider"
 )
 
-func New(resourceManager core_manager.ResourceManager, caManagers core_ca.Managers) sds_provider.SecretProvider {
+func New(resourceManager core_manager.ResourceManager, caManagers core_ca.Managers, revocationManager revocation.Manager) sds_provider.SecretProvider {
 	return &identityCertProvider{
-		resourceManager: resourceManager,
-		caManagers:      caManagers,
+		resourceManager:   resourceManager,
+		caManagers:        caManagers,
+		revocationManager: revocationManager,
 	}
 }
 
 type identityCertProvider struct {
-	resourceManager core_manager.ResourceManager
-	caManagers      core_ca.Managers
+	resourceManager   core_manager.ResourceManager
+	caManagers        core_ca.Managers
+	revocationManager revocation.Manager
 }
 
 func (s *identityCertProvider) RequiresIdentity() bool {
@@ -32,28 +39,75 @@ func (s *identityCertProvider) RequiresIdentity() bool {
 func (s *identityCertProvider) Get(ctx context.Context, name string, requestor sds_auth.Identity) (sds_provider.Secret, error) {
 	meshName := requestor.Mesh
 
+	dataplane := &core_mesh.DataplaneResource{}
+	if err := s.resourceManager.Get(ctx, dataplane, core_store.GetByKey(requestor.Dataplane, meshName)); err != nil {
+		return nil, errors.Wrapf(err, "failed to find a Dataplane %q in mesh %q", requestor.Dataplane, meshName)
+	}
+	revoked, err := s.revocationManager.IsRevoked(ctx, dataplane.GetMeta())
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not check whether dataplane %q in mesh %q is revoked", requestor.Dataplane, meshName)
+	}
+	if revoked {
+		return nil, errors.Errorf("dataplane %q in mesh %q has been revoked", requestor.Dataplane, meshName)
+	}
+
 	meshRes := &core_mesh.MeshResource{}
 	if err := s.resourceManager.Get(ctx, meshRes, core_store.GetByKey(meshName, meshName)); err != nil {
 		return nil, errors.Wrapf(err, "failed to find a Mesh %q", meshName)
 	}
 
-	backend := meshRes.GetEnabledCertificateAuthorityBackend()
-	if backend == nil {
-		return nil, errors.Errorf("CA default backend in mesh %q has to be defined", meshName)
+	caManager, backend, err := s.caManagers.GetEnabled(meshName, &meshRes.Spec)
+	if err != nil {
+		return nil, err
 	}
 
-	caManager, exist := s.caManagers[backend.Type]
-	if !exist {
-		return nil, errors.Errorf("CA manager of type %s not exist", backend.Type)
+	rotation := meshRes.Spec.GetMtls().GetRotation()
+	var validity, window time.Duration
+	if rotation.GetExpiration() != nil {
+		if validity, err = ptypes.Duration(rotation.GetExpiration()); err != nil {
+			return nil, errors.Wrapf(err, "invalid expiration of dataplane certs in mesh %q", meshName)
+		}
+	}
+	if rotation.GetRotationWindow() != nil {
+		if window, err = ptypes.Duration(rotation.GetRotationWindow()); err != nil {
+			return nil, errors.Wrapf(err, "invalid rotation window of dataplane certs in mesh %q", meshName)
+		}
 	}
 
-	pair, err := caManager.GenerateDataplaneCert(ctx, meshName, *backend, requestor.Service)
+	pair, err := caManager.GenerateDataplaneCert(ctx, meshName, *backend, requestor.Service, validity)
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not generate dataplane cert for mesh: %q backend: %q service: %q", meshName, backend.Name, requestor.Service)
 	}
+	if err := s.revocationManager.CertIssued(ctx, dataplane.GetMeta(), backend.Name, pair.CertPEM); err != nil {
+		return nil, errors.Wrapf(err, "could not record dataplane cert for mesh: %q backend: %q service: %q", meshName, backend.Name, requestor.Service)
+	}
+	renewal, err := renewalTime(pair.CertPEM, window)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse dataplane cert for mesh: %q backend: %q service: %q", meshName, backend.Name, requestor.Service)
+	}
 
 	return &IdentityCertSecret{
 		PemCerts: [][]byte{pair.CertPEM},
 		PemKey:   pair.KeyPEM,
+		Renewal:  renewal,
 	}, nil
 }
+
+// renewalTime returns the time a cert has to be renewed at, i.e. the rotation window before it expires.
+// A CA might issue certs that are valid for less than the rotation window, e.g. Vault caps them by the TTL of a role,
+// so such a cert is renewed halfway through its validity instead.
+func renewalTime(certPEM []byte, window time.Duration) (time.Time, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return time.Time{}, errors.New("not a valid PEM certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, err
+	}
+	halfway := cert.NotBefore.Add(cert.NotAfter.Sub(cert.NotBefore) / 2)
+	if renewal := cert.NotAfter.Add(-window); renewal.After(halfway) {
+		return renewal, nil
+	}
+	return halfway, nil
+}
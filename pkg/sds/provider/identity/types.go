@@ -2,6 +2,7 @@ package identity
 
 import (
 	"bytes"
+	"time"
 
 	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
@@ -12,9 +13,14 @@ import (
 type IdentityCertSecret struct {
 	PemCerts [][]byte
 	PemKey   []byte
+	Renewal  time.Time
 }
 
-var _ sds_provider.Secret = &IdentityCertSecret{}
+var _ sds_provider.ExpiringSecret = &IdentityCertSecret{}
+
+func (s *IdentityCertSecret) RenewAt() time.Time {
+	return s.Renewal
+}
 
 func (s *IdentityCertSecret) ToResource(name string) *envoy_auth.Secret {
 	return &envoy_auth.Secret{
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"time"
 
 	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
 
@@ -12,6 +13,14 @@ type Secret interface {
 	ToResource(name string) *envoy_auth.Secret
 }
 
+// ExpiringSecret is a Secret that has to be replaced by a fresh one before it expires,
+// e.g. a short-lived dataplane certificate.
+type ExpiringSecret interface {
+	Secret
+	// RenewAt returns the time at which a fresh Secret has to be pushed to the dataplane
+	RenewAt() time.Time
+}
+
 type SecretProvider interface {
 	RequiresIdentity() bool
 	Get(ctx context.Context, name string, requestor sds_auth.Identity) (Secret, error)
@@ -4,7 +4,6 @@ import (
 	"context"
 
 	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
-	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
 	"github.com/pkg/errors"
 	kube_auth "k8s.io/api/authentication/v1"
 
@@ -74,11 +73,11 @@ func DefaultDataplaneResolver(resourceManager core_manager.ResourceManager) func
 }
 
 func DefaultMeshCaProvider(rt core_runtime.Runtime) sds_provider.SecretProvider {
-	return ca_sds_provider.New(rt.ResourceManager(), rt.CaManagers())
+	return ca_sds_provider.New(rt.ResourceManager(), rt.CaManagers(), rt.RevocationManager())
 }
 
 func DefaultIdentityCertProvider(rt core_runtime.Runtime) sds_provider.SecretProvider {
-	return identity_sds_provider.New(rt.ResourceManager(), rt.CaManagers())
+	return identity_sds_provider.New(rt.ResourceManager(), rt.CaManagers(), rt.RevocationManager())
 }
 
 func DefaultSecretProviderSelector(rt core_runtime.Runtime) func(string) (sds_provider.SecretProvider, error) {
@@ -102,7 +101,7 @@ func DefaultSecretDiscoveryHandler(rt core_runtime.Runtime) (SecretDiscoveryHand
 		return nil, err
 	}
 	secretProviderSelector := DefaultSecretProviderSelector(rt)
-	return SecretDiscoveryHandlerFunc(func(ctx context.Context, req envoy.DiscoveryRequest) (*envoy_auth.Secret, error) {
+	return SecretDiscoveryHandlerFunc(func(ctx context.Context, req envoy.DiscoveryRequest) (sds_provider.Secret, error) {
 		resource := req.ResourceNames[0]
 		provider, err := secretProviderSelector(resource)
 		if err != nil {
@@ -123,16 +122,12 @@ func DefaultSecretDiscoveryHandler(rt core_runtime.Runtime) (SecretDiscoveryHand
 				return nil, err
 			}
 		}
-		secret, err := provider.Get(ctx, resource, requestor)
-		if err != nil {
-			return nil, err
-		}
-		return secret.ToResource(resource), nil
+		return provider.Get(ctx, resource, requestor)
 	}), nil
 }
 
-type SecretDiscoveryHandlerFunc func(ctx context.Context, req envoy.DiscoveryRequest) (*envoy_auth.Secret, error)
+type SecretDiscoveryHandlerFunc func(ctx context.Context, req envoy.DiscoveryRequest) (sds_provider.Secret, error)
 
-func (f SecretDiscoveryHandlerFunc) Handle(ctx context.Context, req envoy.DiscoveryRequest) (*envoy_auth.Secret, error) {
+func (f SecretDiscoveryHandlerFunc) Handle(ctx context.Context, req envoy.DiscoveryRequest) (sds_provider.Secret, error) {
 	return f(ctx, req)
 }
@@ -18,6 +18,7 @@ import (
 	"context"
 	"strconv"
 	"sync/atomic"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
@@ -37,10 +38,11 @@ import (
 	envoy_server "github.com/envoyproxy/go-control-plane/pkg/server"
 
 	"github.com/Kong/kuma/pkg/core"
+	sds_provider "github.com/Kong/kuma/pkg/sds/provider"
 )
 
 type SecretDiscoveryHandler interface {
-	Handle(ctx context.Context, req envoy.DiscoveryRequest) (*envoy_auth.Secret, error)
+	Handle(ctx context.Context, req envoy.DiscoveryRequest) (sds_provider.Secret, error)
 }
 
 type Server interface {
@@ -74,6 +76,12 @@ type state struct {
 	resourceName string
 
 	secretNonce string
+
+	// request is the last SDS request that has been responded to
+	request *envoy.DiscoveryRequest
+
+	// secret is the last Secret that has been sent
+	secret *envoy_auth.Secret
 }
 
 func createResponse(resp *envoy_cache.Response, typeURL string) (*envoy.DiscoveryResponse, error) {
@@ -143,6 +151,47 @@ func (s *server) process(stream stream, reqCh <-chan *envoy.DiscoveryRequest, de
 		return out.Nonce, stream.Send(out)
 	}
 
+	// renewal fires when the Secret sent last has to be replaced by a fresh one, see sds_provider.ExpiringSecret
+	var renewal <-chan time.Time
+	var renewalTimer *time.Timer
+	defer func() {
+		if renewalTimer != nil {
+			renewalTimer.Stop()
+		}
+	}()
+
+	// responds to a request with the current version of a Secret.
+	// A renewed Secret is sent only if it has changed, e.g. a Secret that is only checked for changes periodically.
+	respond := func(req *envoy.DiscoveryRequest, renewing bool) error {
+		secret, err := s.source.Handle(stream.Context(), *req)
+		if err != nil {
+			return err
+		}
+
+		resource := secret.ToResource(req.ResourceNames[0])
+		if !renewing || !proto.Equal(resource, state.secret) {
+			resp := s.toResponse(req, resource)
+
+			nonce, err := send(resp, envoy_cache.SecretType)
+			if err != nil {
+				return err
+			}
+			state.secretNonce = nonce
+			state.request = req
+			state.secret = resource
+		}
+
+		if renewalTimer != nil {
+			renewalTimer.Stop()
+			renewal = nil
+		}
+		if expiring, ok := secret.(sds_provider.ExpiringSecret); ok {
+			renewalTimer = time.NewTimer(time.Until(expiring.RenewAt()))
+			renewal = renewalTimer.C
+		}
+		return nil
+	}
+
 	if s.callbacks != nil {
 		if err := s.callbacks.OnStreamOpen(stream.Context(), streamID, defaultTypeURL); err != nil {
 			return err
@@ -200,18 +249,17 @@ func (s *server) process(stream stream, reqCh <-chan *envoy.DiscoveryRequest, de
 				continue // ACK
 			}
 
-			secret, err := s.source.Handle(stream.Context(), *req)
-			if err != nil {
+			if err := respond(req, false); err != nil {
 				return err
 			}
 
-			resp := s.toResponse(req, secret)
-
-			nonce, err = send(resp, envoy_cache.SecretType)
-			if err != nil {
+		case <-renewal:
+			// push a fresh Secret before the one sent last expires
+			renewal = nil
+			log.V(1).Info("renewing an expiring secret", "resourceName", state.resourceName)
+			if err := respond(state.request, true); err != nil {
 				return err
 			}
-			state.secretNonce = nonce
 		}
 	}
 }
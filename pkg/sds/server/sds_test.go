@@ -9,10 +9,12 @@ import (
 
 	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	sds_provider "github.com/Kong/kuma/pkg/sds/provider"
 	. "github.com/Kong/kuma/pkg/sds/server"
 
 	test_logr "github.com/Kong/kuma/pkg/test/logr"
@@ -87,8 +89,8 @@ var _ = Describe("Sds", func() {
 
 	It("should support valid SDS requests", func(done Done) {
 		// given
-		handler := SecretDiscoveryHandlerFunc(func(ctx context.Context, req envoy.DiscoveryRequest) (*envoy_auth.Secret, error) {
-			return &envoy_auth.Secret{}, nil
+		handler := SecretDiscoveryHandlerFunc(func(ctx context.Context, req envoy.DiscoveryRequest) (sds_provider.Secret, error) {
+			return &staticSecret{}, nil
 		})
 		sds := NewServer(handler, nil, test_logr.NewTestLogger(GinkgoT()))
 
@@ -117,8 +119,137 @@ var _ = Describe("Sds", func() {
 		// finally
 		close(done)
 	})
+
+	It("should push a fresh secret before the one sent last expires", func(done Done) {
+		// given
+		handled := 0
+		handler := SecretDiscoveryHandlerFunc(func(ctx context.Context, req envoy.DiscoveryRequest) (sds_provider.Secret, error) {
+			handled++
+			if handled == 1 {
+				return &expiringSecret{data: "first", renewAt: time.Now().Add(100 * time.Millisecond)}, nil
+			}
+			return &expiringSecret{data: "second", renewAt: time.Now().Add(time.Hour)}, nil
+		})
+		sds := NewServer(handler, nil, test_logr.NewTestLogger(GinkgoT()))
+
+		// when
+		errCh := make(chan error)
+		go func() {
+			defer GinkgoRecover()
+
+			errCh <- sds.StreamSecrets(stream)
+		}()
+
+		// when
+		stream.in <- &envoy.DiscoveryRequest{
+			ResourceNames: []string{"identity_cert"},
+		}
+		// then
+		first := <-stream.out
+		Expect(first).ToNot(BeNil())
+
+		// when ACK is received
+		stream.in <- &envoy.DiscoveryRequest{
+			ResourceNames: []string{"identity_cert"},
+			ResponseNonce: first.Nonce,
+		}
+		// then a fresh secret is pushed without a request once renewal is due
+		second := <-stream.out
+		Expect(second.Nonce).ToNot(Equal(first.Nonce))
+		Expect(second.VersionInfo).ToNot(Equal(first.VersionInfo))
+		Expect(second.Resources).To(HaveLen(1))
+
+		// and the fresh secret is not renewed until it is due
+		select {
+		case <-stream.out:
+			Fail("SDS server should not renew a secret before it is due")
+		case <-time.After(200 * time.Millisecond):
+		}
+
+		// when
+		close(stream.in)
+		// then
+		err := <-errCh
+		Expect(err).ToNot(HaveOccurred())
+		Expect(handled).To(Equal(2))
+
+		// finally
+		close(done)
+	})
+	It("should not push a renewed secret that has not changed", func(done Done) {
+		// given
+		handled := make(chan struct{}, 100)
+		handler := SecretDiscoveryHandlerFunc(func(ctx context.Context, req envoy.DiscoveryRequest) (sds_provider.Secret, error) {
+			handled <- struct{}{}
+			return &expiringSecret{data: "same", renewAt: time.Now().Add(50 * time.Millisecond)}, nil
+		})
+		sds := NewServer(handler, nil, test_logr.NewTestLogger(GinkgoT()))
+
+		// when
+		errCh := make(chan error)
+		go func() {
+			defer GinkgoRecover()
+
+			errCh <- sds.StreamSecrets(stream)
+		}()
+
+		// when
+		stream.in <- &envoy.DiscoveryRequest{
+			ResourceNames: []string{"mesh_ca"},
+		}
+		// then
+		first := <-stream.out
+		Expect(first).ToNot(BeNil())
+
+		// when renewal is due more than once
+		<-handled
+		<-handled
+		<-handled
+
+		// then the unchanged secret is not pushed again
+		Consistently(stream.out, 100*time.Millisecond).ShouldNot(Receive())
+
+		// when
+		close(stream.in)
+		// then
+		err := <-errCh
+		Expect(err).ToNot(HaveOccurred())
+
+		// finally
+		close(done)
+	})
 })
 
+type staticSecret struct{}
+
+func (s *staticSecret) ToResource(name string) *envoy_auth.Secret {
+	return &envoy_auth.Secret{Name: name}
+}
+
+type expiringSecret struct {
+	data    string
+	renewAt time.Time
+}
+
+func (s *expiringSecret) ToResource(name string) *envoy_auth.Secret {
+	return &envoy_auth.Secret{
+		Name: name,
+		Type: &envoy_auth.Secret_ValidationContext{
+			ValidationContext: &envoy_auth.CertificateValidationContext{
+				TrustedCa: &envoy_core.DataSource{
+					Specifier: &envoy_core.DataSource_InlineString{
+						InlineString: s.data,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *expiringSecret) RenewAt() time.Time {
+	return s.renewAt
+}
+
 func newMockStream() *mockStream {
 	return &mockStream{
 		ctx: context.Background(),
@@ -1,8 +1,10 @@
 package runtime
 
 import (
+	"github.com/Kong/kuma/pkg/core/ca/revocation"
 	"github.com/Kong/kuma/pkg/core/datasource"
 	mesh_managers "github.com/Kong/kuma/pkg/core/managers/apis/mesh"
+	traffic_trace_managers "github.com/Kong/kuma/pkg/core/managers/apis/traffictrace"
 	core_mesh "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
 	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
 	core_model "github.com/Kong/kuma/pkg/core/resources/model"
@@ -35,7 +37,9 @@ func BuilderFor(cfg kuma_cp.Config) *core_runtime.Builder {
 		WithXdsContext(core_xds.NewXdsContext())
 
 	builder.WithDataSourceLoader(datasource.NewDataSourceLoader(builder.SecretManager()))
-	builder.WithSecretManager(newSecretManager(builder))
+	builder.WithSecretManager(newSecretManager(builder)).
+		WithSecretCipher(secret_cipher.None())
+	builder.WithRevocationManager(revocation.NewManager(builder.SecretManager()))
 
 	rm := newResourceManager(builder)
 	builder.WithResourceManager(rm).
@@ -59,5 +63,6 @@ func newResourceManager(builder *core_runtime.Builder) core_manager.ResourceMana
 	}
 	meshManager := mesh_managers.NewMeshManager(builder.ResourceStore(), customizableManager, builder.SecretManager(), builder.CaManagers(), registry.Global(), validator)
 	customManagers[core_mesh.MeshType] = meshManager
+	customManagers[core_mesh.TrafficTraceType] = traffic_trace_managers.NewTrafficTraceManager(builder.ResourceStore(), defaultManager)
 	return customizableManager
 }
@@ -2,6 +2,7 @@ package tls
 
 import (
 	"bytes"
+	"crypto/ecdsa"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
@@ -34,6 +35,12 @@ func pemEncodeKey(priv interface{}) ([]byte, error) {
 	switch k := priv.(type) {
 	case *rsa.PrivateKey:
 		block = &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(k)}
+	case *ecdsa.PrivateKey:
+		der, err := x509.MarshalECPrivateKey(k)
+		if err != nil {
+			return nil, err
+		}
+		block = &pem.Block{Type: "EC PRIVATE KEY", Bytes: der}
 	default:
 		return nil, errors.Errorf("unsupported private key type %T", priv)
 	}
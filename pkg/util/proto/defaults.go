@@ -0,0 +1,79 @@
+package proto
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// ApplyDefaults deep-merges defaults into msg, filling only the fields that are not set in msg.
+//
+// Presence of a field is determined the same way as in the generated code:
+// message fields (including well-known wrappers like google.protobuf.UInt32Value)
+// are set when non-nil, repeated and map fields are set when non-empty, oneofs are set when any case is chosen.
+// Plain proto3 scalars have no presence, so a scalar equal to its zero value (e.g. `port: 0`) is treated as unset.
+// Use a wrapper type for a field where an explicit zero value has to survive defaulting.
+func ApplyDefaults(msg proto.Message, defaults proto.Message) {
+	if defaults == nil || reflect.ValueOf(defaults).IsNil() {
+		return
+	}
+	if reflect.TypeOf(msg) != reflect.TypeOf(defaults) {
+		panic(fmt.Sprintf("cannot apply defaults of type %T to a message of type %T", defaults, msg))
+	}
+	// clone defaults so that msg never shares its state with defaults
+	applyDefaults(reflect.ValueOf(msg).Elem(), reflect.ValueOf(proto.Clone(defaults)).Elem())
+}
+
+func applyDefaults(dst, src reflect.Value) {
+	for i := 0; i < dst.NumField(); i++ {
+		if strings.HasPrefix(dst.Type().Field(i).Name, "XXX_") {
+			continue
+		}
+		d, s := dst.Field(i), src.Field(i)
+		switch d.Kind() {
+		case reflect.Ptr:
+			if s.IsNil() {
+				continue
+			}
+			if d.IsNil() {
+				d.Set(s)
+			} else if !isWellKnownType(d) {
+				applyDefaults(d.Elem(), s.Elem())
+			}
+		case reflect.Interface: // oneof
+			if s.IsNil() {
+				continue
+			}
+			if d.IsNil() {
+				d.Set(s)
+			} else if d.Elem().Type() == s.Elem().Type() {
+				applyDefaultsToOneof(d.Elem().Elem(), s.Elem().Elem())
+			}
+		case reflect.Slice, reflect.Map:
+			if d.Len() == 0 {
+				d.Set(s)
+			}
+		default:
+			if d.Interface() == reflect.Zero(d.Type()).Interface() {
+				d.Set(s)
+			}
+		}
+	}
+}
+
+// applyDefaultsToOneof merges a value of the same oneof case, e.g. `*TracingBackend_Zipkin_`.
+func applyDefaultsToOneof(dst, src reflect.Value) {
+	d, s := dst.Field(0), src.Field(0)
+	if d.Kind() == reflect.Ptr && !d.IsNil() && !s.IsNil() && !isWellKnownType(d) {
+		applyDefaults(d.Elem(), s.Elem())
+	}
+}
+
+// isWellKnownType checks whether a value is one of google.protobuf types, e.g. google.protobuf.UInt32Value.
+// Such types are treated as scalars, so that a set wrapper keeps its value even when it is a zero value.
+func isWellKnownType(v reflect.Value) bool {
+	msg, ok := v.Interface().(proto.Message)
+	return ok && strings.HasPrefix(proto.MessageName(msg), "google.protobuf.")
+}
@@ -0,0 +1,182 @@
+package proto_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+var _ = Describe("ApplyDefaults()", func() {
+
+	type testCase struct {
+		input    string
+		defaults string
+		expected string
+	}
+
+	DescribeTable("should fill only unset fields",
+		func(given testCase) {
+			// given
+			mesh := &mesh_proto.Mesh{}
+			Expect(util_proto.FromYAML([]byte(given.input), mesh)).To(Succeed())
+			defaults := &mesh_proto.Mesh{}
+			Expect(util_proto.FromYAML([]byte(given.defaults), defaults)).To(Succeed())
+
+			// when
+			util_proto.ApplyDefaults(mesh, defaults)
+
+			// then
+			actual, err := util_proto.ToYAML(mesh)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("partial metrics config", testCase{
+			input: `
+            metrics:
+              prometheus:
+                path: /non-standard-path
+`,
+			defaults: `
+            metrics:
+              prometheus:
+                port: 5670
+                path: /metrics
+`,
+			expected: `
+            metrics:
+              prometheus:
+                port: 5670
+                path: /non-standard-path
+`,
+		}),
+		Entry("unset metrics config", testCase{
+			input: `
+            mtls:
+              enabledBackend: ca-1
+`,
+			defaults: `
+            metrics:
+              prometheus:
+                port: 5670
+                path: /metrics
+`,
+			expected: `
+            mtls:
+              enabledBackend: ca-1
+            metrics:
+              prometheus:
+                port: 5670
+                path: /metrics
+`,
+		}),
+		Entry("user-set values", testCase{
+			input: `
+            metrics:
+              prometheus:
+                port: 1234
+                path: /non-standard-path
+`,
+			defaults: `
+            metrics:
+              prometheus:
+                port: 5670
+                path: /metrics
+`,
+			expected: `
+            metrics:
+              prometheus:
+                port: 1234
+                path: /non-standard-path
+`,
+		}),
+		Entry("repeated fields are not merged element-wise", testCase{
+			input: `
+            logging:
+              backends:
+              - name: file-1
+                file:
+                  path: /tmp/access.log
+`,
+			defaults: `
+            logging:
+              defaultBackend: file-1
+              backends:
+              - name: tcp-1
+                tcp:
+                  address: logstash:5000
+`,
+			expected: `
+            logging:
+              defaultBackend: file-1
+              backends:
+              - name: file-1
+                file:
+                  path: /tmp/access.log
+`,
+		}),
+		Entry("oneof of the same type", testCase{
+			input: `
+            tracing:
+              backends:
+              - name: zipkin-1
+                zipkin:
+                  url: http://zipkin.local:9411/v2/spans
+`,
+			defaults: `
+            tracing:
+              backends:
+              - name: zipkin-1
+                zipkin:
+                  url: http://zipkin.default:9411/v2/spans
+                  apiVersion: httpJson
+`,
+			expected: `
+            tracing:
+              backends:
+              - name: zipkin-1
+                zipkin:
+                  url: http://zipkin.local:9411/v2/spans
+`,
+		}),
+	)
+
+	It("should not overwrite explicit zero value of a wrapper", func() {
+		// given
+		backend := &mesh_proto.TracingBackend{}
+		Expect(util_proto.FromYAML([]byte(`
+        name: zipkin-1
+        sampling: 0.0
+`), backend)).To(Succeed())
+		defaults := &mesh_proto.TracingBackend{}
+		Expect(util_proto.FromYAML([]byte(`
+        sampling: 100.0
+`), defaults)).To(Succeed())
+
+		// when
+		util_proto.ApplyDefaults(backend, defaults)
+
+		// then
+		Expect(backend.Sampling).ToNot(BeNil())
+		Expect(backend.Sampling.Value).To(Equal(0.0))
+	})
+
+	It("should not share state with defaults", func() {
+		// given
+		mesh := &mesh_proto.Mesh{}
+		defaults := &mesh_proto.Mesh{
+			Metrics: &mesh_proto.Metrics{
+				Prometheus: &mesh_proto.Metrics_Prometheus{Port: 5670, Path: "/metrics"},
+			},
+		}
+
+		// when
+		util_proto.ApplyDefaults(mesh, defaults)
+		mesh.Metrics.Prometheus.Port = 1234
+
+		// then
+		Expect(defaults.Metrics.Prometheus.Port).To(Equal(uint32(5670)))
+	})
+})
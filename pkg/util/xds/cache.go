@@ -17,13 +17,19 @@ package xds
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+
+	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache"
 	envoy_log "github.com/envoyproxy/go-control-plane/pkg/log"
+	envoy_xds "github.com/envoyproxy/go-control-plane/pkg/server"
 )
 
 // This is a slightly modified version of SnapshotCache from github.com/envoyproxy/go-control-plane
@@ -61,6 +67,63 @@ type Snapshot interface {
 
 	// WithVersion creates a new snapshot with a different version for a given resource type.
 	WithVersion(typ string, version string) Snapshot
+
+	// WithResources creates a new snapshot with different resources and version for a given resource type.
+	// Resources of other types are shared with the original snapshot.
+	WithResources(typ string, version string, resources map[string]envoy_cache.Resource) Snapshot
+
+	// Clone creates a copy of the snapshot that does not share resource maps with the original,
+	// so that the original can be safely modified afterwards.
+	Clone() Snapshot
+}
+
+// CloneResources creates a copy of Resources that does not share the map of items with the original.
+func CloneResources(resources envoy_cache.Resources) envoy_cache.Resources {
+	return envoy_cache.Resources{Version: resources.Version, Items: cloneItems(resources.Items)}
+}
+
+func cloneItems(items map[string]envoy_cache.Resource) map[string]envoy_cache.Resource {
+	if items == nil {
+		return nil
+	}
+	clone := make(map[string]envoy_cache.Resource, len(items))
+	for name, item := range items {
+		clone[name] = item
+	}
+	return clone
+}
+
+// IndexResourcesByName creates a map from the resource name to the resource.
+// Unlike its counterpart from github.com/envoyproxy/go-control-plane library,
+// it returns an error instead of silently collapsing resources of the same name.
+func IndexResourcesByName(typ string, items []envoy_cache.Resource) (map[string]envoy_cache.Resource, error) {
+	indexed := make(map[string]envoy_cache.Resource, len(items))
+	for _, item := range items {
+		name := envoy_cache.GetResourceName(item)
+		if _, exists := indexed[name]; exists {
+			return nil, fmt.Errorf("duplicate resource name %q of type %s", name, typ)
+		}
+		indexed[name] = item
+	}
+	return indexed, nil
+}
+
+// validateResourceNames verifies that every resource is indexed under a distinct name.
+// Resources whose names are not known to github.com/envoyproxy/go-control-plane library
+// are assumed to be indexed by their names.
+func validateResourceNames(typ string, resources map[string]envoy_cache.Resource) error {
+	names := make(map[string]bool, len(resources))
+	for key, resource := range resources {
+		name := envoy_cache.GetResourceName(resource)
+		if name == "" {
+			name = key
+		}
+		if names[name] {
+			return fmt.Errorf("duplicate resource name %q of type %s", name, typ)
+		}
+		names[name] = true
+	}
+	return nil
 }
 
 // SnapshotCache is a snapshot-based cache that maintains a single versioned
@@ -72,6 +135,10 @@ type Snapshot interface {
 // to ensure that Envoy makes the request for all EDS clusters or RDS routes
 // eventually.
 //
+// EDS watches that name clusters explicitly are responded only when endpoints of any of those clusters
+// change, and only with endpoints of the changed clusters, so that a single flapping endpoint doesn't cause
+// every cluster's assignment to be sent again.
+//
 // SnapshotCache can operate as a REST or regular xDS backend. The snapshot
 // can be partial, e.g. only include RDS or EDS resources.
 type SnapshotCache interface {
@@ -83,15 +150,125 @@ type SnapshotCache interface {
 	//
 	// This method will cause the server to respond to all open watches, for which
 	// the version differs from the snapshot version.
+	//
+	// The cache keeps its own copy of the snapshot, so the caller is free to modify
+	// the snapshot afterwards.
+	//
+	// Setting a snapshot equal to the current one, including versions, is a no-op
+	// that leaves open watches untouched.
+	//
+	// It returns an error if the snapshot contains several resources of the same type and name.
 	SetSnapshot(node string, snapshot Snapshot) error
 
+	// SetSnapshots sets snapshots of several nodes at once, e.g. to warm the cache up.
+	// It behaves as SetSnapshot called for every node, but locks the cache only once.
+	//
+	// It returns errors of nodes whose snapshots could not be set indexed by node IDs.
+	// Snapshots of other nodes are set anyway.
+	SetSnapshots(snapshots map[string]Snapshot) map[string]error
+
+	// SetSnapshotResources updates resources of a single type in the existing snapshot for a node,
+	// leaving resources and versions of other types untouched.
+	//
+	// This method will cause the server to respond only to open watches of that type, for which
+	// the version differs from the new version. It returns an error if there is no snapshot for a node yet.
+	SetSnapshotResources(node string, typ string, version string, resources map[string]envoy_cache.Resource) error
+
+	// ClearSnapshotType removes resources of a single type from the existing snapshot for a node,
+	// e.g. to force Envoy to resolve endpoints again, leaving resources of other types and the node status untouched.
+	//
+	// The version of that type is bumped, so open watches of that type get an empty response,
+	// including watches that name specific resources, so that Envoy removes them.
+	// It returns an error if there is no snapshot for a node yet.
+	ClearSnapshotType(node string, typ string) error
+
+	// SetDefaultSnapshot sets a snapshot served to nodes that have no snapshot of their own yet,
+	// e.g. a minimal safe configuration for dataplanes that connect before their snapshot is computed.
+	//
+	// A snapshot set for a node always takes precedence over the default one, so the node gets
+	// its own snapshot on the next watch. Open watches of nodes without a snapshot are responded
+	// with the default snapshot right away. Setting nil removes the default snapshot.
+	//
+	// It returns an error if the snapshot contains several resources of the same type and name.
+	SetDefaultSnapshot(snapshot Snapshot) error
+
 	// GetSnapshots gets the snapshot for a node.
+	// The default snapshot is not returned for nodes that have no snapshot of their own.
 	GetSnapshot(node string) (Snapshot, error)
 
+	// GetLatest returns resources of a given type that are currently served to a node together with their version,
+	// without opening a watch. If names are given, only the matching resources are returned,
+	// the same way as in a response to a watch. It returns false if there is neither a snapshot for a node
+	// nor a default one or the snapshot doesn't support a given type.
+	GetLatest(node string, typeURL string, names ...string) (map[string]envoy_cache.Resource, string, bool)
+
 	// ClearSnapshot removes all status and snapshot information associated with a node.
+	// See WithSnapshotClearedCallback to get notified about cleared nodes.
 	ClearSnapshot(node string)
+
+	// CancelWatches releases all open watches of a node at once, e.g. when its dataplane disconnects,
+	// leaving its snapshot and status untouched. Channels of released watches are closed,
+	// so that consumers blocked on them, including watches created by CreateWatchReplay, are released too.
+	// Cancellation functions of released watches remain safe to call.
+	CancelWatches(node string)
+
+	// GetRetainedBytes returns the size of snapshot resources retained for a node, indexed by resource type.
+	// It is the marshalled size of resources, or their compressed size if the cache compresses snapshots.
+	GetRetainedBytes(node string) (map[string]int, error)
+
+	// GetSnapshotSetTime returns the time the snapshot currently served to a node was last updated,
+	// either by SetSnapshot or SetSnapshotResources, so that the age of the snapshot can be computed
+	// as now - setTime, e.g. to alert on stale configuration. Setting an equal snapshot doesn't update it.
+	// It returns false if there is no snapshot for a node.
+	GetSnapshotSetTime(node string) (time.Time, bool)
+
+	// CreateWatchReplay returns a watch that delivers the current state of resources of the requested type
+	// right away if there is a snapshot for a node, regardless of the requested version,
+	// and then streams subsequent updates on the same channel until the watch is cancelled.
+	//
+	// Unlike CreateWatch, whose channel gets at most one response, the channel stays open until cancelled.
+	// Responses are delivered in the order snapshots are set, but versions set while a previous response
+	// has not been consumed yet are skipped, so that a slow consumer catches up with the latest version
+	// rather than with every intermediate one. The channel is closed once the watch is cancelled
+	// or the node of the request cannot be grouped.
+	CreateWatchReplay(request envoy_cache.Request) (chan envoy_cache.Response, func())
+
+	// GetResponseInfo returns the node, type and version of resources of a response sent on an xDS stream
+	// identified by the nonce the xDS server assigned to it, so that an ACK or a NACK of a node can be correlated
+	// with the exact snapshot that produced it. Responses are recorded only by callbacks returned by ResponseCallbacks
+	// and are retained for a limited time, see WithNonceRetention, or until the stream is closed.
+	// It returns false if the nonce is unknown or has already expired.
+	GetResponseInfo(streamID int64, nonce string) (ResponseInfo, bool)
+
+	// ResponseCallbacks returns callbacks of an xDS server that record responses sent on xDS streams
+	// with nonces assigned by the server, see GetResponseInfo. They must be registered with the xDS server
+	// that serves the cache.
+	ResponseCallbacks() envoy_xds.Callbacks
+
+	// FetchWait is a long-polling variant of Fetch. If the requested version is up-to-date
+	// or there is no snapshot for a node yet, it blocks until a newer snapshot is set
+	// or the context is done, in which case SkipFetchError is returned.
+	FetchWait(ctx context.Context, request envoy_cache.Request) (*envoy_cache.Response, error)
 }
 
+// ReadOnlyCache is a read-only view of SnapshotCache for components that only inspect the cache,
+// e.g. metrics or debug endpoints, so that they cannot modify snapshots served to nodes by accident.
+type ReadOnlyCache interface {
+	// GetSnapshot gets the snapshot for a node.
+	GetSnapshot(node string) (Snapshot, error)
+
+	// GetStatusKeys retrieves all node IDs in the status map.
+	GetStatusKeys() []string
+
+	// GetStatusInfo retrieves status information for a node ID.
+	GetStatusInfo(node string) envoy_cache.StatusInfo
+
+	// Fetch returns resources of a requested type currently served to a node without opening a watch.
+	Fetch(ctx context.Context, request envoy_cache.Request) (*envoy_cache.Response, error)
+}
+
+var _ ReadOnlyCache = SnapshotCache(nil)
+
 type snapshotCache struct {
 	log envoy_log.Logger
 
@@ -101,18 +278,363 @@ type snapshotCache struct {
 	// snapshots are cached resources indexed by node IDs
 	snapshots map[string]Snapshot
 
+	// defaultSnapshot is an optional snapshot served to nodes without a snapshot of their own
+	defaultSnapshot Snapshot
+
 	// status information for all nodes indexed by node IDs
 	status map[string]*statusInfo
 
-	// hash is the hashing function for Envoy nodes
-	hash envoy_cache.NodeHash
+	// group maps Envoy nodes to node group keys
+	group NodeGroup
 
 	// watchCount is an atomic counter incremented for each watch
 	watchCount int64
 
+	// compression flag to keep snapshot resources gzip-compressed
+	compression bool
+
+	// setTimes are timestamps of the last update of snapshot resources indexed by node IDs and resource types
+	setTimes map[string]map[string]time.Time
+
+	// onResponse is an optional callback invoked when a response is delivered on a watch
+	onResponse func(ResponseStats)
+
+	// now is the clock used to measure delivery latency and snapshot age
+	now func() time.Time
+
+	// versionFunc computes versions of snapshot resources sent to nodes
+	versionFunc VersionFunc
+
+	// marshaler is an optional custom marshaler of resources in responses
+	marshaler ResourceMarshaler
+
+	// onWatchPressure is an optional callback invoked when the buffer of a streaming watch becomes full or is drained
+	onWatchPressure func(WatchPressure)
+
+	// onSnapshotCleared is an optional callback invoked when a node is cleared from the cache
+	onSnapshotCleared func(node string)
+
+	// sizeLimits are optional limits of the size of snapshot resources of a single type
+	sizeLimits SizeLimits
+
+	// sorted flag to order resources of responses by name
+	sorted bool
+
+	// indexers compute index keys of resources indexed by resource types
+	indexers map[string]IndexFunc
+
+	// indexes are lazily built indexes of snapshot resources indexed by node IDs and resource types
+	indexes map[string]map[string]*resourceIndex
+
+	// expectedTypes is an optional check of types populated by snapshots
+	expectedTypes *ExpectedTypes
+
+	// transform is an optional transform applied to every snapshot before it is stored
+	transform SnapshotTransform
+
+	// responses are infos of responses sent on xDS streams indexed by stream IDs and nonces
+	responses map[responseKey]ResponseInfo
+
+	// responseKeys are keys of retained responses in the order they were sent
+	responseKeys []responseKey
+
+	// responseMu guards responses, which are recorded by callbacks of an xDS server
+	responseMu sync.RWMutex
+
+	// nonceRetention is the time infos of responses are retained for
+	nonceRetention time.Duration
+
+	// endpointVersions are versions at which endpoints of clusters last changed indexed by node IDs and cluster names,
+	// so that EDS watches that name clusters are responded only when endpoints of those clusters change
+	endpointVersions map[string]map[string]string
+
+	// indexMu guards indexes, which are built on reads as well
+	indexMu sync.Mutex
+
 	mu sync.RWMutex
 }
 
+// NodeGroup maps Envoy nodes to keys of node groups that share a snapshot.
+//
+// Unlike envoy_cache.NodeHash, it may fail to map a node, e.g. if the node
+// is identified by structured metadata that turns out to be malformed.
+type NodeGroup interface {
+	ID(node *envoy_core.Node) (string, error)
+}
+
+// NodeGroupFunc is an adapter to use an ordinary function as NodeGroup.
+type NodeGroupFunc func(node *envoy_core.Node) (string, error)
+
+func (f NodeGroupFunc) ID(node *envoy_core.Node) (string, error) {
+	return f(node)
+}
+
+// NodeGroupFromHash adapts an error-free envoy_cache.NodeHash to NodeGroup.
+func NodeGroupFromHash(hash envoy_cache.NodeHash) NodeGroup {
+	return NodeGroupFunc(func(node *envoy_core.Node) (string, error) {
+		return hash.ID(node), nil
+	})
+}
+
+// SnapshotCacheOption configures optional behaviour of SnapshotCache.
+type SnapshotCacheOption func(*snapshotCache)
+
+// WithCompression makes SnapshotCache keep resources of every snapshot gzip-compressed
+// and decompress them only when a response is assembled. It trades CPU for memory,
+// which pays off for big meshes with large listener and route payloads.
+//
+// Notice that GetSnapshot returns a compressed copy of the snapshot rather than the original one.
+func WithCompression() SnapshotCacheOption {
+	return func(cache *snapshotCache) {
+		cache.compression = true
+	}
+}
+
+// WithSortedResources makes SnapshotCache order resources of every response by name,
+// so that responses for the same snapshot are identical, e.g. in golden files or when diffed.
+// By default, resources are ordered at random.
+func WithSortedResources() SnapshotCacheOption {
+	return func(cache *snapshotCache) {
+		cache.sorted = true
+	}
+}
+
+// ResponseStats describes delivery of a response on a watch.
+type ResponseStats struct {
+	// Node is the ID of a node group the response was delivered to.
+	Node string
+	// TypeURL is the type of resources in the response.
+	TypeURL string
+	// Version is the version of resources in the response.
+	Version string
+	// Latency is the time between the update of the snapshot resources and the delivery of the response.
+	Latency time.Duration
+}
+
+// WithResponseStatsCallback makes SnapshotCache invoke a callback every time a response
+// is delivered on a watch, which allows to catch slow consumers of xDS configuration.
+//
+// The callback is invoked synchronously while the cache is locked, so it must be fast
+// and must not call the cache back.
+func WithResponseStatsCallback(callback func(ResponseStats)) SnapshotCacheOption {
+	return func(cache *snapshotCache) {
+		cache.onResponse = callback
+	}
+}
+
+// WatchPressure describes a change of the buffer state of a watch created by CreateWatchReplay.
+type WatchPressure struct {
+	// Node is the ID of a node group the watch belongs to.
+	Node string
+	// TypeURL is the type of resources of the watch.
+	TypeURL string
+	// Full is true once a response cannot be buffered because the consumer has not received
+	// the previous one yet, and false once the consumer has drained the buffer.
+	Full bool
+}
+
+// WithWatchPressureCallback makes SnapshotCache invoke a callback every time the buffer of a watch
+// created by CreateWatchReplay becomes full or is drained, so that an xDS server can apply flow control,
+// e.g. stop reading requests of a stream, while the consumer of the watch is lagging behind.
+//
+// The callback is invoked from a goroutine of the watch, which is blocked until the callback returns,
+// and not while the cache is locked, so it may call the cache back.
+func WithWatchPressureCallback(callback func(WatchPressure)) SnapshotCacheOption {
+	return func(cache *snapshotCache) {
+		cache.onWatchPressure = callback
+	}
+}
+
+// WithSnapshotClearedCallback makes SnapshotCache invoke a callback once a node is cleared
+// from the cache, so that resources minted for the node, e.g. short-lived mTLS certificates,
+// can be released.
+//
+// The callback is invoked exactly once per clear of a node known to the cache, after the cache
+// has been unlocked, so it may call the cache back.
+func WithSnapshotClearedCallback(callback func(node string)) SnapshotCacheOption {
+	return func(cache *snapshotCache) {
+		cache.onSnapshotCleared = callback
+	}
+}
+
+// SizeLimits are limits of the marshalled size of snapshot resources of a single type,
+// i.e. of a single xDS response, regardless of whether the cache compresses snapshots.
+// Zero value of a limit disables it.
+type SizeLimits struct {
+	// Soft is the size above which a warning is reported, but the snapshot is still set.
+	Soft int
+	// Hard is the size above which the snapshot is rejected with an error,
+	// e.g. to stay below the gRPC message limit of Envoy.
+	Hard int
+	// OnSoftLimit is an optional callback invoked instead of logging a warning
+	// when the soft limit is exceeded.
+	OnSoftLimit func(SizeLimitExceeded)
+}
+
+// SizeLimitExceeded describes snapshot resources of a single type that exceed a size limit.
+type SizeLimitExceeded struct {
+	// Node is the ID of a node group the snapshot is set for.
+	Node string
+	// TypeURL is the type of resources that exceed the limit.
+	TypeURL string
+	// Size is the marshalled size of resources in bytes.
+	Size int
+	// Limit is the exceeded limit in bytes.
+	Limit int
+}
+
+// WithSizeLimits makes SnapshotCache check the size of snapshot resources of every type when
+// a snapshot is set, so that scale issues of big meshes are caught before Envoy resets xDS streams
+// with oversized messages.
+func WithSizeLimits(limits SizeLimits) SnapshotCacheOption {
+	return func(cache *snapshotCache) {
+		cache.sizeLimits = limits
+	}
+}
+
+// ExpectedTypes configures a check of types of resources every snapshot of a node is expected to populate.
+type ExpectedTypes struct {
+	// Types are types of resources expected in every snapshot, e.g. clusters and listeners.
+	// If not set, types a node has opened watches for are expected.
+	Types []string
+	// OnMissing is an optional callback invoked instead of logging a warning
+	// when a snapshot has no resources of an expected type.
+	OnMissing func(MissingTypes)
+}
+
+// MissingTypes describes a snapshot that has no resources of some of the expected types.
+type MissingTypes struct {
+	// Node is the ID of a node group the snapshot is set for.
+	Node string
+	// TypeURLs are the expected types the snapshot has no resources of.
+	TypeURLs []string
+}
+
+// WithExpectedTypes makes SnapshotCache report snapshots set by SetSnapshot or SetSnapshots that have
+// no resources of an expected type, which Envoy treats as if all resources of that type were deleted.
+// Snapshots are set anyway, since a type might be legitimately empty, e.g. routes of a TCP-only node.
+func WithExpectedTypes(expected ExpectedTypes) SnapshotCacheOption {
+	return func(cache *snapshotCache) {
+		cache.expectedTypes = &expected
+	}
+}
+
+// SnapshotTransform transforms a snapshot set for a node before the cache stores it,
+// e.g. to add resources that every node should get. The node is empty for the default snapshot.
+//
+// A transform must not modify the given snapshot, but rather return a new one, e.g. created by WithResources.
+type SnapshotTransform func(node string, snapshot Snapshot) (Snapshot, error)
+
+// WithSnapshotTransform makes SnapshotCache apply a transform to every snapshot set by SetSnapshot,
+// SetSnapshots or SetDefaultSnapshot, so that configuration served to all nodes can be augmented centrally
+// instead of at every call site. Snapshots are validated, compared and versioned after the transform,
+// so that the served resources are the transformed ones.
+func WithSnapshotTransform(transform SnapshotTransform) SnapshotCacheOption {
+	return func(cache *snapshotCache) {
+		cache.transform = transform
+	}
+}
+
+// DefaultNonceRetention is the default time infos of responses are retained for, see GetResponseInfo.
+const DefaultNonceRetention = time.Minute
+
+// WithNonceRetention makes SnapshotCache retain infos of responses sent on xDS streams for a given time
+// instead of DefaultNonceRetention. It should exceed the time a node takes to ACK or NACK a response.
+func WithNonceRetention(retention time.Duration) SnapshotCacheOption {
+	return func(cache *snapshotCache) {
+		cache.nonceRetention = retention
+	}
+}
+
+// ResponseInfo describes a response sent on an xDS stream.
+type ResponseInfo struct {
+	// Node is the ID of a node group the response was sent to.
+	Node string
+	// TypeURL is the type of resources in the response.
+	TypeURL string
+	// Version is the version of snapshot resources in the response.
+	Version string
+	// SentAt is the time the response was sent.
+	SentAt time.Time
+}
+
+// responseKey identifies a response sent on an xDS stream, since xDS servers assign nonces per stream.
+type responseKey struct {
+	streamID int64
+	nonce    string
+}
+
+// WithClock makes SnapshotCache use a given clock instead of time.Now.
+func WithClock(now func() time.Time) SnapshotCacheOption {
+	return func(cache *snapshotCache) {
+		cache.now = now
+	}
+}
+
+// WithNodeGroup makes SnapshotCache map requests to snapshots with a NodeGroup instead of the hash,
+// so that requests of nodes that cannot be grouped are rejected rather than mapped to a bad key.
+func WithNodeGroup(group NodeGroup) SnapshotCacheOption {
+	return func(cache *snapshotCache) {
+		cache.group = group
+	}
+}
+
+// VersionFunc computes the version of snapshot resources of a given type that is sent to a node
+// and compared against the version in requests.
+type VersionFunc func(snapshot Snapshot, typeURL string) string
+
+// ResourceMarshaler marshals a resource of a given type into the bytes sent to a node.
+type ResourceMarshaler func(typeURL string, resource envoy_cache.Resource) ([]byte, error)
+
+// snapshotVersion is the default VersionFunc.
+func snapshotVersion(snapshot Snapshot, typeURL string) string {
+	return snapshot.GetVersion(typeURL)
+}
+
+// WithVersionFunc makes SnapshotCache use a custom version of snapshot resources
+// instead of the one returned by Snapshot.GetVersion.
+func WithVersionFunc(versionFunc VersionFunc) SnapshotCacheOption {
+	return func(cache *snapshotCache) {
+		cache.versionFunc = versionFunc
+	}
+}
+
+// WithResourceMarshaler makes SnapshotCache marshal resources of responses with a custom marshaler,
+// e.g. to embed extra metadata into them.
+//
+// Notice that resources of such responses are only meant to be marshaled by an xDS server,
+// they cannot be cast back to their original types.
+func WithResourceMarshaler(marshaler ResourceMarshaler) SnapshotCacheOption {
+	return func(cache *snapshotCache) {
+		cache.marshaler = marshaler
+	}
+}
+
+// IndexedNamePrefix is a prefix of requested resource names that select resources
+// by a key of a secondary index rather than by name, e.g. `index:service=web`.
+const IndexedNamePrefix = "index:"
+
+// IndexFunc returns keys of a secondary index for a resource, e.g. `service=web` for every tag of endpoints.
+type IndexFunc func(resource envoy_cache.Resource) []string
+
+// WithIndex makes SnapshotCache index resources of a given type by keys returned by a given IndexFunc,
+// so that watches and fetches can select resources by their metadata rather than by names.
+// A requested name `index:<key>` matches every resource indexed under that key.
+//
+// The index is built lazily on the first request that uses it, once per version of snapshot resources.
+// Exact names, prefixes and empty names are matched as usual.
+func WithIndex(typeURL string, index IndexFunc) SnapshotCacheOption {
+	return func(cache *snapshotCache) {
+		cache.indexers[typeURL] = index
+	}
+}
+
+// resourceIndex maps index keys to names of resources of a single version.
+type resourceIndex struct {
+	version string
+	names   map[string][]string
+}
+
 // NewSnapshotCache initializes a simple cache.
 //
 // ADS flag forces a delay in responding to streaming requests until all
@@ -123,35 +645,425 @@ type snapshotCache struct {
 // requests are sent across multiple streams and re-using the snapshot version
 // is OK.
 //
+// Hash is used to map requests to snapshots. Requests without a node, i.e. with nil Node,
+// are mapped through the hash the same way as any other request, therefore
+// the hash must not panic on a nil node and should return a default node group key for it.
+// The hash can be replaced by a NodeGroup that may fail, see WithNodeGroup.
+//
 // Logger is optional.
-func NewSnapshotCache(ads bool, hash envoy_cache.NodeHash, logger envoy_log.Logger) SnapshotCache {
-	return &snapshotCache{
-		log:       logger,
-		ads:       ads,
-		snapshots: make(map[string]Snapshot),
-		status:    make(map[string]*statusInfo),
-		hash:      hash,
+func NewSnapshotCache(ads bool, hash envoy_cache.NodeHash, logger envoy_log.Logger, opts ...SnapshotCacheOption) SnapshotCache {
+	cache := &snapshotCache{
+		log:              logger,
+		ads:              ads,
+		snapshots:        make(map[string]Snapshot),
+		status:           make(map[string]*statusInfo),
+		group:            NodeGroupFromHash(hash),
+		setTimes:         make(map[string]map[string]time.Time),
+		now:              time.Now,
+		versionFunc:      snapshotVersion,
+		indexers:         make(map[string]IndexFunc),
+		indexes:          make(map[string]map[string]*resourceIndex),
+		endpointVersions: make(map[string]map[string]string),
+		responses:        make(map[responseKey]ResponseInfo),
+		nonceRetention:   DefaultNonceRetention,
+	}
+	for _, opt := range opts {
+		opt(cache)
 	}
+	return cache
 }
 
 // SetSnapshotCache updates a snapshot for a node.
 func (cache *snapshotCache) SetSnapshot(node string, snapshot Snapshot) error {
+	snapshot, err := cache.prepareSnapshot(node, snapshot)
+	if err != nil || snapshot == nil {
+		return err
+	}
+
 	cache.mu.Lock()
 	defer cache.mu.Unlock()
 
+	cache.setSnapshot(node, snapshot)
+
+	return nil
+}
+
+// SetSnapshots updates snapshots for several nodes.
+func (cache *snapshotCache) SetSnapshots(snapshots map[string]Snapshot) map[string]error {
+	errs := make(map[string]error)
+	prepared := make(map[string]Snapshot, len(snapshots))
+	for node, snapshot := range snapshots {
+		snapshot, err := cache.prepareSnapshot(node, snapshot)
+		if err != nil {
+			errs[node] = err
+			continue
+		}
+		if snapshot != nil {
+			prepared[node] = snapshot
+		}
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	for node, snapshot := range prepared {
+		cache.setSnapshot(node, snapshot)
+	}
+
+	return errs
+}
+
+// prepareSnapshot validates a snapshot and makes a copy of it that is kept by the cache.
+// It returns nil if the snapshot is equal to the current one, so there is nothing to update.
+func (cache *snapshotCache) prepareSnapshot(node string, snapshot Snapshot) (Snapshot, error) {
+	snapshot, err := cache.transformSnapshot(node, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	for _, typ := range snapshot.GetSupportedTypes() {
+		if err := validateResourceNames(typ, snapshot.GetResources(typ)); err != nil {
+			return nil, err
+		}
+	}
+
+	prepared := snapshot
+	if cache.compression {
+		// compressed snapshot never shares resources with the original
+		// and it is compared with the current one without decompressing either of them
+		compressed, err := compressSnapshot(snapshot)
+		if err != nil {
+			return nil, err
+		}
+		prepared = compressed
+	}
+
+	cache.mu.RLock()
+	current, exists := cache.snapshots[node]
+	cache.mu.RUnlock()
+	if exists && equalSnapshots(current, prepared) {
+		return nil, nil
+	}
+
+	for _, typ := range snapshot.GetSupportedTypes() {
+		if err := cache.checkSize(node, typ, snapshot.GetResources(typ)); err != nil {
+			return nil, err
+		}
+	}
+	cache.checkTypes(node, snapshot)
+
+	if cache.compression {
+		return prepared, nil
+	}
+	return snapshot.Clone(), nil
+}
+
+// transformSnapshot applies the transform configured by WithSnapshotTransform to a snapshot.
+func (cache *snapshotCache) transformSnapshot(node string, snapshot Snapshot) (Snapshot, error) {
+	if cache.transform == nil {
+		return snapshot, nil
+	}
+	transformed, err := cache.transform(node, snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("could not transform snapshot for node %q: %v", node, err)
+	}
+	return transformed, nil
+}
+
+// setSnapshot updates a snapshot for a node and responds to open watches.
+// The cache mutex must be held by the caller.
+func (cache *snapshotCache) setSnapshot(node string, snapshot Snapshot) {
 	// update the existing entry
+	previous := cache.snapshots[node]
 	cache.snapshots[node] = snapshot
+	cache.updateEndpointVersions(node, previous, snapshot)
+	setAt := cache.now()
+	cache.setTimes[node] = make(map[string]time.Time)
+	for _, typ := range snapshot.GetSupportedTypes() {
+		cache.setTimes[node][typ] = setAt
+	}
 
 	// trigger existing watches for which version changed
+	cache.respondWatches(node, snapshot, func(string) bool { return true })
+}
+
+// SetDefaultSnapshot updates the snapshot served to nodes without a snapshot of their own.
+func (cache *snapshotCache) SetDefaultSnapshot(snapshot Snapshot) error {
+	if snapshot != nil {
+		transformed, err := cache.transformSnapshot("", snapshot)
+		if err != nil {
+			return err
+		}
+		snapshot = transformed
+		for _, typ := range snapshot.GetSupportedTypes() {
+			if err := validateResourceNames(typ, snapshot.GetResources(typ)); err != nil {
+				return err
+			}
+		}
+		if cache.compression {
+			compressed, err := compressSnapshot(snapshot)
+			if err != nil {
+				return err
+			}
+			snapshot = compressed
+		} else {
+			snapshot = snapshot.Clone()
+		}
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cache.defaultSnapshot = snapshot
+	if snapshot == nil {
+		return nil
+	}
+	for node := range cache.status {
+		if _, exists := cache.snapshots[node]; !exists {
+			cache.respondWatches(node, snapshot, func(string) bool { return true })
+		}
+	}
+	return nil
+}
+
+// snapshotOf returns the snapshot served to a node, falling back to the default snapshot.
+// The cache mutex must be held by the caller.
+func (cache *snapshotCache) snapshotOf(node string) (Snapshot, bool) {
+	if snapshot, exists := cache.snapshots[node]; exists {
+		return snapshot, true
+	}
+	return cache.defaultSnapshot, cache.defaultSnapshot != nil
+}
+
+// equalSnapshots returns true if both snapshots have the same versions and resources of every type.
+func equalSnapshots(s1, s2 Snapshot) bool {
+	types := s1.GetSupportedTypes()
+	if len(types) != len(s2.GetSupportedTypes()) {
+		return false
+	}
+	// versions are compared first since it is cheap
+	for _, typ := range types {
+		if !supportsType(s2, typ) || s1.GetVersion(typ) != s2.GetVersion(typ) {
+			return false
+		}
+	}
+	c1, compressed1 := s1.(*compressedSnapshot)
+	c2, compressed2 := s2.(*compressedSnapshot)
+	for _, typ := range types {
+		if compressed1 && compressed2 {
+			if !equalCompressedResources(c1.resources[typ], c2.resources[typ]) {
+				return false
+			}
+		} else if !equalResources(s1.GetResources(typ), s2.GetResources(typ)) {
+			return false
+		}
+	}
+	return true
+}
+
+func equalResources(r1, r2 map[string]envoy_cache.Resource) bool {
+	if len(r1) != len(r2) {
+		return false
+	}
+	for name, resource := range r1 {
+		other, ok := r2[name]
+		if !ok || !proto.Equal(resource, other) {
+			return false
+		}
+	}
+	return true
+}
+
+// SetSnapshotResources updates resources of a single type in the snapshot for a node.
+func (cache *snapshotCache) SetSnapshotResources(node string, typ string, version string, resources map[string]envoy_cache.Resource) error {
+	if err := validateResourceNames(typ, resources); err != nil {
+		return err
+	}
+	if err := cache.checkSize(node, typ, resources); err != nil {
+		return err
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	snapshot, err := cache.snapshotSupporting(node, typ)
+	if err != nil {
+		return err
+	}
+	return cache.setSnapshotResources(node, snapshot, typ, version, resources)
+}
+
+// clearedHash is a hash of the version of resources removed by ClearSnapshotType.
+const clearedHash = "cleared"
+
+// ClearSnapshotType removes resources of a single type from the snapshot for a node.
+func (cache *snapshotCache) ClearSnapshotType(node string, typ string) error {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	snapshot, err := cache.snapshotSupporting(node, typ)
+	if err != nil {
+		return err
+	}
+	version := ParseSnapshotVersion(snapshot.GetVersion(typ)).Next(clearedHash).String()
+	return cache.setSnapshotResources(node, snapshot, typ, version, map[string]envoy_cache.Resource{})
+}
+
+// snapshotSupporting returns the snapshot for a node if it supports a given type.
+// The cache mutex must be held by the caller.
+func (cache *snapshotCache) snapshotSupporting(node string, typ string) (Snapshot, error) {
+	snapshot, ok := cache.snapshots[node]
+	if !ok {
+		return nil, fmt.Errorf("no snapshot found for node %s", node)
+	}
+	if !supportsType(snapshot, typ) {
+		return nil, fmt.Errorf("resource type %q is not supported by snapshot for node %s", typ, node)
+	}
+	return snapshot, nil
+}
+
+// setSnapshotResources updates resources of a single type in the snapshot for a node
+// and responds to open watches of that type. The cache mutex must be held by the caller.
+func (cache *snapshotCache) setSnapshotResources(node string, snapshot Snapshot, typ string, version string, resources map[string]envoy_cache.Resource) error {
+	if compressed, ok := snapshot.(*compressedSnapshot); ok {
+		updated, err := compressed.withResources(typ, version, resources)
+		if err != nil {
+			return err
+		}
+		snapshot = updated
+	} else {
+		snapshot = snapshot.WithResources(typ, version, cloneItems(resources))
+	}
+
+	// update the existing entry
+	previous := cache.snapshots[node]
+	cache.snapshots[node] = snapshot
+	if typ == envoy_cache.EndpointType {
+		cache.updateEndpointVersions(node, previous, snapshot)
+	}
+	cache.setTimes[node][typ] = cache.now()
+
+	// trigger existing watches of that type for which version changed
+	cache.respondWatches(node, snapshot, func(typeURL string) bool { return typeURL == typ })
+
+	return nil
+}
+
+// updateEndpointVersions records the version at which endpoints of every cluster of a node last changed,
+// including clusters whose endpoints have just been removed. The cache mutex must be held by the caller.
+func (cache *snapshotCache) updateEndpointVersions(node string, previous, current Snapshot) {
+	if !supportsType(current, envoy_cache.EndpointType) {
+		delete(cache.endpointVersions, node)
+		return
+	}
+	version := cache.versionFunc(current, envoy_cache.EndpointType)
+	var before map[string]envoy_cache.Resource
+	if previous != nil && supportsType(previous, envoy_cache.EndpointType) {
+		if cache.versionFunc(previous, envoy_cache.EndpointType) == version {
+			// changes made without a new version cannot be attributed to a version,
+			// so the next version is treated as if it changed endpoints of every cluster
+			delete(cache.endpointVersions, node)
+			return
+		}
+		resources, err := resourcesOf(previous, envoy_cache.EndpointType)
+		if err != nil {
+			cache.logDecodingError(node, err)
+			delete(cache.endpointVersions, node)
+			return
+		}
+		before = resources
+	}
+	after, err := resourcesOf(current, envoy_cache.EndpointType)
+	if err != nil {
+		cache.logDecodingError(node, err)
+		delete(cache.endpointVersions, node)
+		return
+	}
+	known := cache.endpointVersions[node]
+
+	versions := make(map[string]string, len(after))
+	for name, resource := range after {
+		if lastChanged, ok := known[name]; ok && proto.Equal(before[name], resource) {
+			versions[name] = lastChanged
+		} else {
+			versions[name] = version
+		}
+	}
+	for name := range before {
+		if _, ok := after[name]; !ok {
+			versions[name] = version
+		}
+	}
+	cache.endpointVersions[node] = versions
+}
+
+// changedEndpoints returns names of clusters named by an EDS request whose endpoints changed in a given version.
+// It returns false if the request has to be responded with all matching resources, e.g. because it is not an EDS request,
+// it doesn't name clusters explicitly or endpoint versions of a node are unknown.
+// The cache mutex must be held by the caller.
+func (cache *snapshotCache) changedEndpoints(node string, request envoy_cache.Request, version string) ([]string, bool) {
+	if request.TypeUrl != envoy_cache.EndpointType || len(request.ResourceNames) == 0 {
+		return nil, false
+	}
+	versions, ok := cache.endpointVersions[node]
+	if !ok {
+		return nil, false
+	}
+	var changed []string
+	for _, name := range request.ResourceNames {
+		if strings.HasSuffix(name, "*") || strings.HasPrefix(name, IndexedNamePrefix) {
+			return nil, false
+		}
+		if versions[name] == version {
+			changed = append(changed, name)
+		}
+	}
+	return changed, true
+}
+
+// upToDate returns true if the version requested by a node is the current version of snapshot resources.
+func upToDate(requested, current string) bool {
+	return ParseSnapshotVersion(requested).Equal(ParseSnapshotVersion(current))
+}
+
+func supportsType(snapshot Snapshot, typ string) bool {
+	for _, supported := range snapshot.GetSupportedTypes() {
+		if supported == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// respondWatches responds to open watches of matching types for which version differs from the snapshot version.
+// The cache mutex must be held by the caller.
+func (cache *snapshotCache) respondWatches(node string, snapshot Snapshot, matches func(typeURL string) bool) {
 	if info, ok := cache.status[node]; ok {
+		decoded := decode(snapshot)
 		info.mu.Lock()
 		for id, watch := range info.watches {
-			version := snapshot.GetVersion(watch.Request.TypeUrl)
-			if version != watch.Request.VersionInfo {
+			if !matches(watch.Request.TypeUrl) {
+				continue
+			}
+			version := cache.versionFunc(snapshot, watch.Request.TypeUrl)
+			if !upToDate(watch.Request.VersionInfo, version) {
+				changed, partial := cache.changedEndpoints(node, watch.Request, version)
+				if partial && len(changed) == 0 {
+					// endpoints of named clusters are the same as in the version the watch was opened with
+					continue
+				}
+				resources, err := decoded.get(watch.Request.TypeUrl)
+				if err != nil {
+					// the response is dropped, the watch stays open until the next snapshot
+					cache.logDecodingError(node, err)
+					continue
+				}
 				if cache.log != nil {
 					cache.log.Infof("respond open watch %d%v with new version %q", id, watch.Request.ResourceNames, version)
 				}
-				cache.respond(watch.Request, watch.Response, snapshot.GetResources(watch.Request.TypeUrl), version)
+				if !cache.respond(node, watch.Request, watch.Response, resources, version, changed) {
+					// in ADS mode, the watch is held until a snapshot matches its names
+					continue
+				}
 
 				// discard the watch
 				delete(info.watches, id)
@@ -159,8 +1071,6 @@ func (cache *snapshotCache) SetSnapshot(node string, snapshot Snapshot) error {
 		}
 		info.mu.Unlock()
 	}
-
-	return nil
 }
 
 // GetSnapshots gets the snapshot for a node, and returns an error if not found.
@@ -175,37 +1085,303 @@ func (cache *snapshotCache) GetSnapshot(node string) (Snapshot, error) {
 	return snap, nil
 }
 
+// GetLatest returns the current resources and version of a given type for a node.
+func (cache *snapshotCache) GetLatest(node string, typeURL string, names ...string) (map[string]envoy_cache.Resource, string, bool) {
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+
+	snapshot, ok := cache.snapshotOf(node)
+	if !ok || !supportsType(snapshot, typeURL) {
+		return nil, "", false
+	}
+	resources, err := resourcesOf(snapshot, typeURL)
+	if err != nil {
+		cache.logDecodingError(node, err)
+		return nil, "", false
+	}
+	if len(names) == 0 {
+		// the caller must not be able to modify resources retained by the cache
+		resources = cloneItems(resources)
+	}
+	version := cache.versionFunc(snapshot, typeURL)
+	names = cache.resolveIndexedNames(node, typeURL, version, resources, names)
+	return matchingResources(names, resources), version, true
+}
+
+// GetRetainedBytes computes the size of snapshot resources retained for a node, and returns an error if not found.
+// Resources of a compressed snapshot are not decompressed, their compressed size is reported instead.
+func (cache *snapshotCache) GetRetainedBytes(node string) (map[string]int, error) {
+	snap, err := cache.GetSnapshot(node)
+	if err != nil {
+		return nil, err
+	}
+	sizes := make(map[string]int)
+	for _, typ := range snap.GetSupportedTypes() {
+		if compressed, ok := snap.(*compressedSnapshot); ok {
+			sizes[typ] = compressed.retainedBytes(typ)
+		} else {
+			sizes[typ] = retainedBytes(snap.GetResources(typ))
+		}
+	}
+	return sizes, nil
+}
+
+// GetSnapshotSetTime returns the latest update time of any resource type in the snapshot for a node.
+func (cache *snapshotCache) GetSnapshotSetTime(node string) (time.Time, bool) {
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+
+	if _, ok := cache.snapshots[node]; !ok {
+		return time.Time{}, false
+	}
+	var setTime time.Time
+	for _, setAt := range cache.setTimes[node] {
+		if setAt.After(setTime) {
+			setTime = setAt
+		}
+	}
+	return setTime, true
+}
+
+// logDecodingError reports resources of a compressed snapshot that cannot be decompressed,
+// in which case responses with them are dropped.
+func (cache *snapshotCache) logDecodingError(node string, err error) {
+	if cache.log != nil {
+		cache.log.Errorf("could not respond to node %q: %v", node, err)
+	}
+}
+
+// retainedBytes returns the marshalled size of resources.
+func retainedBytes(resources map[string]envoy_cache.Resource) int {
+	size := 0
+	for _, resource := range resources {
+		size += proto.Size(resource)
+	}
+	return size
+}
+
+// checkSize returns an error if resources of a given type exceed the hard size limit
+// and reports a warning if they exceed the soft one.
+func (cache *snapshotCache) checkSize(node string, typ string, resources map[string]envoy_cache.Resource) error {
+	limits := cache.sizeLimits
+	if limits.Soft <= 0 && limits.Hard <= 0 {
+		return nil
+	}
+	size := retainedBytes(resources)
+	if limits.Hard > 0 && size > limits.Hard {
+		return fmt.Errorf("resources of type %s for node %q take %d bytes, which exceeds the hard limit of %d bytes", typ, node, size, limits.Hard)
+	}
+	if limits.Soft > 0 && size > limits.Soft {
+		exceeded := SizeLimitExceeded{Node: node, TypeURL: typ, Size: size, Limit: limits.Soft}
+		if limits.OnSoftLimit != nil {
+			limits.OnSoftLimit(exceeded)
+		} else if cache.log != nil {
+			cache.log.Errorf("resources of type %s for node %q take %d bytes, which exceeds the soft limit of %d bytes", typ, node, size, limits.Soft)
+		}
+	}
+	return nil
+}
+
+// checkTypes reports expected types a snapshot has no resources of.
+func (cache *snapshotCache) checkTypes(node string, snapshot Snapshot) {
+	if cache.expectedTypes == nil {
+		return
+	}
+	expected := cache.expectedTypes.Types
+	if len(expected) == 0 {
+		expected = cache.watchedTypes(node)
+	}
+	var missing []string
+	for _, typ := range expected {
+		if len(snapshot.GetResources(typ)) == 0 {
+			missing = append(missing, typ)
+		}
+	}
+	if len(missing) == 0 {
+		return
+	}
+	if cache.expectedTypes.OnMissing != nil {
+		cache.expectedTypes.OnMissing(MissingTypes{Node: node, TypeURLs: missing})
+	} else if cache.log != nil {
+		cache.log.Errorf("snapshot for node %q has no resources of expected types %v, which makes Envoy delete them", node, missing)
+	}
+}
+
+// watchedTypes returns types a node has opened watches for, in order.
+func (cache *snapshotCache) watchedTypes(node string) []string {
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+	info, ok := cache.status[node]
+	if !ok {
+		return nil
+	}
+	info.mu.RLock()
+	defer info.mu.RUnlock()
+	var types []string
+	for typ := range info.watchedTypes {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+	return types
+}
+
 // ClearSnapshot clears snapshot and info for a node.
 func (cache *snapshotCache) ClearSnapshot(node string) {
+	if cleared := cache.clearSnapshot(node); cleared && cache.onSnapshotCleared != nil {
+		cache.onSnapshotCleared(node)
+	}
+}
+
+// clearSnapshot clears snapshot and info for a node and reports whether the node was known to the cache.
+func (cache *snapshotCache) clearSnapshot(node string) bool {
 	cache.mu.Lock()
 	defer cache.mu.Unlock()
 
+	_, hasSnapshot := cache.snapshots[node]
+	_, hasStatus := cache.status[node]
 	delete(cache.snapshots, node)
+	delete(cache.setTimes, node)
+	delete(cache.endpointVersions, node)
 	delete(cache.status, node)
+	cache.indexMu.Lock()
+	delete(cache.indexes, node)
+	cache.indexMu.Unlock()
+	return hasSnapshot || hasStatus
 }
 
-// nameSet creates a map from a string slice to value true.
-func nameSet(names []string) map[string]bool {
-	set := make(map[string]bool)
+// nameSet creates a name matcher from a list of requested resource names.
+//
+// Besides exact names, a request may subscribe to all resources with `*`
+// or to all resources sharing a common prefix with a trailing `*` (e.g. `foo.*`).
+func nameSet(names []string) nameMatcher {
+	set := nameMatcher{exact: make(map[string]bool)}
 	for _, name := range names {
-		set[name] = true
+		switch {
+		case name == "*":
+			set.wildcard = true
+		case strings.HasSuffix(name, "*"):
+			set.prefixes = append(set.prefixes, strings.TrimSuffix(name, "*"))
+		default:
+			set.exact[name] = true
+		}
 	}
 	return set
 }
 
+// nameMatcher matches resource names against exact names, prefixes and a wildcard.
+type nameMatcher struct {
+	exact    map[string]bool
+	prefixes []string
+	wildcard bool
+}
+
+func (m nameMatcher) Matches(name string) bool {
+	if m.wildcard || m.exact[name] {
+		return true
+	}
+	for _, prefix := range m.prefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchingResources selects resources listed in the names set.
+// Empty names select all resources.
+func matchingResources(names []string, resources map[string]envoy_cache.Resource) map[string]envoy_cache.Resource {
+	if len(names) == 0 {
+		return resources
+	}
+	set := nameSet(names)
+	matching := make(map[string]envoy_cache.Resource)
+	for name, resource := range resources {
+		if set.Matches(name) {
+			matching[name] = resource
+		}
+	}
+	return matching
+}
+
+// resolveIndexedNames adds names of resources selected by `index:<key>` names to the requested names.
+// Indexed names are preserved, so that a key that matches no resources doesn't turn into a request for all resources.
+func (cache *snapshotCache) resolveIndexedNames(nodeID string, typeURL string, version string, resources map[string]envoy_cache.Resource, names []string) []string {
+	indexer, ok := cache.indexers[typeURL]
+	if !ok {
+		return names
+	}
+	var keys []string
+	for _, name := range names {
+		if strings.HasPrefix(name, IndexedNamePrefix) {
+			keys = append(keys, strings.TrimPrefix(name, IndexedNamePrefix))
+		}
+	}
+	if len(keys) == 0 {
+		return names
+	}
+	index := cache.index(nodeID, typeURL, version, resources, indexer)
+	resolved := append([]string{}, names...)
+	for _, key := range keys {
+		resolved = append(resolved, index[key]...)
+	}
+	return resolved
+}
+
+// index returns the index of resources of a given type for a node, building it if the version has changed.
+func (cache *snapshotCache) index(nodeID string, typeURL string, version string, resources map[string]envoy_cache.Resource, indexer IndexFunc) map[string][]string {
+	cache.indexMu.Lock()
+	defer cache.indexMu.Unlock()
+
+	if index, ok := cache.indexes[nodeID][typeURL]; ok && index.version == version {
+		return index.names
+	}
+	names := make(map[string][]string)
+	for name, resource := range resources {
+		for _, key := range indexer(resource) {
+			names[key] = append(names[key], name)
+		}
+	}
+	if cache.indexes[nodeID] == nil {
+		cache.indexes[nodeID] = make(map[string]*resourceIndex)
+	}
+	cache.indexes[nodeID][typeURL] = &resourceIndex{version: version, names: names}
+	return names
+}
+
 // superset checks that all resources are listed in the names set.
-func superset(names map[string]bool, resources map[string]envoy_cache.Resource) error {
+func superset(names nameMatcher, resources map[string]envoy_cache.Resource) error {
 	for resourceName := range resources {
-		if _, exists := names[resourceName]; !exists {
+		if !names.Matches(resourceName) {
 			return fmt.Errorf("%q not listed", resourceName)
 		}
 	}
 	return nil
 }
 
+// nodeID maps a request to a node group key.
+// Both watches and fetches must use it, so that requests with nil Node resolve to the same key.
+func (cache *snapshotCache) nodeID(request envoy_cache.Request) (string, error) {
+	nodeID, err := cache.group.ID(request.Node)
+	if err != nil {
+		return "", fmt.Errorf("could not determine node group of node %q: %v", request.Node.GetId(), err)
+	}
+	return nodeID, nil
+}
+
 // CreateWatch returns a watch for an xDS request.
+//
+// If the node of the request cannot be grouped, the returned channel is closed right away,
+// which makes an xDS server fail the stream.
 func (cache *snapshotCache) CreateWatch(request envoy_cache.Request) (chan envoy_cache.Response, func()) {
-	nodeID := cache.hash.ID(request.Node)
+	nodeID, err := cache.nodeID(request)
+	if err != nil {
+		if cache.log != nil {
+			cache.log.Errorf("failed to open watch for %s%v: %v", request.TypeUrl, request.ResourceNames, err)
+		}
+		value := make(chan envoy_cache.Response)
+		close(value)
+		return value, nil
+	}
 
 	cache.mu.Lock()
 	defer cache.mu.Unlock()
@@ -218,35 +1394,107 @@ func (cache *snapshotCache) CreateWatch(request envoy_cache.Request) (chan envoy
 
 	// update last watch request time
 	info.mu.Lock()
-	info.lastWatchRequestTime = time.Now()
+	info.lastWatchRequestTime = cache.now()
+	info.watchedTypes[request.TypeUrl] = true
+	// a node group might have been created by a request without a node
+	if info.node == nil {
+		info.node = request.Node
+	}
 	info.mu.Unlock()
 
 	// allocate capacity 1 to allow one-time non-blocking use
 	value := make(chan envoy_cache.Response, 1)
 
-	snapshot, exists := cache.snapshots[nodeID]
+	snapshot, exists := cache.snapshotOf(nodeID)
 	version := ""
 	if exists {
-		version = snapshot.GetVersion(request.TypeUrl)
+		version = cache.versionFunc(snapshot, request.TypeUrl)
 	}
 
-	// if the requested version is up-to-date or missing a response, leave an open watch
-	if !exists || request.VersionInfo == version {
-		watchID := cache.nextWatchID()
-		if cache.log != nil {
-			cache.log.Infof("open watch %d for %s%v from nodeID %q, version %q", watchID,
-				request.TypeUrl, request.ResourceNames, nodeID, request.VersionInfo)
+	// otherwise, the watch may be responded immediately
+	if exists && !upToDate(request.VersionInfo, version) {
+		resources, err := resourcesOf(snapshot, request.TypeUrl)
+		if err != nil {
+			// the response is dropped, the watch stays open until the next snapshot
+			cache.logDecodingError(nodeID, err)
+		} else if cache.respond(nodeID, request, value, resources, version, nil) {
+			return value, nil
 		}
-		info.mu.Lock()
-		info.watches[watchID] = envoy_cache.ResponseWatch{Request: request, Response: value}
-		info.mu.Unlock()
-		return value, cache.cancelWatch(nodeID, watchID)
 	}
 
-	// otherwise, the watch may be responded immediately
-	cache.respond(request, value, snapshot.GetResources(request.TypeUrl), version)
+	// if the requested version is up-to-date, missing a response or held in ADS mode, leave an open watch
+	watchID := cache.nextWatchID()
+	if cache.log != nil {
+		cache.log.Infof("open watch %d for %s%v from nodeID %q, version %q", watchID,
+			request.TypeUrl, request.ResourceNames, nodeID, request.VersionInfo)
+	}
+	info.mu.Lock()
+	info.watches[watchID] = envoy_cache.ResponseWatch{Request: request, Response: value}
+	info.mu.Unlock()
+	return value, cache.cancelWatch(nodeID, watchID)
+}
 
-	return value, nil
+// CreateWatchReplay returns a streaming watch that starts with the current state of a node.
+func (cache *snapshotCache) CreateWatchReplay(request envoy_cache.Request) (chan envoy_cache.Response, func()) {
+	value := make(chan envoy_cache.Response, 1)
+	done := make(chan struct{})
+	// the current state is replayed regardless of the requested version
+	request.VersionInfo = ""
+	go func() {
+		defer close(value)
+		for {
+			watch, cancel := cache.CreateWatch(request)
+			select {
+			case response, ok := <-watch:
+				if !ok {
+					return
+				}
+				if !cache.send(value, response, done) {
+					return
+				}
+				// subsequent updates are watched for as if the consumer acknowledged the response
+				request.VersionInfo = response.Version
+			case <-done:
+				if cancel != nil {
+					cancel()
+				}
+				return
+			}
+		}
+	}()
+	var once sync.Once
+	return value, func() {
+		once.Do(func() { close(done) })
+	}
+}
+
+// send delivers a response on a streaming watch unless the watch is cancelled meanwhile,
+// which is reported by returning false. It reports pressure while the buffer of the watch is full.
+func (cache *snapshotCache) send(value chan envoy_cache.Response, response envoy_cache.Response, done chan struct{}) bool {
+	select {
+	case value <- response:
+		return true
+	case <-done:
+		return false
+	default:
+	}
+	if cache.onWatchPressure == nil {
+		select {
+		case value <- response:
+			return true
+		case <-done:
+			return false
+		}
+	}
+	nodeID, _ := cache.nodeID(response.Request)
+	cache.onWatchPressure(WatchPressure{Node: nodeID, TypeURL: response.Request.TypeUrl, Full: true})
+	defer cache.onWatchPressure(WatchPressure{Node: nodeID, TypeURL: response.Request.TypeUrl, Full: false})
+	select {
+	case value <- response:
+		return true
+	case <-done:
+		return false
+	}
 }
 
 func (cache *snapshotCache) nextWatchID() int64 {
@@ -267,46 +1515,186 @@ func (cache *snapshotCache) cancelWatch(nodeID string, watchID int64) func() {
 	}
 }
 
+func (cache *snapshotCache) CancelWatches(node string) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	info, ok := cache.status[node]
+	if !ok {
+		return
+	}
+	info.mu.Lock()
+	defer info.mu.Unlock()
+	for _, watch := range info.watches {
+		close(watch.Response)
+	}
+	if cache.log != nil && len(info.watches) > 0 {
+		cache.log.Infof("cancelled %d watches of nodeID %q", len(info.watches), node)
+	}
+	info.watches = make(map[int64]envoy_cache.ResponseWatch)
+}
+
 // Respond to a watch with the snapshot value. The value channel should have capacity not to block.
+// If names of changed resources are given, only those are sent, which Envoy accepts for EDS
+// since it keeps endpoints of clusters missing from a response.
 // TODO(kuat) do not respond always, see issue https://github.com/envoyproxy/go-control-plane/issues/46
-func (cache *snapshotCache) respond(request envoy_cache.Request, value chan envoy_cache.Response, resources map[string]envoy_cache.Resource, version string) {
+func (cache *snapshotCache) respond(nodeID string, request envoy_cache.Request, value chan envoy_cache.Response, resources map[string]envoy_cache.Resource, version string, changed []string) bool {
 	// for ADS, the request names must match the snapshot names
-	// if they do not, then the watch is never responded, and it is expected that envoy makes another request
-	if len(request.ResourceNames) != 0 && cache.ads {
-		if err := superset(nameSet(request.ResourceNames), resources); err != nil {
+	// if they do not, then the response is held, which is reported by returning false,
+	// and the watch stays open until either a snapshot matches the names or envoy makes another request
+	// requested names missing from the snapshot don't hold the response though, since a response
+	// without a resource that was removed from the snapshot is how Envoy learns to remove it
+	names := cache.resolveIndexedNames(nodeID, request.TypeUrl, version, resources, request.ResourceNames)
+	if len(names) != 0 && cache.ads {
+		if err := superset(nameSet(names), resources); err != nil {
 			if cache.log != nil {
 				cache.log.Infof("ADS mode: not responding to request: %v", err)
 			}
-			return
+			return false
 		}
 	}
 	if cache.log != nil {
 		cache.log.Infof("respond %s%v version %q with version %q",
 			request.TypeUrl, request.ResourceNames, request.VersionInfo, version)
 	}
+	if changed != nil {
+		names = changed
+	}
+
+	value <- cache.createResponse(request, names, resources, version)
+
+	if cache.onResponse != nil {
+		cache.onResponse(ResponseStats{
+			Node:    nodeID,
+			TypeURL: request.TypeUrl,
+			Version: version,
+			Latency: cache.now().Sub(cache.setTimes[nodeID][request.TypeUrl]),
+		})
+	}
+	return true
+}
+
+// ResponseCallbacks returns callbacks that record responses sent by an xDS server.
+func (cache *snapshotCache) ResponseCallbacks() envoy_xds.Callbacks {
+	return &responseCallbacks{cache: cache}
+}
+
+// retainResponse retains the info of a response sent on a stream, dropping infos that have expired.
+func (cache *snapshotCache) retainResponse(key responseKey, info ResponseInfo) {
+	cache.responseMu.Lock()
+	defer cache.responseMu.Unlock()
+
+	expired := 0
+	for _, retained := range cache.responseKeys {
+		if info.SentAt.Sub(cache.responses[retained].SentAt) < cache.nonceRetention {
+			break
+		}
+		delete(cache.responses, retained)
+		expired++
+	}
+	cache.responseKeys = cache.responseKeys[expired:]
+
+	cache.responses[key] = info
+	cache.responseKeys = append(cache.responseKeys, key)
+}
+
+// releaseResponses drops infos of responses sent on a stream that has been closed.
+func (cache *snapshotCache) releaseResponses(streamID int64) {
+	cache.responseMu.Lock()
+	defer cache.responseMu.Unlock()
+
+	keys := cache.responseKeys[:0]
+	for _, key := range cache.responseKeys {
+		if key.streamID == streamID {
+			delete(cache.responses, key)
+			continue
+		}
+		keys = append(keys, key)
+	}
+	cache.responseKeys = keys
+}
+
+// GetResponseInfo returns the info of a response sent on a stream.
+func (cache *snapshotCache) GetResponseInfo(streamID int64, nonce string) (ResponseInfo, bool) {
+	cache.responseMu.RLock()
+	defer cache.responseMu.RUnlock()
+
+	info, ok := cache.responses[responseKey{streamID: streamID, nonce: nonce}]
+	if !ok || cache.now().Sub(info.SentAt) >= cache.nonceRetention {
+		return ResponseInfo{}, false
+	}
+	return info, true
+}
 
-	value <- createResponse(request, resources, version)
+// responseCallbacks record responses sent by an xDS server together with the nonces the server assigned to them.
+type responseCallbacks struct {
+	cache *snapshotCache
 }
 
-func createResponse(request envoy_cache.Request, resources map[string]envoy_cache.Resource, version string) envoy_cache.Response {
+var _ envoy_xds.Callbacks = &responseCallbacks{}
+
+func (cb *responseCallbacks) OnStreamOpen(context.Context, int64, string) error {
+	return nil
+}
+
+func (cb *responseCallbacks) OnStreamClosed(streamID int64) {
+	cb.cache.releaseResponses(streamID)
+}
+
+func (cb *responseCallbacks) OnStreamRequest(int64, *envoy.DiscoveryRequest) error {
+	return nil
+}
+
+// OnStreamResponse records a response with the nonce it is sent with.
+// The request is left untouched, since it is shared with other callbacks.
+func (cb *responseCallbacks) OnStreamResponse(streamID int64, req *envoy.DiscoveryRequest, resp *envoy.DiscoveryResponse) {
+	nodeID, err := cb.cache.nodeID(*req)
+	if err != nil {
+		return
+	}
+	cb.cache.retainResponse(responseKey{streamID: streamID, nonce: resp.GetNonce()}, ResponseInfo{
+		Node:    nodeID,
+		TypeURL: resp.GetTypeUrl(),
+		Version: resp.GetVersionInfo(),
+		SentAt:  cb.cache.now(),
+	})
+}
+
+func (cb *responseCallbacks) OnFetchRequest(context.Context, *envoy.DiscoveryRequest) error {
+	return nil
+}
+
+func (cb *responseCallbacks) OnFetchResponse(*envoy.DiscoveryRequest, *envoy.DiscoveryResponse) {
+}
+
+// createResponse creates a response to a request with resources matching names resolved from the request.
+func (cache *snapshotCache) createResponse(request envoy_cache.Request, names []string, resources map[string]envoy_cache.Resource, version string) envoy_cache.Response {
 	filtered := make([]envoy_cache.Resource, 0, len(resources))
 
 	// Reply only with the requested resources. Envoy may ask each resource
 	// individually in a separate stream. It is ok to reply with the same version
 	// on separate streams since requests do not share their response versions.
-	if len(request.ResourceNames) != 0 {
-		set := nameSet(request.ResourceNames)
-		for name, resource := range resources {
-			if set[name] {
-				filtered = append(filtered, resource)
-			}
+	matching := matchingResources(names, resources)
+	if cache.sorted {
+		sortedNames := make([]string, 0, len(matching))
+		for name := range matching {
+			sortedNames = append(sortedNames, name)
+		}
+		sort.Strings(sortedNames)
+		for _, name := range sortedNames {
+			filtered = append(filtered, matching[name])
 		}
 	} else {
-		for _, resource := range resources {
+		for _, resource := range matching {
 			filtered = append(filtered, resource)
 		}
 	}
 
+	if cache.marshaler != nil {
+		for i, resource := range filtered {
+			filtered[i] = &marshalingResource{Resource: resource, typeURL: request.TypeUrl, marshaler: cache.marshaler}
+		}
+	}
+
 	return envoy_cache.Response{
 		Request:   request,
 		Version:   version,
@@ -314,30 +1702,72 @@ func createResponse(request envoy_cache.Request, resources map[string]envoy_cach
 	}
 }
 
+// marshalingResource defers marshaling of a resource to a custom marshaler.
+// Since it implements proto.Marshaler, it is picked up by proto.Marshal.
+type marshalingResource struct {
+	envoy_cache.Resource
+	typeURL   string
+	marshaler ResourceMarshaler
+}
+
+func (r *marshalingResource) Marshal() ([]byte, error) {
+	return r.marshaler(r.typeURL, r.Resource)
+}
+
 // Fetch implements the cache fetch function.
 // Fetch is called on multiple streams, so responding to individual names with the same version works.
 func (cache *snapshotCache) Fetch(ctx context.Context, request envoy_cache.Request) (*envoy_cache.Response, error) {
-	nodeID := cache.hash.ID(request.Node)
+	nodeID, err := cache.nodeID(request)
+	if err != nil {
+		return nil, err
+	}
 
 	cache.mu.RLock()
 	defer cache.mu.RUnlock()
 
-	if snapshot, exists := cache.snapshots[nodeID]; exists {
+	if snapshot, exists := cache.snapshotOf(nodeID); exists {
 		// Respond only if the request version is distinct from the current snapshot state.
 		// It might be beneficial to hold the request since Envoy will re-attempt the refresh.
-		version := snapshot.GetVersion(request.TypeUrl)
-		if request.VersionInfo == version {
+		version := cache.versionFunc(snapshot, request.TypeUrl)
+		if upToDate(request.VersionInfo, version) {
 			return nil, &envoy_cache.SkipFetchError{}
 		}
 
-		resources := snapshot.GetResources(request.TypeUrl)
-		out := createResponse(request, resources, version)
+		resources, err := resourcesOf(snapshot, request.TypeUrl)
+		if err != nil {
+			return nil, err
+		}
+		names := cache.resolveIndexedNames(nodeID, request.TypeUrl, version, resources, request.ResourceNames)
+		out := cache.createResponse(request, names, resources, version)
 		return &out, nil
 	}
 
 	return nil, fmt.Errorf("missing snapshot for %q", nodeID)
 }
 
+// FetchWait implements the long-polling fetch function.
+func (cache *snapshotCache) FetchWait(ctx context.Context, request envoy_cache.Request) (*envoy_cache.Response, error) {
+	nodeID, err := cache.nodeID(request)
+	if err != nil {
+		return nil, err
+	}
+	// a one-shot watch is either responded immediately or once a newer snapshot is set
+	value, cancel := cache.CreateWatch(request)
+	if cancel != nil {
+		defer cancel()
+	}
+	select {
+	case out, ok := <-value:
+		// the watch is closed without a response when watches of the node are cancelled
+		if !ok {
+			return nil, fmt.Errorf("watch of node %q has been cancelled", nodeID)
+		}
+		return &out, nil
+	case <-ctx.Done():
+		return nil, &envoy_cache.SkipFetchError{}
+	}
+}
+
 // GetStatusInfo retrieves the status info for the node.
 func (cache *snapshotCache) GetStatusInfo(node string) envoy_cache.StatusInfo {
 	cache.mu.RLock()
@@ -366,6 +1796,7 @@ func (cache *snapshotCache) GetStatusKeys() []string {
 
 type statusInfo struct {
 	// node is the constant Envoy node metadata.
+	// It is nil until the first request with a node.
 	node *envoy_core.Node
 
 	// watches are indexed channels for the response watches and the original requests.
@@ -374,6 +1805,9 @@ type statusInfo struct {
 	// the timestamp of the last watch request
 	lastWatchRequestTime time.Time
 
+	// watchedTypes are types of resources the node has opened watches for
+	watchedTypes map[string]bool
+
 	// mutex to protect the status fields.
 	// should not acquire mutex of the parent cache after acquiring this mutex.
 	mu sync.RWMutex
@@ -382,8 +1816,9 @@ type statusInfo struct {
 // newStatusInfo initializes a status info data structure.
 func newStatusInfo(node *envoy_core.Node) *statusInfo {
 	out := statusInfo{
-		node:    node,
-		watches: make(map[int64]envoy_cache.ResponseWatch),
+		node:         node,
+		watches:      make(map[int64]envoy_cache.ResponseWatch),
+		watchedTypes: make(map[string]bool),
 	}
 	return &out
 }
@@ -16,17 +16,25 @@ package xds_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	goruntime "runtime"
+	"sort"
 	"testing"
 	"time"
 
 	. "github.com/Kong/kuma/pkg/util/xds"
 
+	"github.com/golang/protobuf/proto"
+	pstruct "github.com/golang/protobuf/ptypes/struct"
+
 	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	"github.com/envoyproxy/go-control-plane/pkg/cache"
+	"github.com/envoyproxy/go-control-plane/pkg/server"
 	"github.com/envoyproxy/go-control-plane/pkg/test/resource"
+	"google.golang.org/grpc"
 )
 
 const (
@@ -120,6 +128,48 @@ func (s *SampleSnapshot) WithVersion(typ string, version string) Snapshot {
 	return new
 }
 
+// WithResources creates a new snapshot with different resources and version for a given resource type.
+func (s *SampleSnapshot) WithResources(typ string, version string, resources map[string]cache.Resource) Snapshot {
+	if s == nil {
+		return nil
+	}
+	new := &SampleSnapshot{
+		Snapshot: s.Snapshot,
+	}
+	switch typ {
+	case cache.EndpointType:
+		new.Endpoints = cache.Resources{Version: version, Items: resources}
+	case cache.ClusterType:
+		new.Clusters = cache.Resources{Version: version, Items: resources}
+	case cache.RouteType:
+		new.Routes = cache.Resources{Version: version, Items: resources}
+	case cache.ListenerType:
+		new.Listeners = cache.Resources{Version: version, Items: resources}
+	case cache.SecretType:
+		new.Secrets = cache.Resources{Version: version, Items: resources}
+	case cache.RuntimeType:
+		new.Runtimes = cache.Resources{Version: version, Items: resources}
+	}
+	return new
+}
+
+// Clone creates a copy of the snapshot that does not share resource maps with the original.
+func (s *SampleSnapshot) Clone() Snapshot {
+	if s == nil {
+		return nil
+	}
+	return &SampleSnapshot{
+		Snapshot: cache.Snapshot{
+			Endpoints: CloneResources(s.Endpoints),
+			Clusters:  CloneResources(s.Clusters),
+			Routes:    CloneResources(s.Routes),
+			Listeners: CloneResources(s.Listeners),
+			Secrets:   CloneResources(s.Secrets),
+			Runtimes:  CloneResources(s.Runtimes),
+		},
+	}
+}
+
 var (
 	version  = "x"
 	version2 = "y"
@@ -232,6 +282,124 @@ func TestSnapshotCacheFetch(t *testing.T) {
 	}
 }
 
+var _ ReadOnlyCache = SnapshotCache(nil)
+
+// statusOf is an example of a component that only needs to read the cache.
+func statusOf(c ReadOnlyCache, node string) (nodes []string, version string, err error) {
+	if c.GetStatusInfo(node) == nil {
+		return nil, "", fmt.Errorf("no status for node %q", node)
+	}
+	snap, err := c.GetSnapshot(node)
+	if err != nil {
+		return nil, "", err
+	}
+	resp, err := c.Fetch(context.Background(), v2.DiscoveryRequest{TypeUrl: cache.ClusterType, Node: &core.Node{Id: node}})
+	if err != nil {
+		return nil, "", err
+	}
+	if resp.Version != snap.GetVersion(cache.ClusterType) {
+		return nil, "", fmt.Errorf("fetched version %q, snapshot version %q", resp.Version, snap.GetVersion(cache.ClusterType))
+	}
+	return c.GetStatusKeys(), resp.Version, nil
+}
+
+func TestSnapshotCacheReadOnly(t *testing.T) {
+	c := NewSnapshotCache(true, group{}, logger{t: t})
+	if err := c.SetSnapshot(key, snapshot); err != nil {
+		t.Fatal(err)
+	}
+	// status is tracked once a node opens a watch
+	_, cancel := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.ClusterType, ResourceNames: names[cache.ClusterType], VersionInfo: version})
+	defer cancel()
+
+	nodes, v, err := statusOf(c, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(nodes, []string{key}) {
+		t.Errorf("got nodes %v, want %v", nodes, []string{key})
+	}
+	if v != version {
+		t.Errorf("got version %q, want %q", v, version)
+	}
+
+	if _, _, err := statusOf(c, "unknown"); err == nil {
+		t.Error("expected an error for a node without status")
+	}
+}
+
+func TestSnapshotCacheFetchWait(t *testing.T) {
+	c := NewSnapshotCache(false, group{}, logger{t: t})
+	if err := c.SetSnapshot(key, snapshot); err != nil {
+		t.Fatal(err)
+	}
+
+	// respond immediately for outdated version
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if resp, err := c.FetchWait(ctx, v2.DiscoveryRequest{TypeUrl: cache.ClusterType}); err != nil || resp == nil || resp.Version != version {
+		t.Fatalf("outdated version: got response %v and error %v", resp, err)
+	}
+
+	// block until a newer snapshot is set
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		snapshot2 := &SampleSnapshot{cache.Snapshot{
+			Clusters: cache.NewResources(version2, []cache.Resource{cluster}),
+		}}
+		if err := c.SetSnapshot(key, snapshot2); err != nil {
+			t.Error(err)
+		}
+	}()
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	resp, err := c.FetchWait(ctx, v2.DiscoveryRequest{TypeUrl: cache.ClusterType, VersionInfo: version})
+	if err != nil || resp == nil {
+		t.Fatalf("latest version: got response %v and error %v", resp, err)
+	}
+	if resp.Version != version2 {
+		t.Errorf("got version %q, want %q", resp.Version, version2)
+	}
+
+	// time out when there is no newer snapshot
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	resp, err = c.FetchWait(ctx, v2.DiscoveryRequest{TypeUrl: cache.ClusterType, VersionInfo: version2})
+	if resp != nil {
+		t.Errorf("timeout: response is not nil %v", resp)
+	}
+	if _, ok := err.(*cache.SkipFetchError); !ok {
+		t.Errorf("timeout: got error %v, want SkipFetchError", err)
+	}
+	if count := c.GetStatusInfo(key).GetNumWatches(); count != 0 {
+		t.Errorf("watches should be released on timeout: %d", count)
+	}
+}
+
+func TestSnapshotCacheFetchWaitCancelWatches(t *testing.T) {
+	c := NewSnapshotCache(false, group{}, logger{t: t})
+	if err := c.SetSnapshot(key, snapshot); err != nil {
+		t.Fatal(err)
+	}
+
+	// cancel watches of the node while FetchWait is blocked
+	go func() {
+		for c.GetStatusInfo(key).GetNumWatches() == 0 {
+			time.Sleep(10 * time.Millisecond)
+		}
+		c.CancelWatches(key)
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	resp, err := c.FetchWait(ctx, v2.DiscoveryRequest{TypeUrl: cache.ClusterType, VersionInfo: version})
+	if resp != nil {
+		t.Errorf("got response %v, want none", resp)
+	}
+	if want := fmt.Sprintf("watch of node %q has been cancelled", key); err == nil || err.Error() != want {
+		t.Errorf("got error %v, want %q", err, want)
+	}
+}
+
 func TestSnapshotCacheWatch(t *testing.T) {
 	c := NewSnapshotCache(true, group{}, logger{t: t})
 	watches := make(map[string]chan cache.Response)
@@ -289,64 +457,1682 @@ func TestSnapshotCacheWatch(t *testing.T) {
 	}
 }
 
-func TestConcurrentSetWatch(t *testing.T) {
-	c := NewSnapshotCache(false, group{}, logger{t: t})
-	for i := 0; i < 50; i++ {
-		func(i int) {
-			t.Run(fmt.Sprintf("worker%d", i), func(t *testing.T) {
-				t.Parallel()
-				id := fmt.Sprintf("%d", i%2)
-				var cancel func()
-				if i < 25 {
-					_ = c.SetSnapshot(id, &SampleSnapshot{cache.Snapshot{
-						Endpoints: cache.NewResources(fmt.Sprintf("v%d", i), []cache.Resource{resource.MakeEndpoint(clusterName, uint32(i))}),
-					}})
-				} else {
-					if cancel != nil {
-						cancel()
+func TestSnapshotCacheWatchAdsAndSotw(t *testing.T) {
+	for name, ads := range map[string]bool{"ads": true, "sotw": false} {
+		t.Run(name, func(t *testing.T) {
+			c := NewSnapshotCache(ads, group{}, logger{t: t})
+			if err := c.SetSnapshot(key, newSnapshot()); err != nil {
+				t.Fatal(err)
+			}
+
+			// watches of every type are up-to-date
+			watches := make(map[string]chan cache.Response)
+			for _, typ := range testTypes {
+				watches[typ], _ = c.CreateWatch(v2.DiscoveryRequest{TypeUrl: typ, ResourceNames: names[typ], VersionInfo: version})
+			}
+
+			// a snapshot that changes only clusters responds only to the watch of clusters
+			snapshot2 := newSnapshot().WithVersion(cache.ClusterType, version2)
+			if err := c.SetSnapshot(key, snapshot2); err != nil {
+				t.Fatal(err)
+			}
+			if count := c.GetStatusInfo(key).GetNumWatches(); count != len(testTypes)-1 {
+				t.Errorf("watches of unaffected types should be preserved: %d", count)
+			}
+			for _, typ := range testTypes {
+				select {
+				case out := <-watches[typ]:
+					if typ != cache.ClusterType {
+						t.Errorf("watch for %s => got %v, want none", typ, out)
+					} else if out.Version != version2 {
+						t.Errorf("got version %q, want %q", out.Version, version2)
+					}
+				default:
+					if typ == cache.ClusterType {
+						t.Errorf("failed to receive response for %s", typ)
 					}
-					_, _ = c.CreateWatch(v2.DiscoveryRequest{
-						Node:    &core.Node{Id: id},
-						TypeUrl: cache.EndpointType,
-					})
 				}
+			}
+
+			// a watch whose names don't cover all endpoints
+			other := resource.MakeEndpoint("cluster1", 8080)
+			snapshot3 := snapshot2.WithResources(cache.EndpointType, version2, map[string]cache.Resource{
+				clusterName: endpoint,
+				"cluster1":  other,
 			})
-		}(i)
+			if err := c.SetSnapshot(key, snapshot3); err != nil {
+				t.Fatal(err)
+			}
+			value, _ := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.EndpointType, ResourceNames: []string{clusterName}, VersionInfo: version})
+			select {
+			case out := <-value:
+				// each type is independent of others in SotW mode, so the named endpoints are responded
+				if ads {
+					t.Errorf("watch for partial names in ADS mode => got %v, want none", out)
+				}
+				assertResourceNames(t, out.Resources, []string{clusterName})
+			case <-time.After(time.Second / 4):
+				if !ads {
+					t.Fatal("failed to receive snapshot response")
+				}
+			}
+			if !ads {
+				return
+			}
+
+			// in ADS mode, the watch is held open until a snapshot matches its names
+			snapshot4 := snapshot3.WithResources(cache.EndpointType, "z", map[string]cache.Resource{
+				clusterName: resource.MakeEndpoint(clusterName, 9090),
+			})
+			if err := c.SetSnapshot(key, snapshot4); err != nil {
+				t.Fatal(err)
+			}
+			select {
+			case out := <-value:
+				if out.Version != "z" {
+					t.Errorf("got version %q, want %q", out.Version, "z")
+				}
+				assertResourceNames(t, out.Resources, []string{clusterName})
+			case <-time.After(time.Second):
+				t.Fatal("failed to receive snapshot response")
+			}
+		})
 	}
 }
 
-func TestSnapshotCacheWatchCancel(t *testing.T) {
+func TestSnapshotCacheSetEqualSnapshot(t *testing.T) {
+	for name, opts := range map[string][]SnapshotCacheOption{
+		"uncompressed": nil,
+		"compressed":   {WithCompression()},
+	} {
+		t.Run(name, func(t *testing.T) {
+			snapshot := newSnapshot()
+			now := time.Unix(0, 0)
+			var stats []ResponseStats
+			c := NewSnapshotCache(false, group{}, logger{t: t}, append(opts,
+				WithClock(func() time.Time { return now }),
+				WithResponseStatsCallback(func(s ResponseStats) { stats = append(stats, s) }))...)
+
+			if err := c.SetSnapshot(key, snapshot); err != nil {
+				t.Fatal(err)
+			}
+			watches := make(map[string]chan cache.Response)
+			for _, typ := range testTypes {
+				watches[typ], _ = c.CreateWatch(v2.DiscoveryRequest{TypeUrl: typ, ResourceNames: names[typ], VersionInfo: version})
+			}
+
+			// set an equal snapshot later on
+			now = now.Add(3 * time.Second)
+			if err := c.SetSnapshot(key, snapshot.Clone()); err != nil {
+				t.Fatal(err)
+			}
+			for _, typ := range testTypes {
+				select {
+				case out := <-watches[typ]:
+					t.Errorf("watch for %s responded with version %q, want none", typ, out.Version)
+				default:
+				}
+			}
+			if count := c.GetStatusInfo(key).GetNumWatches(); count != len(testTypes) {
+				t.Errorf("watches should be preserved: %d", count)
+			}
+
+			// the snapshot is still considered set by the first call
+			value, _ := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.ClusterType})
+			<-value
+			want := []ResponseStats{{Node: key, TypeURL: cache.ClusterType, Version: version, Latency: 3 * time.Second}}
+			if !reflect.DeepEqual(stats, want) {
+				t.Errorf("got stats %v, want %v", stats, want)
+			}
+
+			// set a snapshot with the same versions but different resources
+			snapshot2 := NewSampleSnapshot(version,
+				[]cache.Resource{resource.MakeEndpoint(clusterName, 9090)},
+				[]cache.Resource{cluster},
+				[]cache.Resource{route},
+				[]cache.Resource{listener},
+				[]cache.Resource{runtime})
+			if err := c.SetSnapshot(key, snapshot2); err != nil {
+				t.Fatal(err)
+			}
+			latest, _, _ := c.GetLatest(key, cache.EndpointType)
+			if !proto.Equal(latest[clusterName], snapshot2.GetResources(cache.EndpointType)[clusterName]) {
+				t.Errorf("got endpoints %v, want %v", latest, snapshot2.GetResources(cache.EndpointType))
+			}
+		})
+	}
+}
+
+func TestSnapshotCacheSetSnapshotResources(t *testing.T) {
 	c := NewSnapshotCache(true, group{}, logger{t: t})
+	endpoint2 := resource.MakeEndpoint(clusterName, 9090)
+
+	// there is no base snapshot yet
+	if err := c.SetSnapshotResources(key, cache.EndpointType, version2, map[string]cache.Resource{clusterName: endpoint2}); err == nil {
+		t.Error("expected an error on update of a missing snapshot")
+	}
+
+	if err := c.SetSnapshot(key, newSnapshot()); err != nil {
+		t.Fatal(err)
+	}
+
+	// open watches with the latest version
+	watches := make(map[string]chan cache.Response)
 	for _, typ := range testTypes {
-		_, cancel := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: typ, ResourceNames: names[typ]})
-		cancel()
+		watches[typ], _ = c.CreateWatch(v2.DiscoveryRequest{TypeUrl: typ, ResourceNames: names[typ], VersionInfo: version})
 	}
-	// should be status info for the node
-	if keys := c.GetStatusKeys(); len(keys) == 0 {
-		t.Error("got 0, want status info for the node")
+
+	// update endpoints only
+	if err := c.SetSnapshotResources(key, cache.EndpointType, version2, map[string]cache.Resource{clusterName: endpoint2}); err != nil {
+		t.Fatal(err)
+	}
+	if count := c.GetStatusInfo(key).GetNumWatches(); count != len(testTypes)-1 {
+		t.Errorf("watches should be preserved for all but one: %d", count)
+	}
+
+	// validate response for endpoints
+	select {
+	case out := <-watches[cache.EndpointType]:
+		if out.Version != version2 {
+			t.Errorf("got version %q, want %q", out.Version, version2)
+		}
+		if !reflect.DeepEqual(cache.IndexResourcesByName(out.Resources), map[string]cache.Resource{clusterName: endpoint2}) {
+			t.Errorf("get resources %v, want %v", out.Resources, endpoint2)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("failed to receive snapshot response")
 	}
 
+	// other types are untouched
+	snap, err := c.GetSnapshot(key)
+	if err != nil {
+		t.Fatal(err)
+	}
 	for _, typ := range testTypes {
-		if count := c.GetStatusInfo(key).GetNumWatches(); count > 0 {
-			t.Errorf("watches should be released for %s", typ)
+		if typ == cache.EndpointType {
+			continue
+		}
+		if snap.GetVersion(typ) != version {
+			t.Errorf("got version %q for %s, want %q", snap.GetVersion(typ), typ, version)
+		}
+		if !reflect.DeepEqual(snap.GetResources(typ), newSnapshot().GetResources(typ)) {
+			t.Errorf("get resources %v for %s, want %v", snap.GetResources(typ), typ, newSnapshot().GetResources(typ))
+		}
+		select {
+		case out := <-watches[typ]:
+			t.Errorf("watch for %s => got %v, want none", typ, out)
+		default:
 		}
 	}
 
-	if empty := c.GetStatusInfo("missing"); empty != nil {
-		t.Errorf("should not return a status for unknown key: got %#v", empty)
+	// unsupported types are rejected
+	if err := c.SetSnapshotResources(key, "unsupported type", version2, nil); err == nil {
+		t.Error("expected an error on update of an unsupported type")
 	}
 }
 
-func TestSnapshotClear(t *testing.T) {
-	c := NewSnapshotCache(true, group{}, logger{t: t})
-	if err := c.SetSnapshot(key, snapshot); err != nil {
+func TestSnapshotCacheResourceRemoval(t *testing.T) {
+	for _, ads := range []bool{false, true} {
+		t.Run(fmt.Sprintf("ads=%v", ads), func(t *testing.T) {
+			testSnapshotCacheResourceRemoval(t, ads)
+		})
+	}
+}
+
+func testSnapshotCacheResourceRemoval(t *testing.T, ads bool) {
+	c := NewSnapshotCache(ads, group{}, logger{t: t})
+	if err := c.SetSnapshot(key, newSnapshot()); err != nil {
 		t.Fatal(err)
 	}
-	c.ClearSnapshot(key)
-	if empty := c.GetStatusInfo(key); empty != nil {
-		t.Errorf("cache should be cleared")
+
+	// Envoy acknowledged the endpoint and watches it by name
+	watch, _ := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.EndpointType, ResourceNames: names[cache.EndpointType], VersionInfo: version})
+	select {
+	case out := <-watch:
+		t.Fatalf("watch for an up-to-date version => got %v, want none", out)
+	default:
 	}
-	if keys := c.GetStatusKeys(); len(keys) != 0 {
-		t.Errorf("keys should be empty")
+
+	// the endpoint is removed
+	withoutEndpoint := NewSampleSnapshot(version2, nil, []cache.Resource{cluster}, []cache.Resource{route}, []cache.Resource{listener}, []cache.Resource{runtime})
+	if err := c.SetSnapshot(key, withoutEndpoint); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case out := <-watch:
+		if out.Version != version2 {
+			t.Errorf("got version %q, want %q", out.Version, version2)
+		}
+		if len(out.Resources) != 0 {
+			t.Errorf("got resources %v, want none", out.Resources)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("failed to receive a response reflecting the removal")
+	}
+}
+
+func TestSnapshotCacheEndpointsPerCluster(t *testing.T) {
+	c := NewSnapshotCache(false, group{}, logger{t: t})
+
+	clusters := func(version string, port uint32) Snapshot {
+		return NewSampleSnapshot(version, []cache.Resource{
+			resource.MakeEndpoint("cluster0", 8080),
+			resource.MakeEndpoint("cluster1", port),
+		}, nil, nil, nil, nil)
+	}
+	if err := c.SetSnapshot(key, clusters(version, 8081)); err != nil {
+		t.Fatal(err)
+	}
+
+	// watches of a single cluster each and of both clusters
+	watchOf := func(clusterNames ...string) chan cache.Response {
+		watch, _ := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.EndpointType, ResourceNames: clusterNames, VersionInfo: version})
+		return watch
+	}
+	watch0 := watchOf("cluster0")
+	watch1 := watchOf("cluster1")
+	watchBoth := watchOf("cluster0", "cluster1")
+
+	// endpoints of a single cluster change
+	if err := c.SetSnapshot(key, clusters(version2, 9091)); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, watch := range []chan cache.Response{watch1, watchBoth} {
+		select {
+		case out := <-watch:
+			if out.Version != version2 {
+				t.Errorf("got version %q, want %q", out.Version, version2)
+			}
+			if len(out.Resources) != 1 || cache.GetResourceName(out.Resources[0]) != "cluster1" {
+				t.Errorf("got resources %v, want only cluster1", out.Resources)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("failed to receive a response for a changed cluster")
+		}
+	}
+	select {
+	case out := <-watch0:
+		t.Errorf("watch of an unchanged cluster => got %v, want none", out)
+	default:
+	}
+	if got := c.GetStatusInfo(key).GetNumWatches(); got != 1 {
+		t.Errorf("got %d open watches, want 1", got)
+	}
+
+	// the watch of the unchanged cluster is still responded once its endpoints change
+	if err := c.SetSnapshot(key, NewSampleSnapshot("z", []cache.Resource{resource.MakeEndpoint("cluster0", 9090)}, nil, nil, nil, nil)); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case out := <-watch0:
+		if out.Version != "z" || len(out.Resources) != 1 {
+			t.Errorf("got version %q and resources %v, want version %q and cluster0", out.Version, out.Resources, "z")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("failed to receive a response for a changed cluster")
+	}
+}
+
+func BenchmarkSnapshotCacheEndpointFlapping(b *testing.B) {
+	const clusterCount = 1000
+	c := NewSnapshotCache(false, group{}, nil)
+
+	endpoints := make([]cache.Resource, clusterCount)
+	ports := make([]uint32, clusterCount)
+	for i := range endpoints {
+		ports[i] = 8080
+		endpoints[i] = resource.MakeEndpoint(fmt.Sprintf("cluster%d", i), ports[i])
+	}
+	snapshotOf := func(version int) Snapshot {
+		return NewSampleSnapshot(fmt.Sprint(version), endpoints, nil, nil, nil, nil)
+	}
+	if err := c.SetSnapshot(key, snapshotOf(0)); err != nil {
+		b.Fatal(err)
+	}
+	watchOf := func(i int, version string) chan cache.Response {
+		watch, _ := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.EndpointType, ResourceNames: []string{fmt.Sprintf("cluster%d", i)}, VersionInfo: version})
+		return watch
+	}
+	watches := make([]chan cache.Response, clusterCount)
+	for i := range watches {
+		watches[i] = watchOf(i, "0")
+	}
+
+	b.ResetTimer()
+	for n := 1; n <= b.N; n++ {
+		// a single endpoint flaps
+		flapping := n % clusterCount
+		ports[flapping] ^= 1
+		endpoints[flapping] = resource.MakeEndpoint(fmt.Sprintf("cluster%d", flapping), ports[flapping])
+		if err := c.SetSnapshot(key, snapshotOf(n)); err != nil {
+			b.Fatal(err)
+		}
+		out := <-watches[flapping]
+		watches[flapping] = watchOf(flapping, out.Version)
+	}
+}
+
+func TestSnapshotCacheClearSnapshotType(t *testing.T) {
+	c := NewSnapshotCache(false, group{}, logger{t: t})
+
+	// there is no base snapshot yet
+	if err := c.ClearSnapshotType(key, cache.EndpointType); err == nil {
+		t.Error("expected an error on clear of a missing snapshot")
+	}
+
+	if err := c.SetSnapshot(key, newSnapshot()); err != nil {
+		t.Fatal(err)
+	}
+
+	// open watches with the latest version
+	watches := make(map[string]chan cache.Response)
+	for _, typ := range testTypes {
+		watches[typ], _ = c.CreateWatch(v2.DiscoveryRequest{TypeUrl: typ, ResourceNames: names[typ], VersionInfo: version})
+	}
+
+	// clear endpoints only
+	if err := c.ClearSnapshotType(key, cache.EndpointType); err != nil {
+		t.Fatal(err)
+	}
+	if count := c.GetStatusInfo(key).GetNumWatches(); count != len(testTypes)-1 {
+		t.Errorf("watches should be preserved for all but one: %d", count)
+	}
+
+	// validate response for endpoints
+	select {
+	case out := <-watches[cache.EndpointType]:
+		if out.Version == version {
+			t.Errorf("got version %q, want a new one", out.Version)
+		}
+		if len(out.Resources) != 0 {
+			t.Errorf("got resources %v, want none", out.Resources)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("failed to receive snapshot response")
+	}
+
+	// other types are untouched
+	snap, err := c.GetSnapshot(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snap.GetResources(cache.EndpointType)) != 0 {
+		t.Errorf("got endpoints %v, want none", snap.GetResources(cache.EndpointType))
+	}
+	for _, typ := range testTypes {
+		if typ == cache.EndpointType {
+			continue
+		}
+		if snap.GetVersion(typ) != version {
+			t.Errorf("got version %q for %s, want %q", snap.GetVersion(typ), typ, version)
+		}
+		if !reflect.DeepEqual(snap.GetResources(typ), newSnapshot().GetResources(typ)) {
+			t.Errorf("get resources %v for %s, want %v", snap.GetResources(typ), typ, newSnapshot().GetResources(typ))
+		}
+		select {
+		case out := <-watches[typ]:
+			t.Errorf("watch for %s => got %v, want none", typ, out)
+		default:
+		}
+	}
+
+	// clearing again bumps the version again
+	cleared := snap.GetVersion(cache.EndpointType)
+	if err := c.ClearSnapshotType(key, cache.EndpointType); err != nil {
+		t.Fatal(err)
+	}
+	if snap, _ := c.GetSnapshot(key); snap.GetVersion(cache.EndpointType) == cleared {
+		t.Errorf("got version %q, want a new one", cleared)
+	}
+
+	// unsupported types are rejected
+	if err := c.ClearSnapshotType(key, "unsupported type"); err == nil {
+		t.Error("expected an error on clear of an unsupported type")
+	}
+}
+
+func TestSnapshotCacheDuplicateResourceNames(t *testing.T) {
+	c := NewSnapshotCache(true, group{}, logger{t: t})
+	duplicate := resource.MakeCluster(resource.Ads, clusterName)
+	want := fmt.Sprintf("duplicate resource name %q of type %s", clusterName, cache.ClusterType)
+
+	// resources of the same name cannot be indexed
+	if _, err := IndexResourcesByName(cache.ClusterType, []cache.Resource{cluster, duplicate}); err == nil || err.Error() != want {
+		t.Errorf("got error %v, want %q", err, want)
+	}
+
+	// snapshot with two clusters of the same name is rejected
+	snap := newSnapshot().WithResources(cache.ClusterType, version, map[string]cache.Resource{
+		clusterName: cluster,
+		"cluster1":  duplicate,
+	})
+	if err := c.SetSnapshot(key, snap); err == nil || err.Error() != want {
+		t.Errorf("got error %v, want %q", err, want)
+	}
+	if _, err := c.GetSnapshot(key); err == nil {
+		t.Error("snapshot with duplicate resource names should not be cached")
+	}
+
+	// update with two clusters of the same name is rejected
+	if err := c.SetSnapshot(key, newSnapshot()); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetSnapshotResources(key, cache.ClusterType, version2, map[string]cache.Resource{
+		clusterName: cluster,
+		"cluster1":  duplicate,
+	}); err == nil || err.Error() != want {
+		t.Errorf("got error %v, want %q", err, want)
+	}
+}
+
+func TestSnapshotCacheGetLatest(t *testing.T) {
+	c := NewSnapshotCache(false, group{}, logger{t: t})
+
+	// there is no snapshot yet
+	if _, _, ok := c.GetLatest(key, cache.ClusterType); ok {
+		t.Error("expected no resources for a missing snapshot")
+	}
+
+	cluster1 := resource.MakeCluster(resource.Ads, "cluster1")
+	other := resource.MakeCluster(resource.Ads, "other")
+	snap := newSnapshot().WithResources(cache.ClusterType, version, map[string]cache.Resource{
+		clusterName: cluster,
+		"cluster1":  cluster1,
+		"other":     other,
+	})
+	if err := c.SetSnapshot(key, snap); err != nil {
+		t.Fatal(err)
+	}
+
+	// unsupported types are not served
+	if _, _, ok := c.GetLatest(key, "unsupported type"); ok {
+		t.Error("expected no resources of an unsupported type")
+	}
+
+	for _, names := range [][]string{nil, {clusterName}, {"cluster*"}, {"*"}, {"missing"}} {
+		t.Run(fmt.Sprintf("%v", names), func(t *testing.T) {
+			resources, latestVersion, ok := c.GetLatest(key, cache.ClusterType, names...)
+			if !ok {
+				t.Fatal("expected resources of a snapshot")
+			}
+
+			// a one-shot watch for the same request delivers the same state
+			resp, err := c.FetchWait(context.Background(), v2.DiscoveryRequest{TypeUrl: cache.ClusterType, ResourceNames: names})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if latestVersion != resp.Version {
+				t.Errorf("got version %q, want %q", latestVersion, resp.Version)
+			}
+			if want := cache.IndexResourcesByName(resp.Resources); !reflect.DeepEqual(resources, want) {
+				t.Errorf("got resources %v, want %v", resources, want)
+			}
+		})
+	}
+
+	// modification of returned resources doesn't affect the cache
+	resources, _, _ := c.GetLatest(key, cache.ClusterType)
+	delete(resources, clusterName)
+	if resources, _, _ := c.GetLatest(key, cache.ClusterType); len(resources) != 3 {
+		t.Errorf("got %d resources, want 3", len(resources))
+	}
+
+	// no watch is left open
+	if count := c.GetStatusInfo(key).GetNumWatches(); count != 0 {
+		t.Errorf("got %d open watches, want none", count)
+	}
+}
+
+func TestSnapshotCacheDefaultSnapshot(t *testing.T) {
+	c := NewSnapshotCache(false, group{}, logger{t: t})
+	node := &core.Node{Id: "new"}
+	defaultVersion := "default"
+	defaultSnapshot := NewSampleSnapshot(defaultVersion, nil, nil, nil, nil, nil)
+
+	// open watch of a node without a snapshot is responded once the default snapshot is set
+	pending, _ := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.ClusterType, Node: node})
+	select {
+	case out := <-pending:
+		t.Fatalf("watch without any snapshot => got %v, want none", out)
+	default:
+	}
+	if err := c.SetDefaultSnapshot(defaultSnapshot); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case out := <-pending:
+		if out.Version != defaultVersion {
+			t.Errorf("got version %q, want %q", out.Version, defaultVersion)
+		}
+		if len(out.Resources) != 0 {
+			t.Errorf("got resources %v, want none", out.Resources)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("failed to receive the default snapshot")
+	}
+
+	// a node with no snapshot gets the default one right away
+	resp, err := c.Fetch(context.Background(), v2.DiscoveryRequest{TypeUrl: cache.ClusterType, Node: &core.Node{Id: "other"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Version != defaultVersion {
+		t.Errorf("got version %q, want %q", resp.Version, defaultVersion)
+	}
+	if _, err := c.GetSnapshot(node.Id); err == nil {
+		t.Errorf("unexpected snapshot found for node %q", node.Id)
+	}
+
+	// a node snapshot overrides the default one on the next watch
+	watch, _ := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.ClusterType, Node: node, VersionInfo: defaultVersion})
+	if err := c.SetSnapshot(node.Id, snapshot); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case out := <-watch:
+		if out.Version != version {
+			t.Errorf("got version %q, want %q", out.Version, version)
+		}
+		if !reflect.DeepEqual(cache.IndexResourcesByName(out.Resources), snapshot.GetResources(cache.ClusterType)) {
+			t.Errorf("got resources %v, want %v", out.Resources, snapshot.GetResources(cache.ClusterType))
+		}
+	case <-time.After(time.Second):
+		t.Fatal("failed to receive the node snapshot")
+	}
+
+	// once the default snapshot is removed, nodes without a snapshot get nothing
+	if err := c.SetDefaultSnapshot(nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Fetch(context.Background(), v2.DiscoveryRequest{TypeUrl: cache.ClusterType, Node: &core.Node{Id: "other"}}); err == nil {
+		t.Error("expected an error for a node without a snapshot")
+	}
+}
+
+func TestSnapshotCacheSnapshotTransform(t *testing.T) {
+	accessLogCluster := resource.MakeCluster(resource.Ads, "access_log_sink")
+	transform := func(node string, snapshot Snapshot) (Snapshot, error) {
+		clusters := map[string]cache.Resource{}
+		for name, resource := range snapshot.GetResources(cache.ClusterType) {
+			clusters[name] = resource
+		}
+		clusters["access_log_sink"] = accessLogCluster
+		return snapshot.WithResources(cache.ClusterType, snapshot.GetVersion(cache.ClusterType), clusters), nil
+	}
+	c := NewSnapshotCache(false, group{}, logger{t: t}, WithSnapshotTransform(transform))
+
+	if err := c.SetSnapshot("node1", newSnapshot()); err != nil {
+		t.Fatal(err)
+	}
+	if errs := c.SetSnapshots(map[string]Snapshot{"node2": newSnapshot()}); len(errs) != 0 {
+		t.Fatal(errs)
+	}
+
+	for _, node := range []string{"node1", "node2"} {
+		snap, err := c.GetSnapshot(node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		clusters := snap.GetResources(cache.ClusterType)
+		if len(clusters) != 2 || clusters["access_log_sink"] == nil || clusters[clusterName] == nil {
+			t.Errorf("got clusters %v for node %q, want %q and %q", clusters, node, clusterName, "access_log_sink")
+		}
+
+		// served responses include the added cluster
+		watch, _ := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.ClusterType, Node: &core.Node{Id: node}})
+		select {
+		case out := <-watch:
+			if len(out.Resources) != 2 {
+				t.Errorf("got resources %v for node %q, want 2", out.Resources, node)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("failed to receive a response for node %q", node)
+		}
+	}
+
+	// the caller's snapshot is not modified
+	original := newSnapshot()
+	if err := c.SetSnapshot("node3", original); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(original.GetResources(cache.ClusterType)); got != 1 {
+		t.Errorf("got %d clusters in the original snapshot, want 1", got)
+	}
+
+	// the error of a transform is returned
+	failing := NewSnapshotCache(false, group{}, logger{t: t}, WithSnapshotTransform(func(string, Snapshot) (Snapshot, error) {
+		return nil, errors.New("boom")
+	}))
+	if err := failing.SetSnapshot(key, newSnapshot()); err == nil || err.Error() != `could not transform snapshot for node "node": boom` {
+		t.Errorf("got error %v, want a transform error", err)
+	}
+}
+
+func TestConcurrentSetWatch(t *testing.T) {
+	c := NewSnapshotCache(false, group{}, logger{t: t})
+	for i := 0; i < 50; i++ {
+		func(i int) {
+			t.Run(fmt.Sprintf("worker%d", i), func(t *testing.T) {
+				t.Parallel()
+				id := fmt.Sprintf("%d", i%2)
+				var cancel func()
+				if i < 25 {
+					_ = c.SetSnapshot(id, &SampleSnapshot{cache.Snapshot{
+						Endpoints: cache.NewResources(fmt.Sprintf("v%d", i), []cache.Resource{resource.MakeEndpoint(clusterName, uint32(i))}),
+					}})
+				} else {
+					if cancel != nil {
+						cancel()
+					}
+					_, _ = c.CreateWatch(v2.DiscoveryRequest{
+						Node:    &core.Node{Id: id},
+						TypeUrl: cache.EndpointType,
+					})
+				}
+			})
+		}(i)
+	}
+}
+
+func TestSnapshotCacheWatchReplay(t *testing.T) {
+	c := NewSnapshotCache(false, group{}, logger{t: t})
+	if err := c.SetSnapshot(key, newSnapshot()); err != nil {
+		t.Fatal(err)
+	}
+
+	// the current state is replayed even if the requested version is up-to-date
+	value, cancel := c.CreateWatchReplay(v2.DiscoveryRequest{TypeUrl: cache.ListenerType, VersionInfo: version})
+	select {
+	case out := <-value:
+		if out.Version != version {
+			t.Errorf("got version %q, want %q", out.Version, version)
+		}
+		if len(out.Resources) != 1 {
+			t.Errorf("got %d resources, want 1", len(out.Resources))
+		}
+	case <-time.After(time.Second):
+		t.Fatal("failed to receive snapshot response")
+	}
+
+	// subsequent updates are streamed on the same channel
+	if err := c.SetSnapshotResources(key, cache.ListenerType, version2, map[string]cache.Resource{listenerName: listener}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case out := <-value:
+		if out.Version != version2 {
+			t.Errorf("got version %q, want %q", out.Version, version2)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("failed to receive snapshot response")
+	}
+
+	// the channel is closed once the watch is cancelled
+	cancel()
+	select {
+	case out, ok := <-value:
+		if ok {
+			t.Errorf("got response with version %q, want closed channel", out.Version)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("channel should be closed")
+	}
+	if count := c.GetStatusInfo(key).GetNumWatches(); count > 0 {
+		t.Errorf("watches should be released: %d", count)
+	}
+}
+
+func TestSnapshotCacheWatchPressure(t *testing.T) {
+	pressure := make(chan WatchPressure, 10)
+	c := NewSnapshotCache(false, group{}, logger{t: t}, WithWatchPressureCallback(func(p WatchPressure) {
+		pressure <- p
+	}))
+	if err := c.SetSnapshot(key, newSnapshot()); err != nil {
+		t.Fatal(err)
+	}
+	value, cancel := c.CreateWatchReplay(v2.DiscoveryRequest{TypeUrl: cache.ListenerType})
+	defer cancel()
+	// wait until the current state fills the buffer
+	for i := 0; len(value) == 0; i++ {
+		if i > 100 {
+			t.Fatal("failed to buffer snapshot response")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// an update that cannot be buffered raises pressure
+	if err := c.SetSnapshotResources(key, cache.ListenerType, version2, map[string]cache.Resource{listenerName: listener}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case p := <-pressure:
+		want := WatchPressure{Node: key, TypeURL: cache.ListenerType, Full: true}
+		if p != want {
+			t.Errorf("got pressure %+v, want %+v", p, want)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("pressure should be raised")
+	}
+
+	// draining the buffer clears pressure
+	if out := <-value; out.Version != version {
+		t.Errorf("got version %q, want %q", out.Version, version)
+	}
+	select {
+	case p := <-pressure:
+		want := WatchPressure{Node: key, TypeURL: cache.ListenerType, Full: false}
+		if p != want {
+			t.Errorf("got pressure %+v, want %+v", p, want)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("pressure should be cleared")
+	}
+	if out := <-value; out.Version != version2 {
+		t.Errorf("got version %q, want %q", out.Version, version2)
+	}
+
+	// a consumer that keeps up causes no pressure
+	if err := c.SetSnapshot(key, newSnapshot()); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case out := <-value:
+		if out.Version != version {
+			t.Errorf("got version %q, want %q", out.Version, version)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("failed to receive snapshot response")
+	}
+	if len(pressure) > 0 {
+		t.Errorf("got unexpected pressure %+v", <-pressure)
+	}
+}
+
+func TestSnapshotCacheWatchCancel(t *testing.T) {
+	c := NewSnapshotCache(true, group{}, logger{t: t})
+	for _, typ := range testTypes {
+		_, cancel := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: typ, ResourceNames: names[typ]})
+		cancel()
+	}
+	// should be status info for the node
+	if keys := c.GetStatusKeys(); len(keys) == 0 {
+		t.Error("got 0, want status info for the node")
+	}
+
+	for _, typ := range testTypes {
+		if count := c.GetStatusInfo(key).GetNumWatches(); count > 0 {
+			t.Errorf("watches should be released for %s", typ)
+		}
+	}
+
+	if empty := c.GetStatusInfo("missing"); empty != nil {
+		t.Errorf("should not return a status for unknown key: got %#v", empty)
+	}
+}
+
+func TestSnapshotCacheCancelWatches(t *testing.T) {
+	c := NewSnapshotCache(true, group{}, logger{t: t})
+	goroutines := goruntime.NumGoroutine()
+
+	var watches []chan cache.Response
+	for _, typ := range testTypes {
+		value, _ := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: typ, ResourceNames: names[typ]})
+		watches = append(watches, value)
+	}
+	replay, cancelReplay := c.CreateWatchReplay(v2.DiscoveryRequest{TypeUrl: cache.ListenerType})
+	defer cancelReplay()
+	// wait for the replay watch to be opened
+	for i := 0; c.GetStatusInfo(key).GetNumWatches() != len(testTypes)+1; i++ {
+		if i > 100 {
+			t.Fatal("replay watch should be opened")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	c.CancelWatches(key)
+
+	if count := c.GetStatusInfo(key).GetNumWatches(); count > 0 {
+		t.Errorf("watches should be released: %d", count)
+	}
+	for i, value := range append(watches, replay) {
+		select {
+		case out, ok := <-value:
+			if ok {
+				t.Errorf("watch %d: got response with version %q, want closed channel", i, out.Version)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("watch %d: channel should be closed", i)
+		}
+	}
+	// goroutine of the replay watch should exit
+	for i := 0; goruntime.NumGoroutine() > goroutines; i++ {
+		if i > 100 {
+			t.Fatalf("goroutines leaked: got %d, want %d", goruntime.NumGoroutine(), goroutines)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// snapshots set afterwards are not delivered to released watches
+	if err := c.SetSnapshot(key, newSnapshot()); err != nil {
+		t.Fatal(err)
+	}
+	if keys := c.GetStatusKeys(); len(keys) == 0 {
+		t.Error("got 0, want status info for the node")
+	}
+	// cancelling watches of an unknown node is a no-op
+	c.CancelWatches("missing")
+}
+
+func TestSnapshotCacheRetainedBytes(t *testing.T) {
+	c := NewSnapshotCache(true, group{}, logger{t: t})
+	if err := c.SetSnapshot(key, snapshot); err != nil {
+		t.Fatal(err)
+	}
+
+	sizes, err := c.GetRetainedBytes(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, typ := range []string{cache.EndpointType, cache.ClusterType} {
+		if sizes[typ] == 0 {
+			t.Errorf("got 0 bytes for %s, want non-zero", typ)
+		}
+	}
+	expected := 0
+	for _, resource := range snapshot.GetResources(cache.EndpointType) {
+		expected += proto.Size(resource)
+	}
+	if sizes[cache.EndpointType] != expected {
+		t.Errorf("got %d bytes for endpoints, want %d", sizes[cache.EndpointType], expected)
+	}
+
+	// sizes are consistent across repeated calls
+	again, err := c.GetRetainedBytes(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(sizes, again) {
+		t.Errorf("got %v, want %v", again, sizes)
+	}
+
+	// error for missing snapshot
+	if _, err := c.GetRetainedBytes("missing"); err == nil {
+		t.Error("missing snapshot: expected an error")
+	}
+}
+
+// responseStream is an ADS stream of an xDS server driven by a test.
+type responseStream struct {
+	ctx  context.Context
+	recv chan *v2.DiscoveryRequest
+	sent chan *v2.DiscoveryResponse
+	grpc.ServerStream
+}
+
+func (stream *responseStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *responseStream) Send(resp *v2.DiscoveryResponse) error {
+	stream.sent <- resp
+	return nil
+}
+
+func (stream *responseStream) Recv() (*v2.DiscoveryRequest, error) {
+	req, more := <-stream.recv
+	if !more {
+		return nil, errors.New("stream closed")
+	}
+	return req, nil
+}
+
+// requestRecorder records requests of responses seen by callbacks of an xDS server.
+type requestRecorder struct {
+	CallbacksChain
+	requests []v2.DiscoveryRequest
+}
+
+func (r *requestRecorder) OnStreamResponse(streamID int64, req *v2.DiscoveryRequest, resp *v2.DiscoveryResponse) {
+	r.requests = append(r.requests, *req)
+}
+
+func TestSnapshotCacheResponseInfos(t *testing.T) {
+	now := time.Unix(100, 0)
+	c := NewSnapshotCache(false, group{}, logger{t: t},
+		WithClock(func() time.Time { return now }),
+		WithNonceRetention(time.Minute),
+	)
+	if err := c.SetSnapshot(key, newSnapshot()); err != nil {
+		t.Fatal(err)
+	}
+	recorder := &requestRecorder{}
+	srv := server.NewServer(context.Background(), c, CallbacksChain{c.ResponseCallbacks(), recorder})
+	stream := &responseStream{
+		ctx:  context.Background(),
+		recv: make(chan *v2.DiscoveryRequest, 1),
+		sent: make(chan *v2.DiscoveryResponse, 1),
+	}
+	done := make(chan struct{})
+	go func() {
+		_ = srv.StreamAggregatedResources(stream)
+		close(done)
+	}()
+	const streamID = 1
+	node := &core.Node{Id: key}
+
+	// the first response is recorded with the nonce sent by the server
+	stream.recv <- &v2.DiscoveryRequest{Node: node, TypeUrl: cache.ClusterType}
+	first := <-stream.sent
+	info, ok := c.GetResponseInfo(streamID, first.Nonce)
+	if !ok {
+		t.Fatalf("no info of a response with nonce %q", first.Nonce)
+	}
+	want := ResponseInfo{Node: key, TypeURL: cache.ClusterType, Version: version, SentAt: now}
+	if !reflect.DeepEqual(info, want) {
+		t.Errorf("got info %+v of a response with nonce %q, want %+v", info, first.Nonce, want)
+	}
+
+	// the ACK carries the nonce of the response it acknowledges
+	now = now.Add(30 * time.Second)
+	ack := &v2.DiscoveryRequest{Node: node, TypeUrl: cache.ClusterType, VersionInfo: first.VersionInfo, ResponseNonce: first.Nonce}
+	stream.recv <- ack
+	if err := c.SetSnapshot(key, newSnapshot().WithVersion(cache.ClusterType, version2)); err != nil {
+		t.Fatal(err)
+	}
+	second := <-stream.sent
+	if info, ok := c.GetResponseInfo(streamID, ack.ResponseNonce); !ok || info.Version != version {
+		t.Errorf("got info %+v of an ACKed nonce %q, want version %q", info, ack.ResponseNonce, version)
+	}
+	if info, ok := c.GetResponseInfo(streamID, second.Nonce); !ok || info.Version != version2 {
+		t.Errorf("got info %+v of a response with nonce %q, want version %q", info, second.Nonce, version2)
+	}
+
+	// requests seen by other callbacks are left untouched
+	nonces := []string{}
+	for _, req := range recorder.requests {
+		nonces = append(nonces, req.ResponseNonce)
+	}
+	if want := []string{"", ack.ResponseNonce}; !reflect.DeepEqual(nonces, want) {
+		t.Errorf("got nonces %q in requests of responses, want %q", nonces, want)
+	}
+
+	// nonces are assigned per stream
+	if _, ok := c.GetResponseInfo(streamID+1, first.Nonce); ok {
+		t.Error("expected no info of a nonce of another stream")
+	}
+	if _, ok := c.GetResponseInfo(streamID, "unknown"); ok {
+		t.Error("expected no info of an unknown nonce")
+	}
+
+	// infos expire after the retention time
+	now = now.Add(30 * time.Second)
+	if _, ok := c.GetResponseInfo(streamID, first.Nonce); ok {
+		t.Errorf("expected no info of an expired nonce %q", first.Nonce)
+	}
+	if _, ok := c.GetResponseInfo(streamID, second.Nonce); !ok {
+		t.Errorf("no info of a response with nonce %q", second.Nonce)
+	}
+
+	// infos are dropped once the stream is closed
+	close(stream.recv)
+	<-done
+	if _, ok := c.GetResponseInfo(streamID, second.Nonce); ok {
+		t.Errorf("expected no info of a nonce %q of a closed stream", second.Nonce)
+	}
+}
+
+func TestSnapshotCacheSetTime(t *testing.T) {
+	now := time.Unix(100, 0)
+	c := NewSnapshotCache(false, group{}, logger{t: t}, WithClock(func() time.Time { return now }))
+
+	// no set time for missing snapshot
+	if _, ok := c.GetSnapshotSetTime(key); ok {
+		t.Error("missing snapshot: expected no set time")
+	}
+
+	if err := c.SetSnapshot(key, newSnapshot()); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(10 * time.Second)
+	if setTime, ok := c.GetSnapshotSetTime(key); !ok || !setTime.Equal(time.Unix(100, 0)) {
+		t.Errorf("got set time %v (%v), want %v", setTime, ok, time.Unix(100, 0))
+	}
+
+	// setting an equal snapshot doesn't refresh the set time
+	if err := c.SetSnapshot(key, newSnapshot()); err != nil {
+		t.Fatal(err)
+	}
+	if setTime, _ := c.GetSnapshotSetTime(key); !setTime.Equal(time.Unix(100, 0)) {
+		t.Errorf("got set time %v, want %v", setTime, time.Unix(100, 0))
+	}
+
+	// update of a single type refreshes the set time
+	if err := c.SetSnapshotResources(key, cache.ListenerType, version2, map[string]cache.Resource{listenerName: listener}); err != nil {
+		t.Fatal(err)
+	}
+	if setTime, _ := c.GetSnapshotSetTime(key); !setTime.Equal(time.Unix(110, 0)) {
+		t.Errorf("got set time %v, want %v", setTime, time.Unix(110, 0))
+	}
+
+	// no set time once the node is cleared
+	c.ClearSnapshot(key)
+	if _, ok := c.GetSnapshotSetTime(key); ok {
+		t.Error("cleared snapshot: expected no set time")
+	}
+}
+
+func TestSnapshotCacheClone(t *testing.T) {
+	snapshot := newSnapshot()
+	c := NewSnapshotCache(false, group{}, logger{t: t})
+	if err := c.SetSnapshot(key, snapshot); err != nil {
+		t.Fatal(err)
+	}
+
+	// mutate items of the original snapshot
+	delete(snapshot.Clusters.Items, clusterName)
+	snapshot.Endpoints.Items[clusterName] = resource.MakeEndpoint(clusterName, 9090)
+	snapshot.Endpoints.Items["cluster1"] = resource.MakeEndpoint("cluster1", 9090)
+
+	// served resources are unchanged
+	resp, err := c.Fetch(context.Background(), v2.DiscoveryRequest{TypeUrl: cache.ClusterType})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(cache.IndexResourcesByName(resp.Resources), map[string]cache.Resource{clusterName: cluster}) {
+		t.Errorf("got clusters %v, want %v", resp.Resources, cluster)
+	}
+	resp, err = c.Fetch(context.Background(), v2.DiscoveryRequest{TypeUrl: cache.EndpointType})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(cache.IndexResourcesByName(resp.Resources), map[string]cache.Resource{clusterName: endpoint}) {
+		t.Errorf("got endpoints %v, want %v", resp.Resources, endpoint)
+	}
+}
+
+func TestSnapshotCacheNilNode(t *testing.T) {
+	c := NewSnapshotCache(false, group{}, logger{t: t})
+
+	// watch without a node is opened for the default node group
+	value, cancel := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.ClusterType})
+	if cancel == nil {
+		t.Fatal("watch should be left open until a snapshot is set")
+	}
+	if keys := c.GetStatusKeys(); !reflect.DeepEqual(keys, []string{key}) {
+		t.Errorf("got status keys %v, want %v", keys, []string{key})
+	}
+	if node := c.GetStatusInfo(key).GetNode(); node != nil {
+		t.Errorf("got node %v, want none", node)
+	}
+
+	if err := c.SetSnapshot(key, newSnapshot()); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case out := <-value:
+		if out.Version != version {
+			t.Errorf("got version %q, want %q", out.Version, version)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("failed to receive snapshot response")
+	}
+
+	// fetch without a node resolves to the default node group
+	resp, err := c.Fetch(context.Background(), v2.DiscoveryRequest{TypeUrl: cache.ClusterType})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Version != version {
+		t.Errorf("got version %q, want %q", resp.Version, version)
+	}
+
+	// node metadata is picked up from the first request with a node
+	node := &core.Node{Id: key}
+	_, cancel = c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.ClusterType, Node: node, VersionInfo: version})
+	defer cancel()
+	if got := c.GetStatusInfo(key).GetNode(); got != node {
+		t.Errorf("got node %v, want %v", got, node)
+	}
+}
+
+func TestSnapshotCacheNodeGroupError(t *testing.T) {
+	// nodes are grouped by a "group" field of their metadata
+	metadataGroup := NodeGroupFunc(func(node *core.Node) (string, error) {
+		field, ok := node.GetMetadata().GetFields()["group"]
+		if !ok || field.GetStringValue() == "" {
+			return "", errors.New("metadata field \"group\" is missing")
+		}
+		return field.GetStringValue(), nil
+	})
+	c := NewSnapshotCache(false, group{}, logger{t: t}, WithNodeGroup(metadataGroup))
+	if err := c.SetSnapshot(key, newSnapshot()); err != nil {
+		t.Fatal(err)
+	}
+	malformed := &core.Node{Id: "malformed"}
+	want := `could not determine node group of node "malformed": metadata field "group" is missing`
+
+	// watch of a malformed node is closed right away
+	value, cancel := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.ClusterType, Node: malformed})
+	if cancel != nil {
+		t.Error("watch of a malformed node should not be left open")
+	}
+	select {
+	case out, more := <-value:
+		if more {
+			t.Errorf("got response %v, want closed watch", out)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("watch of a malformed node should be closed")
+	}
+	if keys := c.GetStatusKeys(); len(keys) != 0 {
+		t.Errorf("got status keys %v, want none", keys)
+	}
+
+	// fetches of a malformed node fail
+	if _, err := c.Fetch(context.Background(), v2.DiscoveryRequest{TypeUrl: cache.ClusterType, Node: malformed}); err == nil || err.Error() != want {
+		t.Errorf("got error %v, want %q", err, want)
+	}
+	if _, err := c.FetchWait(context.Background(), v2.DiscoveryRequest{TypeUrl: cache.ClusterType, Node: malformed}); err == nil || err.Error() != want {
+		t.Errorf("got error %v, want %q", err, want)
+	}
+
+	// well-formed nodes are served as usual
+	node := &core.Node{Id: "node", Metadata: &pstruct.Struct{Fields: map[string]*pstruct.Value{
+		"group": {Kind: &pstruct.Value_StringValue{StringValue: key}},
+	}}}
+	resp, err := c.Fetch(context.Background(), v2.DiscoveryRequest{TypeUrl: cache.ClusterType, Node: node})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Version != version {
+		t.Errorf("got version %q, want %q", resp.Version, version)
+	}
+}
+
+func TestSnapshotCacheResponseStats(t *testing.T) {
+	now := time.Unix(0, 0)
+	var stats []ResponseStats
+	c := NewSnapshotCache(false, group{}, logger{t: t},
+		WithClock(func() time.Time { return now }),
+		WithResponseStatsCallback(func(s ResponseStats) { stats = append(stats, s) }))
+
+	// open watch is responded as soon as a snapshot is set
+	value, _ := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.ListenerType})
+	if err := c.SetSnapshot(key, newSnapshot()); err != nil {
+		t.Fatal(err)
+	}
+	<-value
+
+	// slow consumer requests clusters 3 seconds later
+	now = now.Add(3 * time.Second)
+	value, _ = c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.ClusterType})
+	<-value
+
+	// update of a single type is stamped separately
+	value, _ = c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.ListenerType, VersionInfo: version})
+	if err := c.SetSnapshotResources(key, cache.ListenerType, version2, map[string]cache.Resource{listenerName: listener}); err != nil {
+		t.Fatal(err)
+	}
+	<-value
+	now = now.Add(5 * time.Second)
+	value, _ = c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.ListenerType})
+	<-value
+
+	want := []ResponseStats{
+		{Node: key, TypeURL: cache.ListenerType, Version: version, Latency: 0},
+		{Node: key, TypeURL: cache.ClusterType, Version: version, Latency: 3 * time.Second},
+		{Node: key, TypeURL: cache.ListenerType, Version: version2, Latency: 0},
+		{Node: key, TypeURL: cache.ListenerType, Version: version2, Latency: 5 * time.Second},
+	}
+	if !reflect.DeepEqual(stats, want) {
+		t.Errorf("got stats %v, want %v", stats, want)
+	}
+}
+
+func TestSnapshotCacheCustomVersion(t *testing.T) {
+	c := NewSnapshotCache(false, group{}, logger{t: t},
+		WithVersionFunc(func(snapshot Snapshot, typeURL string) string {
+			return fmt.Sprintf("%s+%d", snapshot.GetVersion(typeURL), len(snapshot.GetResources(typeURL)))
+		}))
+	if err := c.SetSnapshot(key, newSnapshot()); err != nil {
+		t.Fatal(err)
+	}
+
+	value, _ := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.ClusterType})
+	select {
+	case out := <-value:
+		if out.Version != version+"+1" {
+			t.Errorf("got version %q, want %q", out.Version, version+"+1")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("failed to receive snapshot response")
+	}
+
+	// custom version is compared against the version in requests
+	_, cancel := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.ClusterType, VersionInfo: version + "+1"})
+	if cancel == nil {
+		t.Fatal("watch for an up-to-date custom version should be left open")
+	}
+	cancel()
+	if _, err := c.Fetch(context.Background(), v2.DiscoveryRequest{TypeUrl: cache.ClusterType, VersionInfo: version + "+1"}); err == nil {
+		t.Error("fetch of an up-to-date custom version should be skipped")
+	}
+}
+
+func TestSnapshotCacheCustomMarshaler(t *testing.T) {
+	c := NewSnapshotCache(false, group{}, logger{t: t},
+		WithResourceMarshaler(func(typeURL string, resource cache.Resource) ([]byte, error) {
+			data, err := proto.Marshal(resource)
+			if err != nil {
+				return nil, err
+			}
+			return append(data, []byte(typeURL)...), nil
+		}))
+	if err := c.SetSnapshot(key, newSnapshot()); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := c.Fetch(context.Background(), v2.DiscoveryRequest{TypeUrl: cache.ClusterType})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Resources) != 1 {
+		t.Fatalf("got %d resources, want 1", len(resp.Resources))
+	}
+	actual, err := proto.Marshal(resp.Resources[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := proto.Marshal(cluster)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = append(expected, []byte(cache.ClusterType)...)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("got marshaled resource %v, want %v", actual, expected)
+	}
+}
+
+func TestSnapshotCacheSizeLimits(t *testing.T) {
+	snapshot := NewSampleSnapshot(version, nil, []cache.Resource{cluster}, nil, nil, nil)
+	sizes, err := func() (map[string]int, error) {
+		c := NewSnapshotCache(true, group{}, logger{t: t})
+		if err := c.SetSnapshot(key, snapshot); err != nil {
+			t.Fatal(err)
+		}
+		return c.GetRetainedBytes(key)
+	}()
+	if err != nil {
+		t.Fatal(err)
+	}
+	clusters := sizes[cache.ClusterType]
+
+	tests := []struct {
+		name     string
+		limits   SizeLimits
+		exceeded []SizeLimitExceeded
+		err      string
+	}{
+		{
+			name:   "under limits",
+			limits: SizeLimits{Soft: clusters, Hard: clusters},
+		},
+		{
+			name:     "soft limit",
+			limits:   SizeLimits{Soft: clusters - 1, Hard: clusters},
+			exceeded: []SizeLimitExceeded{{Node: key, TypeURL: cache.ClusterType, Size: clusters, Limit: clusters - 1}},
+		},
+		{
+			name:   "hard limit",
+			limits: SizeLimits{Soft: clusters - 1, Hard: clusters - 1},
+			err: fmt.Sprintf("resources of type %s for node %q take %d bytes, which exceeds the hard limit of %d bytes",
+				cache.ClusterType, key, clusters, clusters-1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var exceeded []SizeLimitExceeded
+			limits := tt.limits
+			limits.OnSoftLimit = func(e SizeLimitExceeded) {
+				exceeded = append(exceeded, e)
+			}
+			c := NewSnapshotCache(true, group{}, logger{t: t}, WithSizeLimits(limits))
+
+			err := c.SetSnapshot(key, snapshot)
+
+			if tt.err == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("got error %v, want %q", err, tt.err)
+				}
+				if _, err := c.GetSnapshot(key); err == nil {
+					t.Errorf("snapshot exceeding the hard limit should not be set")
+				}
+			}
+			if !reflect.DeepEqual(exceeded, tt.exceeded) {
+				t.Errorf("got exceeded limits %v, want %v", exceeded, tt.exceeded)
+			}
+		})
+	}
+}
+
+func TestSnapshotCacheExpectedTypes(t *testing.T) {
+	withoutClusters := NewSampleSnapshot(version, []cache.Resource{endpoint}, nil, []cache.Resource{route}, []cache.Resource{listener}, nil)
+
+	t.Run("configured types", func(t *testing.T) {
+		var missing []MissingTypes
+		c := NewSnapshotCache(true, group{}, logger{t: t}, WithExpectedTypes(ExpectedTypes{
+			Types: []string{cache.ClusterType, cache.ListenerType},
+			OnMissing: func(m MissingTypes) {
+				missing = append(missing, m)
+			},
+		}))
+
+		if err := c.SetSnapshot(key, withoutClusters); err != nil {
+			t.Fatal(err)
+		}
+
+		want := []MissingTypes{{Node: key, TypeURLs: []string{cache.ClusterType}}}
+		if !reflect.DeepEqual(missing, want) {
+			t.Errorf("got missing types %v, want %v", missing, want)
+		}
+		// the snapshot is set anyway
+		if _, err := c.GetSnapshot(key); err != nil {
+			t.Errorf("snapshot should be set: %v", err)
+		}
+	})
+
+	t.Run("watched types", func(t *testing.T) {
+		var missing []MissingTypes
+		c := NewSnapshotCache(true, group{}, logger{t: t}, WithExpectedTypes(ExpectedTypes{
+			OnMissing: func(m MissingTypes) {
+				missing = append(missing, m)
+			},
+		}))
+		// runtimes are not expected, since the node has never watched them
+		for _, typ := range []string{cache.ClusterType, cache.ListenerType} {
+			_, cancel := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: typ, ResourceNames: names[typ]})
+			defer cancel()
+		}
+
+		if err := c.SetSnapshot(key, withoutClusters); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.SetSnapshot(key, newSnapshot()); err != nil {
+			t.Fatal(err)
+		}
+
+		want := []MissingTypes{{Node: key, TypeURLs: []string{cache.ClusterType}}}
+		if !reflect.DeepEqual(missing, want) {
+			t.Errorf("got missing types %v, want %v", missing, want)
+		}
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		c := NewSnapshotCache(true, group{}, logger{t: t})
+		_, cancel := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.ClusterType})
+		defer cancel()
+		if err := c.SetSnapshot(key, withoutClusters); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSnapshotCacheIndex(t *testing.T) {
+	taggedEndpoint := func(name string, service string) cache.Resource {
+		cla := resource.MakeEndpoint(name, 8080)
+		cla.Endpoints[0].LbEndpoints[0].Metadata = &core.Metadata{
+			FilterMetadata: map[string]*pstruct.Struct{
+				"envoy.lb": {Fields: map[string]*pstruct.Value{
+					"service": {Kind: &pstruct.Value_StringValue{StringValue: service}},
+				}},
+			},
+		}
+		return cla
+	}
+	indexed := 0
+	tags := func(res cache.Resource) []string {
+		indexed++
+		var keys []string
+		for _, locality := range res.(*v2.ClusterLoadAssignment).Endpoints {
+			for _, lbEndpoint := range locality.LbEndpoints {
+				for tag, value := range lbEndpoint.GetMetadata().GetFilterMetadata()["envoy.lb"].GetFields() {
+					keys = append(keys, tag+"="+value.GetStringValue())
+				}
+			}
+		}
+		return keys
+	}
+	c := NewSnapshotCache(false, group{}, logger{t: t}, WithIndex(cache.EndpointType, tags))
+	snapshot := NewSampleSnapshot(version, []cache.Resource{
+		taggedEndpoint("web-1", "web"),
+		taggedEndpoint("web-2", "web"),
+		taggedEndpoint("backend", "backend"),
+	}, nil, nil, nil, nil)
+	if err := c.SetSnapshot(key, snapshot); err != nil {
+		t.Fatal(err)
+	}
+
+	responseNames := func(out cache.Response) []string {
+		var names []string
+		for _, res := range out.Resources {
+			names = append(names, cache.GetResourceName(res))
+		}
+		sort.Strings(names)
+		return names
+	}
+
+	tests := []struct {
+		requested []string
+		want      []string
+	}{
+		{requested: []string{"index:service=web"}, want: []string{"web-1", "web-2"}},
+		{requested: []string{"index:service=web", "backend"}, want: []string{"backend", "web-1", "web-2"}},
+		{requested: []string{"index:service=unknown"}, want: nil},
+		{requested: []string{"backend"}, want: []string{"backend"}},
+		{requested: nil, want: []string{"backend", "web-1", "web-2"}},
+	}
+	for _, test := range tests {
+		value, _ := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.EndpointType, ResourceNames: test.requested})
+		select {
+		case out := <-value:
+			if got := responseNames(out); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("watch for %v: got resources %v, want %v", test.requested, got, test.want)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("failed to receive snapshot response")
+		}
+
+		out, err := c.Fetch(context.Background(), v2.DiscoveryRequest{TypeUrl: cache.EndpointType, ResourceNames: test.requested})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := responseNames(*out); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("fetch for %v: got resources %v, want %v", test.requested, got, test.want)
+		}
+	}
+
+	// the index is built once per version
+	if indexed != 3 {
+		t.Errorf("got %d indexed resources, want 3", indexed)
+	}
+}
+
+func TestSnapshotCacheSortedResources(t *testing.T) {
+	var clusters []cache.Resource
+	var want []string
+	for i := 0; i < 20; i++ {
+		name := fmt.Sprintf("cluster-%02d", i)
+		clusters = append(clusters, resource.MakeCluster(resource.Ads, name))
+		want = append(want, name)
+	}
+	c := NewSnapshotCache(false, group{}, logger{t: t}, WithSortedResources())
+	if err := c.SetSnapshot(key, NewSampleSnapshot(version, nil, clusters, nil, nil, nil)); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		out, err := c.Fetch(context.Background(), v2.DiscoveryRequest{TypeUrl: cache.ClusterType, Node: &core.Node{Id: key}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, r := range out.Resources {
+			got = append(got, cache.GetResourceName(r))
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got resources %v, want %v", got, want)
+		}
+	}
+}
+
+func TestSnapshotClear(t *testing.T) {
+	c := NewSnapshotCache(true, group{}, logger{t: t})
+	if err := c.SetSnapshot(key, snapshot); err != nil {
+		t.Fatal(err)
+	}
+	c.ClearSnapshot(key)
+	if empty := c.GetStatusInfo(key); empty != nil {
+		t.Errorf("cache should be cleared")
+	}
+	if keys := c.GetStatusKeys(); len(keys) != 0 {
+		t.Errorf("keys should be empty")
+	}
+}
+
+func TestSnapshotClearCallback(t *testing.T) {
+	var cleared []string
+	var c SnapshotCache
+	c = NewSnapshotCache(true, group{}, logger{t: t},
+		WithSnapshotClearedCallback(func(node string) {
+			// the cache is unlocked when the callback is invoked
+			if _, err := c.GetSnapshot(node); err == nil {
+				t.Errorf("snapshot of node %q should be cleared", node)
+			}
+			cleared = append(cleared, node)
+		}))
+	if err := c.SetSnapshot(key, snapshot); err != nil {
+		t.Fatal(err)
+	}
+
+	c.ClearSnapshot(key)
+	// clearing an unknown node is a no-op
+	c.ClearSnapshot(key)
+	c.ClearSnapshot("unknown")
+
+	if want := []string{key}; !reflect.DeepEqual(cleared, want) {
+		t.Errorf("got cleared nodes %v, want %v", cleared, want)
+	}
+}
+
+var patternSnapshot = NewSampleSnapshot(version, nil,
+	[]cache.Resource{
+		resource.MakeCluster(resource.Ads, "foo.a"),
+		resource.MakeCluster(resource.Ads, "foo.b"),
+		resource.MakeCluster(resource.Ads, "bar.a"),
+	},
+	nil, nil, nil)
+
+func TestSnapshotCacheNamePatterns(t *testing.T) {
+	c := NewSnapshotCache(false, group{}, logger{t: t})
+	if err := c.SetSnapshot(key, patternSnapshot); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		names    []string
+		expected []string
+	}{
+		"prefix": {
+			names:    []string{"foo.*"},
+			expected: []string{"foo.a", "foo.b"},
+		},
+		"prefix and exact name": {
+			names:    []string{"foo.*", "bar.a"},
+			expected: []string{"foo.a", "foo.b", "bar.a"},
+		},
+		"exact name": {
+			names:    []string{"foo.a"},
+			expected: []string{"foo.a"},
+		},
+		"wildcard": {
+			names:    []string{"*"},
+			expected: []string{"foo.a", "foo.b", "bar.a"},
+		},
+		"no names": {
+			names:    nil,
+			expected: []string{"foo.a", "foo.b", "bar.a"},
+		},
+	}
+
+	for name, given := range testCases {
+		given := given
+		t.Run(name, func(t *testing.T) {
+			resp, err := c.Fetch(context.Background(), v2.DiscoveryRequest{TypeUrl: cache.ClusterType, ResourceNames: given.names})
+			if err != nil || resp == nil {
+				t.Fatal("unexpected error or null response")
+			}
+			assertResourceNames(t, resp.Resources, given.expected)
+
+			watch, _ := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.ClusterType, ResourceNames: given.names})
+			select {
+			case out := <-watch:
+				assertResourceNames(t, out.Resources, given.expected)
+			case <-time.After(time.Second):
+				t.Fatal("failed to receive snapshot response")
+			}
+		})
+	}
+}
+
+func TestSnapshotCacheNamePatternsAds(t *testing.T) {
+	c := NewSnapshotCache(true, group{}, logger{t: t})
+	if err := c.SetSnapshot(key, patternSnapshot); err != nil {
+		t.Fatal(err)
+	}
+
+	// prefix that does not cover all resources should not be responded in ADS mode
+	value, _ := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.ClusterType, ResourceNames: []string{"foo.*"}})
+	select {
+	case out := <-value:
+		t.Errorf("watch for clusters and partial prefix => got %v, want none", out)
+	case <-time.After(time.Second / 4):
+	}
+
+	// prefixes that cover all resources should be responded
+	value, _ = c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.ClusterType, ResourceNames: []string{"foo.*", "bar.*"}})
+	select {
+	case out := <-value:
+		assertResourceNames(t, out.Resources, []string{"foo.a", "foo.b", "bar.a"})
+	case <-time.After(time.Second):
+		t.Fatal("failed to receive snapshot response")
+	}
+
+	// wildcard should be responded
+	value, _ = c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.ClusterType, ResourceNames: []string{"*"}})
+	select {
+	case out := <-value:
+		assertResourceNames(t, out.Resources, []string{"foo.a", "foo.b", "bar.a"})
+	case <-time.After(time.Second):
+		t.Fatal("failed to receive snapshot response")
+	}
+}
+
+func assertResourceNames(t *testing.T, resources []cache.Resource, expected []string) {
+	t.Helper()
+	actual := map[string]bool{}
+	for _, resource := range resources {
+		actual[cache.GetResourceName(resource)] = true
+	}
+	want := map[string]bool{}
+	for _, name := range expected {
+		want[name] = true
+	}
+	if !reflect.DeepEqual(actual, want) {
+		t.Errorf("got resources %v, want %v", actual, want)
 	}
 }
@@ -0,0 +1,288 @@
+package xds
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"reflect"
+	"sync"
+
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+)
+
+// compressedSnapshot is a Snapshot that keeps resources gzip-compressed
+// and decompresses them only when they are requested.
+// Decompressed resources are never retained by the snapshot, otherwise it would take as much memory
+// as an uncompressed one, see decodedResources for reusing them while responding to many watches.
+type compressedSnapshot struct {
+	types      []string
+	consistent error
+	versions   map[string]string
+	resources  map[string]map[string]compressedResource
+}
+
+type compressedResource struct {
+	typ  reflect.Type
+	data []byte
+}
+
+var _ Snapshot = &compressedSnapshot{}
+
+func compressSnapshot(snapshot Snapshot) (*compressedSnapshot, error) {
+	if compressed, ok := snapshot.(*compressedSnapshot); ok {
+		return compressed, nil
+	}
+	s := &compressedSnapshot{
+		types:      snapshot.GetSupportedTypes(),
+		consistent: snapshot.Consistent(),
+		versions:   map[string]string{},
+		resources:  map[string]map[string]compressedResource{},
+	}
+	for _, typ := range s.types {
+		s.versions[typ] = snapshot.GetVersion(typ)
+		resources := snapshot.GetResources(typ)
+		if resources == nil {
+			continue
+		}
+		compressed, err := compressResources(typ, resources)
+		if err != nil {
+			return nil, err
+		}
+		s.resources[typ] = compressed
+	}
+	return s, nil
+}
+
+// compressResources compresses resources of a given type and makes sure that every one of them can be decompressed,
+// so that a snapshot that could not be served is rejected when it is set rather than when it is requested.
+func compressResources(typ string, resources map[string]envoy_cache.Resource) (map[string]compressedResource, error) {
+	compressed := make(map[string]compressedResource, len(resources))
+	for name, resource := range resources {
+		data, err := compress(resource)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not compress resource %q of type %q", name, typ)
+		}
+		r := compressedResource{typ: reflect.TypeOf(resource).Elem(), data: data}
+		if _, err := r.decompress(); err != nil {
+			return nil, errors.Wrapf(err, "could not decompress resource %q of type %q", name, typ)
+		}
+		compressed[name] = r
+	}
+	return compressed, nil
+}
+
+// gzip writers are expensive to allocate, so they are reused between resources.
+var gzipWriters = sync.Pool{
+	New: func() interface{} {
+		return gzip.NewWriter(nil)
+	},
+}
+
+// compress marshals a resource deterministically, so that equal resources are compressed to equal bytes
+// and snapshots can be compared without decompressing them.
+func compress(resource envoy_cache.Resource) ([]byte, error) {
+	marshaled := proto.NewBuffer(nil)
+	marshaled.SetDeterministic(true)
+	if err := marshaled.Marshal(resource); err != nil {
+		return nil, err
+	}
+	data := marshaled.Bytes()
+	var buf bytes.Buffer
+	w := gzipWriters.Get().(*gzip.Writer)
+	defer gzipWriters.Put(w)
+	w.Reset(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (r compressedResource) decompress() (envoy_cache.Resource, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(r.data))
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	resource := reflect.New(r.typ).Interface().(envoy_cache.Resource)
+	if err := proto.Unmarshal(data, resource); err != nil {
+		return nil, err
+	}
+	return resource, nil
+}
+
+func (s *compressedSnapshot) GetSupportedTypes() []string {
+	return s.types
+}
+
+func (s *compressedSnapshot) Consistent() error {
+	return s.consistent
+}
+
+// GetResources decompresses snapshot resources of a given type.
+// Every resource has been decompressed once already when the snapshot was built, see compressResources,
+// so failing to decompress it again means that the snapshot has been corrupted in memory.
+// In that case nil is returned, the cache itself uses decompressResources to tell the failure apart.
+func (s *compressedSnapshot) GetResources(typ string) map[string]envoy_cache.Resource {
+	resources, err := s.decompressResources(typ)
+	if err != nil {
+		return nil
+	}
+	return resources
+}
+
+func (s *compressedSnapshot) decompressResources(typ string) (map[string]envoy_cache.Resource, error) {
+	compressed, ok := s.resources[typ]
+	if !ok {
+		return nil, nil
+	}
+	resources := make(map[string]envoy_cache.Resource, len(compressed))
+	for name, r := range compressed {
+		resource, err := r.decompress()
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decompress resource %q of type %q that has been decompressed before", name, typ)
+		}
+		resources[name] = resource
+	}
+	return resources, nil
+}
+
+// retainedBytes returns the compressed size of snapshot resources of a given type.
+func (s *compressedSnapshot) retainedBytes(typ string) int {
+	size := 0
+	for _, r := range s.resources[typ] {
+		size += len(r.data)
+	}
+	return size
+}
+
+// equalCompressedResources returns true if compressed resources have the same names, types and bytes.
+// It relies on resources being marshaled deterministically, see compress.
+func equalCompressedResources(r1, r2 map[string]compressedResource) bool {
+	if len(r1) != len(r2) {
+		return false
+	}
+	for name, resource := range r1 {
+		other, ok := r2[name]
+		if !ok || resource.typ != other.typ || !bytes.Equal(resource.data, other.data) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *compressedSnapshot) GetVersion(typ string) string {
+	return s.versions[typ]
+}
+
+func (s *compressedSnapshot) WithVersion(typ string, version string) Snapshot {
+	if s.GetVersion(typ) == version {
+		return s
+	}
+	versions := make(map[string]string, len(s.versions))
+	for t, v := range s.versions {
+		versions[t] = v
+	}
+	versions[typ] = version
+	return &compressedSnapshot{
+		types:      s.types,
+		consistent: s.consistent,
+		versions:   versions,
+		resources:  s.resources,
+	}
+}
+
+// WithResources creates a new snapshot with compressed resources of a given type.
+// If resources cannot be compressed, the original snapshot is returned with the error reported by Consistent().
+func (s *compressedSnapshot) WithResources(typ string, version string, resources map[string]envoy_cache.Resource) Snapshot {
+	snapshot, err := s.withResources(typ, version, resources)
+	if err != nil {
+		return &compressedSnapshot{
+			types:      s.types,
+			consistent: err,
+			versions:   s.versions,
+			resources:  s.resources,
+		}
+	}
+	return snapshot
+}
+
+func (s *compressedSnapshot) withResources(typ string, version string, resources map[string]envoy_cache.Resource) (*compressedSnapshot, error) {
+	versions := make(map[string]string, len(s.versions))
+	for t, v := range s.versions {
+		versions[t] = v
+	}
+	versions[typ] = version
+	compressed := make(map[string]map[string]compressedResource, len(s.resources))
+	for t, r := range s.resources {
+		compressed[t] = r
+	}
+	delete(compressed, typ)
+	if resources != nil {
+		r, err := compressResources(typ, resources)
+		if err != nil {
+			return nil, err
+		}
+		compressed[typ] = r
+	}
+	return &compressedSnapshot{
+		types:      s.types,
+		consistent: s.consistent,
+		versions:   versions,
+		resources:  compressed,
+	}, nil
+}
+
+// Clone returns the snapshot itself since compressed resources are never modified.
+func (s *compressedSnapshot) Clone() Snapshot {
+	return s
+}
+
+// resourcesOf returns snapshot resources of a given type.
+// It fails only if resources of a compressed snapshot cannot be decompressed.
+func resourcesOf(snapshot Snapshot, typ string) (map[string]envoy_cache.Resource, error) {
+	if compressed, ok := snapshot.(*compressedSnapshot); ok {
+		return compressed.decompressResources(typ)
+	}
+	return snapshot.GetResources(typ), nil
+}
+
+// decodedResources decompresses resources of a snapshot at most once per type,
+// e.g. to respond to every open watch of a node after a snapshot has been set.
+// It is meant to be short-lived, so that decompressed resources are not retained by the cache.
+type decodedResources struct {
+	snapshot  Snapshot
+	resources map[string]map[string]envoy_cache.Resource
+	errs      map[string]error
+}
+
+func decode(snapshot Snapshot) *decodedResources {
+	return &decodedResources{
+		snapshot:  snapshot,
+		resources: map[string]map[string]envoy_cache.Resource{},
+		errs:      map[string]error{},
+	}
+}
+
+func (d *decodedResources) get(typ string) (map[string]envoy_cache.Resource, error) {
+	if resources, ok := d.resources[typ]; ok {
+		return resources, nil
+	}
+	if err, ok := d.errs[typ]; ok {
+		return nil, err
+	}
+	resources, err := resourcesOf(d.snapshot, typ)
+	if err != nil {
+		d.errs[typ] = err
+		return nil, err
+	}
+	d.resources[typ] = resources
+	return resources, nil
+}
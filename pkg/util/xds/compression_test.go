@@ -0,0 +1,358 @@
+package xds_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	go_runtime "runtime"
+	"testing"
+	"time"
+
+	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	"github.com/envoyproxy/go-control-plane/pkg/cache"
+	"github.com/envoyproxy/go-control-plane/pkg/test/resource"
+	"github.com/golang/protobuf/proto"
+
+	. "github.com/Kong/kuma/pkg/util/xds"
+)
+
+// newSnapshot creates a snapshot that is not shared with other tests.
+func newSnapshot() *SampleSnapshot {
+	return NewSampleSnapshot(version,
+		[]cache.Resource{endpoint},
+		[]cache.Resource{cluster},
+		[]cache.Resource{route},
+		[]cache.Resource{listener},
+		[]cache.Resource{runtime})
+}
+
+func TestSnapshotCacheCompression(t *testing.T) {
+	snapshot := newSnapshot()
+	c := NewSnapshotCache(true, group{}, logger{t: t}, WithCompression())
+	if err := c.SetSnapshot(key, snapshot); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, typ := range testTypes {
+		t.Run(typ, func(t *testing.T) {
+			resp, err := c.Fetch(context.Background(), v2.DiscoveryRequest{TypeUrl: typ, ResourceNames: names[typ]})
+			if err != nil || resp == nil {
+				t.Fatal("unexpected error or null response")
+			}
+			if resp.Version != version {
+				t.Errorf("got version %q, want %q", resp.Version, version)
+			}
+			assertEqualResources(t, cache.IndexResourcesByName(resp.Resources), snapshot.GetResources(typ))
+
+			value, _ := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: typ, ResourceNames: names[typ]})
+			select {
+			case out := <-value:
+				if out.Version != version {
+					t.Errorf("got version %q, want %q", out.Version, version)
+				}
+				assertEqualResources(t, cache.IndexResourcesByName(out.Resources), snapshot.GetResources(typ))
+			case <-time.After(time.Second):
+				t.Fatal("failed to receive snapshot response")
+			}
+		})
+	}
+}
+
+func TestSnapshotCacheCompressionWithVersion(t *testing.T) {
+	snapshot := newSnapshot()
+	c := NewSnapshotCache(false, group{}, logger{t: t}, WithCompression())
+	if err := c.SetSnapshot(key, snapshot); err != nil {
+		t.Fatal(err)
+	}
+	snap, err := c.GetSnapshot(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	versioned := snap.WithVersion(cache.ClusterType, version2)
+	if got := versioned.GetVersion(cache.ClusterType); got != version2 {
+		t.Errorf("got version %q, want %q", got, version2)
+	}
+	if got := versioned.GetVersion(cache.EndpointType); got != version {
+		t.Errorf("got version %q, want %q", got, version)
+	}
+	if got := snap.GetVersion(cache.ClusterType); got != version {
+		t.Errorf("original snapshot should not be modified: got version %q, want %q", got, version)
+	}
+	assertEqualResources(t, versioned.GetResources(cache.ClusterType), snapshot.GetResources(cache.ClusterType))
+}
+
+func TestSnapshotCacheCompressionSetSnapshotResources(t *testing.T) {
+	snapshot := newSnapshot()
+	c := NewSnapshotCache(false, group{}, logger{t: t}, WithCompression())
+	if err := c.SetSnapshot(key, snapshot); err != nil {
+		t.Fatal(err)
+	}
+
+	endpoint2 := resource.MakeEndpoint(clusterName, 9090)
+	if err := c.SetSnapshotResources(key, cache.EndpointType, version2, map[string]cache.Resource{clusterName: endpoint2}); err != nil {
+		t.Fatal(err)
+	}
+
+	snap, err := c.GetSnapshot(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := snap.GetVersion(cache.EndpointType); got != version2 {
+		t.Errorf("got version %q, want %q", got, version2)
+	}
+	assertEqualResources(t, snap.GetResources(cache.EndpointType), map[string]cache.Resource{clusterName: endpoint2})
+	if got := snap.GetVersion(cache.ClusterType); got != version {
+		t.Errorf("got version %q, want %q", got, version)
+	}
+	assertEqualResources(t, snap.GetResources(cache.ClusterType), snapshot.GetResources(cache.ClusterType))
+}
+
+// undecodable is a resource that can be marshaled but not unmarshaled.
+type undecodable struct{}
+
+func (*undecodable) Reset()                   {}
+func (*undecodable) String() string           { return "undecodable" }
+func (*undecodable) ProtoMessage()            {}
+func (*undecodable) Marshal() ([]byte, error) { return []byte("undecodable"), nil }
+func (*undecodable) Unmarshal([]byte) error   { return errors.New("cannot unmarshal") }
+
+func TestSnapshotCacheCompressionRejectsUndecodableResources(t *testing.T) {
+	snapshot := newSnapshot()
+	c := NewSnapshotCache(false, group{}, logger{t: t}, WithCompression())
+	if err := c.SetSnapshot(key, snapshot); err != nil {
+		t.Fatal(err)
+	}
+
+	broken := NewSampleSnapshot(version2, nil, []cache.Resource{&undecodable{}}, nil, nil, nil)
+	if err := c.SetSnapshot(key, broken); err == nil {
+		t.Fatal("expected an error for a resource that cannot be decompressed")
+	}
+	if err := c.SetSnapshotResources(key, cache.ClusterType, version2, map[string]cache.Resource{clusterName: &undecodable{}}); err == nil {
+		t.Fatal("expected an error for a resource that cannot be decompressed")
+	}
+
+	snap, err := c.GetSnapshot(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := snap.GetVersion(cache.ClusterType); got != version {
+		t.Errorf("got version %q, want %q", got, version)
+	}
+	assertEqualResources(t, snap.GetResources(cache.ClusterType), snapshot.GetResources(cache.ClusterType))
+}
+
+// counted is a resource that counts how many times it has been unmarshaled,
+// which fails while decoding is broken.
+type counted struct {
+	data []byte
+}
+
+var decoding struct {
+	count  int
+	broken bool
+}
+
+func (*counted) Reset()                     {}
+func (c *counted) String() string           { return string(c.data) }
+func (*counted) ProtoMessage()              {}
+func (c *counted) Marshal() ([]byte, error) { return c.data, nil }
+func (c *counted) Unmarshal(data []byte) error {
+	if decoding.broken {
+		return errors.New("decoding is broken")
+	}
+	decoding.count++
+	c.data = append([]byte(nil), data...)
+	return nil
+}
+
+func TestSnapshotCacheCompressionDecodesOncePerSnapshot(t *testing.T) {
+	decoding.count, decoding.broken = 0, false
+	c := NewSnapshotCache(false, group{}, logger{t: t}, WithCompression())
+	if err := c.SetSnapshot(key, NewSampleSnapshot(version, nil, []cache.Resource{&counted{data: []byte("a")}}, nil, nil, nil)); err != nil {
+		t.Fatal(err)
+	}
+	var watches []chan cache.Response
+	for i := 0; i < 3; i++ {
+		value, _ := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.ClusterType, VersionInfo: version})
+		watches = append(watches, value)
+	}
+
+	decoding.count = 0
+	if err := c.SetSnapshot(key, NewSampleSnapshot(version2, nil, []cache.Resource{&counted{data: []byte("b")}}, nil, nil, nil)); err != nil {
+		t.Fatal(err)
+	}
+	for _, value := range watches {
+		select {
+		case out := <-value:
+			if out.Version != version2 {
+				t.Errorf("got version %q, want %q", out.Version, version2)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("failed to receive snapshot response")
+		}
+	}
+	// once when the snapshot is compressed and once for responding to all of the watches
+	if decoding.count != 2 {
+		t.Errorf("got %d decoded resources, want 2", decoding.count)
+	}
+}
+
+func TestSnapshotCacheCompressionDropsUndecodableResponses(t *testing.T) {
+	decoding.count, decoding.broken = 0, false
+	defer func() { decoding.broken = false }()
+	c := NewSnapshotCache(false, group{}, logger{t: t}, WithCompression())
+	if err := c.SetSnapshot(key, NewSampleSnapshot(version, nil, []cache.Resource{&counted{data: []byte("a")}}, nil, nil, nil)); err != nil {
+		t.Fatal(err)
+	}
+
+	// the snapshot gets corrupted after it has been set
+	decoding.broken = true
+	value, _ := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.ClusterType})
+	select {
+	case out := <-value:
+		t.Fatalf("got response with version %q, want none", out.Version)
+	default:
+	}
+	if count := c.GetStatusInfo(key).GetNumWatches(); count != 1 {
+		t.Errorf("got %d open watches, want 1", count)
+	}
+	if _, err := c.Fetch(context.Background(), v2.DiscoveryRequest{TypeUrl: cache.ClusterType}); err == nil {
+		t.Error("expected an error for resources that cannot be decompressed")
+	}
+	if _, _, ok := c.GetLatest(key, cache.ClusterType); ok {
+		t.Error("got latest resources, want none")
+	}
+	snap, err := c.GetSnapshot(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resources := snap.GetResources(cache.ClusterType); resources != nil {
+		t.Errorf("got resources %v, want none", resources)
+	}
+
+	// the watch is responded by the next snapshot
+	decoding.broken = false
+	if err := c.SetSnapshot(key, NewSampleSnapshot(version2, nil, []cache.Resource{&counted{data: []byte("b")}}, nil, nil, nil)); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case out := <-value:
+		if out.Version != version2 {
+			t.Errorf("got version %q, want %q", out.Version, version2)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("failed to receive snapshot response")
+	}
+}
+
+func TestSnapshotCacheCompressionRetainedBytes(t *testing.T) {
+	snapshot := newSnapshot()
+	c := NewSnapshotCache(false, group{}, logger{t: t}, WithCompression())
+	if err := c.SetSnapshot(key, snapshot); err != nil {
+		t.Fatal(err)
+	}
+
+	sizes, err := c.GetRetainedBytes(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, typ := range testTypes {
+		expected := 0
+		for _, resource := range snapshot.GetResources(typ) {
+			expected += gzipSize(t, resource)
+		}
+		if sizes[typ] != expected {
+			t.Errorf("got %d bytes for %s, want %d compressed bytes", sizes[typ], typ, expected)
+		}
+	}
+}
+
+func gzipSize(t *testing.T, resource cache.Resource) int {
+	t.Helper()
+	marshaled := proto.NewBuffer(nil)
+	marshaled.SetDeterministic(true)
+	if err := marshaled.Marshal(resource); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(marshaled.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Len()
+}
+
+func assertEqualResources(t *testing.T, actual, expected map[string]cache.Resource) {
+	t.Helper()
+	if len(actual) != len(expected) {
+		t.Fatalf("got %d resources, want %d", len(actual), len(expected))
+	}
+	for name, resource := range expected {
+		if !proto.Equal(actual[name], resource) {
+			t.Errorf("got resource %q %v, want %v", name, actual[name], resource)
+		}
+	}
+}
+
+func BenchmarkSnapshotCacheMemory(b *testing.B) {
+	const nodes = 20
+	const services = 200
+
+	var clusters, listeners, routes []cache.Resource
+	for i := 0; i < services; i++ {
+		clusterName := fmt.Sprintf("cluster-%d", i)
+		routeName := fmt.Sprintf("route-%d", i)
+		clusters = append(clusters, resource.MakeCluster(resource.Ads, clusterName))
+		routes = append(routes, resource.MakeRoute(routeName, clusterName))
+		listeners = append(listeners, resource.MakeHTTPListener(resource.Ads, fmt.Sprintf("listener-%d", i), uint32(10000+i), routeName))
+	}
+
+	for name, opts := range map[string][]SnapshotCacheOption{
+		"plain":      nil,
+		"compressed": {WithCompression()},
+	} {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			var retained uint64
+			for i := 0; i < b.N; i++ {
+				before := heapAlloc()
+				c := NewSnapshotCache(false, group{}, nil, opts...)
+				for n := 0; n < nodes; n++ {
+					// every node gets its own copy of resources, just like in a real control plane
+					snap := NewSampleSnapshot(version, nil,
+						cloneResources(clusters), cloneResources(routes), cloneResources(listeners), nil)
+					if err := c.SetSnapshot(fmt.Sprintf("node-%d", n), snap); err != nil {
+						b.Fatal(err)
+					}
+				}
+				after := heapAlloc()
+				if after > before {
+					retained += after - before
+				}
+				go_runtime.KeepAlive(c)
+			}
+			b.ReportMetric(float64(retained)/float64(b.N), "retained-B/op")
+		})
+	}
+}
+
+func cloneResources(resources []cache.Resource) []cache.Resource {
+	var clones []cache.Resource
+	for _, r := range resources {
+		clones = append(clones, proto.Clone(r))
+	}
+	return clones
+}
+
+func heapAlloc() uint64 {
+	go_runtime.GC()
+	var stats go_runtime.MemStats
+	go_runtime.ReadMemStats(&stats)
+	return stats.HeapAlloc
+}
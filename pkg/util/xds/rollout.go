@@ -0,0 +1,109 @@
+package xds
+
+import (
+	"hash/fnv"
+	"sort"
+	"sync"
+)
+
+// RolloutBuckets is a number of buckets nodes are distributed to in a staged rollout,
+// so that a rollout to a given percentage of nodes selects nodes of that many buckets.
+const RolloutBuckets = 100
+
+// RolloutBucket returns a bucket of a node in a staged rollout, i.e. a hash of the node ID modulo RolloutBuckets.
+// The bucket depends only on the node ID, so that the same nodes are selected by every rollout to a given percentage.
+func RolloutBucket(node string) int {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(node))
+	return int(hash.Sum32() % RolloutBuckets)
+}
+
+// RolloutProgress describes progress of a staged rollout of a snapshot.
+type RolloutProgress struct {
+	// Percent is a percentage of nodes the snapshot has been rolled out to.
+	Percent int
+	// Updated are sorted IDs of nodes the snapshot has been set for so far.
+	Updated []string
+	// Total is a number of nodes the snapshot is rolled out to.
+	Total int
+	// Errs are errors of nodes whose snapshots could not be set indexed by node IDs.
+	Errs map[string]error
+}
+
+// StagedRollout rolls a new snapshot out to a growing percentage of nodes, e.g. to bake a configuration change
+// on a few canary nodes before it is rolled out to all of them.
+//
+// A node is selected once its bucket, see RolloutBucket, is lower than the percentage,
+// so nodes selected at a lower percentage are always selected at a higher one as well.
+// The rollout never rolls back, nodes the snapshot has been set for keep it when the percentage is lowered.
+type StagedRollout struct {
+	cache    SnapshotCache
+	snapshot Snapshot
+	nodes    []string
+
+	mu      sync.Mutex
+	percent int
+	updated map[string]bool
+	errs    map[string]error
+}
+
+// NewStagedRollout creates a rollout of a snapshot to given nodes. Nothing is set until RollTo is called.
+func NewStagedRollout(cache SnapshotCache, nodes []string, snapshot Snapshot) *StagedRollout {
+	return &StagedRollout{
+		cache:    cache,
+		snapshot: snapshot,
+		nodes:    append([]string{}, nodes...),
+		updated:  make(map[string]bool),
+		errs:     make(map[string]error),
+	}
+}
+
+// RollTo sets the snapshot for nodes selected at a given percentage that don't have it yet, using SetSnapshots.
+// Nodes whose snapshots could not be set are retried by the next call.
+func (r *StagedRollout) RollTo(percent int) RolloutProgress {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if percent > r.percent {
+		r.percent = percent
+	}
+	snapshots := make(map[string]Snapshot)
+	for _, node := range r.nodes {
+		if !r.updated[node] && RolloutBucket(node) < r.percent {
+			snapshots[node] = r.snapshot
+		}
+	}
+	errs := r.cache.SetSnapshots(snapshots)
+	for node := range snapshots {
+		if err, failed := errs[node]; failed {
+			r.errs[node] = err
+			continue
+		}
+		delete(r.errs, node)
+		r.updated[node] = true
+	}
+	return r.progress()
+}
+
+// Progress returns progress of the rollout.
+func (r *StagedRollout) Progress() RolloutProgress {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.progress()
+}
+
+func (r *StagedRollout) progress() RolloutProgress {
+	progress := RolloutProgress{
+		Percent: r.percent,
+		Total:   len(r.nodes),
+		Errs:    make(map[string]error, len(r.errs)),
+	}
+	for node := range r.updated {
+		progress.Updated = append(progress.Updated, node)
+	}
+	sort.Strings(progress.Updated)
+	for node, err := range r.errs {
+		progress.Errs[node] = err
+	}
+	return progress
+}
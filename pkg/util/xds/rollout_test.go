@@ -0,0 +1,101 @@
+package xds_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/envoyproxy/go-control-plane/pkg/cache"
+	"github.com/envoyproxy/go-control-plane/pkg/test/resource"
+
+	. "github.com/Kong/kuma/pkg/util/xds"
+)
+
+func TestStagedRollout(t *testing.T) {
+	c := NewSnapshotCache(true, group{}, logger{t: t})
+	nodes := []string{"node0", "node1", "node2", "node3", "node4", "node5", "node6", "node7", "node8", "node9"}
+	for _, node := range nodes {
+		if err := c.SetSnapshot(node, newSnapshot()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	canary := newSnapshot().WithVersion(cache.ClusterType, version2)
+	rollout := NewStagedRollout(c, nodes, canary)
+
+	assertVersions := func(t *testing.T, updated []string) {
+		t.Helper()
+		want := map[string]bool{}
+		for _, node := range updated {
+			want[node] = true
+		}
+		for _, node := range nodes {
+			snapshot, err := c.GetSnapshot(node)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expected := version
+			if want[node] {
+				expected = version2
+			}
+			if actual := snapshot.GetVersion(cache.ClusterType); actual != expected {
+				t.Errorf("node %s got version %q, want %q", node, actual, expected)
+			}
+		}
+	}
+
+	// nothing is set before the rollout starts
+	if progress := rollout.Progress(); progress.Percent != 0 || len(progress.Updated) != 0 || progress.Total != len(nodes) {
+		t.Errorf("got progress %+v before rollout", progress)
+	}
+	assertVersions(t, nil)
+
+	// node buckets: node0=23 node1=4 node2=61 node3=42 node4=47 node5=28 node6=85 node7=66 node8=71 node9=52
+	half := []string{"node0", "node1", "node3", "node4", "node5"}
+	progress := rollout.RollTo(50)
+	if progress.Percent != 50 || !reflect.DeepEqual(progress.Updated, half) || len(progress.Errs) != 0 {
+		t.Errorf("got progress %+v, want nodes %v", progress, half)
+	}
+	assertVersions(t, half)
+
+	// selection is stable across calls and rollouts
+	if progress := rollout.RollTo(50); !reflect.DeepEqual(progress.Updated, half) {
+		t.Errorf("got nodes %v on repeated rollout, want %v", progress.Updated, half)
+	}
+	if progress := NewStagedRollout(NewSnapshotCache(true, group{}, logger{t: t}), nodes, canary).RollTo(50); !reflect.DeepEqual(progress.Updated, half) {
+		t.Errorf("got nodes %v on another rollout, want %v", progress.Updated, half)
+	}
+
+	// lowering the percentage doesn't roll back
+	if progress := rollout.RollTo(10); progress.Percent != 50 || !reflect.DeepEqual(progress.Updated, half) {
+		t.Errorf("got progress %+v after lowering percentage, want nodes %v", progress, half)
+	}
+
+	// full rollout updates all nodes
+	progress = rollout.RollTo(100)
+	if progress.Percent != 100 || !reflect.DeepEqual(progress.Updated, nodes) {
+		t.Errorf("got progress %+v, want nodes %v", progress, nodes)
+	}
+	assertVersions(t, nodes)
+	if !reflect.DeepEqual(rollout.Progress(), progress) {
+		t.Errorf("got progress %+v, want %+v", rollout.Progress(), progress)
+	}
+}
+
+func TestStagedRolloutErrors(t *testing.T) {
+	c := NewSnapshotCache(true, group{}, logger{t: t})
+	duplicate := newSnapshot().WithResources(cache.ClusterType, version2, map[string]cache.Resource{
+		clusterName: cluster,
+		"cluster1":  resource.MakeCluster(resource.Ads, clusterName),
+	})
+	rollout := NewStagedRollout(c, []string{"node1"}, duplicate)
+
+	// when
+	progress := rollout.RollTo(100)
+
+	// then
+	if len(progress.Updated) != 0 || progress.Errs["node1"] == nil {
+		t.Errorf("got progress %+v, want an error of node1", progress)
+	}
+	if _, err := c.GetSnapshot("node1"); err == nil {
+		t.Error("snapshot of node1 should not be set")
+	}
+}
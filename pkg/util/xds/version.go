@@ -0,0 +1,86 @@
+package xds
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// SnapshotVersion is a version of xDS resources of a given type.
+//
+// On the wire a version is an opaque string. SnapshotVersion gives it a structure
+// of an optional generation and a hash, e.g. `5.2f1a9c`, so that versions can be
+// ordered and compared without string manipulations.
+// Arbitrary strings that do not follow that format, e.g. UUIDs, are kept as opaque
+// versions with zero generation.
+type SnapshotVersion struct {
+	// Generation is a monotonically increasing number of a version.
+	// Zero value indicates an opaque version.
+	Generation uint64
+	// Hash identifies resources of a version.
+	Hash string
+}
+
+var generationalVersion = regexp.MustCompile(`^([1-9][0-9]*)(?:\.(.+))?$`)
+
+// ParseSnapshotVersion converts a string form of a version into SnapshotVersion.
+// It never fails: a string that is not a generational version becomes an opaque one.
+func ParseSnapshotVersion(version string) SnapshotVersion {
+	matches := generationalVersion.FindStringSubmatch(version)
+	if matches == nil {
+		return SnapshotVersion{Hash: version}
+	}
+	generation, err := strconv.ParseUint(matches[1], 10, 64)
+	if err != nil {
+		return SnapshotVersion{Hash: version}
+	}
+	return SnapshotVersion{Generation: generation, Hash: matches[2]}
+}
+
+// String returns a string form of a version that is sent to Envoy.
+// ParseSnapshotVersion(v.String()) is equal to v for every version v
+// returned by ParseSnapshotVersion.
+func (v SnapshotVersion) String() string {
+	switch {
+	case v.Generation == 0:
+		return v.Hash
+	case v.Hash == "":
+		return strconv.FormatUint(v.Generation, 10)
+	default:
+		return fmt.Sprintf("%d.%s", v.Generation, v.Hash)
+	}
+}
+
+// IsEmpty returns true if there is no version, which is the case of resources
+// that haven't been versioned yet.
+func (v SnapshotVersion) IsEmpty() bool {
+	return v.Generation == 0 && v.Hash == ""
+}
+
+// Next returns a version of the next generation with a given hash.
+func (v SnapshotVersion) Next(hash string) SnapshotVersion {
+	return SnapshotVersion{Generation: v.Generation + 1, Hash: hash}
+}
+
+// Equal returns true if both versions are the same.
+func (v SnapshotVersion) Equal(other SnapshotVersion) bool {
+	return v.Compare(other) == 0
+}
+
+// Compare returns an integer comparing two versions.
+// The result will be 0 if v == other, -1 if v < other, and +1 if v > other.
+//
+// Versions are ordered by generation first, so that opaque versions precede
+// any generational one. Versions of the same generation are ordered by hash,
+// which only makes the order total rather than meaningful.
+func (v SnapshotVersion) Compare(other SnapshotVersion) int {
+	switch {
+	case v.Generation < other.Generation:
+		return -1
+	case v.Generation > other.Generation:
+		return 1
+	default:
+		return strings.Compare(v.Hash, other.Hash)
+	}
+}
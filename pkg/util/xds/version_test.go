@@ -0,0 +1,96 @@
+package xds_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache"
+
+	. "github.com/Kong/kuma/pkg/util/xds"
+)
+
+var _ = Describe("SnapshotVersion", func() {
+
+	DescribeTable("should round-trip a string form",
+		func(given string, expected SnapshotVersion) {
+			// when
+			version := ParseSnapshotVersion(given)
+
+			// then
+			Expect(version).To(Equal(expected))
+			// and
+			Expect(version.String()).To(Equal(given))
+		},
+		Entry("empty version", "", SnapshotVersion{}),
+		Entry("opaque version", "x", SnapshotVersion{Hash: "x"}),
+		Entry("UUID", "a1b2c3d4-0000-4000-8000-123456789abc", SnapshotVersion{Hash: "a1b2c3d4-0000-4000-8000-123456789abc"}),
+		Entry("generation only", "5", SnapshotVersion{Generation: 5}),
+		Entry("generation and hash", "5.2f1a9c", SnapshotVersion{Generation: 5, Hash: "2f1a9c"}),
+		Entry("hash with dots", "5.2f.1a", SnapshotVersion{Generation: 5, Hash: "2f.1a"}),
+		Entry("zero generation", "0.2f1a9c", SnapshotVersion{Hash: "0.2f1a9c"}),
+		Entry("generation with leading zeros", "05", SnapshotVersion{Hash: "05"}),
+		Entry("generation without hash", "5.", SnapshotVersion{Hash: "5."}),
+		Entry("generation out of range", "18446744073709551616", SnapshotVersion{Hash: "18446744073709551616"}),
+	)
+
+	DescribeTable("should order versions",
+		func(lesser, greater string) {
+			// given
+			v1 := ParseSnapshotVersion(lesser)
+			v2 := ParseSnapshotVersion(greater)
+
+			// expect
+			Expect(v1.Compare(v2)).To(Equal(-1))
+			Expect(v2.Compare(v1)).To(Equal(1))
+			Expect(v1.Equal(v2)).To(BeFalse())
+			// and
+			Expect(v1.Compare(v1)).To(Equal(0))
+			Expect(v1.Equal(ParseSnapshotVersion(lesser))).To(BeTrue())
+		},
+		Entry("empty version before opaque one", "", "x"),
+		Entry("opaque versions by hash", "x", "y"),
+		Entry("opaque version before generational one", "z", "1"),
+		Entry("generations numerically", "9.ffff", "10.0000"),
+		Entry("same generation by hash", "3.a", "3.b"),
+		Entry("generation without hash before one with hash", "3", "3.a"),
+	)
+
+	It("should generate the next version", func() {
+		// given
+		version := ParseSnapshotVersion("")
+
+		// when
+		next := version.Next("a")
+		// then
+		Expect(next.String()).To(Equal("1.a"))
+		Expect(next.Compare(version)).To(Equal(1))
+
+		// when
+		next = next.Next("b")
+		// then
+		Expect(next.String()).To(Equal("2.b"))
+		Expect(version.IsEmpty()).To(BeTrue())
+		Expect(next.IsEmpty()).To(BeFalse())
+	})
+
+	It("should round-trip through GetVersion() of a snapshot in the cache", func() {
+		// given
+		version := SnapshotVersion{Generation: 7, Hash: "2f1a9c"}
+		snapshot := NewSampleSnapshot(version.String(), nil, nil, nil, nil, nil)
+		// and
+		cache := NewSnapshotCache(false, group{}, nil)
+
+		// when
+		err := cache.SetSnapshot(key, snapshot)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := cache.GetSnapshot(key)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(ParseSnapshotVersion(actual.GetVersion(envoy_cache.ClusterType))).To(Equal(version))
+	})
+})
@@ -0,0 +1,51 @@
+package xds
+
+import (
+	"context"
+)
+
+// SnapshotBuilder builds a snapshot for a given node, e.g. out of resources in a persistent store.
+type SnapshotBuilder func(ctx context.Context, node string) (Snapshot, error)
+
+// WarmupProgress describes progress of a cache warmup after a snapshot of a node has been built.
+type WarmupProgress struct {
+	// Node is an ID of the node whose snapshot has been built.
+	Node string
+	// Done is a number of nodes whose snapshots have been built so far.
+	Done int
+	// Total is a number of nodes to warm the cache up for.
+	Total int
+	// Err is an error that occurred while building a snapshot of the node.
+	Err error
+}
+
+// Warmup populates the cache with snapshots of given nodes before xDS clients connect to it.
+//
+// Snapshots are built one after another and set into the cache all at once.
+// Progress is reported after every node if progress callback is not nil.
+// Warmup stops building snapshots when ctx is done, snapshots built until then are still set.
+//
+// It returns errors of nodes whose snapshots could not be built or set indexed by node IDs.
+func Warmup(ctx context.Context, cache SnapshotCache, nodes []string, build SnapshotBuilder, progress func(WarmupProgress)) map[string]error {
+	errs := make(map[string]error)
+	snapshots := make(map[string]Snapshot, len(nodes))
+	for i, node := range nodes {
+		if ctx.Err() != nil {
+			errs[node] = ctx.Err()
+			continue
+		}
+		snapshot, err := build(ctx, node)
+		if err != nil {
+			errs[node] = err
+		} else {
+			snapshots[node] = snapshot
+		}
+		if progress != nil {
+			progress(WarmupProgress{Node: node, Done: i + 1, Total: len(nodes), Err: err})
+		}
+	}
+	for node, err := range cache.SetSnapshots(snapshots) {
+		errs[node] = err
+	}
+	return errs
+}
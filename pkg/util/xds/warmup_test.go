@@ -0,0 +1,115 @@
+package xds_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	"github.com/envoyproxy/go-control-plane/pkg/cache"
+	"github.com/envoyproxy/go-control-plane/pkg/test/resource"
+
+	. "github.com/Kong/kuma/pkg/util/xds"
+)
+
+func TestSnapshotCacheWarmup(t *testing.T) {
+	c := NewSnapshotCache(true, group{}, logger{t: t})
+	nodes := []string{"node1", "node2", "node3", "broken", "duplicate"}
+	buildErr := errors.New("could not build snapshot")
+	duplicate := fmt.Sprintf("duplicate resource name %q of type %s", clusterName, cache.ClusterType)
+
+	var progress []WarmupProgress
+	errs := Warmup(context.Background(), c, nodes, func(_ context.Context, node string) (Snapshot, error) {
+		switch node {
+		case "broken":
+			return nil, buildErr
+		case "duplicate":
+			return newSnapshot().WithResources(cache.ClusterType, version, map[string]cache.Resource{
+				clusterName: cluster,
+				"cluster1":  resource.MakeCluster(resource.Ads, clusterName),
+			}), nil
+		default:
+			return newSnapshot(), nil
+		}
+	}, func(p WarmupProgress) {
+		progress = append(progress, p)
+	})
+
+	// errors are reported per node
+	if len(errs) != 2 {
+		t.Errorf("got errors %v, want errors of 2 nodes", errs)
+	}
+	if errs["broken"] != buildErr {
+		t.Errorf("got error %v, want %v", errs["broken"], buildErr)
+	}
+	if err := errs["duplicate"]; err == nil || err.Error() != duplicate {
+		t.Errorf("got error %v, want %q", err, duplicate)
+	}
+
+	// progress is reported after every node
+	want := []WarmupProgress{
+		{Node: "node1", Done: 1, Total: 5},
+		{Node: "node2", Done: 2, Total: 5},
+		{Node: "node3", Done: 3, Total: 5},
+		{Node: "broken", Done: 4, Total: 5, Err: buildErr},
+		{Node: "duplicate", Done: 5, Total: 5},
+	}
+	if !reflect.DeepEqual(progress, want) {
+		t.Errorf("got progress %v, want %v", progress, want)
+	}
+
+	// snapshots are available before any watch is created
+	for _, node := range []string{"node1", "node2", "node3"} {
+		if info := c.GetStatusInfo(node); info != nil && info.GetNumWatches() != 0 {
+			t.Errorf("got %d watches of node %q, want none", info.GetNumWatches(), node)
+		}
+		snap, err := c.GetSnapshot(node)
+		if err != nil {
+			t.Fatalf("failed to get snapshot of node %q: %v", node, err)
+		}
+		if got := snap.GetVersion(cache.ClusterType); got != version {
+			t.Errorf("got version %q of node %q, want %q", got, node, version)
+		}
+	}
+	for _, node := range []string{"broken", "duplicate"} {
+		if _, err := c.GetSnapshot(node); err == nil {
+			t.Errorf("snapshot of node %q should not be cached", node)
+		}
+	}
+
+	// a warmed up snapshot is served right away
+	value, _ := c.CreateWatch(v2.DiscoveryRequest{TypeUrl: cache.ClusterType, Node: &core.Node{Id: "node1"}, ResourceNames: names[cache.ClusterType]})
+	select {
+	case out := <-value:
+		if out.Version != version {
+			t.Errorf("got version %q, want %q", out.Version, version)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("failed to receive snapshot response")
+	}
+}
+
+func TestSnapshotCacheWarmupCanceled(t *testing.T) {
+	c := NewSnapshotCache(true, group{}, logger{t: t})
+	ctx, cancel := context.WithCancel(context.Background())
+
+	errs := Warmup(ctx, c, []string{"node1", "node2"}, func(_ context.Context, node string) (Snapshot, error) {
+		cancel()
+		return newSnapshot(), nil
+	}, nil)
+
+	// snapshots built before cancellation are set
+	if _, err := c.GetSnapshot("node1"); err != nil {
+		t.Errorf("failed to get snapshot of node %q: %v", "node1", err)
+	}
+	if err := errs["node2"]; err != context.Canceled {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+	if _, err := c.GetSnapshot("node2"); err == nil {
+		t.Errorf("snapshot of node %q should not be cached", "node2")
+	}
+}
@@ -61,7 +61,7 @@ func (b *bootstrapGenerator) Generate(ctx context.Context, request types.Bootstr
 	if err != nil {
 		return nil, err
 	}
-	if err := AddTracingConfig(bootstrapCfg, tracingBackend); err != nil {
+	if err := AddTracingConfig(bootstrapCfg, tracingBackend, dataplane.Spec.GetIdentifyingService()); err != nil {
 		return nil, err
 	}
 	return bootstrapCfg, nil
@@ -17,31 +17,63 @@ import (
 	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 )
 
-func AddTracingConfig(bootstrap *envoy_bootstrap.Bootstrap, backend *mesh_proto.TracingBackend) error {
-	if zipkin, ok := backend.GetType().(*mesh_proto.TracingBackend_Zipkin_); ok {
-		cluster, tracingCfg, err := zipkinConfig(bootstrap, zipkin.Zipkin, backend.Name)
-		if err != nil {
-			return err
-		}
-		if bootstrap.StaticResources == nil {
-			bootstrap.StaticResources = &envoy_bootstrap.Bootstrap_StaticResources{}
-		}
-		bootstrap.StaticResources.Clusters = append(bootstrap.StaticResources.Clusters, cluster)
-		bootstrap.Tracing = tracingCfg
+// AddTracingConfig configures Envoy to send spans of a given service to a tracing backend.
+func AddTracingConfig(bootstrap *envoy_bootstrap.Bootstrap, backend *mesh_proto.TracingBackend, service string) error {
+	var cluster *envoy_api.Cluster
+	var tracingCfg *envoy_config_trace_v2.Tracing
+	var err error
+	switch backendType := backend.GetType().(type) {
+	case *mesh_proto.TracingBackend_Zipkin_:
+		cluster, tracingCfg, err = zipkinConfig(bootstrap, backendType.Zipkin, backend.Name)
+	case *mesh_proto.TracingBackend_Datadog_:
+		cluster, tracingCfg, err = datadogConfig(backendType.Datadog, backend.Name, service)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if bootstrap.StaticResources == nil {
+		bootstrap.StaticResources = &envoy_bootstrap.Bootstrap_StaticResources{}
 	}
+	bootstrap.StaticResources.Clusters = append(bootstrap.StaticResources.Clusters, cluster)
+	bootstrap.Tracing = tracingCfg
 	return nil
 }
 
+func datadogConfig(datadog *mesh_proto.TracingBackend_Datadog, backendName string, service string) (*envoy_api.Cluster, *envoy_config_trace_v2.Tracing, error) {
+	cluster := collectorCluster(backendName, datadog.Address, datadog.Port)
+
+	datadogConfig := envoy_config_trace_v2.DatadogConfig{
+		CollectorCluster: cluster.Name,
+		ServiceName:      service,
+	}
+	datadogConfigAny, err := ptypes.MarshalAny(&datadogConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	tracingConfig := &envoy_config_trace_v2.Tracing{
+		Http: &envoy_config_trace_v2.Tracing_Http{
+			Name: "envoy.tracers.datadog",
+			ConfigType: &envoy_config_trace_v2.Tracing_Http_TypedConfig{
+				TypedConfig: datadogConfigAny,
+			},
+		},
+	}
+	return cluster, tracingConfig, nil
+}
+
 func zipkinConfig(bootstrap *envoy_bootstrap.Bootstrap, zipkin *mesh_proto.TracingBackend_Zipkin, backendName string) (*envoy_api.Cluster, *envoy_config_trace_v2.Tracing, error) {
 	url, err := net_url.ParseRequestURI(zipkin.Url)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "invalid URL of Zipkin")
 	}
 
-	cluster, err := zipkinCluster(backendName, url)
+	port, err := strconv.Atoi(url.Port())
 	if err != nil {
 		return nil, nil, err
 	}
+	cluster := collectorCluster(backendName, url.Hostname(), uint32(port))
 
 	zipkinConfig := envoy_config_trace_v2.ZipkinConfig{
 		CollectorCluster:         cluster.Name,
@@ -84,17 +116,12 @@ func apiVersion(zipkin *mesh_proto.TracingBackend_Zipkin, url *net_url.URL) envo
 	return envoy_config_trace_v2.ZipkinConfig_HTTP_JSON
 }
 
-const zipkinClusterTimeout = 10 * time.Second
-
-func zipkinCluster(backendName string, url *net_url.URL) (*envoy_api.Cluster, error) {
-	port, err := strconv.Atoi(url.Port())
-	if err != nil {
-		return nil, err
-	}
+const collectorClusterTimeout = 10 * time.Second
 
-	cluster := &envoy_api.Cluster{
+func collectorCluster(backendName string, address string, port uint32) *envoy_api.Cluster {
+	return &envoy_api.Cluster{
 		Name:                 backendName,
-		ConnectTimeout:       &duration.Duration{Seconds: int64(zipkinClusterTimeout.Seconds())},
+		ConnectTimeout:       &duration.Duration{Seconds: int64(collectorClusterTimeout.Seconds())},
 		ClusterDiscoveryType: &envoy_api.Cluster_Type{Type: envoy_api.Cluster_STRICT_DNS},
 		LbPolicy:             envoy_api.Cluster_ROUND_ROBIN,
 		LoadAssignment: &envoy_api.ClusterLoadAssignment{
@@ -108,9 +135,9 @@ func zipkinCluster(backendName string, url *net_url.URL) (*envoy_api.Cluster, er
 									Address: &envoy_api_v2_core.Address{
 										Address: &envoy_api_v2_core.Address_SocketAddress{
 											SocketAddress: &envoy_api_v2_core.SocketAddress{
-												Address: url.Hostname(),
+												Address: address,
 												PortSpecifier: &envoy_api_v2_core.SocketAddress_PortValue{
-													PortValue: uint32(port),
+													PortValue: port,
 												},
 											},
 										},
@@ -123,5 +150,4 @@ func zipkinCluster(backendName string, url *net_url.URL) (*envoy_api.Cluster, er
 			},
 		},
 	}
-	return cluster, nil
 }
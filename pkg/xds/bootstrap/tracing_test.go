@@ -23,7 +23,7 @@ var _ = Describe("Bootstrap Tracing", func() {
 			bootstrap := &envoy_config_bootstrap_v2.Bootstrap{}
 
 			// when
-			err := AddTracingConfig(bootstrap, given.backend)
+			err := AddTracingConfig(bootstrap, given.backend, "backend")
 
 			// then
 			Expect(err).ToNot(HaveOccurred())
@@ -276,6 +276,40 @@ var _ = Describe("Bootstrap Tracing", func() {
                       collectorEndpoint: /api/v2/spans
                       collectorEndpointVersion: HTTP_JSON
                       traceId128bit: true
+`,
+		}),
+		Entry("datadog", testCase{
+			backend: &mesh_proto.TracingBackend{
+				Name: "datadog",
+				Type: &mesh_proto.TracingBackend_Datadog_{
+					Datadog: &mesh_proto.TracingBackend_Datadog{
+						Address: "datadog-agent",
+						Port:    8126,
+					},
+				},
+			},
+			expectedYAML: `
+                staticResources:
+                  clusters:
+                  - connectTimeout: 10s
+                    loadAssignment:
+                      clusterName: datadog
+                      endpoints:
+                      - lbEndpoints:
+                        - endpoint:
+                            address:
+                              socketAddress:
+                                address: datadog-agent
+                                portValue: 8126
+                    name: datadog
+                    type: STRICT_DNS
+                tracing:
+                  http:
+                    name: envoy.tracers.datadog
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.trace.v2.DatadogConfig
+                      collectorCluster: datadog
+                      serviceName: backend
 `,
 		}),
 	)
@@ -78,7 +78,7 @@ func ClusterWithHealthChecks(cluster *v2.Cluster, healthCheck *mesh_core.HealthC
 	}
 	if healthCheck.HasActiveChecks() {
 		activeChecks := healthCheck.Spec.Conf.GetActiveChecks()
-		cluster.HealthChecks = append(cluster.HealthChecks, &envoy_core.HealthCheck{
+		check := &envoy_core.HealthCheck{
 			HealthChecker: &envoy_core.HealthCheck_TcpHealthCheck_{
 				TcpHealthCheck: &envoy_core.HealthCheck_TcpHealthCheck{},
 			},
@@ -86,7 +86,15 @@ func ClusterWithHealthChecks(cluster *v2.Cluster, healthCheck *mesh_core.HealthC
 			Timeout:            activeChecks.Timeout,
 			UnhealthyThreshold: &wrappers.UInt32Value{Value: activeChecks.UnhealthyThreshold},
 			HealthyThreshold:   &wrappers.UInt32Value{Value: activeChecks.HealthyThreshold},
-		})
+		}
+		if http := activeChecks.GetHttp(); http != nil {
+			check.HealthChecker = &envoy_core.HealthCheck_HttpHealthCheck_{
+				HttpHealthCheck: &envoy_core.HealthCheck_HttpHealthCheck{
+					Path: http.Path,
+				},
+			}
+		}
+		cluster.HealthChecks = append(cluster.HealthChecks, check)
 	}
 	if healthCheck.HasPassiveChecks() {
 		passiveChecks := healthCheck.Spec.Conf.GetPassiveChecks()
@@ -98,6 +106,59 @@ func ClusterWithHealthChecks(cluster *v2.Cluster, healthCheck *mesh_core.HealthC
 	return cluster
 }
 
+// ClusterWithCircuitBreaker limits connections and requests to a given Cluster and ejects its failing endpoints.
+//
+// Outlier detection of a CircuitBreaker takes precedence over passive health checks of a HealthCheck.
+func ClusterWithCircuitBreaker(cluster *v2.Cluster, circuitBreaker *mesh_core.CircuitBreakerResource) *v2.Cluster {
+	if circuitBreaker == nil {
+		return cluster
+	}
+	if circuitBreaker.HasThresholds() {
+		thresholds := circuitBreaker.Spec.Conf.GetThresholds()
+		cluster.CircuitBreakers = &envoy_cluster.CircuitBreakers{
+			Thresholds: []*envoy_cluster.CircuitBreakers_Thresholds{{
+				MaxConnections:     thresholds.MaxConnections,
+				MaxPendingRequests: thresholds.MaxPendingRequests,
+				MaxRetries:         thresholds.MaxRetries,
+			}},
+		}
+	}
+	if circuitBreaker.HasOutlierDetection() {
+		outlierDetection := circuitBreaker.Spec.Conf.GetOutlierDetection()
+		if cluster.OutlierDetection == nil {
+			cluster.OutlierDetection = &envoy_cluster.OutlierDetection{}
+		}
+		if outlierDetection.ConsecutiveErrors != nil {
+			cluster.OutlierDetection.Consecutive_5Xx = outlierDetection.ConsecutiveErrors
+		}
+		if outlierDetection.Interval != nil {
+			cluster.OutlierDetection.Interval = outlierDetection.Interval
+		}
+		if outlierDetection.BaseEjectionTime != nil {
+			cluster.OutlierDetection.BaseEjectionTime = outlierDetection.BaseEjectionTime
+		}
+		if outlierDetection.MaxEjectionPercent != nil {
+			cluster.OutlierDetection.MaxEjectionPercent = outlierDetection.MaxEjectionPercent
+		}
+	}
+	return cluster
+}
+
+// ClusterWithHttp2 makes Envoy talk HTTP/2 to endpoints of a given Cluster, which is required by gRPC services.
+func ClusterWithHttp2(cluster *v2.Cluster) *v2.Cluster {
+	cluster.Http2ProtocolOptions = &envoy_core.Http2ProtocolOptions{}
+	return cluster
+}
+
+// CreateBlackHoleCluster creates a cluster without endpoints, so every connection to it is dropped.
+func CreateBlackHoleCluster(clusterName string) *v2.Cluster {
+	return clusterWithAltStatName(&v2.Cluster{
+		Name:                 clusterName,
+		ConnectTimeout:       ptypes.DurationProto(defaultConnectTimeout),
+		ClusterDiscoveryType: &v2.Cluster_Type{Type: v2.Cluster_STATIC},
+	})
+}
+
 func CreatePassThroughCluster(clusterName string) *v2.Cluster {
 	return clusterWithAltStatName(&v2.Cluster{
 		Name:                 clusterName,
@@ -10,6 +10,7 @@ import (
 	. "github.com/Kong/kuma/pkg/xds/envoy/clusters"
 
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
 
 	envoy_v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 
@@ -316,6 +317,39 @@ var _ = Describe("Clusters", func() {
                   timeout: 4s
                   unhealthyThreshold: 3
                 name: example
+`,
+			}),
+			Entry("HealthCheck with HTTP active checks", testCase{
+				healthCheck: &mesh_core.HealthCheckResource{
+					Spec: mesh_proto.HealthCheck{
+						Sources: []*mesh_proto.Selector{
+							{Match: mesh_proto.TagSelector{"service": "backend"}},
+						},
+						Destinations: []*mesh_proto.Selector{
+							{Match: mesh_proto.TagSelector{"service": "web"}},
+						},
+						Conf: &mesh_proto.HealthCheck_Conf{
+							ActiveChecks: &mesh_proto.HealthCheck_Conf_Active{
+								Interval:           ptypes.DurationProto(5 * time.Second),
+								Timeout:            ptypes.DurationProto(4 * time.Second),
+								UnhealthyThreshold: 3,
+								HealthyThreshold:   2,
+								Http: &mesh_proto.HealthCheck_Conf_Active_Http{
+									Path: "/health",
+								},
+							},
+						},
+					},
+				},
+				expected: `
+                healthChecks:
+                - healthyThreshold: 2
+                  httpHealthCheck:
+                    path: /health
+                  interval: 5s
+                  timeout: 4s
+                  unhealthyThreshold: 3
+                name: example
 `,
 			}),
 			Entry("HealthCheck with passive checks", testCase{
@@ -381,4 +415,108 @@ var _ = Describe("Clusters", func() {
 		)
 	})
 
+	Describe("ClusterWithCircuitBreaker()", func() {
+
+		type testCase struct {
+			cluster        string
+			circuitBreaker *mesh_core.CircuitBreakerResource
+			expected       string
+		}
+		DescribeTable("should add circuit breaking to a given Cluster",
+			func(given testCase) {
+				// given
+				cluster := &envoy_v2.Cluster{}
+				Expect(util_proto.FromYAML([]byte(given.cluster), cluster)).To(Succeed())
+				// when
+				metadata := ClusterWithCircuitBreaker(cluster, given.circuitBreaker)
+				// and
+				actual, err := util_proto.ToYAML(metadata)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("`nil` CircuitBreaker", testCase{
+				cluster: `
+                name: example
+`,
+				circuitBreaker: nil,
+				expected: `
+                name: example
+`,
+			}),
+			Entry("CircuitBreaker with thresholds", testCase{
+				cluster: `
+                name: example
+`,
+				circuitBreaker: &mesh_core.CircuitBreakerResource{
+					Spec: mesh_proto.CircuitBreaker{
+						Conf: &mesh_proto.CircuitBreaker_Conf{
+							Thresholds: &mesh_proto.CircuitBreaker_Conf_Thresholds{
+								MaxConnections:     &wrappers.UInt32Value{Value: 100},
+								MaxPendingRequests: &wrappers.UInt32Value{Value: 50},
+								MaxRetries:         &wrappers.UInt32Value{Value: 3},
+							},
+						},
+					},
+				},
+				expected: `
+                circuitBreakers:
+                  thresholds:
+                  - maxConnections: 100
+                    maxPendingRequests: 50
+                    maxRetries: 3
+                name: example
+`,
+			}),
+			Entry("CircuitBreaker with outlier detection", testCase{
+				cluster: `
+                name: example
+`,
+				circuitBreaker: &mesh_core.CircuitBreakerResource{
+					Spec: mesh_proto.CircuitBreaker{
+						Conf: &mesh_proto.CircuitBreaker_Conf{
+							OutlierDetection: &mesh_proto.CircuitBreaker_Conf_OutlierDetection{
+								ConsecutiveErrors:  &wrappers.UInt32Value{Value: 5},
+								Interval:           ptypes.DurationProto(10 * time.Second),
+								BaseEjectionTime:   ptypes.DurationProto(30 * time.Second),
+								MaxEjectionPercent: &wrappers.UInt32Value{Value: 50},
+							},
+						},
+					},
+				},
+				expected: `
+                name: example
+                outlierDetection:
+                  baseEjectionTime: 30s
+                  consecutive5xx: 5
+                  interval: 10s
+                  maxEjectionPercent: 50
+`,
+			}),
+			Entry("CircuitBreaker with outlier detection on top of passive health checks", testCase{
+				cluster: `
+                name: example
+                outlierDetection:
+                  consecutive5xx: 20
+                  interval: 30s
+`,
+				circuitBreaker: &mesh_core.CircuitBreakerResource{
+					Spec: mesh_proto.CircuitBreaker{
+						Conf: &mesh_proto.CircuitBreaker_Conf{
+							OutlierDetection: &mesh_proto.CircuitBreaker_Conf_OutlierDetection{
+								ConsecutiveErrors: &wrappers.UInt32Value{Value: 5},
+							},
+						},
+					},
+				},
+				expected: `
+                name: example
+                outlierDetection:
+                  consecutive5xx: 5
+                  interval: 30s
+`,
+			}),
+		)
+	})
+
 })
@@ -0,0 +1,27 @@
+package listeners
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+)
+
+// MatchTransportProtocol makes a filter chain accept only connections of a given transport protocol,
+// e.g. `tls`, as detected by TlsInspector.
+func MatchTransportProtocol(transportProtocol string) FilterChainBuilderOpt {
+	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
+		config.Add(&FilterChainMatchConfigurer{
+			transportProtocol: transportProtocol,
+		})
+	})
+}
+
+type FilterChainMatchConfigurer struct {
+	transportProtocol string
+}
+
+func (c *FilterChainMatchConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	if filterChain.FilterChainMatch == nil {
+		filterChain.FilterChainMatch = &envoy_listener.FilterChainMatch{}
+	}
+	filterChain.FilterChainMatch.TransportProtocol = c.transportProtocol
+	return nil
+}
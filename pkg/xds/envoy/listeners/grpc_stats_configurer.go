@@ -0,0 +1,41 @@
+package listeners
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoy_grpc_stats "github.com/envoyproxy/go-control-plane/envoy/config/filter/http/grpc_stats/v2alpha"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/http_connection_manager/v2"
+	"github.com/golang/protobuf/ptypes"
+)
+
+// GrpcStatsFilterName is a name of the Envoy filter that emits statistics of gRPC calls.
+// It is not part of well-known names in go-control-plane yet.
+const GrpcStatsFilterName = "envoy.filters.http.grpc_stats"
+
+// GrpcStats makes Envoy emit per-method statistics of gRPC calls, e.g. number of successful and failed calls.
+func GrpcStats() FilterChainBuilderOpt {
+	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
+		config.Add(&GrpcStatsConfigurer{})
+	})
+}
+
+type GrpcStatsConfigurer struct {
+}
+
+func (g *GrpcStatsConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	config := &envoy_grpc_stats.FilterConfig{}
+	pbst, err := ptypes.MarshalAny(config)
+	if err != nil {
+		return err
+	}
+	return UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
+		manager.HttpFilters = append([]*envoy_hcm.HttpFilter{
+			{
+				Name: GrpcStatsFilterName,
+				ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+					TypedConfig: pbst,
+				},
+			},
+		}, manager.HttpFilters...)
+		return nil
+	})
+}
@@ -0,0 +1,39 @@
+package listeners_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+	. "github.com/Kong/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("GrpcStatsConfigurer", func() {
+
+	It("should generate proper Envoy config", func() {
+		// when
+		filterChain, err := NewFilterChainBuilder().
+			Configure(HttpConnectionManager("backend")).
+			Configure(GrpcStats()).
+			Build()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(filterChain)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(actual).To(MatchYAML(`
+            filters:
+            - name: envoy.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.config.filter.network.http_connection_manager.v2.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.grpc_stats
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.config.filter.http.grpc_stats.v2alpha.FilterConfig
+                - name: envoy.router
+                statPrefix: backend`))
+	})
+})
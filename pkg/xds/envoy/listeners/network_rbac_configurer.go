@@ -12,6 +12,7 @@ import (
 	envoy_wellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
 
 	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	core_ca "github.com/Kong/kuma/pkg/core/ca"
 	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
 	util_xds "github.com/Kong/kuma/pkg/util/xds"
 )
@@ -90,7 +91,7 @@ func createPolicy(permission *mesh_core.TrafficPermissionResource) *rbac_config.
 				Authenticated: &rbac_config.Principal_Authenticated{
 					PrincipalName: &envoy_matcher.StringMatcher{
 						MatchPattern: &envoy_matcher.StringMatcher_Exact{
-							Exact: fmt.Sprintf("spiffe://%s/%s", permission.Meta.GetMesh(), service),
+							Exact: core_ca.ServiceSpiffeID(permission.Meta.GetMesh(), service),
 						},
 					},
 				},
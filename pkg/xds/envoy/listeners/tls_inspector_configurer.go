@@ -0,0 +1,25 @@
+package listeners
+
+import (
+	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoy_wellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
+)
+
+// TlsInspector makes a listener detect whether a connection is TLS,
+// so that filter chains can match the transport protocol.
+func TlsInspector() ListenerBuilderOpt {
+	return ListenerBuilderOptFunc(func(config *ListenerBuilderConfig) {
+		config.Add(&TlsInspectorConfigurer{})
+	})
+}
+
+type TlsInspectorConfigurer struct {
+}
+
+func (c *TlsInspectorConfigurer) Configure(l *v2.Listener) error {
+	l.ListenerFilters = append(l.ListenerFilters, &envoy_listener.ListenerFilter{
+		Name: envoy_wellknown.TlsInspector,
+	})
+	return nil
+}
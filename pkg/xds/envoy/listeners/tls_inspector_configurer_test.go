@@ -0,0 +1,51 @@
+package listeners_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/xds/envoy/listeners"
+
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+	envoy_common "github.com/Kong/kuma/pkg/xds/envoy"
+)
+
+var _ = Describe("TlsInspectorConfigurer", func() {
+
+	It("should generate proper Envoy config", func() {
+		// when
+		listener, err := NewListenerBuilder().
+			Configure(InboundListener("inbound:192.168.0.1:8080", "192.168.0.1", 8080)).
+			Configure(TlsInspector()).
+			Configure(FilterChain(NewFilterChainBuilder().
+				Configure(MatchTransportProtocol("tls")).
+				Configure(TcpProxy("localhost:8080", envoy_common.ClusterInfo{Name: "localhost:8080"})))).
+			Build()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(listener)
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(actual).To(MatchYAML(`
+            name: inbound:192.168.0.1:8080
+            trafficDirection: INBOUND
+            address:
+              socketAddress:
+                address: 192.168.0.1
+                portValue: 8080
+            listenerFilters:
+            - name: envoy.listener.tls_inspector
+            filterChains:
+            - filterChainMatch:
+                transportProtocol: tls
+              filters:
+              - name: envoy.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.tcp_proxy.v2.TcpProxy
+                  cluster: localhost:8080
+                  statPrefix: localhost_8080
+`))
+	})
+})
@@ -50,15 +50,20 @@ func (c RouteConfigurer) routeAction() *envoy_route.RouteAction {
 		}
 	} else {
 		var weightedClusters []*envoy_route.WeightedCluster_ClusterWeight
+		var totalWeight uint32
 		for _, cluster := range c.clusters {
 			weightedClusters = append(weightedClusters, &envoy_route.WeightedCluster_ClusterWeight{
 				Name:   cluster.Name,
 				Weight: &wrappers.UInt32Value{Value: cluster.Weight},
 			})
+			totalWeight += cluster.Weight
 		}
 		routeAction.ClusterSpecifier = &envoy_route.RouteAction_WeightedClusters{
 			WeightedClusters: &envoy_route.WeightedCluster{
 				Clusters: weightedClusters,
+				// Envoy requires weights to add up to the total weight, which is 100 by default,
+				// while weights of a TrafficRoute are relative to each other, e.g. 9 and 1
+				TotalWeight: &wrappers.UInt32Value{Value: totalWeight},
 			},
 		}
 	}
@@ -62,6 +62,26 @@ var _ = Describe("DefaultRouteConfigurer", func() {
                     weight: 30
                   - name: backend{version=v2}
                     weight: 70
+                  totalWeight: 100
+`,
+		}),
+		Entry("basic VirtualHost with destination clusters weighted relative to each other", testCase{
+			clusters: []envoy_common.ClusterInfo{
+				{Name: "backend{version=v1}", Weight: 9},
+				{Name: "backend{version=v2}", Weight: 1},
+			},
+			expected: `
+            routes:
+            - match:
+                prefix: /
+              route:
+                weightedClusters:
+                  clusters:
+                  - name: backend{version=v1}
+                    weight: 9
+                  - name: backend{version=v2}
+                    weight: 1
+                  totalWeight: 10
 `,
 		}),
 	)
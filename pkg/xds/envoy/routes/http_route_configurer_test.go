@@ -0,0 +1,159 @@
+package routes_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/xds/envoy/routes"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+	envoy_common "github.com/Kong/kuma/pkg/xds/envoy"
+)
+
+var _ = Describe("HttpRouteConfigurer", func() {
+
+	type testCase struct {
+		match    string
+		clusters []envoy_common.ClusterInfo
+		expected string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// setup
+			match := &mesh_proto.TrafficRoute_Http_Match{}
+			Expect(util_proto.FromYAML([]byte(given.match), match)).To(Succeed())
+
+			// when
+			virtualHost, err := NewVirtualHostBuilder().
+				Configure(HttpRoute(match, given.clusters...)).
+				Configure(DefaultRoute(envoy_common.ClusterInfo{Name: "backend", Weight: 100})).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(virtualHost)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("route by path prefix to a single destination cluster", testCase{
+			match: `
+            path:
+              prefix: /api
+`,
+			clusters: []envoy_common.ClusterInfo{
+				{Name: "backend{version=v2}", Weight: 100},
+			},
+			expected: `
+            routes:
+            - match:
+                prefix: /api
+              route:
+                cluster: backend{version=v2}
+            - match:
+                prefix: /
+              route:
+                cluster: backend
+`,
+		}),
+		Entry("route by exact path and method to weighted destination clusters", testCase{
+			match: `
+            path:
+              exact: /api/users
+            method:
+              exact: POST
+`,
+			clusters: []envoy_common.ClusterInfo{
+				{Name: "backend{version=v1}", Weight: 90},
+				{Name: "backend{version=v2}", Weight: 10},
+			},
+			expected: `
+            routes:
+            - match:
+                path: /api/users
+                headers:
+                - name: :method
+                  exactMatch: POST
+              route:
+                weightedClusters:
+                  clusters:
+                  - name: backend{version=v1}
+                    weight: 90
+                  - name: backend{version=v2}
+                    weight: 10
+                  totalWeight: 100
+            - match:
+                prefix: /
+              route:
+                cluster: backend
+`,
+		}),
+		Entry("route by path regex and headers", testCase{
+			match: `
+            path:
+              regex: /api/v[0-9]+/.*
+            headers:
+              x-version:
+                exact: v2
+              user-agent:
+                regex: .*Mobile.*
+              cookie:
+                prefix: canary=
+`,
+			clusters: []envoy_common.ClusterInfo{
+				{Name: "backend{version=v2}", Weight: 100},
+			},
+			expected: `
+            routes:
+            - match:
+                safeRegex:
+                  googleRe2: {}
+                  regex: /api/v[0-9]+/.*
+                headers:
+                - name: cookie
+                  prefixMatch: canary=
+                - name: user-agent
+                  safeRegexMatch:
+                    googleRe2: {}
+                    regex: .*Mobile.*
+                - name: x-version
+                  exactMatch: v2
+              route:
+                cluster: backend{version=v2}
+            - match:
+                prefix: /
+              route:
+                cluster: backend
+`,
+		}),
+		Entry("route by headers only", testCase{
+			match: `
+            headers:
+              x-version:
+                exact: v2
+`,
+			clusters: []envoy_common.ClusterInfo{
+				{Name: "backend{version=v2}", Weight: 100},
+			},
+			expected: `
+            routes:
+            - match:
+                prefix: /
+                headers:
+                - name: x-version
+                  exactMatch: v2
+              route:
+                cluster: backend{version=v2}
+            - match:
+                prefix: /
+              route:
+                cluster: backend
+`,
+		}),
+	)
+})
@@ -7,6 +7,7 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/wrappers"
 
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	core_xds "github.com/Kong/kuma/pkg/core/xds"
 	"github.com/Kong/kuma/pkg/sds/server"
 	util_xds "github.com/Kong/kuma/pkg/util/xds"
@@ -50,6 +51,7 @@ func CreateCommonTlsContext(ctx xds_context.Context, metadata *core_xds.Dataplan
 		return nil, err
 	}
 	return &envoy_auth.CommonTlsContext{
+		TlsParams: tlsParams(ctx.Mesh.Resource.Spec.GetMtls().GetTls()),
 		ValidationContextType: &envoy_auth.CommonTlsContext_ValidationContextSdsSecretConfig{
 			ValidationContextSdsSecretConfig: meshCaSecret,
 		},
@@ -59,6 +61,23 @@ func CreateCommonTlsContext(ctx xds_context.Context, metadata *core_xds.Dataplan
 	}, nil
 }
 
+// tlsParams translates TLS settings of a Mesh into TLS parameters of Envoy.
+// Without TLS settings, Envoy defaults are used.
+func tlsParams(tls *mesh_proto.Mesh_Mtls_Tls) *envoy_auth.TlsParameters {
+	if tls == nil {
+		return nil
+	}
+	params := &envoy_auth.TlsParameters{
+		CipherSuites: tls.GetCipherSuites(),
+	}
+	if version, ok := envoy_auth.TlsParameters_TlsProtocol_value[tls.GetMinVersion()]; ok {
+		params.TlsMinimumProtocolVersion = envoy_auth.TlsParameters_TlsProtocol(version)
+		// Envoy does not offer TLS 1.3 on upstream connections unless asked to
+		params.TlsMaximumProtocolVersion = envoy_auth.TlsParameters_TLSv1_3
+	}
+	return params
+}
+
 func sdsSecretConfig(context xds_context.Context, name string, metadata *core_xds.DataplaneMetadata) (*envoy_auth.SdsSecretConfig, error) {
 	withCallCredentials := func(grpc *envoy_core.GrpcService_GoogleGrpc) (*envoy_core.GrpcService_GoogleGrpc, error) {
 		if metadata.GetDataplaneTokenPath() == "" {
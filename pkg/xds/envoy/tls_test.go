@@ -198,6 +198,52 @@ var _ = Describe("CreateDownstreamTlsContext()", func() {
 `,
 			}),
 		)
+
+		It("should restrict TLS version and cipher suites according to the Mesh", func() {
+			// given
+			ctx := xds_context.Context{
+				ControlPlane: &xds_context.ControlPlaneContext{
+					SdsLocation: "kuma-control-plane:5677",
+					SdsTlsCert:  []byte("CERTIFICATE"),
+				},
+				Mesh: xds_context.MeshContext{
+					Resource: &mesh_core.MeshResource{
+						Spec: mesh_proto.Mesh{
+							Mtls: &mesh_proto.Mesh_Mtls{
+								EnabledBackend: "builtin",
+								Backends: []*mesh_proto.CertificateAuthorityBackend{
+									{
+										Name: "builtin",
+										Type: "builtin",
+									},
+								},
+								Tls: &mesh_proto.Mesh_Mtls_Tls{
+									MinVersion:   "TLSv1_2",
+									CipherSuites: []string{"ECDHE-ECDSA-AES128-GCM-SHA256", "ECDHE-RSA-AES128-GCM-SHA256"},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			// when
+			snippet, err := CreateDownstreamTlsContext(ctx, &core_xds.DataplaneMetadata{})
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// when
+			actual, err := util_proto.ToYAML(snippet.CommonTlsContext.TlsParams)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(`
+            tlsMinimumProtocolVersion: TLSv1_2
+            tlsMaximumProtocolVersion: TLSv1_3
+            cipherSuites:
+            - ECDHE-ECDSA-AES128-GCM-SHA256
+            - ECDHE-RSA-AES128-GCM-SHA256
+`))
+		})
 	})
 })
 
@@ -48,6 +48,13 @@ var _ = Describe("InboundProxyGenerator", func() {
 										Type: "builtin",
 									},
 								},
+								Exclusions: []*mesh_proto.Selector{
+									{
+										Match: map[string]string{
+											"service": "legacy",
+										},
+									},
+								},
 							},
 						},
 					},
@@ -162,5 +169,13 @@ var _ = Describe("InboundProxyGenerator", func() {
 			dataplaneFile:   "4-dataplane.input.yaml",
 			envoyConfigFile: "4-envoy-config.golden.yaml",
 		}),
+		Entry("05. mTLS exclusions", testCase{
+			dataplaneFile:   "5-dataplane.input.yaml",
+			envoyConfigFile: "5-envoy-config.golden.yaml",
+		}),
+		Entry("06. gRPC", testCase{
+			dataplaneFile:   "6-dataplane.input.yaml",
+			envoyConfigFile: "6-envoy-config.golden.yaml",
+		}),
 	)
 })
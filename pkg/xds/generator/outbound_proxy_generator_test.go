@@ -330,6 +330,219 @@ var _ = Describe("OutboundProxyGenerator", func() {
 		Expect(actual).To(MatchYAML(expected))
 	})
 
+	It("should generate routes for HTTP matches of a TrafficRoute", func() {
+		// setup
+		gen := &generator.OutboundProxyGenerator{}
+		dp := `
+        networking:
+          outbound:
+          - port: 18080
+            service: backend`
+
+		dataplane := mesh_proto.Dataplane{}
+		Expect(util_proto.FromYAML([]byte(dp), &dataplane)).To(Succeed())
+
+		route := `
+        conf:
+        - weight: 100
+          destination:
+            service: backend
+            version: v1
+        http:
+        - match:
+            path:
+              prefix: /api
+            method:
+              exact: GET
+          split:
+          - weight: 90
+            destination:
+              service: backend
+              version: v1
+          - weight: 10
+            destination:
+              service: backend
+              version: v2
+        - match:
+            headers:
+              x-version:
+                exact: v2
+          split:
+          - weight: 100
+            destination:
+              service: backend
+              version: v2`
+
+		trafficRoute := mesh_proto.TrafficRoute{}
+		Expect(util_proto.FromYAML([]byte(route), &trafficRoute)).To(Succeed())
+
+		proxy := &model.Proxy{
+			Id: model.ProxyId{Name: "side-car", Mesh: "default"},
+			Dataplane: &mesh_core.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Version: "1",
+				},
+				Spec: dataplane,
+			},
+			TrafficRoutes: model.RouteMap{
+				"backend": &mesh_core.TrafficRouteResource{
+					Spec: trafficRoute,
+				},
+			},
+			OutboundTargets: model.EndpointMap{
+				"backend": []model.Endpoint{
+					{Target: "192.168.0.1", Port: 8082, Tags: map[string]string{"service": "backend", "version": "v1", "protocol": "http"}},
+					{Target: "192.168.0.2", Port: 8082, Tags: map[string]string{"service": "backend", "version": "v2", "protocol": "http"}},
+				},
+			},
+			Metadata: &model.DataplaneMetadata{},
+		}
+
+		// when
+		rs, err := gen.Generate(plainCtx, proxy)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		resp, err := model.ResourceList(rs).ToDeltaDiscoveryResponse()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// when
+		actual, err := util_proto.ToYAML(resp)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "outbound-proxy", "http-routes.envoy.golden.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(expected))
+	})
+
+	It("should generate HTTP/2 clusters and gRPC statistics for gRPC services", func() {
+		// setup
+		gen := &generator.OutboundProxyGenerator{}
+		dp := `
+        networking:
+          outbound:
+          - port: 18080
+            service: backend`
+
+		dataplane := mesh_proto.Dataplane{}
+		Expect(util_proto.FromYAML([]byte(dp), &dataplane)).To(Succeed())
+
+		proxy := &model.Proxy{
+			Id: model.ProxyId{Name: "side-car", Mesh: "default"},
+			Dataplane: &mesh_core.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Version: "1",
+				},
+				Spec: dataplane,
+			},
+			TrafficRoutes: model.RouteMap{
+				"backend": &mesh_core.TrafficRouteResource{
+					Spec: mesh_proto.TrafficRoute{
+						Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+							Weight:      100,
+							Destination: mesh_proto.MatchService("backend"),
+						}},
+					},
+				},
+			},
+			OutboundTargets: model.EndpointMap{
+				"backend": []model.Endpoint{
+					{Target: "192.168.0.1", Port: 8082, Tags: map[string]string{"service": "backend", "protocol": "grpc"}},
+					{Target: "192.168.0.2", Port: 8082, Tags: map[string]string{"service": "backend", "protocol": "grpc"}},
+				},
+			},
+			Metadata: &model.DataplaneMetadata{},
+		}
+
+		// when
+		rs, err := gen.Generate(plainCtx, proxy)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		resp, err := model.ResourceList(rs).ToDeltaDiscoveryResponse()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// when
+		actual, err := util_proto.ToYAML(resp)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "outbound-proxy", "grpc.envoy.golden.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(expected))
+	})
+
+	It("should not generate clusters for HTTP matches of a TrafficRoute on a TCP outbound", func() {
+		// setup
+		gen := &generator.OutboundProxyGenerator{}
+		dp := `
+        networking:
+          outbound:
+          - port: 18080
+            service: backend`
+
+		dataplane := mesh_proto.Dataplane{}
+		Expect(util_proto.FromYAML([]byte(dp), &dataplane)).To(Succeed())
+
+		route := `
+        conf:
+        - weight: 100
+          destination:
+            service: backend
+            version: v1
+        http:
+        - match:
+            path:
+              prefix: /api
+          split:
+          - weight: 100
+            destination:
+              service: backend
+              version: v2`
+
+		trafficRoute := mesh_proto.TrafficRoute{}
+		Expect(util_proto.FromYAML([]byte(route), &trafficRoute)).To(Succeed())
+
+		proxy := &model.Proxy{
+			Id: model.ProxyId{Name: "side-car", Mesh: "default"},
+			Dataplane: &mesh_core.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Version: "1",
+				},
+				Spec: dataplane,
+			},
+			TrafficRoutes: model.RouteMap{
+				"backend": &mesh_core.TrafficRouteResource{
+					Spec: trafficRoute,
+				},
+			},
+			OutboundTargets: model.EndpointMap{
+				"backend": []model.Endpoint{
+					{Target: "192.168.0.1", Port: 8082, Tags: map[string]string{"service": "backend", "version": "v1", "protocol": "tcp"}},
+					{Target: "192.168.0.2", Port: 8082, Tags: map[string]string{"service": "backend", "version": "v2", "protocol": "tcp"}},
+				},
+			},
+			Metadata: &model.DataplaneMetadata{},
+		}
+
+		// when
+		rs, err := gen.Generate(plainCtx, proxy)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		var names []string
+		for _, r := range rs {
+			names = append(names, r.Name)
+		}
+		Expect(names).To(ConsistOf("backend{version=v1}", "backend{version=v1}", "outbound:127.0.0.1:18080"))
+	})
+
 	Describe("fail when a user-defined configuration (Dataplane, TrafficRoute, etc) is not valid", func() {
 
 		type testCase struct {
@@ -9,9 +9,10 @@ import (
 var (
 	// protocolStack is a mapping between a protocol and its full protocol stack, e.g.
 	// HTTP has a protocol stack [HTTP, TCP],
-	// GRPC has a protocol stack [GRPC, HTTP2, TCP],
+	// GRPC has a protocol stack [GRPC, TCP],
 	// TCP  has a protocol stack [TCP].
 	protocolStacks = map[mesh_core.Protocol]mesh_core.ProtocolList{
+		mesh_core.ProtocolGRPC: mesh_core.ProtocolList{mesh_core.ProtocolGRPC, mesh_core.ProtocolTCP},
 		mesh_core.ProtocolHTTP: mesh_core.ProtocolList{mesh_core.ProtocolHTTP, mesh_core.ProtocolTCP},
 		mesh_core.ProtocolTCP:  mesh_core.ProtocolList{mesh_core.ProtocolTCP},
 	}
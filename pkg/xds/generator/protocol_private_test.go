@@ -62,6 +62,16 @@ var _ = Describe("getCommonProtocol()", func() {
 			another:  mesh_core.ProtocolHTTP,
 			expected: mesh_core.ProtocolHTTP,
 		}),
+		Entry("`grpc` and `http`", testCase{
+			one:      mesh_core.ProtocolGRPC,
+			another:  mesh_core.ProtocolHTTP,
+			expected: mesh_core.ProtocolTCP,
+		}),
+		Entry("`grpc` and `grpc`", testCase{
+			one:      mesh_core.ProtocolGRPC,
+			another:  mesh_core.ProtocolGRPC,
+			expected: mesh_core.ProtocolGRPC,
+		}),
 		Entry("`tcp` and `tcp`", testCase{
 			one:      mesh_core.ProtocolTCP,
 			another:  mesh_core.ProtocolTCP,
@@ -99,42 +99,62 @@ func (g InboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.Pr
 	}
 	resources := &model.ResourceSet{}
 	for i, endpoint := range endpoints {
+		iface := proxy.Dataplane.Spec.Networking.Inbound[i]
+		service := iface.GetService()
+		protocol := mesh_core.ParseProtocol(iface.GetProtocol())
+
 		// generate CDS resource
 		localClusterName := envoy_names.GetLocalClusterName(endpoint.WorkloadPort)
+		localCluster := envoy_clusters.CreateLocalCluster(localClusterName, "127.0.0.1", endpoint.WorkloadPort)
+		if protocol == mesh_core.ProtocolGRPC {
+			localCluster = envoy_clusters.ClusterWithHttp2(localCluster)
+		}
 		resources.Add(&model.Resource{
 			Name:     localClusterName,
 			Version:  "",
-			Resource: envoy_clusters.CreateLocalCluster(localClusterName, "127.0.0.1", endpoint.WorkloadPort),
+			Resource: localCluster,
 		})
 
 		// generate LDS resource
-		iface := proxy.Dataplane.Spec.Networking.Inbound[i]
-		service := iface.GetService()
-		protocol := mesh_core.ParseProtocol(iface.GetProtocol())
 		inboundListenerName := envoy_names.GetInboundListenerName(endpoint.DataplaneIP, endpoint.DataplanePort)
-		filterChainBuilder := func() *envoy_listeners.FilterChainBuilder {
+		filterChainBuilder := func(mtls bool) *envoy_listeners.FilterChainBuilder {
 			filterChainBuilder := envoy_listeners.NewFilterChainBuilder()
 			switch protocol {
-			case mesh_core.ProtocolHTTP:
+			case mesh_core.ProtocolHTTP, mesh_core.ProtocolGRPC:
 				// configuration for HTTP case
 				filterChainBuilder.
 					Configure(envoy_listeners.HttpConnectionManager(localClusterName)).
 					Configure(envoy_listeners.FaultInjection(proxy.FaultInjections[endpoint])).
 					Configure(envoy_listeners.Tracing(proxy.TracingBackend)).
 					Configure(envoy_listeners.HttpInboundRoute(service, envoy_common.ClusterInfo{Name: localClusterName}))
+				if protocol == mesh_core.ProtocolGRPC {
+					filterChainBuilder.Configure(envoy_listeners.GrpcStats())
+				}
 			case mesh_core.ProtocolTCP:
 				fallthrough
 			default:
 				// configuration for non-HTTP cases
 				filterChainBuilder.Configure(envoy_listeners.TcpProxy(localClusterName, envoy_common.ClusterInfo{Name: localClusterName}))
 			}
+			if !mtls {
+				return filterChainBuilder
+			}
 			return filterChainBuilder.
 				Configure(envoy_listeners.ServerSideMTLS(ctx, proxy.Metadata)).
 				Configure(envoy_listeners.NetworkRBAC(inboundListenerName, ctx.Mesh.Resource.MTLSEnabled(), proxy.TrafficPermissions[endpoint]))
-		}()
-		inboundListener, err := envoy_listeners.NewListenerBuilder().
-			Configure(envoy_listeners.InboundListener(inboundListenerName, endpoint.DataplaneIP, endpoint.DataplanePort)).
-			Configure(envoy_listeners.FilterChain(filterChainBuilder)).
+		}
+		listenerBuilder := envoy_listeners.NewListenerBuilder().
+			Configure(envoy_listeners.InboundListener(inboundListenerName, endpoint.DataplaneIP, endpoint.DataplanePort))
+		if ctx.Mesh.Resource.MTLSExcluded(iface.GetTags()) {
+			// inbound exempt from mTLS accepts plain text connections besides mTLS ones
+			listenerBuilder.
+				Configure(envoy_listeners.TlsInspector()).
+				Configure(envoy_listeners.FilterChain(filterChainBuilder(true).Configure(envoy_listeners.MatchTransportProtocol("tls")))).
+				Configure(envoy_listeners.FilterChain(filterChainBuilder(false)))
+		} else {
+			listenerBuilder.Configure(envoy_listeners.FilterChain(filterChainBuilder(true)))
+		}
+		inboundListener, err := listenerBuilder.
 			Configure(envoy_listeners.TransparentProxying(proxy.Dataplane.Spec.Networking.GetTransparentProxying())).
 			Build()
 		if err != nil {
@@ -176,6 +196,10 @@ func (g OutboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.P
 		if err != nil {
 			return nil, err
 		}
+		httpRoutes, err := g.determineHttpRoutes(route)
+		if err != nil {
+			return nil, err
+		}
 
 		// generate CDS and EDS resources
 		edsResources, endpoints, err := g.generateEds(ctx, proxy, clusters)
@@ -186,6 +210,16 @@ func (g OutboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.P
 
 		protocol := InferServiceProtocol(endpoints)
 
+		// HTTP routes are rendered only on HTTP outbounds, so clusters of their destinations are not needed otherwise
+		switch protocol {
+		case mesh_core.ProtocolHTTP, mesh_core.ProtocolGRPC:
+			edsResources, _, err := g.generateEds(ctx, proxy, httpRouteClusters(clusters, httpRoutes))
+			if err != nil {
+				return nil, err
+			}
+			resources.Add(edsResources...)
+		}
+
 		// generate LDS resource
 		outboundListenerName := envoy_names.GetOutboundListenerName(ofaces[i].DataplaneIP, ofaces[i].DataplanePort)
 		outboundRouteName := envoy_names.GetOutboundRouteName(outbound.Service)
@@ -194,13 +228,16 @@ func (g OutboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.P
 		filterChainBuilder := func() *envoy_listeners.FilterChainBuilder {
 			filterChainBuilder := envoy_listeners.NewFilterChainBuilder()
 			switch protocol {
-			case mesh_core.ProtocolHTTP:
+			case mesh_core.ProtocolHTTP, mesh_core.ProtocolGRPC:
 				// configuration for HTTP case
 				filterChainBuilder.
 					Configure(envoy_listeners.HttpConnectionManager(outbound.Service)).
 					Configure(envoy_listeners.Tracing(proxy.TracingBackend)).
 					Configure(envoy_listeners.HttpAccessLog(meshName, sourceService, destinationService, proxy.Logs[outbound.Service], proxy)).
 					Configure(envoy_listeners.HttpOutboundRoute(outboundRouteName))
+				if protocol == mesh_core.ProtocolGRPC {
+					filterChainBuilder.Configure(envoy_listeners.GrpcStats())
+				}
 			case mesh_core.ProtocolTCP:
 				fallthrough
 			default:
@@ -225,7 +262,7 @@ func (g OutboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.P
 		})
 
 		// generate RDS resources
-		rdsResources, err := g.generateRds(protocol, outbound.Service, outboundRouteName, clusters, proxy.Dataplane.Spec.Tags())
+		rdsResources, err := g.generateRds(protocol, outbound.Service, outboundRouteName, clusters, httpRoutes, proxy.Dataplane.Spec.Tags())
 		if err != nil {
 			return nil, err
 		}
@@ -236,10 +273,34 @@ func (g OutboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.P
 }
 
 func (_ OutboundProxyGenerator) determineClusters(ctx xds_context.Context, proxy *model.Proxy, route *mesh_core.TrafficRouteResource) (clusters []envoy_common.ClusterInfo, err error) {
-	for j, destination := range route.Spec.Conf {
+	return determineSplitClusters(route, validators.RootedAt("conf"), route.Spec.Conf)
+}
+
+// httpRoute is a route of a TrafficRoute that applies only to HTTP requests matching given criteria.
+type httpRoute struct {
+	match    *kuma_mesh.TrafficRoute_Http_Match
+	clusters []envoy_common.ClusterInfo
+}
+
+func (_ OutboundProxyGenerator) determineHttpRoutes(route *mesh_core.TrafficRouteResource) (routes []httpRoute, err error) {
+	for j, http := range route.Spec.Http {
+		clusters, err := determineSplitClusters(route, validators.RootedAt("http").Index(j).Field("split"), http.Split)
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, httpRoute{
+			match:    http.Match,
+			clusters: clusters,
+		})
+	}
+	return
+}
+
+func determineSplitClusters(route *mesh_core.TrafficRouteResource, path validators.PathBuilder, split []*kuma_mesh.TrafficRoute_WeightedDestination) (clusters []envoy_common.ClusterInfo, err error) {
+	for j, destination := range split {
 		service, ok := destination.Destination[kuma_mesh.ServiceTag]
 		if !ok {
-			return nil, errors.Errorf("trafficroute{name=%q}.%s: mandatory tag %q is missing: %v", route.GetMeta().GetName(), validators.RootedAt("conf").Index(j).Field("destination"), kuma_mesh.ServiceTag, destination.Destination)
+			return nil, errors.Errorf("trafficroute{name=%q}.%s: mandatory tag %q is missing: %v", route.GetMeta().GetName(), path.Index(j).Field("destination"), kuma_mesh.ServiceTag, destination.Destination)
 		}
 		if destination.Weight == 0 {
 			// Envoy doesn't support 0 weight
@@ -254,19 +315,42 @@ func (_ OutboundProxyGenerator) determineClusters(ctx xds_context.Context, proxy
 	return
 }
 
+// httpRouteClusters returns clusters referenced by HTTP routes that are not referenced by conf,
+// since the same destination can be shared by several routes.
+func httpRouteClusters(clusters []envoy_common.ClusterInfo, httpRoutes []httpRoute) (unique []envoy_common.ClusterInfo) {
+	seen := map[string]bool{}
+	for _, cluster := range clusters {
+		seen[cluster.Name] = true
+	}
+	for _, route := range httpRoutes {
+		for _, cluster := range route.clusters {
+			if seen[cluster.Name] {
+				continue
+			}
+			seen[cluster.Name] = true
+			unique = append(unique, cluster)
+		}
+	}
+	return
+}
+
 func (_ OutboundProxyGenerator) generateEds(ctx xds_context.Context, proxy *model.Proxy, clusters []envoy_common.ClusterInfo) (resources []*model.Resource, allEndpoints []model.Endpoint, _ error) {
 	for _, cluster := range clusters {
 		serviceName := cluster.Tags[kuma_mesh.ServiceTag]
 		healthCheck := proxy.HealthChecks[serviceName]
+		circuitBreaker := proxy.CircuitBreakers[serviceName]
+		endpoints := model.EndpointList(proxy.OutboundTargets[serviceName]).Filter(kuma_mesh.MatchTags(cluster.Tags))
 		edsCluster, err := envoy_clusters.CreateEdsCluster(ctx, cluster.Name, proxy.Metadata)
 		if err != nil {
 			return nil, nil, err
 		}
+		if InferServiceProtocol(endpoints) == mesh_core.ProtocolGRPC {
+			edsCluster = envoy_clusters.ClusterWithHttp2(edsCluster)
+		}
 		resources = append(resources, &model.Resource{
 			Name:     cluster.Name,
-			Resource: envoy_clusters.ClusterWithHealthChecks(edsCluster, healthCheck),
+			Resource: envoy_clusters.ClusterWithCircuitBreaker(envoy_clusters.ClusterWithHealthChecks(edsCluster, healthCheck), circuitBreaker),
 		})
-		endpoints := model.EndpointList(proxy.OutboundTargets[serviceName]).Filter(kuma_mesh.MatchTags(cluster.Tags))
 		resources = append(resources, &model.Resource{
 			Name:     cluster.Name,
 			Resource: envoy_endpoints.CreateClusterLoadAssignment(cluster.Name, endpoints),
@@ -276,17 +360,22 @@ func (_ OutboundProxyGenerator) generateEds(ctx xds_context.Context, proxy *mode
 	return
 }
 
-func (_ OutboundProxyGenerator) generateRds(protocol mesh_core.Protocol, service string, outboundRouteName string, clusters []envoy_common.ClusterInfo, tags kuma_mesh.MultiValueTagSet) ([]*model.Resource, error) {
+func (_ OutboundProxyGenerator) generateRds(protocol mesh_core.Protocol, service string, outboundRouteName string, clusters []envoy_common.ClusterInfo, httpRoutes []httpRoute, tags kuma_mesh.MultiValueTagSet) ([]*model.Resource, error) {
 	resources := &model.ResourceSet{}
 	switch protocol {
-	case mesh_core.ProtocolHTTP:
+	case mesh_core.ProtocolHTTP, mesh_core.ProtocolGRPC:
 		// generate RDS resource
+		virtualHostBuilder := envoy_routes.NewVirtualHostBuilder().
+			Configure(envoy_routes.CommonVirtualHost(service))
+		// routes are matched in order, so more specific HTTP routes must precede the catch-all one
+		for _, route := range httpRoutes {
+			virtualHostBuilder.Configure(envoy_routes.HttpRoute(route.match, route.clusters...))
+		}
+		virtualHostBuilder.Configure(envoy_routes.DefaultRoute(clusters...))
 		routeConfiguration, err := envoy_routes.NewRouteConfigurationBuilder().
 			Configure(envoy_routes.CommonRouteConfiguration(outboundRouteName)).
 			Configure(envoy_routes.TagsHeader(tags)).
-			Configure(envoy_routes.VirtualHost(envoy_routes.NewVirtualHostBuilder().
-				Configure(envoy_routes.CommonVirtualHost(service)).
-				Configure(envoy_routes.DefaultRoute(clusters...)))).
+			Configure(envoy_routes.VirtualHost(virtualHostBuilder)).
 			Build()
 		if err != nil {
 			return nil, err
@@ -309,10 +398,17 @@ func (_ TransparentProxyGenerator) Generate(ctx xds_context.Context, proxy *mode
 	}
 	sourceService := proxy.Dataplane.Spec.GetIdentifyingService()
 	meshName := ctx.Mesh.Resource.GetMeta().GetName()
+	// traffic to destinations unknown to the Mesh is either passed through or blocked
+	clusterName := "pass_through"
+	cluster := envoy_clusters.CreatePassThroughCluster(clusterName)
+	if !ctx.Mesh.Resource.PassthroughEnabled() {
+		clusterName = "blackhole"
+		cluster = envoy_clusters.CreateBlackHoleCluster(clusterName)
+	}
 	listener, err := envoy_listeners.NewListenerBuilder().
 		Configure(envoy_listeners.OutboundListener("catch_all", "0.0.0.0", redirectPort)).
 		Configure(envoy_listeners.FilterChain(envoy_listeners.NewFilterChainBuilder().
-			Configure(envoy_listeners.TcpProxy("pass_through", envoy_common.ClusterInfo{Name: "pass_through"})).
+			Configure(envoy_listeners.TcpProxy(clusterName, envoy_common.ClusterInfo{Name: clusterName})).
 			Configure(envoy_listeners.NetworkAccessLog(meshName, sourceService, "external", proxy.Logs[mesh_core.PassThroughService], proxy)))).
 		Configure(envoy_listeners.OriginalDstForwarder()).
 		Build()
@@ -326,9 +422,9 @@ func (_ TransparentProxyGenerator) Generate(ctx xds_context.Context, proxy *mode
 			Resource: listener,
 		},
 		&model.Resource{
-			Name:     "pass_through",
+			Name:     clusterName,
 			Version:  proxy.Dataplane.Meta.GetVersion(),
-			Resource: envoy_clusters.CreatePassThroughCluster("pass_through"),
+			Resource: cluster,
 		},
 	}, nil
 }
@@ -1,6 +1,7 @@
 package generator_test
 
 import (
+	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -19,6 +20,7 @@ var _ = Describe("TransparentProxyGenerator", func() {
 
 	type testCase struct {
 		proxy    *model.Proxy
+		mesh     mesh_proto.Mesh
 		expected string
 	}
 
@@ -32,6 +34,7 @@ var _ = Describe("TransparentProxyGenerator", func() {
 						Meta: &test_model.ResourceMeta{
 							Name: "default",
 						},
+						Spec: given.mesh,
 					},
 				},
 			}
@@ -183,6 +186,58 @@ var _ = Describe("TransparentProxyGenerator", func() {
             name: pass_through
             type: ORIGINAL_DST
           version: v1
+`,
+		}),
+		Entry("transparent_proxying=true with passthrough disabled", testCase{
+			proxy: &model.Proxy{
+				Id: model.ProxyId{Name: "side-car"},
+				Dataplane: &mesh_core.DataplaneResource{
+					Meta: &test_model.ResourceMeta{
+						Version: "v1",
+					},
+					Spec: mesh_proto.Dataplane{
+						Networking: &mesh_proto.Dataplane_Networking{
+							TransparentProxying: &mesh_proto.Dataplane_Networking_TransparentProxying{
+								RedirectPort: 15001,
+							},
+						},
+					},
+				},
+			},
+			mesh: mesh_proto.Mesh{
+				Networking: &mesh_proto.Mesh_Networking{
+					Outbound: &mesh_proto.Mesh_Networking_Outbound{
+						Passthrough: &wrappers.BoolValue{Value: false},
+					},
+				},
+			},
+			expected: `
+        resources:
+        - name: catch_all
+          resource:
+            '@type': type.googleapis.com/envoy.api.v2.Listener
+            trafficDirection: OUTBOUND
+            address:
+              socketAddress:
+                address: 0.0.0.0
+                portValue: 15001
+            filterChains:
+            - filters:
+              - name: envoy.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.tcp_proxy.v2.TcpProxy
+                  cluster: blackhole
+                  statPrefix: blackhole
+            name: catch_all
+            useOriginalDst: true
+          version: v1
+        - name: blackhole
+          resource:
+            '@type': type.googleapis.com/envoy.api.v2.Cluster
+            connectTimeout: 5s
+            name: blackhole
+            type: STATIC
+          version: v1
 `,
 		}),
 	)
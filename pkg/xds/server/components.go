@@ -59,6 +59,10 @@ func SetupServer(rt core_runtime.Runtime) error {
 }
 
 func DefaultReconciler(rt core_runtime.Runtime) SnapshotReconciler {
+	var cacher snapshotCacher = &simpleSnapshotCacher{rt.XDS().Hasher(), rt.XDS().Cache()}
+	if interval := rt.Config().XdsServer.DataplaneConfigurationDebounceInterval; interval > 0 {
+		cacher = newDebouncingSnapshotCacher(cacher, interval)
+	}
 	return &reconciler{
 		&templateSnapshotGenerator{
 			ProxyTemplateResolver: &simpleProxyTemplateResolver{
@@ -66,7 +70,7 @@ func DefaultReconciler(rt core_runtime.Runtime) SnapshotReconciler {
 				DefaultProxyTemplate:    xds_template.DefaultProxyTemplate,
 			},
 		},
-		&simpleSnapshotCacher{rt.XDS().Hasher(), rt.XDS().Cache()},
+		cacher,
 	}
 }
 
@@ -127,13 +131,18 @@ func DefaultDataplaneSyncTracker(rt core_runtime.Runtime, reconciler SnapshotRec
 					return err
 				}
 
+				circuitBreakers, err := xds_topology.GetCircuitBreakers(ctx, dataplane, destinations, rt.ReadOnlyResourceManager())
+				if err != nil {
+					return err
+				}
+
 				trafficTrace, err := xds_topology.GetTrafficTrace(ctx, dataplane, rt.ReadOnlyResourceManager())
 				if err != nil {
 					return err
 				}
 				var tracingBackend *mesh_proto.TracingBackend
 				if trafficTrace != nil {
-					tracingBackend = mesh.GetTracingBackend(trafficTrace.Spec.GetConf().GetBackend())
+					tracingBackend = xds_topology.GetTracingBackend(mesh, trafficTrace)
 				}
 
 				matchedPermissions, err := permissionsMatcher.Match(ctx, dataplane)
@@ -159,6 +168,7 @@ func DefaultDataplaneSyncTracker(rt core_runtime.Runtime, reconciler SnapshotRec
 					OutboundSelectors:  destinations,
 					OutboundTargets:    outbound,
 					HealthChecks:       healthChecks,
+					CircuitBreakers:    circuitBreakers,
 					Logs:               matchedLogs,
 					TrafficTrace:       trafficTrace,
 					TracingBackend:     tracingBackend,
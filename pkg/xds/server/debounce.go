@@ -0,0 +1,118 @@
+package server
+
+import (
+	"sync"
+	"time"
+
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache"
+)
+
+var _ snapshotCacher = &debouncingSnapshotCacher{}
+
+// debouncingSnapshotCacher limits pushes of configuration to a node to one per interval,
+// so that a burst of policy updates doesn't make Envoy apply a full configuration for every one of them.
+//
+// A changed snapshot of a node is cached right away unless the node has been pushed to within the interval,
+// in which case it is deferred until the end of the interval. Snapshots deferred within the same interval
+// are coalesced, i.e. only the latest one is cached.
+type debouncingSnapshotCacher struct {
+	cacher   snapshotCacher
+	interval time.Duration
+
+	mu      sync.Mutex
+	windows map[string]*debounceWindow // indexed by node IDs
+}
+
+// debounceWindow is an interval after a push to a node, during which changed snapshots are deferred.
+type debounceWindow struct {
+	node    *envoy_core.Node
+	timer   *time.Timer
+	pending *envoy_cache.Snapshot
+}
+
+func newDebouncingSnapshotCacher(cacher snapshotCacher, interval time.Duration) *debouncingSnapshotCacher {
+	return &debouncingSnapshotCacher{
+		cacher:   cacher,
+		interval: interval,
+		windows:  make(map[string]*debounceWindow),
+	}
+}
+
+// Get returns the latest snapshot of a node, including a deferred one,
+// so that versions of a new snapshot are computed against what is going to be pushed.
+func (d *debouncingSnapshotCacher) Get(node *envoy_core.Node) (envoy_cache.Snapshot, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if window, open := d.windows[node.Id]; open && window.pending != nil {
+		return *window.pending, nil
+	}
+	return d.cacher.Get(node)
+}
+
+func (d *debouncingSnapshotCacher) Cache(node *envoy_core.Node, snapshot envoy_cache.Snapshot) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if window, open := d.windows[node.Id]; open {
+		window.pending = &snapshot
+		return nil
+	}
+	return d.push(node, snapshot)
+}
+
+// push caches a snapshot and, unless nothing is pushed to the node, defers changes until the interval elapses.
+func (d *debouncingSnapshotCacher) push(node *envoy_core.Node, snapshot envoy_cache.Snapshot) error {
+	current, err := d.cacher.Get(node)
+	changed := err != nil || !sameVersions(current, snapshot)
+	if err := d.cacher.Cache(node, snapshot); err != nil {
+		return err
+	}
+	if changed {
+		d.open(node)
+	}
+	return nil
+}
+
+func (d *debouncingSnapshotCacher) Clear(node *envoy_core.Node) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if window, open := d.windows[node.Id]; open {
+		window.timer.Stop()
+		delete(d.windows, node.Id)
+	}
+	d.cacher.Clear(node)
+}
+
+func (d *debouncingSnapshotCacher) open(node *envoy_core.Node) {
+	window := &debounceWindow{node: node}
+	window.timer = time.AfterFunc(d.interval, func() {
+		d.close(window)
+	})
+	d.windows[node.Id] = window
+}
+
+// close pushes a snapshot deferred within a window, if any.
+func (d *debouncingSnapshotCacher) close(window *debounceWindow) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.windows[window.node.Id] != window {
+		return // the node has been cleared in the meantime
+	}
+	delete(d.windows, window.node.Id)
+	if window.pending == nil {
+		return
+	}
+	if err := d.push(window.node, *window.pending); err != nil {
+		reconcileLog.Error(err, "failed to store a deferred snapshot", "snapshot", *window.pending, "node", window.node)
+	}
+}
+
+// sameVersions returns true if all resources of snapshots have the same versions,
+// i.e. caching one snapshot in place of the other doesn't push anything to the node.
+func sameVersions(old envoy_cache.Snapshot, new envoy_cache.Snapshot) bool {
+	return old.Listeners.Version == new.Listeners.Version &&
+		old.Routes.Version == new.Routes.Version &&
+		old.Clusters.Version == new.Clusters.Version &&
+		old.Endpoints.Version == new.Endpoints.Version &&
+		old.Secrets.Version == new.Secrets.Version
+}
@@ -1,6 +1,10 @@
 package server
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+
 	"github.com/golang/protobuf/proto"
 
 	"github.com/Kong/kuma/pkg/core"
@@ -49,7 +53,7 @@ func (r *reconciler) Reconcile(ctx xds_context.Context, proxy *model.Proxy) erro
 	}
 	// to avoid assigning a new version every time,
 	// compare with the previous snapshot and reuse its version whenever possible,
-	// fallback to UUID otherwise
+	// fallback to a hash of the content otherwise
 	previous, err := r.cacher.Get(node)
 	if err != nil {
 		previous = envoy_cache.Snapshot{}
@@ -73,11 +77,40 @@ func (r *reconciler) autoVersion(old envoy_cache.Snapshot, new envoy_cache.Snaps
 func reuseVersion(old, new envoy_cache.Resources) envoy_cache.Resources {
 	new.Version = old.Version
 	if !equalSnapshots(old.Items, new.Items) {
-		new.Version = newUUID()
+		new.Version = contentVersion(new.Items)
 	}
 	return new
 }
 
+// contentVersion returns a version derived from a hash of resources,
+// so that identical resources get the same version, e.g. after a Control Plane restart,
+// and Envoy that already has them is not pushed the same configuration again.
+// Fallback to UUID if resources cannot be marshaled.
+func contentVersion(items map[string]envoy_cache.Resource) string {
+	names := make([]string, 0, len(items))
+	for name := range items {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	hash := sha256.New()
+	buf := proto.NewBuffer(nil)
+	buf.SetDeterministic(true)
+	for _, name := range names {
+		buf.Reset()
+		err := buf.EncodeStringBytes(name)
+		if err == nil {
+			err = buf.EncodeMessage(items[name])
+		}
+		if err != nil {
+			reconcileLog.Error(err, "failed to marshal a resource to compute its version", "name", name)
+			return newUUID()
+		}
+		_, _ = hash.Write(buf.Bytes())
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
 func equalSnapshots(old, new map[string]envoy_cache.Resource) bool {
 	if len(new) != len(old) {
 		return false
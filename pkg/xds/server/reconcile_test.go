@@ -1,11 +1,11 @@
 package server
 
 import (
-	"fmt"
-	"sync/atomic"
+	"time"
 
 	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -20,24 +20,6 @@ import (
 var _ = Describe("Reconcile", func() {
 	Describe("reconciler", func() {
 
-		var backupNewUUID func() string
-
-		BeforeEach(func() {
-			backupNewUUID = newUUID
-		})
-		AfterEach(func() {
-			newUUID = backupNewUUID
-		})
-
-		var serial uint64
-
-		BeforeEach(func() {
-			newUUID = func() string {
-				uuid := atomic.AddUint64(&serial, 1)
-				return fmt.Sprintf("v%d", uuid)
-			}
-		})
-
 		var xdsContext core_xds.XdsContext
 
 		BeforeEach(func() {
@@ -116,11 +98,16 @@ var _ = Describe("Reconcile", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(snapshot).ToNot(BeZero())
 			// and
-			Expect(snapshot.Listeners.Version).To(Equal("v1"))
-			Expect(snapshot.Routes.Version).To(Equal("v2"))
-			Expect(snapshot.Clusters.Version).To(Equal("v3"))
-			Expect(snapshot.Endpoints.Version).To(Equal("v4"))
-			Expect(snapshot.Secrets.Version).To(Equal("v5"))
+			listenersVersion := snapshot.Listeners.Version
+			routesVersion := snapshot.Routes.Version
+			clustersVersion := snapshot.Clusters.Version
+			endpointsVersion := snapshot.Endpoints.Version
+			secretsVersion := snapshot.Secrets.Version
+			Expect(listenersVersion).To(Equal(contentVersion(snapshot.Listeners.Items)))
+			Expect(routesVersion).To(Equal(contentVersion(snapshot.Routes.Items)))
+			Expect(clustersVersion).To(Equal(contentVersion(snapshot.Clusters.Items)))
+			Expect(endpointsVersion).To(Equal(contentVersion(snapshot.Endpoints.Items)))
+			Expect(secretsVersion).To(Equal(contentVersion(snapshot.Secrets.Items)))
 
 			By("simulating discovery event (Dataplane watchdog triggers refresh)")
 			// when
@@ -135,11 +122,11 @@ var _ = Describe("Reconcile", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(snapshot).ToNot(BeZero())
 			// and
-			Expect(snapshot.Listeners.Version).To(Equal("v1"))
-			Expect(snapshot.Routes.Version).To(Equal("v2"))
-			Expect(snapshot.Clusters.Version).To(Equal("v3"))
-			Expect(snapshot.Endpoints.Version).To(Equal("v4"))
-			Expect(snapshot.Secrets.Version).To(Equal("v5"))
+			Expect(snapshot.Listeners.Version).To(Equal(listenersVersion))
+			Expect(snapshot.Routes.Version).To(Equal(routesVersion))
+			Expect(snapshot.Clusters.Version).To(Equal(clustersVersion))
+			Expect(snapshot.Endpoints.Version).To(Equal(endpointsVersion))
+			Expect(snapshot.Secrets.Version).To(Equal(secretsVersion))
 
 			By("simulating discovery event (Dataplane gets changed)")
 			// when
@@ -154,12 +141,136 @@ var _ = Describe("Reconcile", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(snapshot).ToNot(BeZero())
 			// and
-			Expect(snapshot.Listeners.Version).To(Equal("v6"))
-			Expect(snapshot.Routes.Version).To(Equal("v7"))
-			Expect(snapshot.Clusters.Version).To(Equal("v8"))
-			Expect(snapshot.Endpoints.Version).To(Equal("v9"))
-			Expect(snapshot.Secrets.Version).To(Equal("v10"))
+			Expect(snapshot.Listeners.Version).ToNot(Equal(listenersVersion))
+			Expect(snapshot.Routes.Version).ToNot(Equal(routesVersion))
+			Expect(snapshot.Clusters.Version).ToNot(Equal(clustersVersion))
+			Expect(snapshot.Endpoints.Version).ToNot(Equal(endpointsVersion))
+			Expect(snapshot.Secrets.Version).ToNot(Equal(secretsVersion))
 		})
+
+		It("should assign the same version to the same resources regardless of the previous snapshot", func() {
+			// given
+			snapshots := make(chan envoy_cache.Snapshot, 3)
+			snapshots <- snapshot // initial Dataplane configuration
+			snapshots <- envoy_cache.NewSnapshot("", nil, nil, nil, []envoy_cache.Resource{&envoy.Listener{Name: "changed"}}, nil)
+			snapshots <- snapshot // Dataplane configuration is reverted
+
+			// setup
+			generator := snapshotGeneratorFunc(func(ctx xds_context.Context, proxy *xds_model.Proxy) (envoy_cache.Snapshot, error) {
+				return <-snapshots, nil
+			})
+			r := &reconciler{generator, &simpleSnapshotCacher{xdsContext.Hasher(), xdsContext.Cache()}}
+			proxy := &xds_model.Proxy{
+				Id: xds_model.ProxyId{
+					Mesh: "demo",
+					Name: "example",
+				},
+			}
+
+			By("simulating discovery event")
+			// when
+			Expect(r.Reconcile(xds_context.Context{}, proxy)).To(Succeed())
+			// then
+			initial, err := xdsContext.Cache().GetSnapshot("demo.example")
+			Expect(err).ToNot(HaveOccurred())
+
+			By("simulating discovery event (Dataplane gets changed)")
+			// when
+			Expect(r.Reconcile(xds_context.Context{}, proxy)).To(Succeed())
+			// then
+			changed, err := xdsContext.Cache().GetSnapshot("demo.example")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changed.Listeners.Version).ToNot(Equal(initial.Listeners.Version))
+
+			By("simulating discovery event (Dataplane gets reverted)")
+			// when
+			Expect(r.Reconcile(xds_context.Context{}, proxy)).To(Succeed())
+			// then
+			reverted, err := xdsContext.Cache().GetSnapshot("demo.example")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reverted.Listeners.Version).To(Equal(initial.Listeners.Version))
+			Expect(reverted.Routes.Version).To(Equal(initial.Routes.Version))
+			Expect(reverted.Clusters.Version).To(Equal(initial.Clusters.Version))
+			Expect(reverted.Endpoints.Version).To(Equal(initial.Endpoints.Version))
+			Expect(reverted.Secrets.Version).To(Equal(initial.Secrets.Version))
+		})
+	})
+})
+
+var _ = Describe("debouncingSnapshotCacher", func() {
+
+	var xdsContext core_xds.XdsContext
+	var cacher *debouncingSnapshotCacher
+	node := &envoy_core.Node{Id: "demo.example"}
+
+	BeforeEach(func() {
+		xdsContext = core_xds.NewXdsContext()
+		cacher = newDebouncingSnapshotCacher(&simpleSnapshotCacher{xdsContext.Hasher(), xdsContext.Cache()}, 100*time.Millisecond)
+	})
+
+	snapshotOf := func(version string) envoy_cache.Snapshot {
+		return envoy_cache.NewSnapshot(version, nil, nil, nil, []envoy_cache.Resource{&envoy.Listener{Name: version}}, nil)
+	}
+
+	pushedVersion := func() string {
+		snapshot, err := xdsContext.Cache().GetSnapshot("demo.example")
+		Expect(err).ToNot(HaveOccurred())
+		return snapshot.Listeners.Version
+	}
+
+	It("should coalesce changes made within the interval after a push", func() {
+		// when
+		Expect(cacher.Cache(node, snapshotOf("v1"))).To(Succeed())
+
+		// then the first change is pushed right away
+		Expect(pushedVersion()).To(Equal("v1"))
+
+		// when
+		Expect(cacher.Cache(node, snapshotOf("v2"))).To(Succeed())
+		Expect(cacher.Cache(node, snapshotOf("v3"))).To(Succeed())
+
+		// then changes are deferred
+		Expect(pushedVersion()).To(Equal("v1"))
+		// but the latest snapshot is visible to the reconciler
+		latest, err := cacher.Get(node)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(latest.Listeners.Version).To(Equal("v3"))
+
+		// and only the latest change is pushed once the interval elapses
+		Eventually(pushedVersion).Should(Equal("v3"))
+		Consistently(pushedVersion, "200ms", "20ms").Should(Equal("v3"))
+	})
+
+	It("should not defer changes after a snapshot that has not changed", func() {
+		// given
+		Expect(cacher.Cache(node, snapshotOf("v1"))).To(Succeed())
+		Eventually(func() int {
+			cacher.mu.Lock()
+			defer cacher.mu.Unlock()
+			return len(cacher.windows)
+		}).Should(Equal(0))
+
+		// when
+		Expect(cacher.Cache(node, snapshotOf("v1"))).To(Succeed())
+		Expect(cacher.Cache(node, snapshotOf("v2"))).To(Succeed())
+
+		// then
+		Expect(pushedVersion()).To(Equal("v2"))
+	})
+
+	It("should drop a deferred change of a cleared node", func() {
+		// given
+		Expect(cacher.Cache(node, snapshotOf("v1"))).To(Succeed())
+		Expect(cacher.Cache(node, snapshotOf("v2"))).To(Succeed())
+
+		// when
+		cacher.Clear(node)
+
+		// then
+		Consistently(func() error {
+			_, err := xdsContext.Cache().GetSnapshot("demo.example")
+			return err
+		}, "200ms", "20ms").Should(HaveOccurred())
 	})
 })
 
@@ -3,6 +3,9 @@ package topology
 import (
 	"context"
 
+	"github.com/golang/protobuf/proto"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	core_policy "github.com/Kong/kuma/pkg/core/policy"
 	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
 	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
@@ -25,3 +28,15 @@ func GetTrafficTrace(ctx context.Context, dataplane *mesh_core.DataplaneResource
 	}
 	return nil, nil
 }
+
+// GetTracingBackend returns a tracing backend of the Mesh referenced by TrafficTrace.
+// Sampling defined in TrafficTrace takes precedence over sampling of the backend.
+func GetTracingBackend(mesh *mesh_core.MeshResource, trafficTrace *mesh_core.TrafficTraceResource) *mesh_proto.TracingBackend {
+	backend := mesh.GetTracingBackend(trafficTrace.Spec.GetConf().GetBackend())
+	if backend == nil || trafficTrace.Spec.GetConf().GetSampling() == nil {
+		return backend
+	}
+	backend = proto.Clone(backend).(*mesh_proto.TracingBackend)
+	backend.Sampling = trafficTrace.Spec.GetConf().GetSampling()
+	return backend
+}
@@ -3,6 +3,8 @@ package topology_test
 import (
 	"context"
 
+	"github.com/golang/protobuf/ptypes/wrappers"
+
 	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	core_mesh "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
 	resources_manager "github.com/Kong/kuma/pkg/core/resources/manager"
@@ -106,3 +108,73 @@ var _ = Describe("GetTrafficTrace", func() {
 		Expect(picked).To(BeNil())
 	})
 })
+
+var _ = Describe("GetTracingBackend", func() {
+
+	mesh := &core_mesh.MeshResource{
+		Spec: mesh_proto.Mesh{
+			Tracing: &mesh_proto.Tracing{
+				DefaultBackend: "zipkin-us",
+				Backends: []*mesh_proto.TracingBackend{
+					{
+						Name:     "zipkin-us",
+						Sampling: &wrappers.DoubleValue{Value: 50.0},
+					},
+					{
+						Name: "jaeger-eu",
+					},
+				},
+			},
+		},
+	}
+
+	It("should return default backend of the Mesh when TrafficTrace does not refer any", func() {
+		// given
+		trafficTrace := &core_mesh.TrafficTraceResource{}
+
+		// when
+		backend := topology.GetTracingBackend(mesh, trafficTrace)
+
+		// then
+		Expect(backend).To(Equal(mesh.Spec.Tracing.Backends[0]))
+	})
+
+	It("should override sampling of the backend with sampling of TrafficTrace", func() {
+		// given
+		trafficTrace := &core_mesh.TrafficTraceResource{
+			Spec: mesh_proto.TrafficTrace{
+				Conf: &mesh_proto.TrafficTrace_Conf{
+					Backend:  "zipkin-us",
+					Sampling: &wrappers.DoubleValue{Value: 10.0},
+				},
+			},
+		}
+
+		// when
+		backend := topology.GetTracingBackend(mesh, trafficTrace)
+
+		// then
+		Expect(backend.Name).To(Equal("zipkin-us"))
+		Expect(backend.Sampling.Value).To(Equal(10.0))
+		// and the Mesh is not modified
+		Expect(mesh.Spec.Tracing.Backends[0].Sampling.Value).To(Equal(50.0))
+	})
+
+	It("should return nil when TrafficTrace refers unknown backend", func() {
+		// given
+		trafficTrace := &core_mesh.TrafficTraceResource{
+			Spec: mesh_proto.TrafficTrace{
+				Conf: &mesh_proto.TrafficTrace_Conf{
+					Backend:  "zipkin-eu",
+					Sampling: &wrappers.DoubleValue{Value: 10.0},
+				},
+			},
+		}
+
+		// when
+		backend := topology.GetTracingBackend(mesh, trafficTrace)
+
+		// then
+		Expect(backend).To(BeNil())
+	})
+})
@@ -53,5 +53,6 @@ gen_help kumactl get secret
 gen_help kumactl get secrets
 gen_help kumactl delete
 gen_help kumactl inspect
+gen_help kumactl inspect dataplane
 gen_help kumactl inspect dataplanes
 gen_help kumactl version